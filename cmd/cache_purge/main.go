@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/Ippolid/auth/internal/client/cache/redis"
+	"github.com/Ippolid/auth/internal/config"
+	cache "github.com/Ippolid/auth/internal/repository/redis"
+)
+
+// Одноразовая миграция кэша: удаляет ключи старого формата (профили с хешем пароля по голому ID,
+// роли по голому имени и множества эндпоинтов ролей), оставшиеся после перехода на ключи auth:v1:*.
+func main() {
+	configPath := flag.String("config-path", "./.env", "path to config file")
+	dryRun := flag.Bool("dry-run", false, "only list legacy keys without deleting them")
+	flag.Parse()
+
+	if err := run(context.Background(), *configPath, *dryRun); err != nil {
+		log.Fatalf("failed to purge legacy cache keys: %s", err.Error())
+	}
+}
+
+func run(ctx context.Context, configPath string, dryRun bool) error {
+	if err := config.Load(configPath); err != nil {
+		return err
+	}
+
+	redisConfig, err := config.NewRedisConfig()
+	if err != nil {
+		return err
+	}
+
+	pool := redis.NewPool(redisConfig)
+	defer func() {
+		_ = pool.Close()
+	}()
+
+	keys, err := cache.PurgeLegacyKeys(ctx, redis.NewClient(pool, redisConfig), dryRun)
+	for _, key := range keys {
+		log.Printf("legacy key: %s", key)
+	}
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		log.Printf("found %d legacy cache keys", len(keys))
+		return nil
+	}
+	log.Printf("purged %d legacy cache keys", len(keys))
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package access
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/Ippolid/auth/internal/model"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	adminRole = "admin"
+
+	defaultAllow = "allow"
+	defaultDeny  = "deny"
+)
+
+// fileConfig формат файла правил доступа (см. deploy/access.yaml)
+type fileConfig struct {
+	Default   string                   `yaml:"default"`
+	Endpoints map[string]endpointRoles `yaml:"endpoints"`
+	Rules     []fileRule               `yaml:"rules"`
+}
+
+// fileRule правило в секции rules. Effect по умолчанию — allow.
+type fileRule struct {
+	Pattern string   `yaml:"pattern"`
+	Effect  string   `yaml:"effect"`
+	Roles   []string `yaml:"roles"`
+}
+
+// endpointRoles список ролей эндпоинта. Для совместимости со старым форматом принимает и bool:
+// true — только администратор, false — любой пользователь.
+type endpointRoles []string
+
+func (r *endpointRoles) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!bool" {
+		var adminOnly bool
+		if err := node.Decode(&adminOnly); err != nil {
+			return err
+		}
+
+		*r = endpointRoles{}
+		if adminOnly {
+			*r = endpointRoles{adminRole}
+		}
+		return nil
+	}
+
+	var roles []string
+	if err := node.Decode(&roles); err != nil {
+		return fmt.Errorf("строка %d: ожидается bool или список ролей: %w", node.Line, err)
+	}
+	*r = roles
+
+	return nil
+}
+
+// fileRules правила из файла вместе с режимом по умолчанию
+type fileRules struct {
+	rules       []model.AccessRule
+	defaultDeny bool
+}
+
+func loadFile(path string) (*fileRules, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения файла правил доступа '%s': %w", path, err)
+	}
+
+	loaded, err := parseFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка парсинга YAML из '%s': %w", path, err)
+	}
+
+	return loaded, nil
+}
+
+// parseFile разбирает и проверяет YAML с правилами доступа.
+// Неизвестные ключи и некорректные шаблоны считаются ошибкой, чтобы опечатка
+// не открывала эндпоинт молча.
+func parseFile(data []byte) (*fileRules, error) {
+	var cfg fileConfig
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	switch cfg.Default {
+	case "", defaultAllow, defaultDeny:
+	default:
+		return nil, fmt.Errorf("default: ожидается %s или %s, получено %q", defaultAllow, defaultDeny, cfg.Default)
+	}
+
+	// Секция endpoints — разрешающие правила; сортируем ключи, чтобы порядок не зависел от мапы
+	endpoints := make([]string, 0, len(cfg.Endpoints))
+	for endpoint := range cfg.Endpoints {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
+	loaded := &fileRules{
+		rules:       make([]model.AccessRule, 0, len(endpoints)+len(cfg.Rules)),
+		defaultDeny: cfg.Default == defaultDeny,
+	}
+	for _, endpoint := range endpoints {
+		loaded.rules = append(loaded.rules, model.AccessRule{
+			Pattern: endpoint,
+			Effect:  model.AccessAllow,
+			Roles:   cfg.Endpoints[endpoint],
+		})
+	}
+
+	for _, r := range cfg.Rules {
+		effect := r.Effect
+		if effect == "" {
+			effect = model.AccessAllow
+		}
+
+		loaded.rules = append(loaded.rules, model.AccessRule{
+			Pattern: r.Pattern,
+			Effect:  effect,
+			Roles:   r.Roles,
+		})
+	}
+
+	// Проверяем правила заранее: сломанный файл не должен подменить рабочую политику
+	if _, err := NewPolicy(loaded.rules, loaded.defaultDeny); err != nil {
+		return nil, err
+	}
+
+	return loaded, nil
+}
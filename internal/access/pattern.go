@@ -0,0 +1,98 @@
+package access
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+const (
+	prefixSuffix = "**"
+	globChars    = "*?["
+)
+
+type patternKind int
+
+// Порядок важен: чем больше значение, тем конкретнее шаблон
+const (
+	kindPrefix patternKind = iota
+	kindGlob
+	kindExact
+)
+
+type pattern struct {
+	raw    string
+	kind   patternKind
+	prefix string
+	// literal число символов шаблона без метасимволов — мера конкретности внутри одного вида
+	literal int
+}
+
+// ValidatePattern проверяет синтаксис шаблона эндпоинта
+func ValidatePattern(raw string) error {
+	_, err := compilePattern(raw)
+	return err
+}
+
+// MatchPattern сообщает, подходит ли эндпоинт под шаблон. Некорректный шаблон не подходит ни под что.
+func MatchPattern(raw, endpoint string) bool {
+	p, err := compilePattern(raw)
+	if err != nil {
+		return false
+	}
+
+	return p.match(endpoint)
+}
+
+func compilePattern(raw string) (pattern, error) {
+	if !strings.HasPrefix(raw, "/") {
+		return pattern{}, fmt.Errorf("pattern %q must start with /", raw)
+	}
+
+	if strings.HasSuffix(raw, prefixSuffix) {
+		prefix := strings.TrimSuffix(raw, prefixSuffix)
+		if strings.ContainsAny(prefix, globChars) {
+			return pattern{}, fmt.Errorf("pattern %q: prefix rule cannot contain other wildcards", raw)
+		}
+
+		return pattern{raw: raw, kind: kindPrefix, prefix: prefix, literal: len(prefix)}, nil
+	}
+
+	if !strings.ContainsAny(raw, globChars) {
+		return pattern{raw: raw, kind: kindExact, literal: len(raw)}, nil
+	}
+
+	if _, err := path.Match(raw, ""); err != nil {
+		return pattern{}, fmt.Errorf("pattern %q: %w", raw, err)
+	}
+
+	literal := 0
+	for _, r := range raw {
+		if !strings.ContainsRune(globChars, r) {
+			literal++
+		}
+	}
+
+	return pattern{raw: raw, kind: kindGlob, literal: literal}, nil
+}
+
+func (p pattern) match(endpoint string) bool {
+	switch p.kind {
+	case kindExact:
+		return p.raw == endpoint
+	case kindPrefix:
+		return strings.HasPrefix(endpoint, p.prefix)
+	default:
+		ok, _ := path.Match(p.raw, endpoint)
+		return ok
+	}
+}
+
+// moreSpecific сообщает, должен ли шаблон p проверяться раньше шаблона o
+func (p pattern) moreSpecific(o pattern) bool {
+	if p.kind != o.kind {
+		return p.kind > o.kind
+	}
+
+	return p.literal > o.literal
+}
@@ -0,0 +1,137 @@
+package access
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"go.uber.org/zap"
+)
+
+// Policy скомпилированный набор правил доступа.
+// Правила упорядочены от самого конкретного к самому общему: точный адрес, затем glob,
+// затем префикс; внутри вида — по длине литеральной части. При равенстве deny проверяется
+// раньше allow, а дальше сохраняется порядок из конфигурации.
+type Policy struct {
+	rules       []rule
+	defaultDeny bool
+
+	// grants права ролей из таблицы access: роль -> шаблоны эндпоинтов
+	grants map[string][]pattern
+}
+
+type rule struct {
+	model.AccessRule
+	pattern pattern
+}
+
+// Decision результат проверки эндпоинта политикой
+type Decision struct {
+	// Allowed доступ разрешен политикой
+	Allowed bool
+	// Final отказ окончательный (явный deny); иначе его может снять право роли из таблицы access
+	Final bool
+	// Rule правило, принявшее решение; nil — ни одно правило не подошло
+	Rule *model.AccessRule
+}
+
+// NewPolicy проверяет и компилирует правила. defaultDeny запрещает эндпоинты,
+// под которые не подошло ни одно правило.
+func NewPolicy(rules []model.AccessRule, defaultDeny bool) (*Policy, error) {
+	compiled := make([]rule, 0, len(rules))
+	for _, r := range rules {
+		if r.Effect != model.AccessAllow && r.Effect != model.AccessDeny {
+			return nil, fmt.Errorf("rule %q: unknown effect %q", r.Pattern, r.Effect)
+		}
+
+		p, err := compilePattern(r.Pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		compiled = append(compiled, rule{AccessRule: r, pattern: p})
+	}
+
+	sort.SliceStable(compiled, func(i, j int) bool {
+		a, b := compiled[i], compiled[j]
+		if a.pattern.moreSpecific(b.pattern) {
+			return true
+		}
+		if b.pattern.moreSpecific(a.pattern) {
+			return false
+		}
+
+		return a.Effect == model.AccessDeny && b.Effect == model.AccessAllow
+	})
+
+	return &Policy{rules: compiled, defaultDeny: defaultDeny}, nil
+}
+
+// Evaluate проверяет доступ к эндпоинту для набора ролей.
+// Deny-правило с ролями касается только их владельцев, для остальных проверка продолжается
+// на более общих правилах. Первое подошедшее allow-правило решает окончательно: если ролей
+// вызывающего в нем нет, доступ дают только права из таблицы access.
+func (p *Policy) Evaluate(endpoint string, roles []string) Decision {
+	for i := range p.rules {
+		r := &p.rules[i]
+		if !r.pattern.match(endpoint) {
+			continue
+		}
+
+		applies := len(r.Roles) == 0 || hasAnyRole(roles, r.Roles)
+
+		if r.Effect == model.AccessDeny {
+			if applies {
+				return Decision{Final: true, Rule: &r.AccessRule}
+			}
+			continue
+		}
+
+		return Decision{Allowed: applies, Rule: &r.AccessRule}
+	}
+
+	return Decision{Allowed: !p.defaultDeny}
+}
+
+// WithGrants возвращает копию политики с правами ролей из таблицы access.
+// Права с некорректным шаблоном пропускаются: одна плохая строка в таблице не должна ломать проверку.
+func (p *Policy) WithGrants(grants []model.RolePermission) *Policy {
+	withGrants := *p
+	withGrants.grants = make(map[string][]pattern, len(grants))
+	for _, grant := range grants {
+		compiled, err := compilePattern(grant.Endpoint)
+		if err != nil {
+			logger.Warn("skipping invalid access grant", zap.String("role", grant.Role), zap.Error(err))
+			continue
+		}
+
+		withGrants.grants[grant.Role] = append(withGrants.grants[grant.Role], compiled)
+	}
+
+	return &withGrants
+}
+
+// Grant возвращает право из таблицы access, которым эндпоинт выдан одной из ролей, или nil.
+// Используется, когда Evaluate отказал не окончательно.
+func (p *Policy) Grant(endpoint string, roles []string) *model.RolePermission {
+	for _, role := range roles {
+		for _, granted := range p.grants[role] {
+			if granted.match(endpoint) {
+				return &model.RolePermission{Role: role, Endpoint: granted.raw}
+			}
+		}
+	}
+
+	return nil
+}
+
+func hasAnyRole(roles, required []string) bool {
+	for _, role := range required {
+		if model.HasRole(roles, role) {
+			return true
+		}
+	}
+
+	return false
+}
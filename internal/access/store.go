@@ -0,0 +1,222 @@
+package access
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/metric"
+	"github.com/Ippolid/auth/internal/model"
+	"go.uber.org/zap"
+)
+
+const (
+	sourceFile = "file"
+	sourceDB   = "db"
+
+	resultOK    = "ok"
+	resultError = "error"
+)
+
+// Source источник текущей политики доступа
+type Source interface {
+	Policy() *Policy
+}
+
+// GrantsReloader перечитывает права ролей, не дожидаясь периодической проверки
+type GrantsReloader interface {
+	ReloadGrants(ctx context.Context) error
+}
+
+// GrantsLoader загружает права ролей из таблицы access
+type GrantsLoader func(ctx context.Context) ([]model.RolePermission, error)
+
+// Store хранит политику, собранную из файла правил и таблицы access.
+// Политика подменяется атомарно, поэтому Check читает ее без блокировок во время перезагрузки.
+type Store struct {
+	path       string
+	loadGrants GrantsLoader
+	current    atomic.Pointer[Policy]
+
+	// mu защищает поля ниже и сериализует перезагрузки
+	mu      sync.Mutex
+	modTime time.Time
+	file    *fileRules
+	grants  []model.RolePermission
+}
+
+// NewStatic создает хранилище с фиксированной политикой без перезагрузки
+func NewStatic(policy *Policy) *Store {
+	s := &Store{}
+	s.current.Store(policy)
+
+	return s
+}
+
+// NewStore загружает правила из файла и права из таблицы access.
+// Ошибка любой из загрузок при старте фатальна: работать без политики нельзя.
+func NewStore(ctx context.Context, path string, loadGrants GrantsLoader) (*Store, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := loadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := loadGrants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Store{
+		path:       path,
+		loadGrants: loadGrants,
+		modTime:    info.ModTime(),
+		file:       file,
+		grants:     grants,
+	}
+	if err = s.rebuild(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Policy возвращает текущую политику
+func (s *Store) Policy() *Policy {
+	return s.current.Load()
+}
+
+// Reload перечитывает файл правил и таблицу access. При ошибке остается предыдущая политика.
+func (s *Store) Reload(ctx context.Context) error {
+	if _, err := s.reloadFile(true); err != nil {
+		return err
+	}
+
+	return s.reloadGrants(ctx)
+}
+
+// ReloadGrants перечитывает только таблицу access: вызывается после изменения прав ролей,
+// чтобы они действовали сразу, а не после очередной проверки Watch.
+// Для статического хранилища ничего не делает.
+func (s *Store) ReloadGrants(ctx context.Context) error {
+	if s.loadGrants == nil {
+		return nil
+	}
+
+	return s.reloadGrants(ctx)
+}
+
+// Watch раз в interval проверяет время изменения файла и содержимое таблицы access
+// и пересобирает политику при изменениях. Завершается вместе с ctx.
+// Для статического хранилища ничего не делает.
+func (s *Store) Watch(ctx context.Context, interval time.Duration) {
+	if s.path == "" {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Ошибки уже залогированы, предыдущая политика остается в силе
+			_, _ = s.reloadFile(false)
+			_ = s.reloadGrants(ctx)
+		}
+	}
+}
+
+// reloadFile перечитывает файл правил, если он изменился (или всегда при force).
+// Невалидный файл отклоняется, а предыдущая политика остается в силе.
+func (s *Store) reloadFile(force bool) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		logger.Error("failed to stat access rules file", zap.String("path", s.path), zap.Error(err))
+		return false, err
+	}
+	if !force && info.ModTime().Equal(s.modTime) {
+		return false, nil
+	}
+	// Не пытаемся перечитывать тот же сломанный файл на каждом тике
+	s.modTime = info.ModTime()
+
+	file, err := loadFile(s.path)
+	if err == nil {
+		previous := s.file
+		s.file = file
+		if err = s.rebuild(); err != nil {
+			s.file = previous
+		}
+	}
+	if err != nil {
+		metric.IncAccessReloadCounter(sourceFile, resultError)
+		logger.Error("failed to reload access rules, keeping previous policy", zap.String("path", s.path), zap.Error(err))
+		return false, err
+	}
+
+	metric.IncAccessReloadCounter(sourceFile, resultOK)
+	logger.Info("access rules reloaded",
+		zap.String("path", s.path),
+		zap.Int("rules", len(file.rules)),
+		zap.Bool("default_deny", file.defaultDeny),
+	)
+
+	return true, nil
+}
+
+// reloadGrants перечитывает таблицу access и пересобирает политику, если права изменились
+func (s *Store) reloadGrants(ctx context.Context) error {
+	grants, err := s.loadGrants(ctx)
+	if err != nil {
+		metric.IncAccessReloadCounter(sourceDB, resultError)
+		logger.Error("failed to reload access grants, keeping previous policy", zap.Error(err))
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if reflect.DeepEqual(grants, s.grants) {
+		return nil
+	}
+
+	previous := s.grants
+	s.grants = grants
+	if err = s.rebuild(); err != nil {
+		s.grants = previous
+		metric.IncAccessReloadCounter(sourceDB, resultError)
+		logger.Error("failed to rebuild access policy", zap.Error(err))
+		return err
+	}
+
+	metric.IncAccessReloadCounter(sourceDB, resultOK)
+	logger.Info("access grants reloaded", zap.Int("grants", len(grants)))
+
+	return nil
+}
+
+// rebuild собирает политику из текущих правил и прав и атомарно подменяет ее
+func (s *Store) rebuild() error {
+	policy, err := NewPolicy(s.file.rules, s.file.defaultDeny)
+	if err != nil {
+		return err
+	}
+
+	s.current.Store(policy.WithGrants(s.grants))
+
+	return nil
+}
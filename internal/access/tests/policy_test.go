@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/Ippolid/auth/internal/access"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyEvaluate(t *testing.T) {
+	rules := []model.AccessRule{
+		{Pattern: "/chat_server_v1.ChatV1/**", Effect: model.AccessAllow, Roles: []string{"support"}},
+		{Pattern: "/chat_server_v1.ChatV1/*", Effect: model.AccessAllow},
+		{Pattern: "/chat_server_v1.ChatV1/Delete", Effect: model.AccessAllow, Roles: []string{"moderator"}},
+		{Pattern: "/*.*V1/Export", Effect: model.AccessDeny},
+		{Pattern: "/user_v1.UserV1/*", Effect: model.AccessDeny, Roles: []string{"banned"}},
+		{Pattern: "/user_v1.**", Effect: model.AccessAllow},
+	}
+
+	tests := []struct {
+		name        string
+		defaultDeny bool
+		endpoint    string
+		roles       []string
+		want        access.Decision
+		wantPattern string
+	}{
+		{
+			name:        "glob wins over prefix",
+			endpoint:    "/chat_server_v1.ChatV1/Send",
+			roles:       []string{model.RoleUser},
+			want:        access.Decision{Allowed: true},
+			wantPattern: "/chat_server_v1.ChatV1/*",
+		},
+		{
+			name:        "exact wins over glob",
+			endpoint:    "/chat_server_v1.ChatV1/Delete",
+			roles:       []string{model.RoleUser},
+			want:        access.Decision{},
+			wantPattern: "/chat_server_v1.ChatV1/Delete",
+		},
+		{
+			name:        "method wildcard deny",
+			endpoint:    "/report_v1.ReportV1/Export",
+			roles:       []string{model.RoleUser},
+			want:        access.Decision{Final: true},
+			wantPattern: "/*.*V1/Export",
+		},
+		{
+			name:        "deny with roles applies only to their holders",
+			endpoint:    "/user_v1.UserV1/Get",
+			roles:       []string{"banned"},
+			want:        access.Decision{Final: true},
+			wantPattern: "/user_v1.UserV1/*",
+		},
+		{
+			name:        "deny with roles skipped for others",
+			endpoint:    "/user_v1.UserV1/Get",
+			roles:       []string{model.RoleUser},
+			want:        access.Decision{Allowed: true},
+			wantPattern: "/user_v1.**",
+		},
+		{
+			name:     "unknown endpoint allowed by default",
+			endpoint: "/unknown.Service/Method",
+			want:     access.Decision{Allowed: true},
+		},
+		{
+			name:        "unknown endpoint in default-deny mode",
+			defaultDeny: true,
+			endpoint:    "/unknown.Service/Method",
+			want:        access.Decision{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := access.NewPolicy(rules, tt.defaultDeny)
+			require.NoError(t, err)
+
+			got := policy.Evaluate(tt.endpoint, tt.roles)
+			require.Equal(t, tt.want.Allowed, got.Allowed)
+			require.Equal(t, tt.want.Final, got.Final)
+			if tt.wantPattern == "" {
+				require.Nil(t, got.Rule)
+				return
+			}
+			require.NotNil(t, got.Rule)
+			require.Equal(t, tt.wantPattern, got.Rule.Pattern)
+		})
+	}
+}
+
+func TestNewPolicyRejectsInvalidRules(t *testing.T) {
+	for _, r := range []model.AccessRule{
+		{Pattern: "chat_server_v1.ChatV1/Send", Effect: model.AccessAllow},
+		{Pattern: "/chat_server_v1.ChatV1/[", Effect: model.AccessAllow},
+		{Pattern: "/chat_*/**", Effect: model.AccessAllow},
+		{Pattern: "/chat_server_v1.ChatV1/Send", Effect: "permit"},
+	} {
+		_, err := access.NewPolicy([]model.AccessRule{r}, false)
+		require.Error(t, err, r.Pattern)
+	}
+}
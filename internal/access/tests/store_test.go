@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Ippolid/auth/internal/access"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+const rulesV1 = `
+endpoints:
+  "/v1/user/delete": true
+  "/v1/user/get": false
+`
+
+const rulesV2 = `
+default: deny
+endpoints:
+  "/v1/user/delete": ["admin", "support"]
+rules:
+  - pattern: "/chat_server_v1.ChatV1/*"
+    roles: ["support"]
+`
+
+func writeRules(t *testing.T, path, content string) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestStoreReload(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "access.yaml")
+	writeRules(t, path, rulesV1)
+
+	grants := []model.RolePermission{{Role: "billing", Endpoint: "/v1/user/delete"}}
+	loadGrants := func(context.Context) ([]model.RolePermission, error) {
+		return grants, nil
+	}
+
+	store, err := access.NewStore(ctx, path, loadGrants)
+	require.NoError(t, err)
+
+	support := []string{"support"}
+	require.False(t, store.Policy().Evaluate("/v1/user/delete", support).Allowed)
+	require.True(t, store.Policy().Evaluate("/unknown.Service/Method", support).Allowed)
+	require.NotNil(t, store.Policy().Grant("/v1/user/delete", []string{"billing"}))
+
+	// Новые правила и права из таблицы подхватываются без пересоздания хранилища
+	writeRules(t, path, rulesV2)
+	grants = []model.RolePermission{{Role: "billing", Endpoint: "/report_v1.**"}}
+	require.NoError(t, store.Reload(ctx))
+
+	policy := store.Policy()
+	require.True(t, policy.Evaluate("/v1/user/delete", support).Allowed)
+	require.True(t, policy.Evaluate("/chat_server_v1.ChatV1/Send", support).Allowed)
+	require.False(t, policy.Evaluate("/unknown.Service/Method", support).Allowed)
+	require.Nil(t, policy.Grant("/v1/user/delete", []string{"billing"}))
+	require.Equal(t, &model.RolePermission{Role: "billing", Endpoint: "/report_v1.**"},
+		policy.Grant("/report_v1.ReportV1/Export", []string{"billing"}))
+
+	// Сломанный файл отклоняется, предыдущая политика остается
+	for _, broken := range []string{
+		"endpoints: [",
+		"endpoint:\n  \"/v1/user/delete\": true\n",
+		"rules:\n  - pattern: \"v1/user/*\"\n",
+		"default: maybe\n",
+	} {
+		writeRules(t, path, broken)
+		require.Error(t, store.Reload(ctx), broken)
+		require.Same(t, policy, store.Policy())
+	}
+}
+
+func TestStoreReloadGrants(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "access.yaml")
+	writeRules(t, path, rulesV1)
+
+	var grants []model.RolePermission
+	store, err := access.NewStore(ctx, path, func(context.Context) ([]model.RolePermission, error) {
+		return grants, nil
+	})
+	require.NoError(t, err)
+
+	// Права применяются сразу, даже если файл правил сейчас сломан
+	writeRules(t, path, "endpoints: [")
+	grants = []model.RolePermission{{Role: "billing", Endpoint: "/v1/user/delete"}}
+	require.NoError(t, store.ReloadGrants(ctx))
+	require.NotNil(t, store.Policy().Grant("/v1/user/delete", []string{"billing"}))
+
+	// Статическое хранилище не перезагружается
+	require.NoError(t, access.NewStatic(store.Policy()).ReloadGrants(ctx))
+}
@@ -17,3 +17,23 @@ func (i *Controller) Check(ctx context.Context, req *auth_v1.CheckRequest) (*emp
 
 	return &emptypb.Empty{}, nil
 }
+
+// CheckMany обрабатывает запрос на проверку доступа к нескольким эндпоинтам
+func (i *Controller) CheckMany(ctx context.Context, req *auth_v1.CheckManyRequest) (*auth_v1.CheckManyResponse, error) {
+	decisions, err := i.authService.CheckMany(ctx, *converter.ToCheckManyFromAuthAPI(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToCheckManyAPIFromDecisions(decisions), nil
+}
+
+// ExplainAccess обрабатывает запрос на пробную проверку доступа
+func (i *Controller) ExplainAccess(ctx context.Context, req *auth_v1.ExplainAccessRequest) (*auth_v1.ExplainAccessResponse, error) {
+	decision, err := i.authService.ExplainAccess(ctx, *converter.ToExplainAccessFromAuthAPI(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth_v1.ExplainAccessResponse{Decision: converter.ToEndpointDecisionAPI(decision)}, nil
+}
@@ -14,7 +14,5 @@ func (i *Controller) Login(ctx context.Context, req *auth_v1.LoginRequest) (*aut
 		return nil, err
 	}
 
-	return &auth_v1.LoginResponse{
-		RefreshToken: resp.Token,
-	}, nil
+	return converter.ToLoginAPIFromService(resp), nil
 }
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/converter"
+	"github.com/Ippolid/auth/pkg/auth_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Logout обрабатывает запрос на завершение сессии
+func (i *Controller) Logout(ctx context.Context, req *auth_v1.LogoutRequest) (*emptypb.Empty, error) {
+	err := i.authService.Logout(ctx, *converter.ToLogoutFromAuthAPI(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// LogoutAll обрабатывает запрос на завершение всех сессий пользователя
+func (i *Controller) LogoutAll(ctx context.Context, req *auth_v1.LogoutAllRequest) (*emptypb.Empty, error) {
+	err := i.authService.LogoutAll(ctx, *converter.ToLogoutAllFromAuthAPI(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
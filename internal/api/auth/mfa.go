@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/converter"
+	"github.com/Ippolid/auth/pkg/auth_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// EnrollTOTP обрабатывает запрос на подключение TOTP
+func (i *Controller) EnrollTOTP(ctx context.Context, _ *emptypb.Empty) (*auth_v1.EnrollTOTPResponse, error) {
+	resp, err := i.authService.EnrollTOTP(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToEnrollTOTPAPIFromService(resp), nil
+}
+
+// ConfirmTOTP обрабатывает запрос на подтверждение TOTP
+func (i *Controller) ConfirmTOTP(ctx context.Context, req *auth_v1.ConfirmTOTPRequest) (*auth_v1.ConfirmTOTPResponse, error) {
+	codes, err := i.authService.ConfirmTOTP(ctx, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth_v1.ConfirmTOTPResponse{RecoveryCodes: codes}, nil
+}
+
+// VerifyMFA обрабатывает второй шаг входа
+func (i *Controller) VerifyMFA(ctx context.Context, req *auth_v1.VerifyMFARequest) (*auth_v1.LoginResponse, error) {
+	resp, err := i.authService.VerifyMFA(ctx, *converter.ToVerifyMFAFromAuthAPI(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToLoginAPIFromService(resp), nil
+}
@@ -0,0 +1,19 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/converter"
+	"github.com/Ippolid/auth/pkg/auth_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UnlockLogin обрабатывает запрос на снятие блокировки Login
+func (i *Controller) UnlockLogin(ctx context.Context, req *auth_v1.UnlockLoginRequest) (*emptypb.Empty, error) {
+	err := i.authService.UnlockLogin(ctx, *converter.ToUnlockLoginFromAuthAPI(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
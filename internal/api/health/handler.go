@@ -0,0 +1,57 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"go.uber.org/zap"
+)
+
+// Path адрес проверки состояния сервиса
+const Path = "/healthz"
+
+const (
+	statusOK       = "ok"
+	statusDegraded = "degraded"
+)
+
+// CacheState источник состояния кэша
+type CacheState interface {
+	Degraded() bool
+}
+
+// Handler отдает состояние сервиса. Недоступный Redis не делает сервис неработоспособным:
+// запросы обслуживаются из базы, поэтому ответ остается 200, а статус становится degraded.
+type Handler struct {
+	cache CacheState
+}
+
+type report struct {
+	Status string `json:"status"`
+	Cache  string `json:"cache"`
+}
+
+// NewHandler создает новый экземпляр Handler
+func NewHandler(cache CacheState) *Handler {
+	return &Handler{cache: cache}
+}
+
+// ServeHTTP обрабатывает запрос состояния
+func (h *Handler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	rep := report{Status: statusOK, Cache: statusOK}
+	if h.cache.Degraded() {
+		rep = report{Status: statusDegraded, Cache: "unavailable"}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(rep); err != nil {
+		logger.Error("Failed to write health response", zap.Error(err))
+	}
+}
+
+// HandlePath адаптер для runtime.ServeMux.HandlePath
+func (h *Handler) HandlePath(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	h.ServeHTTP(w, r)
+}
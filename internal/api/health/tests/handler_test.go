@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Ippolid/auth/internal/api/health"
+	"github.com/stretchr/testify/require"
+)
+
+type cacheState bool
+
+func (c cacheState) Degraded() bool {
+	return bool(c)
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		degraded   bool
+		wantStatus string
+		wantCache  string
+	}{
+		{
+			name:       "redis available",
+			degraded:   false,
+			wantStatus: "ok",
+			wantCache:  "ok",
+		},
+		{
+			name:       "redis unavailable",
+			degraded:   true,
+			wantStatus: "degraded",
+			wantCache:  "unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			health.NewHandler(cacheState(tt.degraded)).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, health.Path, nil))
+
+			// Без кэша сервис работает из базы, поэтому проверка не должна выводить его из балансировки
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			var body struct {
+				Status string `json:"status"`
+				Cache  string `json:"cache"`
+			}
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+			require.Equal(t, tt.wantStatus, body.Status)
+			require.Equal(t, tt.wantCache, body.Cache)
+		})
+	}
+}
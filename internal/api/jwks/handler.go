@@ -0,0 +1,54 @@
+package jwks
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/Ippolid/auth/internal/keyring"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/utils"
+	"go.uber.org/zap"
+)
+
+// Path адрес, по которому публикуются открытые ключи
+const Path = "/.well-known/jwks.json"
+
+// Handler отдает открытые ключи подписи access-токенов в формате JWKS (RFC 7517),
+// чтобы другие сервисы могли проверять токены локально, без секрета и без вызова Check
+type Handler struct {
+	keys keyring.Source
+}
+
+type keySet struct {
+	Keys []utils.JWK `json:"keys"`
+}
+
+// NewHandler создает новый экземпляр Handler
+func NewHandler(keys keyring.Source) *Handler {
+	return &Handler{keys: keys}
+}
+
+// ServeHTTP обрабатывает запрос на получение JWKS
+func (h *Handler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	// Публикуем все еще принимаемые ключи, чтобы токены, подписанные до ротации, проверялись по kid.
+	// Симметричные ключи не публикуются: при HS256 набор пуст
+	valid := h.keys.Access().Valid(time.Now())
+	set := keySet{Keys: make([]utils.JWK, 0, len(valid))}
+	for _, key := range valid {
+		if jwk, ok := key.JWK(); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	if err := json.NewEncoder(w).Encode(set); err != nil {
+		logger.Error("Failed to write JWKS response", zap.Error(err))
+	}
+}
+
+// HandlePath адаптер для runtime.ServeMux.HandlePath
+func (h *Handler) HandlePath(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	h.ServeHTTP(w, r)
+}
@@ -0,0 +1,19 @@
+package role
+
+import (
+	"github.com/Ippolid/auth/internal/service"
+	"github.com/Ippolid/auth/pkg/role_v1"
+)
+
+// Controller структура для обработки запросов управления ролями
+type Controller struct {
+	role_v1.UnimplementedRoleV1Server
+	roleService service.RoleService
+}
+
+// NewController создает новый экземпляр Controller
+func NewController(roleService service.RoleService) *Controller {
+	return &Controller{
+		roleService: roleService,
+	}
+}
@@ -0,0 +1,49 @@
+package role
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/converter"
+	"github.com/Ippolid/auth/pkg/role_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AddPermission выдает роли доступ к эндпоинту
+func (i *Controller) AddPermission(ctx context.Context, req *role_v1.PermissionRequest) (*emptypb.Empty, error) {
+	err := i.roleService.AddPermission(ctx, *converter.ToRolePermissionFromRoleAPI(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RemovePermission отзывает у роли доступ к эндпоинту
+func (i *Controller) RemovePermission(ctx context.Context, req *role_v1.PermissionRequest) (*emptypb.Empty, error) {
+	err := i.roleService.RemovePermission(ctx, *converter.ToRolePermissionFromRoleAPI(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// AssignRole назначает роль пользователю
+func (i *Controller) AssignRole(ctx context.Context, req *role_v1.UserRoleRequest) (*emptypb.Empty, error) {
+	err := i.roleService.AssignRole(ctx, *converter.ToUserRoleFromRoleAPI(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// UnassignRole снимает роль с пользователя
+func (i *Controller) UnassignRole(ctx context.Context, req *role_v1.UserRoleRequest) (*emptypb.Empty, error) {
+	err := i.roleService.UnassignRole(ctx, *converter.ToUserRoleFromRoleAPI(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,39 @@
+package role
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/converter"
+	"github.com/Ippolid/auth/pkg/role_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Create создает новую роль
+func (i *Controller) Create(ctx context.Context, req *role_v1.CreateRequest) (*role_v1.CreateResponse, error) {
+	id, err := i.roleService.Create(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &role_v1.CreateResponse{Id: id}, nil
+}
+
+// List возвращает все роли с их эндпоинтами
+func (i *Controller) List(ctx context.Context, _ *emptypb.Empty) (*role_v1.ListResponse, error) {
+	roles, err := i.roleService.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToRoleAPIFromRoles(roles), nil
+}
+
+// Delete удаляет роль
+func (i *Controller) Delete(ctx context.Context, req *role_v1.DeleteRequest) (*emptypb.Empty, error) {
+	err := i.roleService.Delete(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/Ippolid/auth/internal/converter"
+	"github.com/Ippolid/auth/internal/passwordpolicy"
 	"github.com/Ippolid/auth/pkg/user_v1"
 
 	"log"
@@ -11,6 +12,10 @@ import (
 
 // Create реализует метод создания пользователя
 func (i *Controller) Create(ctx context.Context, req *user_v1.CreateRequest) (*user_v1.CreateResponse, error) {
+	if err := passwordpolicy.Confirm(req.GetInfo().GetPassword(), req.GetInfo().GetPasswordConfirm()); err != nil {
+		return nil, err
+	}
+
 	id, err := i.userService.Create(ctx, converter.ToUserCreateFromUserAPI(req))
 	if err != nil {
 		return nil, err
@@ -5,8 +5,6 @@ import (
 	"log"
 
 	"github.com/Ippolid/auth/pkg/user_v1"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -16,7 +14,7 @@ func (i *Controller) Delete(ctx context.Context, req *user_v1.DeleteRequest) (*e
 
 	if err != nil {
 		log.Printf("failed to delete user: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
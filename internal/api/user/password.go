@@ -0,0 +1,64 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/converter"
+	"github.com/Ippolid/auth/internal/passwordpolicy"
+	"github.com/Ippolid/auth/pkg/user_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ChangePassword реализует метод смены пароля
+func (i *Controller) ChangePassword(ctx context.Context, req *user_v1.ChangePasswordRequest) (*emptypb.Empty, error) {
+	if err := passwordpolicy.Confirm(req.GetNewPassword(), req.GetNewPasswordConfirm()); err != nil {
+		return nil, err
+	}
+
+	if err := i.userService.ChangePassword(ctx, *converter.ToChangePasswordFromUserAPI(req)); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResetPassword реализует метод выдачи токена сброса пароля
+func (i *Controller) ResetPassword(ctx context.Context, req *user_v1.ResetPasswordRequest) (*user_v1.ResetPasswordResponse, error) {
+	resp, err := i.userService.ResetPassword(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToResetPasswordAPIFromService(resp), nil
+}
+
+// RequestPasswordReset реализует метод запроса ссылки для сброса пароля
+func (i *Controller) RequestPasswordReset(ctx context.Context, req *user_v1.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if err := i.userService.RequestPasswordReset(ctx, req.GetEmail()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ConfirmPasswordReset реализует метод установки пароля по токену сброса
+func (i *Controller) ConfirmPasswordReset(ctx context.Context, req *user_v1.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	if err := passwordpolicy.Confirm(req.GetNewPassword(), req.GetNewPasswordConfirm()); err != nil {
+		return nil, err
+	}
+
+	if err := i.userService.ConfirmPasswordReset(ctx, *converter.ToConfirmPasswordResetFromUserAPI(req)); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// VerifyEmail реализует метод подтверждения email по токену
+func (i *Controller) VerifyEmail(ctx context.Context, req *user_v1.VerifyEmailRequest) (*emptypb.Empty, error) {
+	if err := i.userService.VerifyEmail(ctx, req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
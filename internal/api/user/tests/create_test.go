@@ -50,7 +50,7 @@ func TestController_Create(t *testing.T) {
 				Email: &req.GetInfo().GetUser().Email,
 			},
 			Password: req.GetInfo().GetPassword(),
-			Role:     req.GetInfo().GetRole() > 0,
+			Roles:    []string{model.RoleAdmin},
 		}
 	)
 
@@ -85,6 +85,21 @@ func TestController_Create(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "password confirmation mismatch",
+			args: args{ctx: ctx, req: &desc.CreateRequest{
+				Info: &desc.UserInfoCreate{
+					User:            req.GetInfo().GetUser(),
+					Password:        "password",
+					PasswordConfirm: "passw0rd",
+				},
+			}},
+			wantResp: nil,
+			wantErr:  model.ErrWeakPassword,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return mocks.NewUserServiceMock(mc)
+			},
+		},
 	}
 
 	for _, tt := range tests {
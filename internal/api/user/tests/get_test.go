@@ -46,7 +46,7 @@ func TestController_Get(t *testing.T) {
 				Name:  ptr("Test Name"),
 				Email: ptr("test@example.com"),
 			},
-			Role:      true, // true соответствует Role_ADMIN
+			Roles:     []string{model.RoleAdmin}, // admin соответствует Role_ADMIN
 			Password:  "password",
 			CreatedAt: time.Time{}, // Нулевое значение времени
 		}
@@ -67,6 +67,7 @@ func TestController_Get(t *testing.T) {
 					Id:        user.ID,
 					Info:      &user_v1.UserInfo{Name: *user.User.Name, Email: *user.User.Email},
 					Role:      user_v1.Role_ADMIN,
+					Roles:     []string{model.RoleAdmin},
 					CreatedAt: timestamppb.New(time.Time{}),
 					UpdatedAt: timestamppb.New(time.Time{}),
 				},
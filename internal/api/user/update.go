@@ -6,8 +6,6 @@ import (
 
 	"github.com/Ippolid/auth/internal/converter"
 	"github.com/Ippolid/auth/pkg/user_v1"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -17,7 +15,7 @@ func (i *Controller) Update(ctx context.Context, req *user_v1.UpdateRequest) (*e
 	err := i.userService.Update(ctx, req.GetId(), user)
 	if err != nil {
 		log.Printf("failed to update user: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
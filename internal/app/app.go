@@ -14,11 +14,14 @@ import (
 	"github.com/Ippolid/auth/internal/tracing"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	"github.com/Ippolid/auth/internal/api/health"
+	"github.com/Ippolid/auth/internal/api/jwks"
 	"github.com/Ippolid/auth/internal/api/middleware"
 	"github.com/Ippolid/auth/internal/config"
 	"github.com/Ippolid/auth/internal/interceptor"
 	"github.com/Ippolid/auth/internal/logger"
 	"github.com/Ippolid/auth/pkg/auth_v1"
+	"github.com/Ippolid/auth/pkg/role_v1"
 	"github.com/Ippolid/auth/pkg/user_v1"
 	"github.com/Ippolid/platform_libary/pkg/closer"
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -162,8 +165,10 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 		grpc.UnaryInterceptor(
 			grpcMiddleware.ChainUnaryServer(
 				interceptor.ServerTracingInterceptor,
+				interceptor.ErrorCodesInterceptor,
 				interceptor.LogInterceptor,
 				interceptor.ValidateInterceptor,
+				interceptor.NewAuthInterceptor(a.serviceProvider.KeyRing(ctx), a.serviceProvider.Revocations(ctx), interceptor.ServerMethodRules),
 				interceptor.MetricsInterceptor,
 			),
 		),
@@ -172,6 +177,7 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	reflection.Register(a.grpcServer)
 	user_v1.RegisterUserV1Server(a.grpcServer, a.serviceProvider.UserController(ctx))
 	auth_v1.RegisterAuthServer(a.grpcServer, a.serviceProvider.AuthController(ctx))
+	role_v1.RegisterRoleV1Server(a.grpcServer, a.serviceProvider.RoleController(ctx))
 
 	return nil
 }
@@ -193,6 +199,18 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		return errors.Wrap(err, "failed to register UserV1 handler with grpc-gateway")
 	}
 
+	if err = role_v1.RegisterRoleV1HandlerFromEndpoint(ctx, mux, grpcAddr, dialOpts); err != nil {
+		return errors.Wrap(err, "failed to register RoleV1 handler with grpc-gateway")
+	}
+
+	if err = mux.HandlePath(http.MethodGet, jwks.Path, a.serviceProvider.JWKSHandler(ctx).HandlePath); err != nil {
+		return errors.Wrap(err, "failed to register JWKS handler")
+	}
+
+	if err = mux.HandlePath(http.MethodGet, health.Path, a.serviceProvider.HealthHandler(ctx).HandlePath); err != nil {
+		return errors.Wrap(err, "failed to register health handler")
+	}
+
 	corsMiddleware := middleware.NewCorsMiddleware()
 	a.httpServer = &http.Server{
 		Addr:              a.serviceProvider.HTTPConfig().Address(),
@@ -4,18 +4,32 @@ import (
 	"context"
 	"log"
 
+	"github.com/Ippolid/auth/internal/access"
 	"github.com/Ippolid/auth/internal/api/auth"
+	"github.com/Ippolid/auth/internal/api/health"
+	"github.com/Ippolid/auth/internal/api/jwks"
+	"github.com/Ippolid/auth/internal/api/role"
 
 	"github.com/Ippolid/auth/internal/client/cache/redis"
+	"github.com/Ippolid/auth/internal/client/notifier"
+	notifierFile "github.com/Ippolid/auth/internal/client/notifier/file"
+	notifierSMTP "github.com/Ippolid/auth/internal/client/notifier/smtp"
 
 	"github.com/Ippolid/auth/internal/api/user"
 	"github.com/Ippolid/auth/internal/config"
+	"github.com/Ippolid/auth/internal/keyring"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/passwordhash"
+	"github.com/Ippolid/auth/internal/passwordpolicy"
 	"github.com/Ippolid/auth/internal/repository"
 	auth2 "github.com/Ippolid/auth/internal/repository/auth"
 	redisCache "github.com/Ippolid/auth/internal/repository/redis"
+	role2 "github.com/Ippolid/auth/internal/repository/role"
 	user2 "github.com/Ippolid/auth/internal/repository/user"
+	"github.com/Ippolid/auth/internal/revocation"
 	"github.com/Ippolid/auth/internal/service"
 	auth3 "github.com/Ippolid/auth/internal/service/auth"
+	role3 "github.com/Ippolid/auth/internal/service/role"
 	user3 "github.com/Ippolid/auth/internal/service/user"
 	"github.com/Ippolid/platform_libary/pkg/closer"
 	"github.com/Ippolid/platform_libary/pkg/db"
@@ -25,29 +39,47 @@ import (
 )
 
 type serviceProvider struct {
-	pgConfig      config.PGConfig
-	grpcConfig    config.GRPCConfig
-	redisConfig   config.RedisConfig
-	httpConfig    config.HTTPConfig
-	swaggerConfig config.SwaggerConfig
-	tlsConfig     config.TLSConfig
-	jwtConfig     config.JWTConfig
-	accessConfig  config.AccessConfig
+	pgConfig       config.PGConfig
+	grpcConfig     config.GRPCConfig
+	redisConfig    config.RedisConfig
+	httpConfig     config.HTTPConfig
+	swaggerConfig  config.SwaggerConfig
+	tlsConfig      config.TLSConfig
+	jwtConfig      config.JWTConfig
+	accessConfig   config.AccessConfig
+	loginConfig    config.LoginProtectionConfig
+	passwordConfig config.PasswordPolicyConfig
+	notifierConfig config.NotifierConfig
+	verifyConfig   config.EmailVerificationConfig
+	hashConfig     config.PasswordHashConfig
+	mfaConfig      config.MFAConfig
+
+	keyRing      *keyring.Ring
+	accessPolicy *access.Store
+	passwords    *passwordpolicy.Policy
+	hasher       *passwordhash.Set
+	notifier     notifier.Notifier
 
 	dbClient       db.Client
 	txManager      db.TxManager
 	userRepository repository.UserRepository
 	authRepository repository.AuthRepository
+	roleRepository repository.RoleRepository
 
 	redisPool    *redigo.Pool
 	serviceCache repository.CacheInterface
-	redisClient  redis.Client
+	redisClient  *redis.Client
+	revocations  *revocation.Checker
 
 	userService service.UserService
 	authService service.AuthService
+	roleService service.RoleService
 
 	userController *user.Controller
 	authController *auth.Controller
+	roleController *role.Controller
+	jwksHandler    *jwks.Handler
+	healthHandler  *health.Handler
 
 	//logger *zap.Logger
 }
@@ -155,6 +187,199 @@ func (s *serviceProvider) GetJWTConfig(_ context.Context) config.JWTConfig {
 	return s.jwtConfig
 }
 
+func (s *serviceProvider) GetLoginProtectionConfig(_ context.Context) config.LoginProtectionConfig {
+	if s.loginConfig == nil {
+		cfg, err := config.NewLoginProtectionConfig()
+		if err != nil {
+			log.Fatalf("failed to get login protection config: %s", err.Error())
+		}
+		s.loginConfig = cfg
+	}
+	return s.loginConfig
+}
+
+func (s *serviceProvider) GetPasswordPolicyConfig(_ context.Context) config.PasswordPolicyConfig {
+	if s.passwordConfig == nil {
+		cfg, err := config.NewPasswordPolicyConfig()
+		if err != nil {
+			log.Fatalf("failed to get password policy config: %s", err.Error())
+		}
+		s.passwordConfig = cfg
+	}
+	return s.passwordConfig
+}
+
+// PasswordPolicy политика паролей для создания и смены пароля
+func (s *serviceProvider) PasswordPolicy(ctx context.Context) *passwordpolicy.Policy {
+	if s.passwords == nil {
+		cfg := s.GetPasswordPolicyConfig(ctx)
+
+		policy, err := passwordpolicy.New(passwordpolicy.Rules{
+			MinLength:      cfg.MinLength(),
+			MaxLength:      cfg.MaxLength(),
+			MinCharClasses: cfg.MinCharClasses(),
+		}, cfg.BlocklistPath())
+		if err != nil {
+			log.Fatalf("failed to init password policy: %v", err)
+		}
+		s.passwords = policy
+	}
+
+	return s.passwords
+}
+
+func (s *serviceProvider) GetEmailVerificationConfig(_ context.Context) config.EmailVerificationConfig {
+	if s.verifyConfig == nil {
+		cfg, err := config.NewEmailVerificationConfig()
+		if err != nil {
+			log.Fatalf("failed to get email verification config: %s", err.Error())
+		}
+		s.verifyConfig = cfg
+	}
+	return s.verifyConfig
+}
+
+func (s *serviceProvider) GetPasswordHashConfig(_ context.Context) config.PasswordHashConfig {
+	if s.hashConfig == nil {
+		cfg, err := config.NewPasswordHashConfig()
+		if err != nil {
+			log.Fatalf("failed to get password hash config: %s", err.Error())
+		}
+		s.hashConfig = cfg
+	}
+	return s.hashConfig
+}
+
+// PasswordHasher хеширует новые пароли выбранным алгоритмом и проверяет хеши обоих алгоритмов
+func (s *serviceProvider) PasswordHasher(ctx context.Context) *passwordhash.Set {
+	if s.hasher == nil {
+		cfg := s.GetPasswordHashConfig(ctx)
+
+		bcryptHasher, err := passwordhash.NewBcrypt(cfg.BcryptCost())
+		if err != nil {
+			log.Fatalf("failed to init bcrypt hasher: %v", err)
+		}
+		argon2Hasher, err := passwordhash.NewArgon2id(passwordhash.Argon2idParams{
+			Memory:      cfg.Argon2Memory(),
+			Iterations:  cfg.Argon2Iterations(),
+			Parallelism: cfg.Argon2Parallelism(),
+		})
+		if err != nil {
+			log.Fatalf("failed to init argon2id hasher: %v", err)
+		}
+
+		if cfg.Algorithm() == config.HashBcrypt {
+			s.hasher = passwordhash.New(cfg.Concurrency(), bcryptHasher, argon2Hasher)
+		} else {
+			s.hasher = passwordhash.New(cfg.Concurrency(), argon2Hasher, bcryptHasher)
+		}
+	}
+
+	return s.hasher
+}
+
+func (s *serviceProvider) GetMFAConfig(_ context.Context) config.MFAConfig {
+	if s.mfaConfig == nil {
+		cfg, err := config.NewMFAConfig()
+		if err != nil {
+			log.Fatalf("failed to get mfa config: %s", err.Error())
+		}
+		s.mfaConfig = cfg
+	}
+	return s.mfaConfig
+}
+
+func (s *serviceProvider) GetNotifierConfig(_ context.Context) config.NotifierConfig {
+	if s.notifierConfig == nil {
+		cfg, err := config.NewNotifierConfig()
+		if err != nil {
+			log.Fatalf("failed to get notifier config: %s", err.Error())
+		}
+		s.notifierConfig = cfg
+	}
+	return s.notifierConfig
+}
+
+// Notifier доставка уведомлений пользователям: SMTP или файл/журнал для локальной разработки.
+// Уведомления доставляются в фоне через очередь с ограниченным числом обработчиков.
+func (s *serviceProvider) Notifier(ctx context.Context) notifier.Notifier {
+	if s.notifier == nil {
+		cfg := s.GetNotifierConfig(ctx)
+
+		var next notifier.Notifier
+		if cfg.Type() == config.NotifierSMTP {
+			next = notifierSMTP.NewClient(cfg)
+		} else {
+			next = notifierFile.NewClient(cfg.FilePath(), cfg.PasswordResetURL(), cfg.EmailVerificationURL())
+		}
+
+		queue := notifier.NewQueue(next, cfg.Workers(), cfg.QueueSize(), cfg.Timeout())
+		closer.Add(queue.Close)
+		s.notifier = queue
+	}
+
+	return s.notifier
+}
+
+func (s *serviceProvider) KeyRing(ctx context.Context) *keyring.Ring {
+	if s.keyRing == nil {
+		cfg := s.GetJWTConfig(ctx)
+		if cfg.KeyRingPath() == "" {
+			s.keyRing = keyring.NewStatic(cfg.AccessKey(), cfg.RefreshKey())
+			return s.keyRing
+		}
+
+		ring, err := keyring.NewFromFile(cfg.KeyRingPath())
+		if err != nil {
+			log.Fatalf("failed to load key ring: %s", err.Error())
+		}
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		closer.Add(func() error {
+			cancel()
+			return nil
+		})
+		go ring.Watch(watchCtx, cfg.KeyRingReloadInterval())
+
+		s.keyRing = ring
+	}
+
+	return s.keyRing
+}
+
+func (s *serviceProvider) AccessPolicy(ctx context.Context) *access.Store {
+	if s.accessPolicy == nil {
+		cfg := s.GetAccessConfig(ctx)
+
+		store, err := access.NewStore(ctx, cfg.Path(), s.AuthRepository(ctx).GetAccessGrants)
+		if err != nil {
+			log.Fatalf("failed to load access policy: %s", err.Error())
+		}
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		closer.Add(func() error {
+			cancel()
+			return nil
+		})
+		go store.Watch(watchCtx, cfg.ReloadInterval())
+
+		// Права ролей, измененные на другом экземпляре, применяются сразу, а не при очередной проверке Watch.
+		// Профили и роли пользователей лежат в общем Redis и уже удалены опубликовавшим экземпляром.
+		redisConfig := s.GetRedisConfig()
+		go redisCache.ListenInvalidations(watchCtx, s.GetRedisClient(ctx), redisConfig.ProbeInterval(), redisConfig.IOTimeout()/2,
+			func(ctx context.Context, inv model.CacheInvalidation) {
+				// Ошибка уже залогирована, предыдущая политика остается в силе
+				if inv.Grants {
+					_ = store.ReloadGrants(ctx)
+				}
+			})
+
+		s.accessPolicy = store
+	}
+
+	return s.accessPolicy
+}
+
 //func (s *serviceProvider) IntitLogger() *zap.Logger {
 //	if s.logger == nil {
 //		// Initialize the logger with a default configuration and InfoLevel.
@@ -189,21 +414,29 @@ func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 
 func (s *serviceProvider) RedisPool() *redigo.Pool {
 	if s.redisPool == nil {
-		s.redisPool = &redigo.Pool{
-			MaxIdle:     s.GetRedisConfig().MaxIdle(),
-			IdleTimeout: s.GetRedisConfig().IdleTimeout(),
-			DialContext: func(ctx context.Context) (redigo.Conn, error) {
-				return redigo.DialContext(ctx, "tcp", s.GetRedisConfig().Address())
-			},
-		}
+		s.redisPool = redis.NewPool(s.GetRedisConfig())
 	}
 
 	return s.redisPool
 }
 
-func (s *serviceProvider) GetRedisClient(_ context.Context) redis.Client {
-	if s.redisConfig == nil {
-		s.redisClient = redis.NewClient(s.RedisPool(), s.GetRedisConfig())
+func (s *serviceProvider) GetRedisClient(ctx context.Context) *redis.Client {
+	if s.redisClient == nil {
+		cl := redis.NewClient(s.RedisPool(), s.GetRedisConfig())
+
+		// Пока Redis был недоступен, инвалидации терялись: после восстановления кэшированные данные из базы сбрасываются
+		watchCtx, cancel := context.WithCancel(ctx)
+		closer.Add(func() error {
+			cancel()
+			return nil
+		})
+		go cl.Probe(watchCtx, s.GetRedisConfig().ProbeInterval(), func(ctx context.Context) {
+			if err := redisCache.FlushNamespace(ctx, cl); err != nil {
+				log.Printf("failed to flush cache after redis recovery: %v", err)
+			}
+		})
+
+		s.redisClient = &cl
 	}
 
 	return s.redisClient
@@ -219,7 +452,7 @@ func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 
 func (s *serviceProvider) UserRepository(ctx context.Context) repository.UserRepository {
 	if s.userRepository == nil {
-		s.userRepository = user2.NewRepository(s.DBClient(ctx))
+		s.userRepository = user2.NewRepository(s.DBClient(ctx), s.PasswordHasher(ctx))
 	}
 
 	return s.userRepository
@@ -227,25 +460,46 @@ func (s *serviceProvider) UserRepository(ctx context.Context) repository.UserRep
 
 func (s *serviceProvider) AuthRepository(ctx context.Context) repository.AuthRepository {
 	if s.authRepository == nil {
-		s.authRepository = auth2.NewRepository(s.DBClient(ctx))
+		s.authRepository = auth2.NewRepository(s.DBClient(ctx), s.PasswordHasher(ctx))
 	}
 
 	return s.authRepository
 }
+
+func (s *serviceProvider) RoleRepository(ctx context.Context) repository.RoleRepository {
+	if s.roleRepository == nil {
+		s.roleRepository = role2.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.roleRepository
+}
+
 func (s *serviceProvider) GetCache(ctx context.Context) repository.CacheInterface {
 	if s.serviceCache == nil {
-		s.serviceCache = redisCache.NewRedisCache(s.GetRedisClient(ctx))
+		s.serviceCache = redisCache.NewRedisCache(*s.GetRedisClient(ctx))
 	}
 
 	return s.serviceCache
 }
 
+func (s *serviceProvider) Revocations(ctx context.Context) *revocation.Checker {
+	if s.revocations == nil {
+		s.revocations = revocation.NewChecker(s.GetCache(ctx), s.AuthRepository(ctx))
+	}
+
+	return s.revocations
+}
+
 func (s *serviceProvider) UserService(ctx context.Context) service.UserService {
 	if s.userService == nil {
 		s.userService = user3.NewService(
 			s.UserRepository(ctx),
 			s.TxManager(ctx),
 			s.GetCache(ctx),
+			s.PasswordPolicy(ctx),
+			s.AuthRepository(ctx),
+			s.GetLoginProtectionConfig(ctx),
+			s.Notifier(ctx),
 		)
 	}
 
@@ -258,14 +512,30 @@ func (s *serviceProvider) AuthService(ctx context.Context) service.AuthService {
 			s.AuthRepository(ctx),
 			s.TxManager(ctx),
 			s.GetCache(ctx),
-			s.GetJWTConfig(ctx),
-			s.GetAccessConfig(ctx),
+			s.KeyRing(ctx),
+			s.AccessPolicy(ctx),
+			s.GetLoginProtectionConfig(ctx),
+			s.GetEmailVerificationConfig(ctx),
+			s.GetMFAConfig(ctx),
 		)
 	}
 
 	return s.authService
 }
 
+func (s *serviceProvider) RoleService(ctx context.Context) service.RoleService {
+	if s.roleService == nil {
+		s.roleService = role3.NewService(
+			s.RoleRepository(ctx),
+			s.TxManager(ctx),
+			s.GetCache(ctx),
+			s.AccessPolicy(ctx),
+		)
+	}
+
+	return s.roleService
+}
+
 func (s *serviceProvider) UserController(ctx context.Context) *user.Controller {
 	if s.userController == nil {
 		s.userController = user.NewController(s.UserService(ctx))
@@ -281,3 +551,27 @@ func (s *serviceProvider) AuthController(ctx context.Context) *auth.Controller {
 
 	return s.authController
 }
+
+func (s *serviceProvider) RoleController(ctx context.Context) *role.Controller {
+	if s.roleController == nil {
+		s.roleController = role.NewController(s.RoleService(ctx))
+	}
+
+	return s.roleController
+}
+
+func (s *serviceProvider) JWKSHandler(ctx context.Context) *jwks.Handler {
+	if s.jwksHandler == nil {
+		s.jwksHandler = jwks.NewHandler(s.KeyRing(ctx))
+	}
+
+	return s.jwksHandler
+}
+
+func (s *serviceProvider) HealthHandler(ctx context.Context) *health.Handler {
+	if s.healthHandler == nil {
+		s.healthHandler = health.NewHandler(s.GetRedisClient(ctx))
+	}
+
+	return s.healthHandler
+}
@@ -0,0 +1,100 @@
+package redis
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// breaker размыкает цепь после threshold ошибок соединения подряд. Пока цепь разомкнута,
+// запросы к Redis не отправляются и не ждут таймаута; замыкает ее только успешный PING из Probe.
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	failures  int
+	open      bool
+	// onChange вызывается при смене состояния вне блокировки
+	onChange func(open bool)
+}
+
+func newBreaker(threshold int, onChange func(open bool)) *breaker {
+	if threshold <= 0 {
+		threshold = 1
+	}
+
+	return &breaker{threshold: threshold, onChange: onChange}
+}
+
+// allow сообщает, можно ли отправить запрос
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return !b.open
+}
+
+// isOpen сообщает, разомкнута ли цепь
+func (b *breaker) isOpen() bool {
+	return !b.allow()
+}
+
+// record учитывает результат запроса. Цепь размыкают только ошибки соединения; успех и ответ
+// Redis с ошибкой (redis.Error, ErrNil) показывают, что сервер доступен, и сбрасывают счетчик.
+// Прочие ошибки (исчерпание пула, разбор ответа) на состояние не влияют.
+func (b *breaker) record(err error) {
+	if err != nil && !isConnectionError(err) {
+		if !isReply(err) {
+			return
+		}
+		err = nil
+	}
+
+	b.mu.Lock()
+	if err == nil {
+		b.failures = 0
+		b.mu.Unlock()
+		return
+	}
+
+	b.failures++
+	opened := !b.open && b.failures >= b.threshold
+	if opened {
+		b.open = true
+	}
+	b.mu.Unlock()
+
+	if opened && b.onChange != nil {
+		b.onChange(true)
+	}
+}
+
+// reset замыкает цепь после успешной проверки
+func (b *breaker) reset() {
+	b.mu.Lock()
+	closed := b.open
+	b.open = false
+	b.failures = 0
+	b.mu.Unlock()
+
+	if closed && b.onChange != nil {
+		b.onChange(false)
+	}
+}
+
+// isConnectionError отличает недоступность Redis (ошибки установки соединения и ввода-вывода)
+// от ответов сервера, исчерпания пула и истекших дедлайнов вызывающего: перегрузка отдельных
+// запросов не должна отключать кэш для всего экземпляра.
+func isConnectionError(err error) bool {
+	// Дедлайн контекста тоже реализует net.Error, поэтому проверяем именно ошибку сетевой операции
+	var opErr *net.OpError
+	return errors.As(err, &opErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// isReply сообщает, что ошибку вернул сам Redis
+func isReply(err error) bool {
+	var replyErr redis.Error
+	return errors.As(err, &replyErr) || errors.Is(err, redis.ErrNil)
+}
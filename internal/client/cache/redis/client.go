@@ -7,30 +7,51 @@ import (
 	"time"
 
 	"github.com/Ippolid/auth/internal/config"
+	"github.com/Ippolid/auth/internal/metric"
+	"github.com/Ippolid/auth/internal/model"
 
 	"github.com/gomodule/redigo/redis"
 )
 
 type handler func(ctx context.Context, conn redis.Conn) error
 
+// ErrCircuitOpen запрос не отправлен: Redis признан недоступным и еще не ответил на проверку
+var ErrCircuitOpen = fmt.Errorf("%w: circuit breaker is open", model.ErrCacheUnavailable)
+
 // Client клиент для redis.
 type Client struct {
-	pool   *redis.Pool
-	config config.RedisConfig
+	pool    *redis.Pool
+	config  config.RedisConfig
+	breaker *breaker
 }
 
 // NewClient конструктор для клиента redis.
 func NewClient(pool *redis.Pool, config config.RedisConfig) Client {
 	return Client{
-		pool:   pool,
-		config: config,
+		pool:    pool,
+		config:  config,
+		breaker: newBreaker(config.BreakerFailures(), onBreakerChange),
+	}
+}
+
+func onBreakerChange(open bool) {
+	metric.SetCacheDegraded(open)
+	if open {
+		log.Printf("redis is unavailable, circuit breaker opened: falling back to the database")
+		return
 	}
+	log.Printf("redis is available again, circuit breaker closed")
 }
 
 func (c *Client) execute(ctx context.Context, handler handler) error {
+	if c.breaker != nil && !c.breaker.allow() {
+		return ErrCircuitOpen
+	}
+
 	conn, err := c.getConnect(ctx)
 	if err != nil {
-		return fmt.Errorf("could not connect to redis: %w", err)
+		c.record(ctx, err)
+		return fmt.Errorf("%w: could not connect to redis: %w", model.ErrCacheUnavailable, err)
 	}
 
 	defer func() {
@@ -40,13 +61,72 @@ func (c *Client) execute(ctx context.Context, handler handler) error {
 		}
 	}()
 
-	if err = handler(ctx, conn); err != nil {
+	err = handler(ctx, conn)
+	c.record(ctx, err)
+	if err != nil {
 		return fmt.Errorf("could not handle request: %w", err)
 	}
 
 	return nil
 }
 
+// record передает результат запроса размыкателю. Запрос, прерванный самим вызывающим,
+// ничего не говорит о доступности Redis и не учитывается.
+func (c *Client) record(ctx context.Context, err error) {
+	if c.breaker == nil || ctx.Err() != nil {
+		return
+	}
+
+	c.breaker.record(err)
+}
+
+// Degraded сообщает, что Redis признан недоступным и запросы к нему не отправляются
+func (c *Client) Degraded() bool {
+	return c.breaker != nil && c.breaker.isOpen()
+}
+
+// Probe раз в interval проверяет через PING недоступный Redis и, когда он отвечает,
+// снова пропускает к нему запросы и вызывает onRecover (может быть nil).
+// Завершается вместе с ctx.
+func (c *Client) Probe(ctx context.Context, interval time.Duration, onRecover func(context.Context)) {
+	if c.breaker == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !c.breaker.isOpen() || c.ping(ctx) != nil {
+				continue
+			}
+
+			c.breaker.reset()
+			if onRecover != nil {
+				onRecover(ctx)
+			}
+		}
+	}
+}
+
+// ping проверяет соединение в обход размыкателя
+func (c *Client) ping(ctx context.Context) error {
+	conn, err := c.getConnect(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	_, err = conn.Do("PING")
+	return err
+}
+
 func (c *Client) Execute(ctx context.Context, handler handler) error {
 	if ctx == nil {
 		ctx = context.Background()
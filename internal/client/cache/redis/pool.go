@@ -0,0 +1,25 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/config"
+	"github.com/gomodule/redigo/redis"
+)
+
+// NewPool создает пул соединений с Redis. Соединение устанавливается не дольше ConnectionTimeout,
+// а каждая команда читается и пишется не дольше IOTimeout: conn.Do не учитывает ctx, и без таймаутов
+// Redis, который держит соединение, но не отвечает, блокировал бы запросы навсегда.
+func NewPool(cfg config.RedisConfig) *redis.Pool {
+	return &redis.Pool{
+		MaxIdle:     cfg.MaxIdle(),
+		IdleTimeout: cfg.IdleTimeout(),
+		DialContext: func(ctx context.Context) (redis.Conn, error) {
+			return redis.DialContext(ctx, "tcp", cfg.Address(),
+				redis.DialConnectTimeout(cfg.ConnectionTimeout()),
+				redis.DialReadTimeout(cfg.IOTimeout()),
+				redis.DialWriteTimeout(cfg.IOTimeout()),
+			)
+		},
+	}
+}
@@ -0,0 +1,183 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cacheclient "github.com/Ippolid/auth/internal/client/cache/redis"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+)
+
+const breakerFailures = 3
+
+type redisConfig struct{}
+
+func (redisConfig) Address() string                  { return "redis:6379" }
+func (redisConfig) ConnectionTimeout() time.Duration { return time.Second }
+func (redisConfig) MaxIdle() int                     { return 1 }
+func (redisConfig) IdleTimeout() time.Duration       { return time.Minute }
+func (redisConfig) BreakerFailures() int             { return breakerFailures }
+func (redisConfig) ProbeInterval() time.Duration     { return 10 * time.Millisecond }
+func (redisConfig) IOTimeout() time.Duration         { return time.Second }
+
+// pongConn соединение, которое отвечает на любую команду PONG
+type pongConn struct{}
+
+func (pongConn) Close() error                                   { return nil }
+func (pongConn) Err() error                                     { return nil }
+func (pongConn) Do(string, ...interface{}) (interface{}, error) { return "PONG", nil }
+func (pongConn) Send(string, ...interface{}) error              { return nil }
+func (pongConn) Flush() error                                   { return nil }
+func (pongConn) Receive() (interface{}, error)                  { return "PONG", nil }
+
+// flakyRedis пул, соединение с которым устанавливается только при up
+type flakyRedis struct {
+	up    atomic.Bool
+	dials atomic.Int32
+}
+
+func (f *flakyRedis) pool() *redis.Pool {
+	return &redis.Pool{
+		DialContext: func(context.Context) (redis.Conn, error) {
+			f.dials.Add(1)
+			if !f.up.Load() {
+				return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+			}
+			return pongConn{}, nil
+		},
+	}
+}
+
+func noop(context.Context, redis.Conn) error { return nil }
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	flaky := &flakyRedis{}
+	client := cacheclient.NewClient(flaky.pool(), redisConfig{})
+
+	for i := 0; i < breakerFailures; i++ {
+		err := client.Execute(ctx, noop)
+		require.ErrorIs(t, err, model.ErrCacheUnavailable)
+		require.NotErrorIs(t, err, cacheclient.ErrCircuitOpen)
+	}
+	require.True(t, client.Degraded())
+
+	// Разомкнутая цепь не ждет таймаута соединения
+	err := client.Execute(ctx, noop)
+	require.ErrorIs(t, err, cacheclient.ErrCircuitOpen)
+	require.Equal(t, int32(breakerFailures), flaky.dials.Load())
+
+	flaky.up.Store(true)
+	recovered := make(chan struct{})
+	probeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go client.Probe(probeCtx, redisConfig{}.ProbeInterval(), func(context.Context) {
+		close(recovered)
+	})
+
+	select {
+	case <-recovered:
+	case <-time.After(time.Second):
+		t.Fatal("breaker was not closed after redis recovered")
+	}
+	require.False(t, client.Degraded())
+	require.NoError(t, client.Execute(ctx, noop))
+}
+
+func TestCircuitBreakerIgnoresNonConnectionErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "reply error", err: redis.Error("WRONGTYPE")},
+		{name: "nil reply", err: redis.ErrNil},
+		{name: "pool exhausted", err: redis.ErrPoolExhausted},
+		{name: "caller deadline", err: context.DeadlineExceeded},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			flaky := &flakyRedis{}
+			flaky.up.Store(true)
+			client := cacheclient.NewClient(flaky.pool(), redisConfig{})
+
+			for i := 0; i < breakerFailures*2; i++ {
+				err := client.Execute(context.Background(), func(context.Context, redis.Conn) error {
+					return tt.err
+				})
+				require.Error(t, err)
+			}
+			require.False(t, client.Degraded())
+		})
+	}
+}
+
+func TestCircuitBreakerIgnoresCanceledCallers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := cacheclient.NewClient((&flakyRedis{}).pool(), redisConfig{})
+	for i := 0; i < breakerFailures*2; i++ {
+		require.Error(t, client.Execute(ctx, noop))
+	}
+	require.False(t, client.Degraded())
+}
+
+// silentConfig конфигурация с адресом тестового сервера и коротким таймаутом команд
+type silentConfig struct {
+	redisConfig
+	addr string
+}
+
+func (c silentConfig) Address() string        { return c.addr }
+func (silentConfig) IOTimeout() time.Duration { return 20 * time.Millisecond }
+
+func TestCircuitBreakerOpensOnSilentServer(t *testing.T) {
+	// Сервер принимает соединения и читает команды, но ничего не отвечает
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, errAccept := listener.Accept()
+			if errAccept != nil {
+				return
+			}
+			go func() {
+				defer func() { _ = conn.Close() }()
+				_, _ = io.Copy(io.Discard, conn)
+			}()
+		}
+	}()
+
+	cfg := silentConfig{addr: listener.Addr().String()}
+	pool := cacheclient.NewPool(cfg)
+	t.Cleanup(func() { _ = pool.Close() })
+	client := cacheclient.NewClient(pool, cfg)
+
+	get := func(_ context.Context, conn redis.Conn) error {
+		_, errGet := conn.Do("GET", "key")
+		return errGet
+	}
+	for i := 0; i < breakerFailures; i++ {
+		done := make(chan error, 1)
+		go func() { done <- client.Execute(context.Background(), get) }()
+
+		select {
+		case err = <-done:
+			require.Error(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("request to a silent redis did not time out")
+		}
+	}
+	require.True(t, client.Degraded())
+}
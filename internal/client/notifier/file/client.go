@@ -0,0 +1,94 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Ippolid/auth/internal/client/notifier"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"go.uber.org/zap"
+)
+
+// Client записывает уведомления в файл (JSON по строке на уведомление) или, без файла, в журнал.
+// Для локальной разработки и тестов: письма никуда не отправляются.
+type Client struct {
+	path            string
+	resetURL        string
+	verificationURL string
+	mu              sync.Mutex
+}
+
+// NewClient конструктор для файлового клиента уведомлений. Пустой path — запись в журнал.
+func NewClient(path, passwordResetURL, emailVerificationURL string) *Client {
+	return &Client{path: path, resetURL: passwordResetURL, verificationURL: emailVerificationURL}
+}
+
+type record struct {
+	Kind    string    `json:"kind"`
+	To      string    `json:"to"`
+	Subject string    `json:"subject"`
+	Link    string    `json:"link"`
+	Body    string    `json:"body"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// NotifyPasswordReset записывает уведомление о сбросе пароля
+func (c *Client) NotifyPasswordReset(_ context.Context, notice model.PasswordResetNotice) error {
+	link := notifier.TokenLink(c.resetURL, notice.Token)
+
+	return c.write(record{
+		Kind:    "password_reset",
+		To:      notice.Email,
+		Subject: notifier.PasswordResetSubject,
+		Link:    link,
+		Body:    notifier.PasswordResetText(notice, link),
+	})
+}
+
+// NotifyEmailVerification записывает уведомление с подтверждением email
+func (c *Client) NotifyEmailVerification(_ context.Context, notice model.EmailVerificationNotice) error {
+	link := notifier.TokenLink(c.verificationURL, notice.Token)
+
+	return c.write(record{
+		Kind:    "email_verification",
+		To:      notice.Email,
+		Subject: notifier.EmailVerificationSubject,
+		Link:    link,
+		Body:    notifier.EmailVerificationText(notice, link),
+	})
+}
+
+func (c *Client) write(rec record) error {
+	if c.path == "" {
+		logger.Info("notification", zap.String("kind", rec.Kind), zap.String("to", rec.To), zap.String("link", rec.Link))
+		return nil
+	}
+
+	rec.SentAt = time.Now()
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	f, err := os.OpenFile(c.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec // путь задается конфигурацией сервиса
+	if err != nil {
+		return fmt.Errorf("failed to open notification file: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write notification: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/Ippolid/auth/internal/model"
+)
+
+// Notifier доставляет уведомления пользователям
+type Notifier interface {
+	NotifyPasswordReset(ctx context.Context, notice model.PasswordResetNotice) error
+	NotifyEmailVerification(ctx context.Context, notice model.EmailVerificationNotice) error
+}
+
+// Темы уведомлений
+const (
+	PasswordResetSubject     = "Password reset"
+	EmailVerificationSubject = "Confirm your email address"
+)
+
+const expiresAtFormat = "2006-01-02 15:04 MST"
+
+// TokenLink ссылка на страницу с токеном в параметре token; без baseURL возвращается сам токен
+func TokenLink(baseURL, token string) string {
+	if baseURL == "" {
+		return token
+	}
+
+	link, err := url.Parse(baseURL)
+	if err != nil {
+		return token
+	}
+
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return link.String()
+}
+
+// PasswordResetText текст уведомления о сбросе пароля
+func PasswordResetText(notice model.PasswordResetNotice, link string) string {
+	return fmt.Sprintf("Hello, %s!\r\n\r\n"+
+		"Someone requested a password reset for your account. To set a new password, open:\r\n\r\n"+
+		"%s\r\n\r\n"+
+		"The link is valid until %s and can be used once. If you did not request a reset, ignore this message.\r\n",
+		notice.Name, link, notice.ExpiresAt.UTC().Format(expiresAtFormat))
+}
+
+// EmailVerificationText текст уведомления с подтверждением email
+func EmailVerificationText(notice model.EmailVerificationNotice, link string) string {
+	return fmt.Sprintf("Hello, %s!\r\n\r\n"+
+		"Please confirm that %s is your email address by opening:\r\n\r\n"+
+		"%s\r\n\r\n"+
+		"The link is valid until %s. If you did not create an account, ignore this message.\r\n",
+		notice.Name, notice.Email, link, notice.ExpiresAt.UTC().Format(expiresAtFormat))
+}
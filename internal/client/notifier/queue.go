@@ -0,0 +1,117 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"go.uber.org/zap"
+)
+
+// ErrQueueFull очередь уведомлений заполнена, уведомление не принято
+var ErrQueueFull = errors.New("notification queue is full")
+
+// ErrQueueClosed очередь уведомлений остановлена
+var ErrQueueClosed = errors.New("notification queue is closed")
+
+type delivery struct {
+	kind string
+	send func(ctx context.Context) error
+}
+
+// Queue доставляет уведомления в фоне: Notify* только ставят уведомление в очередь и не ждут
+// почтового сервера. Одновременно доставляется не больше workers уведомлений, ждать может
+// не больше size; сверх этого новые уведомления отбрасываются, а не копят горутины.
+// Ошибки доставки только логируются.
+type Queue struct {
+	next    Notifier
+	timeout time.Duration
+	jobs    chan delivery
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewQueue создает очередь и запускает workers обработчиков. timeout ограничивает доставку одного уведомления.
+func NewQueue(next Notifier, workers, size int, timeout time.Duration) *Queue {
+	ctx, cancel := context.WithCancel(context.Background())
+	q := &Queue{
+		next:    next,
+		timeout: timeout,
+		jobs:    make(chan delivery, size),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+
+	q.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go q.work()
+	}
+
+	return q
+}
+
+// NotifyPasswordReset ставит в очередь уведомление о сбросе пароля
+func (q *Queue) NotifyPasswordReset(_ context.Context, notice model.PasswordResetNotice) error {
+	return q.enqueue(delivery{kind: "password reset", send: func(ctx context.Context) error {
+		return q.next.NotifyPasswordReset(ctx, notice)
+	}})
+}
+
+// NotifyEmailVerification ставит в очередь уведомление с подтверждением email
+func (q *Queue) NotifyEmailVerification(_ context.Context, notice model.EmailVerificationNotice) error {
+	return q.enqueue(delivery{kind: "email verification", send: func(ctx context.Context) error {
+		return q.next.NotifyEmailVerification(ctx, notice)
+	}})
+}
+
+// Close останавливает обработчики и прерывает текущие доставки; уведомления из очереди отбрасываются
+func (q *Queue) Close() error {
+	q.cancel()
+	q.wg.Wait()
+
+	if dropped := len(q.jobs); dropped > 0 {
+		logger.Warn("notification queue closed, pending notifications dropped", zap.Int("dropped", dropped))
+	}
+
+	return nil
+}
+
+func (q *Queue) enqueue(job delivery) error {
+	if q.ctx.Err() != nil {
+		return ErrQueueClosed
+	}
+
+	select {
+	case q.jobs <- job:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+func (q *Queue) work() {
+	defer q.wg.Done()
+
+	for {
+		select {
+		case <-q.ctx.Done():
+			return
+		case job := <-q.jobs:
+			q.deliver(job)
+		}
+	}
+}
+
+func (q *Queue) deliver(job delivery) {
+	ctx, cancel := context.WithTimeout(q.ctx, q.timeout)
+	defer cancel()
+
+	if err := job.send(ctx); err != nil {
+		logger.Error("failed to deliver notification", zap.String("kind", job.kind), zap.Error(err))
+	}
+}
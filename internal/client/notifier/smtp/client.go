@@ -0,0 +1,120 @@
+package smtp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/Ippolid/auth/internal/client/notifier"
+	"github.com/Ippolid/auth/internal/config"
+	"github.com/Ippolid/auth/internal/model"
+)
+
+// Client отправляет уведомления письмами через SMTP-сервер
+type Client struct {
+	config config.NotifierConfig
+}
+
+// NewClient конструктор для SMTP-клиента уведомлений
+func NewClient(config config.NotifierConfig) *Client {
+	return &Client{config: config}
+}
+
+// NotifyPasswordReset отправляет письмо со ссылкой для сброса пароля
+func (c *Client) NotifyPasswordReset(ctx context.Context, notice model.PasswordResetNotice) error {
+	link := notifier.TokenLink(c.config.PasswordResetURL(), notice.Token)
+
+	return c.send(ctx, notice.Email, notifier.PasswordResetSubject, notifier.PasswordResetText(notice, link))
+}
+
+// NotifyEmailVerification отправляет письмо со ссылкой для подтверждения email
+func (c *Client) NotifyEmailVerification(ctx context.Context, notice model.EmailVerificationNotice) error {
+	link := notifier.TokenLink(c.config.EmailVerificationURL(), notice.Token)
+
+	return c.send(ctx, notice.Email, notifier.EmailVerificationSubject, notifier.EmailVerificationText(notice, link))
+}
+
+// send отправляет письмо так же, как smtp.SendMail, но не дольше Timeout и срока ctx:
+// зависший сервер не должен занимать обработчик очереди уведомлений бесконечно.
+func (c *Client) send(ctx context.Context, to, subject, body string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout())
+	defer cancel()
+
+	if err := c.deliver(ctx, to, subject, body); err != nil {
+		return fmt.Errorf("failed to send %q email: %w", subject, err)
+	}
+
+	return nil
+}
+
+func (c *Client) deliver(ctx context.Context, to, subject, body string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", c.config.SMTPAddress())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Срок ctx ограничивает каждую операцию чтения и записи, а отмена ctx прерывает ожидание ответа сервера
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	client, err := smtp.NewClient(conn, c.config.SMTPHost())
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: c.config.SMTPHost()}); err != nil {
+			return err
+		}
+	}
+	if c.config.SMTPUsername() != "" {
+		auth := smtp.PlainAuth("", c.config.SMTPUsername(), c.config.SMTPPassword(), c.config.SMTPHost())
+		if err = client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err = client.Mail(c.config.SMTPFrom()); err != nil {
+		return err
+	}
+	if err = client.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	msg := strings.Join([]string{
+		"From: " + c.config.SMTPFrom(),
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	if _, err = w.Write([]byte(msg)); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/client/notifier"
+	"github.com/Ippolid/auth/internal/client/notifier/smtp"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+// blockingNotifier держит каждую доставку, пока не закроется release или не отменится ctx
+type blockingNotifier struct {
+	started   chan struct{}
+	release   chan struct{}
+	delivered atomic.Int32
+}
+
+func newBlockingNotifier() *blockingNotifier {
+	return &blockingNotifier{started: make(chan struct{}, 16), release: make(chan struct{})}
+}
+
+func (n *blockingNotifier) wait(ctx context.Context) error {
+	n.started <- struct{}{}
+	select {
+	case <-n.release:
+		n.delivered.Add(1)
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (n *blockingNotifier) NotifyPasswordReset(ctx context.Context, _ model.PasswordResetNotice) error {
+	return n.wait(ctx)
+}
+
+func (n *blockingNotifier) NotifyEmailVerification(ctx context.Context, _ model.EmailVerificationNotice) error {
+	return n.wait(ctx)
+}
+
+func TestQueue(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	t.Run("bounded workers and queue", func(t *testing.T) {
+		next := newBlockingNotifier()
+		queue := notifier.NewQueue(next, 1, 1, time.Minute)
+		t.Cleanup(func() { _ = queue.Close() })
+
+		ctx := context.Background()
+		require.NoError(t, queue.NotifyPasswordReset(ctx, model.PasswordResetNotice{}))
+		<-next.started
+
+		// Обработчик занят, одно место в очереди
+		require.NoError(t, queue.NotifyEmailVerification(ctx, model.EmailVerificationNotice{}))
+		require.ErrorIs(t, queue.NotifyPasswordReset(ctx, model.PasswordResetNotice{}), notifier.ErrQueueFull)
+
+		close(next.release)
+		require.Eventually(t, func() bool { return next.delivered.Load() == 2 }, time.Second, time.Millisecond)
+	})
+
+	t.Run("delivery bounded by timeout", func(t *testing.T) {
+		next := newBlockingNotifier()
+		queue := notifier.NewQueue(next, 1, 1, 10*time.Millisecond)
+		t.Cleanup(func() { _ = queue.Close() })
+
+		require.NoError(t, queue.NotifyPasswordReset(context.Background(), model.PasswordResetNotice{}))
+		<-next.started
+		// Зависшая доставка освобождает обработчик по таймауту
+		require.NoError(t, queue.NotifyPasswordReset(context.Background(), model.PasswordResetNotice{}))
+		select {
+		case <-next.started:
+		case <-time.After(time.Second):
+			t.Fatal("worker was not released by timeout")
+		}
+	})
+
+	t.Run("close interrupts delivery", func(t *testing.T) {
+		next := newBlockingNotifier()
+		queue := notifier.NewQueue(next, 1, 1, time.Minute)
+
+		require.NoError(t, queue.NotifyPasswordReset(context.Background(), model.PasswordResetNotice{}))
+		<-next.started
+
+		require.NoError(t, queue.Close())
+		require.ErrorIs(t, queue.NotifyPasswordReset(context.Background(), model.PasswordResetNotice{}), notifier.ErrQueueClosed)
+		require.Zero(t, next.delivered.Load())
+	})
+}
+
+type smtpConfig struct {
+	address string
+}
+
+func (c smtpConfig) Type() string                 { return "smtp" }
+func (c smtpConfig) FilePath() string             { return "" }
+func (c smtpConfig) PasswordResetURL() string     { return "" }
+func (c smtpConfig) EmailVerificationURL() string { return "" }
+func (c smtpConfig) SMTPAddress() string          { return c.address }
+func (c smtpConfig) SMTPHost() string             { return "127.0.0.1" }
+func (c smtpConfig) SMTPUsername() string         { return "" }
+func (c smtpConfig) SMTPPassword() string         { return "" }
+func (c smtpConfig) SMTPFrom() string             { return "auth@example.com" }
+func (c smtpConfig) Workers() int                 { return 1 }
+func (c smtpConfig) QueueSize() int               { return 1 }
+func (c smtpConfig) Timeout() time.Duration       { return 50 * time.Millisecond }
+
+func TestSMTPSilentServer(t *testing.T) {
+	// Сервер принимает соединение, но не отправляет приветствие
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		var conns []net.Conn
+		defer func() {
+			for _, conn := range conns {
+				_ = conn.Close()
+			}
+		}()
+		for {
+			conn, errAccept := listener.Accept()
+			if errAccept != nil {
+				return
+			}
+			conns = append(conns, conn)
+		}
+	}()
+
+	client := smtp.NewClient(smtpConfig{address: listener.Addr().String()})
+
+	start := time.Now()
+	err = client.NotifyPasswordReset(context.Background(), model.PasswordResetNotice{Email: "alice@example.com"})
+	require.Error(t, err)
+	require.Less(t, time.Since(start), time.Second)
+}
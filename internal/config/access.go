@@ -2,54 +2,59 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/pkg/errors"
-	"gopkg.in/yaml.v3"
 )
 
-const yamldir = "ACCESS_YAML_DIR"
+const (
+	yamldir = "ACCESS_YAML_DIR"
+
+	accessReloadIntervalKey = "ACCESS_RELOAD_INTERVAL"
+
+	defaultAccessReloadInterval = 30 * time.Second
+)
 
 // AccessConfig интерфейс для получения конфигурации доступа к эндпоинтам.
 type AccessConfig interface {
-	CFG() map[string]bool
+	// Path путь к YAML-файлу с правилами доступа (формат см. в пакете access).
+	Path() string
+	// ReloadInterval период проверки файла и таблицы access на изменения.
+	ReloadInterval() time.Duration
 }
 type accessConfigImpl struct {
-	Endpoints map[string]bool `yaml:"endpoints"`
+	path           string
+	reloadInterval time.Duration
 }
 
-// NewAccessConfig создает новую конфигурацию доступа из YAML-файла.
-// Путь к директории YAML-файла берется из переменной окружения, указанной в константе yamldir.
-// Имя файла по умолчанию "access.yaml".
+// NewAccessConfig создает новую конфигурацию доступа.
+// Путь к YAML-файлу берется из переменной окружения, указанной в константе yamldir,
+// период перезагрузки — из ACCESS_RELOAD_INTERVAL (по умолчанию 30s).
 func NewAccessConfig() (AccessConfig, error) {
 	yamlPathDir := os.Getenv(yamldir) // Используем вашу константу yamldir
 	if yamlPathDir == "" {
 		return nil, errors.Errorf("переменная окружения %s не установлена", yamldir)
 	}
 
-	// Имя файла конфигурации можно сделать константой или параметром
-
-	data, err := os.ReadFile(yamlPathDir) //nolint:gosec
-	if err != nil {
-		return nil, errors.Wrapf(err, "ошибка чтения файла конфигурации '%s'", yamlPathDir)
-	}
-
-	var cfg accessConfigImpl
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, errors.Wrapf(err, "ошибка парсинга YAML из '%s'", yamlPathDir)
-	}
-
-	if cfg.Endpoints == nil {
-		// Инициализируем мапу, если YAML пуст или не содержит ключ 'endpoints'
-		cfg.Endpoints = make(map[string]bool)
+	reloadInterval := defaultAccessReloadInterval
+	if raw := os.Getenv(accessReloadIntervalKey); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			return nil, errors.Errorf("invalid %s: %q", accessReloadIntervalKey, raw)
+		}
+		reloadInterval = parsed
 	}
 
-	return &cfg, nil
+	return &accessConfigImpl{
+		path:           yamlPathDir,
+		reloadInterval: reloadInterval,
+	}, nil
 }
 
-func (cfg *accessConfigImpl) CFG() map[string]bool {
-	// Возвращаем мапу эндпоинтов
-	return cfg.Endpoints
-
+func (cfg *accessConfigImpl) Path() string {
+	return cfg.path
 }
 
-// HasAccess проверяет, имеет ли указанная роль доступ к эндпоинту.
+func (cfg *accessConfigImpl) ReloadInterval() time.Duration {
+	return cfg.reloadInterval
+}
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"math"
+	"os"
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	passwordHashAlgorithmKey    = "PASSWORD_HASH_ALGORITHM"
+	passwordBcryptCostKey       = "PASSWORD_BCRYPT_COST"
+	passwordArgon2MemoryKey     = "PASSWORD_ARGON2_MEMORY_KIB"
+	passwordArgon2IterationsKey = "PASSWORD_ARGON2_ITERATIONS"
+	passwordArgon2ParallelKey   = "PASSWORD_ARGON2_PARALLELISM"
+	passwordHashConcurrencyKey  = "PASSWORD_HASH_CONCURRENCY"
+
+	defaultPasswordBcryptCost       = 10
+	defaultPasswordArgon2Memory     = 64 * 1024
+	defaultPasswordArgon2Iterations = 3
+	defaultPasswordArgon2Parallel   = 2
+)
+
+// Алгоритмы хеширования новых паролей
+const (
+	// HashArgon2id argon2id, по умолчанию
+	HashArgon2id = "argon2id"
+	// HashBcrypt bcrypt
+	HashBcrypt = "bcrypt"
+)
+
+// PasswordHashConfig параметры хеширования паролей. Хеши обоих алгоритмов проверяются всегда;
+// при входе хеш другого алгоритма или с другой стоимостью заменяется на хеш с текущими параметрами.
+type PasswordHashConfig interface {
+	// Algorithm алгоритм для новых паролей: argon2id (по умолчанию) или bcrypt
+	Algorithm() string
+	// BcryptCost стоимость bcrypt
+	BcryptCost() int
+	// Argon2Memory объем памяти argon2id в KiB
+	Argon2Memory() uint32
+	// Argon2Iterations число проходов argon2id
+	Argon2Iterations() uint32
+	// Argon2Parallelism число потоков argon2id
+	Argon2Parallelism() uint8
+	// Concurrency сколько хешей вычисляется одновременно; остальные ждут. Каждое вычисление argon2id
+	// занимает Argon2Memory памяти, поэтому без ограничения поток входов может исчерпать память.
+	// По умолчанию — число процессоров.
+	Concurrency() int
+}
+
+type passwordHashConfig struct {
+	algorithm         string
+	bcryptCost        int
+	argon2Memory      uint32
+	argon2Iterations  uint32
+	argon2Parallelism uint8
+	concurrency       int
+}
+
+// NewPasswordHashConfig читает параметры хеширования паролей из переменных окружения.
+// Все параметры необязательны и имеют значения по умолчанию.
+func NewPasswordHashConfig() (PasswordHashConfig, error) {
+	algorithm := os.Getenv(passwordHashAlgorithmKey)
+	switch algorithm {
+	case "":
+		algorithm = HashArgon2id
+	case HashArgon2id, HashBcrypt:
+	default:
+		return nil, errors.Errorf("invalid %s: %q", passwordHashAlgorithmKey, algorithm)
+	}
+
+	bcryptCost, err := positiveIntFromEnv(passwordBcryptCostKey, defaultPasswordBcryptCost)
+	if err != nil {
+		return nil, err
+	}
+	memory, err := boundedIntFromEnv(passwordArgon2MemoryKey, defaultPasswordArgon2Memory, math.MaxUint32)
+	if err != nil {
+		return nil, err
+	}
+	iterations, err := boundedIntFromEnv(passwordArgon2IterationsKey, defaultPasswordArgon2Iterations, math.MaxUint32)
+	if err != nil {
+		return nil, err
+	}
+	parallelism, err := boundedIntFromEnv(passwordArgon2ParallelKey, defaultPasswordArgon2Parallel, math.MaxUint8)
+	if err != nil {
+		return nil, err
+	}
+	concurrency, err := boundedIntFromEnv(passwordHashConcurrencyKey, int64(runtime.NumCPU()), math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passwordHashConfig{
+		algorithm:         algorithm,
+		bcryptCost:        int(bcryptCost),
+		argon2Memory:      uint32(memory),     //nolint:gosec // ограничено boundedIntFromEnv
+		argon2Iterations:  uint32(iterations), //nolint:gosec // ограничено boundedIntFromEnv
+		argon2Parallelism: uint8(parallelism), //nolint:gosec // ограничено boundedIntFromEnv
+		concurrency:       int(concurrency),
+	}, nil
+}
+
+// boundedIntFromEnv как positiveIntFromEnv, но не больше upper
+func boundedIntFromEnv(key string, def int64, upper int64) (int64, error) {
+	value, err := positiveIntFromEnv(key, def)
+	if err != nil {
+		return 0, err
+	}
+	if value > upper {
+		return 0, errors.Errorf("invalid %s: %d is greater than %d", key, value, upper)
+	}
+
+	return value, nil
+}
+
+func (cfg *passwordHashConfig) Algorithm() string {
+	return cfg.algorithm
+}
+
+func (cfg *passwordHashConfig) BcryptCost() int {
+	return cfg.bcryptCost
+}
+
+func (cfg *passwordHashConfig) Argon2Memory() uint32 {
+	return cfg.argon2Memory
+}
+
+func (cfg *passwordHashConfig) Argon2Iterations() uint32 {
+	return cfg.argon2Iterations
+}
+
+func (cfg *passwordHashConfig) Argon2Parallelism() uint8 {
+	return cfg.argon2Parallelism
+}
+
+func (cfg *passwordHashConfig) Concurrency() int {
+	return cfg.concurrency
+}
@@ -2,48 +2,121 @@ package config
 
 import (
 	"os"
+	"time"
 
+	"github.com/Ippolid/auth/internal/utils"
 	"github.com/pkg/errors"
 )
 
 const (
 	refreshTokenSecretKey = "REFRESH_TOKEN"
 	accessTokenSecretKey  = "ACCESS_TOKEN"
+
+	accessTokenAlgKey     = "ACCESS_TOKEN_ALG"
+	accessTokenKeyPathKey = "ACCESS_TOKEN_PRIVATE_KEY_PATH"
+
+	keyRingPathKey           = "JWT_KEYRING_PATH"
+	keyRingReloadIntervalKey = "JWT_KEYRING_RELOAD_INTERVAL"
+
+	defaultKeyRingReloadInterval = 30 * time.Second
 )
 
 // JWTConfig интерфейс для конфигурации JWT-токенов
 type JWTConfig interface {
-	RefreshToken() string
-	AccessToken() string
+	RefreshKey() utils.SigningKey
+	AccessKey() utils.SigningKey
+	KeyRingPath() string
+	KeyRingReloadInterval() time.Duration
 }
 
 type jwtConfig struct {
-	refreshkey string
-	accesskey  string
+	refreshKey utils.SigningKey
+	accessKey  utils.SigningKey
+
+	keyRingPath           string
+	keyRingReloadInterval time.Duration
 }
 
-// NewJWTConfig создает новую конфигурацию JWT-токенов, извлекая ключи из переменных окружения
+// NewJWTConfig создает новую конфигурацию JWT-токенов, извлекая ключи из переменных окружения.
+// Если задан JWT_KEYRING_PATH, ключи берутся из файла кольца (см. пакет keyring), а переменные
+// с секретами не нужны. Иначе refresh-токены подписываются HMAC-секретом REFRESH_TOKEN, а access-токены
+// по умолчанию тоже HS256, но ACCESS_TOKEN_ALG (RS256, ES256, EdDSA) вместе с
+// ACCESS_TOKEN_PRIVATE_KEY_PATH включают асимметричную подпись, и тогда секрет ACCESS_TOKEN не нужен.
 func NewJWTConfig() (JWTConfig, error) {
+	reloadInterval := defaultKeyRingReloadInterval
+	if raw := os.Getenv(keyRingReloadIntervalKey); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			return nil, errors.Errorf("invalid %s: %q", keyRingReloadIntervalKey, raw)
+		}
+		reloadInterval = parsed
+	}
+
+	if keyRingPath := os.Getenv(keyRingPathKey); keyRingPath != "" {
+		return &jwtConfig{
+			keyRingPath:           keyRingPath,
+			keyRingReloadInterval: reloadInterval,
+		}, nil
+	}
+
 	refreshkey := os.Getenv(refreshTokenSecretKey)
 	if len(refreshkey) == 0 {
 		return nil, errors.New("refreshkey not found")
 	}
 
-	accesskey := os.Getenv(accessTokenSecretKey)
-	if len(accesskey) == 0 {
-		return nil, errors.New("accesskey port not found")
+	accessKey, err := newAccessKey()
+	if err != nil {
+		return nil, err
 	}
 
 	return &jwtConfig{
-		refreshkey: refreshkey,
-		accesskey:  accesskey,
+		refreshKey:            utils.NewHMACKey([]byte(refreshkey)),
+		accessKey:             accessKey,
+		keyRingReloadInterval: reloadInterval,
 	}, nil
 }
 
-func (cfg *jwtConfig) RefreshToken() string {
-	return cfg.refreshkey
+func newAccessKey() (utils.SigningKey, error) {
+	alg := os.Getenv(accessTokenAlgKey)
+	if alg == "" || alg == utils.AlgHS256 {
+		accesskey := os.Getenv(accessTokenSecretKey)
+		if len(accesskey) == 0 {
+			return utils.SigningKey{}, errors.New("accesskey port not found")
+		}
+
+		return utils.NewHMACKey([]byte(accesskey)), nil
+	}
+
+	keyPath := os.Getenv(accessTokenKeyPathKey)
+	if keyPath == "" {
+		return utils.SigningKey{}, errors.Errorf("%s not set for %s", accessTokenKeyPathKey, alg)
+	}
+
+	data, err := os.ReadFile(keyPath) //nolint:gosec
+	if err != nil {
+		return utils.SigningKey{}, errors.Wrapf(err, "failed to read access token key '%s'", keyPath)
+	}
+
+	key, err := utils.ParsePrivateKeyPEM(alg, data)
+	if err != nil {
+		return utils.SigningKey{}, errors.Wrapf(err, "invalid access token key '%s'", keyPath)
+	}
+
+	return key, nil
+}
+
+func (cfg *jwtConfig) RefreshKey() utils.SigningKey {
+	return cfg.refreshKey
+}
+
+func (cfg *jwtConfig) AccessKey() utils.SigningKey {
+	return cfg.accessKey
+}
+
+func (cfg *jwtConfig) KeyRingPath() string {
+	return cfg.keyRingPath
 }
 
-func (cfg *jwtConfig) AccessToken() string {
-	return cfg.accesskey
+func (cfg *jwtConfig) KeyRingReloadInterval() time.Duration {
+	return cfg.keyRingReloadInterval
 }
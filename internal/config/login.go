@@ -0,0 +1,130 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	loginMaxFailuresKey     = "LOGIN_MAX_FAILURES"
+	loginIPMaxFailuresKey   = "LOGIN_IP_MAX_FAILURES"
+	loginFailureWindowKey   = "LOGIN_FAILURE_WINDOW"
+	loginLockoutDurationKey = "LOGIN_LOCKOUT_DURATION"
+	loginBackoffBaseKey     = "LOGIN_BACKOFF_BASE"
+	loginBackoffMaxKey      = "LOGIN_BACKOFF_MAX"
+
+	defaultLoginMaxFailures     = 5
+	defaultLoginIPMaxFailures   = 50
+	defaultLoginFailureWindow   = 15 * time.Minute
+	defaultLoginLockoutDuration = 15 * time.Minute
+	defaultLoginBackoffBase     = time.Second
+	defaultLoginBackoffMax      = 30 * time.Second
+)
+
+// LoginProtectionConfig параметры защиты Login от перебора паролей
+type LoginProtectionConfig interface {
+	// MaxFailures неудачных попыток для имени пользователя до блокировки
+	MaxFailures() int64
+	// IPMaxFailures неудачных попыток с одного адреса до блокировки
+	IPMaxFailures() int64
+	// FailureWindow время, за которое считаются неудачные попытки
+	FailureWindow() time.Duration
+	// LockoutDuration длительность временной блокировки
+	LockoutDuration() time.Duration
+	// BackoffBase пауза после первой неудачи; каждая следующая удваивает ее
+	BackoffBase() time.Duration
+	// BackoffMax верхняя граница паузы
+	BackoffMax() time.Duration
+}
+
+type loginProtectionConfig struct {
+	maxFailures     int64
+	ipMaxFailures   int64
+	failureWindow   time.Duration
+	lockoutDuration time.Duration
+	backoffBase     time.Duration
+	backoffMax      time.Duration
+}
+
+// NewLoginProtectionConfig читает параметры защиты Login из переменных окружения.
+// Все параметры необязательны и имеют значения по умолчанию.
+func NewLoginProtectionConfig() (LoginProtectionConfig, error) {
+	cfg := &loginProtectionConfig{}
+
+	var err error
+	if cfg.maxFailures, err = positiveIntFromEnv(loginMaxFailuresKey, defaultLoginMaxFailures); err != nil {
+		return nil, err
+	}
+	if cfg.ipMaxFailures, err = positiveIntFromEnv(loginIPMaxFailuresKey, defaultLoginIPMaxFailures); err != nil {
+		return nil, err
+	}
+	if cfg.failureWindow, err = durationFromEnv(loginFailureWindowKey, defaultLoginFailureWindow); err != nil {
+		return nil, err
+	}
+	if cfg.lockoutDuration, err = durationFromEnv(loginLockoutDurationKey, defaultLoginLockoutDuration); err != nil {
+		return nil, err
+	}
+	if cfg.backoffBase, err = durationFromEnv(loginBackoffBaseKey, defaultLoginBackoffBase); err != nil {
+		return nil, err
+	}
+	if cfg.backoffMax, err = durationFromEnv(loginBackoffMaxKey, defaultLoginBackoffMax); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func positiveIntFromEnv(key string, def int64) (int64, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return def, nil
+	}
+
+	parsed, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || parsed <= 0 {
+		return 0, errors.Errorf("invalid %s: %q", key, raw)
+	}
+
+	return parsed, nil
+}
+
+func durationFromEnv(key string, def time.Duration) (time.Duration, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return def, nil
+	}
+
+	parsed, err := time.ParseDuration(raw)
+	if err != nil || parsed <= 0 {
+		return 0, errors.Errorf("invalid %s: %q", key, raw)
+	}
+
+	return parsed, nil
+}
+
+func (cfg *loginProtectionConfig) MaxFailures() int64 {
+	return cfg.maxFailures
+}
+
+func (cfg *loginProtectionConfig) IPMaxFailures() int64 {
+	return cfg.ipMaxFailures
+}
+
+func (cfg *loginProtectionConfig) FailureWindow() time.Duration {
+	return cfg.failureWindow
+}
+
+func (cfg *loginProtectionConfig) LockoutDuration() time.Duration {
+	return cfg.lockoutDuration
+}
+
+func (cfg *loginProtectionConfig) BackoffBase() time.Duration {
+	return cfg.backoffBase
+}
+
+func (cfg *loginProtectionConfig) BackoffMax() time.Duration {
+	return cfg.backoffMax
+}
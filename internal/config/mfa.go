@@ -0,0 +1,35 @@
+package config
+
+import (
+	"os"
+)
+
+const (
+	totpIssuerEnvName = "TOTP_ISSUER"
+
+	defaultTOTPIssuer = "auth"
+)
+
+// MFAConfig параметры двухфакторной аутентификации
+type MFAConfig interface {
+	// Issuer название сервиса в приложении-аутентификаторе
+	Issuer() string
+}
+
+type mfaConfig struct {
+	issuer string
+}
+
+// NewMFAConfig читает параметры двухфакторной аутентификации из переменных окружения
+func NewMFAConfig() (MFAConfig, error) {
+	issuer := os.Getenv(totpIssuerEnvName)
+	if issuer == "" {
+		issuer = defaultTOTPIssuer
+	}
+
+	return &mfaConfig{issuer: issuer}, nil
+}
+
+func (cfg *mfaConfig) Issuer() string {
+	return cfg.issuer
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"net"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	notifierTypeEnvName     = "NOTIFIER_TYPE"
+	notifierFilePathEnvName = "NOTIFIER_FILE_PATH"
+	passwordResetURLEnvName = "PASSWORD_RESET_URL"
+	emailVerifyURLEnvName   = "EMAIL_VERIFICATION_URL"
+	notifierWorkersEnvName  = "NOTIFIER_WORKERS"
+	notifierQueueEnvName    = "NOTIFIER_QUEUE_SIZE"
+	notifierTimeoutEnvName  = "NOTIFIER_TIMEOUT"
+
+	smtpHostEnvName     = "SMTP_HOST"
+	smtpPortEnvName     = "SMTP_PORT"
+	smtpUsernameEnvName = "SMTP_USERNAME"
+	smtpPasswordEnvName = "SMTP_PASSWORD"
+	smtpFromEnvName     = "SMTP_FROM"
+
+	defaultSMTPPort = "587"
+
+	defaultNotifierWorkers   = 4
+	defaultNotifierQueueSize = 100
+	defaultNotifierTimeout   = 30 * time.Second
+)
+
+// Способы доставки уведомлений
+const (
+	// NotifierSMTP письма через SMTP-сервер
+	NotifierSMTP = "smtp"
+	// NotifierFile запись уведомлений в файл или журнал, для локальной разработки
+	NotifierFile = "file"
+)
+
+// NotifierConfig параметры доставки уведомлений пользователям
+type NotifierConfig interface {
+	// Type способ доставки: smtp или file (по умолчанию)
+	Type() string
+	// FilePath файл для уведомлений при Type() == file; пусто — уведомления пишутся в журнал
+	FilePath() string
+	// PasswordResetURL адрес страницы сброса пароля; токен добавляется параметром token
+	PasswordResetURL() string
+	// EmailVerificationURL адрес страницы подтверждения email; токен добавляется параметром token
+	EmailVerificationURL() string
+	// SMTPAddress адрес SMTP-сервера host:port
+	SMTPAddress() string
+	SMTPHost() string
+	SMTPUsername() string
+	SMTPPassword() string
+	// SMTPFrom адрес отправителя
+	SMTPFrom() string
+	// Workers сколько уведомлений доставляется одновременно
+	Workers() int
+	// QueueSize сколько уведомлений может ждать доставки; сверх этого новые отбрасываются
+	QueueSize() int
+	// Timeout предельное время доставки одного уведомления, включая подключение к серверу
+	Timeout() time.Duration
+}
+
+type notifierConfig struct {
+	notifierType     string
+	filePath         string
+	passwordResetURL string
+	emailVerifyURL   string
+
+	smtpHost     string
+	smtpPort     string
+	smtpUsername string
+	smtpPassword string
+	smtpFrom     string
+
+	workers   int
+	queueSize int
+	timeout   time.Duration
+}
+
+// NewNotifierConfig читает параметры уведомлений из переменных окружения.
+// Для smtp обязательны SMTP_HOST и SMTP_FROM.
+func NewNotifierConfig() (NotifierConfig, error) {
+	cfg := &notifierConfig{
+		notifierType:     os.Getenv(notifierTypeEnvName),
+		filePath:         os.Getenv(notifierFilePathEnvName),
+		passwordResetURL: os.Getenv(passwordResetURLEnvName),
+		emailVerifyURL:   os.Getenv(emailVerifyURLEnvName),
+		smtpHost:         os.Getenv(smtpHostEnvName),
+		smtpPort:         os.Getenv(smtpPortEnvName),
+		smtpUsername:     os.Getenv(smtpUsernameEnvName),
+		smtpPassword:     os.Getenv(smtpPasswordEnvName),
+		smtpFrom:         os.Getenv(smtpFromEnvName),
+	}
+
+	if cfg.notifierType == "" {
+		cfg.notifierType = NotifierFile
+	}
+	if cfg.smtpPort == "" {
+		cfg.smtpPort = defaultSMTPPort
+	}
+
+	workers, err := positiveIntFromEnv(notifierWorkersEnvName, defaultNotifierWorkers)
+	if err != nil {
+		return nil, err
+	}
+	queueSize, err := positiveIntFromEnv(notifierQueueEnvName, defaultNotifierQueueSize)
+	if err != nil {
+		return nil, err
+	}
+	cfg.workers, cfg.queueSize = int(workers), int(queueSize)
+	if cfg.timeout, err = durationFromEnv(notifierTimeoutEnvName, defaultNotifierTimeout); err != nil {
+		return nil, err
+	}
+
+	switch cfg.notifierType {
+	case NotifierFile:
+	case NotifierSMTP:
+		if cfg.smtpHost == "" || cfg.smtpFrom == "" {
+			return nil, errors.Errorf("%s and %s are required for smtp notifier", smtpHostEnvName, smtpFromEnvName)
+		}
+	default:
+		return nil, errors.Errorf("invalid %s: %q", notifierTypeEnvName, cfg.notifierType)
+	}
+
+	return cfg, nil
+}
+
+func (cfg *notifierConfig) Type() string {
+	return cfg.notifierType
+}
+
+func (cfg *notifierConfig) FilePath() string {
+	return cfg.filePath
+}
+
+func (cfg *notifierConfig) PasswordResetURL() string {
+	return cfg.passwordResetURL
+}
+
+func (cfg *notifierConfig) EmailVerificationURL() string {
+	return cfg.emailVerifyURL
+}
+
+func (cfg *notifierConfig) SMTPAddress() string {
+	return net.JoinHostPort(cfg.smtpHost, cfg.smtpPort)
+}
+
+func (cfg *notifierConfig) SMTPHost() string {
+	return cfg.smtpHost
+}
+
+func (cfg *notifierConfig) SMTPUsername() string {
+	return cfg.smtpUsername
+}
+
+func (cfg *notifierConfig) SMTPPassword() string {
+	return cfg.smtpPassword
+}
+
+func (cfg *notifierConfig) SMTPFrom() string {
+	return cfg.smtpFrom
+}
+
+func (cfg *notifierConfig) Workers() int {
+	return cfg.workers
+}
+
+func (cfg *notifierConfig) QueueSize() int {
+	return cfg.queueSize
+}
+
+func (cfg *notifierConfig) Timeout() time.Duration {
+	return cfg.timeout
+}
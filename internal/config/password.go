@@ -0,0 +1,75 @@
+package config
+
+import (
+	"os"
+)
+
+const (
+	passwordMinLengthKey      = "PASSWORD_MIN_LENGTH"
+	passwordMaxLengthKey      = "PASSWORD_MAX_LENGTH"
+	passwordMinCharClassesKey = "PASSWORD_MIN_CHAR_CLASSES"
+	passwordBlocklistPathKey  = "PASSWORD_BLOCKLIST_PATH"
+
+	defaultPasswordMinLength      = 8
+	defaultPasswordMaxLength      = 72
+	defaultPasswordMinCharClasses = 3
+)
+
+// PasswordPolicyConfig параметры политики паролей
+type PasswordPolicyConfig interface {
+	// MinLength минимальная длина пароля в символах
+	MinLength() int
+	// MaxLength максимальная длина пароля в байтах (bcrypt учитывает только первые 72 байта)
+	MaxLength() int
+	// MinCharClasses сколько классов символов (строчные, заглавные, цифры, прочие) должно быть в пароле
+	MinCharClasses() int
+	// BlocklistPath файл с SHA-1 хешами утекших паролей; пусто — проверка выключена
+	BlocklistPath() string
+}
+
+type passwordPolicyConfig struct {
+	minLength      int
+	maxLength      int
+	minCharClasses int
+	blocklistPath  string
+}
+
+// NewPasswordPolicyConfig читает параметры политики паролей из переменных окружения.
+// Все параметры необязательны и имеют значения по умолчанию.
+func NewPasswordPolicyConfig() (PasswordPolicyConfig, error) {
+	minLength, err := positiveIntFromEnv(passwordMinLengthKey, defaultPasswordMinLength)
+	if err != nil {
+		return nil, err
+	}
+	maxLength, err := positiveIntFromEnv(passwordMaxLengthKey, defaultPasswordMaxLength)
+	if err != nil {
+		return nil, err
+	}
+	minCharClasses, err := positiveIntFromEnv(passwordMinCharClassesKey, defaultPasswordMinCharClasses)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passwordPolicyConfig{
+		minLength:      int(minLength),
+		maxLength:      int(maxLength),
+		minCharClasses: int(minCharClasses),
+		blocklistPath:  os.Getenv(passwordBlocklistPathKey),
+	}, nil
+}
+
+func (cfg *passwordPolicyConfig) MinLength() int {
+	return cfg.minLength
+}
+
+func (cfg *passwordPolicyConfig) MaxLength() int {
+	return cfg.maxLength
+}
+
+func (cfg *passwordPolicyConfig) MinCharClasses() int {
+	return cfg.minCharClasses
+}
+
+func (cfg *passwordPolicyConfig) BlocklistPath() string {
+	return cfg.blocklistPath
+}
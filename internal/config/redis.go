@@ -15,6 +15,13 @@ const (
 	redisConnectionTimeoutEnvName = "REDIS_CONNECTION_TIMEOUT_SEC"
 	redisMaxIdleEnvName           = "REDIS_MAX_IDLE"
 	redisIdleTimeoutEnvName       = "REDIS_IDLE_TIMEOUT_SEC"
+	redisBreakerFailuresEnvName   = "REDIS_BREAKER_FAILURES"
+	redisProbeIntervalEnvName     = "REDIS_PROBE_INTERVAL"
+	redisIOTimeoutEnvName         = "REDIS_IO_TIMEOUT"
+
+	defaultRedisBreakerFailures = 5
+	defaultRedisProbeInterval   = 5 * time.Second
+	defaultRedisIOTimeout       = time.Second
 )
 
 // RedisConfig представляет интерфейс для получения конфигурации Redis
@@ -23,6 +30,9 @@ type RedisConfig interface {
 	ConnectionTimeout() time.Duration
 	MaxIdle() int
 	IdleTimeout() time.Duration
+	BreakerFailures() int
+	ProbeInterval() time.Duration
+	IOTimeout() time.Duration
 }
 
 type redisConfig struct {
@@ -33,6 +43,10 @@ type redisConfig struct {
 
 	maxIdle     int
 	idleTimeout time.Duration
+
+	breakerFailures int
+	probeInterval   time.Duration
+	ioTimeout       time.Duration
 }
 
 // Address получаем хост и порт на котором запущен redis.
@@ -55,6 +69,23 @@ func (cfg *redisConfig) IdleTimeout() time.Duration {
 	return cfg.idleTimeout
 }
 
+// BreakerFailures число ошибок подряд, после которого Redis считается недоступным
+// и запросы к нему перестают отправляться.
+func (cfg *redisConfig) BreakerFailures() int {
+	return cfg.breakerFailures
+}
+
+// ProbeInterval как часто проверять через PING, вернулся ли недоступный Redis.
+func (cfg *redisConfig) ProbeInterval() time.Duration {
+	return cfg.probeInterval
+}
+
+// IOTimeout сколько ждать чтения или записи одной команды. Redis, который держит соединение,
+// но не отвечает, дает ошибку по таймауту, и размыкатель ее учитывает.
+func (cfg *redisConfig) IOTimeout() time.Duration {
+	return cfg.ioTimeout
+}
+
 // NewRedisConfig получаем переменные окружения для старта redis.
 func NewRedisConfig() (*redisConfig, error) {
 	host := os.Getenv(redisHostEnvName)
@@ -97,11 +128,29 @@ func NewRedisConfig() (*redisConfig, error) {
 		return nil, errors.Wrap(err, "failed to parse idle timeout")
 	}
 
+	breakerFailures, err := positiveIntFromEnv(redisBreakerFailuresEnvName, defaultRedisBreakerFailures)
+	if err != nil {
+		return nil, err
+	}
+
+	probeInterval, err := durationFromEnv(redisProbeIntervalEnvName, defaultRedisProbeInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	ioTimeout, err := durationFromEnv(redisIOTimeoutEnvName, defaultRedisIOTimeout)
+	if err != nil {
+		return nil, err
+	}
+
 	return &redisConfig{
 		host:              host,
 		port:              port,
 		connectionTimeout: time.Duration(connectionTimeout) * time.Second,
 		maxIdle:           maxIdle,
 		idleTimeout:       time.Duration(idleTimeout) * time.Second,
+		breakerFailures:   int(breakerFailures),
+		probeInterval:     probeInterval,
+		ioTimeout:         ioTimeout,
 	}, nil
 }
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const unverifiedLoginEnvName = "UNVERIFIED_LOGIN_POLICY"
+
+// Как Login обращается с учетными записями без подтвержденного email
+const (
+	// UnverifiedLoginAllow вход как обычно
+	UnverifiedLoginAllow = "allow"
+	// UnverifiedLoginLimited вход только с ролью user, остальные роли выдаются после подтверждения
+	UnverifiedLoginLimited = "limited"
+	// UnverifiedLoginDeny вход запрещен до подтверждения email
+	UnverifiedLoginDeny = "deny"
+)
+
+// EmailVerificationConfig параметры подтверждения email
+type EmailVerificationConfig interface {
+	// UnverifiedLogin политика входа без подтвержденного email: allow (по умолчанию), limited или deny
+	UnverifiedLogin() string
+}
+
+type emailVerificationConfig struct {
+	unverifiedLogin string
+}
+
+// NewEmailVerificationConfig читает политику входа без подтвержденного email из UNVERIFIED_LOGIN_POLICY
+func NewEmailVerificationConfig() (EmailVerificationConfig, error) {
+	policy := os.Getenv(unverifiedLoginEnvName)
+	switch policy {
+	case "":
+		policy = UnverifiedLoginAllow
+	case UnverifiedLoginAllow, UnverifiedLoginLimited, UnverifiedLoginDeny:
+	default:
+		return nil, errors.Errorf("invalid %s: %q", unverifiedLoginEnvName, policy)
+	}
+
+	return &emailVerificationConfig{unverifiedLogin: policy}, nil
+}
+
+func (cfg *emailVerificationConfig) UnverifiedLogin() string {
+	return cfg.unverifiedLogin
+}
@@ -3,6 +3,7 @@ package converter
 import (
 	"github.com/Ippolid/auth/internal/model"
 	"github.com/Ippolid/auth/pkg/auth_v1"
+	"github.com/Ippolid/auth/pkg/role_v1"
 	"github.com/Ippolid/auth/pkg/user_v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -28,9 +29,14 @@ func ToUserAPIFromUserGet(req *model.User) *user_v1.GetResponse {
 		return nil
 	}
 	role := user_v1.Role_USER // Значение по умолчанию
-	if req.Role {
-		role = user_v1.Role_ADMIN // Если Role = true, устанавливаем ADMIN
+	if model.HasRole(req.Roles, model.RoleAdmin) {
+		role = user_v1.Role_ADMIN // Если среди ролей есть admin, устанавливаем ADMIN
 	}
+	var verifiedAt *timestamppb.Timestamp
+	if req.VerifiedAt != nil {
+		verifiedAt = timestamppb.New(*req.VerifiedAt)
+	}
+
 	return &user_v1.GetResponse{
 		User: &user_v1.UserGet{
 			Id: req.ID,
@@ -38,9 +44,11 @@ func ToUserAPIFromUserGet(req *model.User) *user_v1.GetResponse {
 				Name:  *req.User.Name,
 				Email: *req.User.Email,
 			},
-			Role:      role,
-			CreatedAt: timestamppb.New(req.CreatedAt),
-			UpdatedAt: timestamppb.New(req.CreatedAt),
+			Role:       role,
+			Roles:      req.Roles,
+			CreatedAt:  timestamppb.New(req.CreatedAt),
+			UpdatedAt:  timestamppb.New(req.CreatedAt),
+			VerifiedAt: verifiedAt,
 		},
 	}
 }
@@ -54,8 +62,14 @@ func ToUserCreateFromUserAPI(req *user_v1.CreateRequest) *model.User {
 	name := req.GetInfo().GetUser().Name
 	email := req.GetInfo().GetUser().Email
 	password := req.GetInfo().GetPassword()
-	// Преобразуем Role в bool (предполагая, что Role - это enum или int32)
-	role := req.GetInfo().GetRole() > 0
+	// Список ролей имеет приоритет, устаревшее поле role учитывается только без него
+	roles := req.GetInfo().GetRoles()
+	if len(roles) == 0 {
+		roles = []string{model.RoleUser}
+		if req.GetInfo().GetRole() == user_v1.Role_ADMIN {
+			roles = []string{model.RoleAdmin}
+		}
+	}
 
 	userInfo := model.UserInfo{
 		Name:  &name,
@@ -65,11 +79,45 @@ func ToUserCreateFromUserAPI(req *user_v1.CreateRequest) *model.User {
 	user := model.User{
 		User:     userInfo,
 		Password: password,
-		Role:     role,
+		Roles:    roles,
 	}
 	return &user
 }
 
+// ToChangePasswordFromUserAPI преобразует ChangePasswordRequest в ChangePasswordRequest
+func ToChangePasswordFromUserAPI(req *user_v1.ChangePasswordRequest) *model.ChangePasswordRequest {
+	if req == nil {
+		return nil
+	}
+	return &model.ChangePasswordRequest{
+		ID:              req.GetId(),
+		CurrentPassword: req.GetCurrentPassword(),
+		NewPassword:     req.GetNewPassword(),
+	}
+}
+
+// ToResetPasswordAPIFromService преобразует ResetPasswordResponse в ResetPasswordResponse
+func ToResetPasswordAPIFromService(resp *model.ResetPasswordResponse) *user_v1.ResetPasswordResponse {
+	if resp == nil {
+		return nil
+	}
+	return &user_v1.ResetPasswordResponse{
+		Token:     resp.Token,
+		ExpiresAt: timestamppb.New(resp.ExpiresAt),
+	}
+}
+
+// ToConfirmPasswordResetFromUserAPI преобразует ConfirmPasswordResetRequest в ConfirmPasswordResetRequest
+func ToConfirmPasswordResetFromUserAPI(req *user_v1.ConfirmPasswordResetRequest) *model.ConfirmPasswordResetRequest {
+	if req == nil {
+		return nil
+	}
+	return &model.ConfirmPasswordResetRequest{
+		Token:       req.GetToken(),
+		NewPassword: req.GetNewPassword(),
+	}
+}
+
 // ToLoginFromAuthAPI преобразует LoginRequest в LoginRequest
 func ToLoginFromAuthAPI(req *auth_v1.LoginRequest) *model.LoginRequest {
 	if req == nil {
@@ -112,3 +160,166 @@ func ToCheckAccessFromAuthAPI(req *auth_v1.CheckRequest) *model.CheckRequest {
 	}
 
 }
+
+// ToCheckManyFromAuthAPI преобразует CheckManyRequest в CheckManyRequest
+func ToCheckManyFromAuthAPI(req *auth_v1.CheckManyRequest) *model.CheckManyRequest {
+	if req == nil {
+		return nil
+	}
+	return &model.CheckManyRequest{
+		EndpointAddresses: req.GetEndpointAddresses(),
+	}
+}
+
+// ToCheckManyAPIFromDecisions преобразует решения о доступе в CheckManyResponse
+func ToCheckManyAPIFromDecisions(decisions []model.EndpointDecision) *auth_v1.CheckManyResponse {
+	results := make([]*auth_v1.EndpointDecision, 0, len(decisions))
+	for i := range decisions {
+		results = append(results, ToEndpointDecisionAPI(&decisions[i]))
+	}
+
+	return &auth_v1.CheckManyResponse{Results: results}
+}
+
+// ToEndpointDecisionAPI преобразует EndpointDecision в EndpointDecision
+func ToEndpointDecisionAPI(decision *model.EndpointDecision) *auth_v1.EndpointDecision {
+	if decision == nil {
+		return nil
+	}
+
+	res := &auth_v1.EndpointDecision{
+		EndpointAddress: decision.EndpointAddress,
+		Allowed:         decision.Allowed,
+		Reason:          decision.Reason,
+		RequiredRoles:   decision.RequiredRoles,
+		CallerRoles:     decision.CallerRoles,
+	}
+	if decision.Rule != nil {
+		res.MatchedRule = &auth_v1.AccessRule{
+			Pattern: decision.Rule.Pattern,
+			Effect:  decision.Rule.Effect,
+			Roles:   decision.Rule.Roles,
+		}
+	}
+	if decision.Grant != nil {
+		res.Grant = &auth_v1.AccessGrant{
+			Role:     decision.Grant.Role,
+			Endpoint: decision.Grant.Endpoint,
+		}
+	}
+
+	return res
+}
+
+// ToExplainAccessFromAuthAPI преобразует ExplainAccessRequest в ExplainAccessRequest
+func ToExplainAccessFromAuthAPI(req *auth_v1.ExplainAccessRequest) *model.ExplainAccessRequest {
+	if req == nil {
+		return nil
+	}
+	return &model.ExplainAccessRequest{
+		EndpointAddress: req.GetEndpointAddress(),
+		Username:        req.GetUsername(),
+		Roles:           req.GetRoles(),
+	}
+}
+
+// ToLogoutFromAuthAPI преобразует LogoutRequest в LogoutRequest
+func ToLogoutFromAuthAPI(req *auth_v1.LogoutRequest) *model.LogoutRequest {
+	if req == nil {
+		return nil
+	}
+	return &model.LogoutRequest{
+		RefreshToken: req.GetRefreshToken(),
+	}
+}
+
+// ToLogoutAllFromAuthAPI преобразует LogoutAllRequest в LogoutAllRequest
+func ToLogoutAllFromAuthAPI(req *auth_v1.LogoutAllRequest) *model.LogoutAllRequest {
+	if req == nil {
+		return nil
+	}
+	return &model.LogoutAllRequest{
+		RefreshToken: req.GetRefreshToken(),
+	}
+}
+
+// ToUnlockLoginFromAuthAPI преобразует UnlockLoginRequest в UnlockLoginRequest
+func ToUnlockLoginFromAuthAPI(req *auth_v1.UnlockLoginRequest) *model.UnlockLoginRequest {
+	if req == nil {
+		return nil
+	}
+	return &model.UnlockLoginRequest{
+		Username: req.GetUsername(),
+		IP:       req.GetIp(),
+	}
+}
+
+// ToLoginAPIFromService преобразует LoginResponse в ответ Login и VerifyMFA
+func ToLoginAPIFromService(resp *model.LoginResponse) *auth_v1.LoginResponse {
+	if resp == nil {
+		return nil
+	}
+	return &auth_v1.LoginResponse{
+		RefreshToken: resp.Token,
+		MfaToken:     resp.MFAToken,
+	}
+}
+
+// ToVerifyMFAFromAuthAPI преобразует VerifyMFARequest в VerifyMFARequest
+func ToVerifyMFAFromAuthAPI(req *auth_v1.VerifyMFARequest) *model.VerifyMFARequest {
+	if req == nil {
+		return nil
+	}
+	return &model.VerifyMFARequest{
+		MFAToken: req.GetMfaToken(),
+		Code:     req.GetCode(),
+	}
+}
+
+// ToEnrollTOTPAPIFromService преобразует EnrollTOTPResponse в EnrollTOTPResponse
+func ToEnrollTOTPAPIFromService(resp *model.EnrollTOTPResponse) *auth_v1.EnrollTOTPResponse {
+	if resp == nil {
+		return nil
+	}
+	return &auth_v1.EnrollTOTPResponse{
+		Secret: resp.Secret,
+		Uri:    resp.URI,
+	}
+}
+
+// ToRoleAPIFromRoles преобразует список ролей в ListResponse
+func ToRoleAPIFromRoles(roles []model.Role) *role_v1.ListResponse {
+	res := make([]*role_v1.Role, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, &role_v1.Role{
+			Id:        role.ID,
+			Name:      role.Name,
+			Endpoints: role.Endpoints,
+			CreatedAt: timestamppb.New(role.CreatedAt),
+		})
+	}
+
+	return &role_v1.ListResponse{Roles: res}
+}
+
+// ToRolePermissionFromRoleAPI преобразует PermissionRequest в RolePermission
+func ToRolePermissionFromRoleAPI(req *role_v1.PermissionRequest) *model.RolePermission {
+	if req == nil {
+		return nil
+	}
+	return &model.RolePermission{
+		Role:     req.GetRole(),
+		Endpoint: req.GetEndpoint(),
+	}
+}
+
+// ToUserRoleFromRoleAPI преобразует UserRoleRequest в UserRole
+func ToUserRoleFromRoleAPI(req *role_v1.UserRoleRequest) *model.UserRole {
+	if req == nil {
+		return nil
+	}
+	return &model.UserRole{
+		UserID: req.GetUserId(),
+		Role:   req.GetRole(),
+	}
+}
@@ -0,0 +1,203 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Ippolid/auth/internal/keyring"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/requestctx"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/Ippolid/auth/pkg/user_v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Access уровень доступа к методу
+type Access int
+
+const (
+	// AccessPublic метод доступен без токена. Если валидный токен передан, его claims все равно
+	// попадают в контекст; невалидный токен игнорируется — метод проверяет его сам, если нужно.
+	AccessPublic Access = iota
+	// AccessAuthenticated нужен валидный access-токен
+	AccessAuthenticated
+	// AccessOwner нужен access-токен владельца учетной записи из запроса или администратора
+	AccessOwner
+	// AccessSelf нужен access-токен владельца учетной записи из запроса; администратор не исключение
+	AccessSelf
+	// AccessAdmin нужен access-токен администратора
+	AccessAdmin
+)
+
+// MethodRule правило авторизации одного метода
+type MethodRule struct {
+	Access Access
+	// Owner возвращает ID учетной записи, к которой обращается запрос. Нужен для AccessOwner и AccessSelf.
+	Owner func(req interface{}) int64
+	// Check дополнительная проверка запроса с учетом вызывающего; claims равны nil для анонимного вызова
+	Check func(claims *model.UserClaims, req interface{}) error
+}
+
+// MethodRules правила авторизации по полному имени метода gRPC
+type MethodRules map[string]MethodRule
+
+// ServerMethodRules правила авторизации методов самого сервиса auth.
+// Check и CheckMany проверяют токен сами, чтобы вернуть клиенту причину отказа в errdetails;
+// Logout и LogoutAll принимают refresh-токен в теле запроса.
+var ServerMethodRules = MethodRules{
+	"/user_v1.UserV1/Create":               {Access: AccessPublic, Check: checkSignupRoles},
+	"/user_v1.UserV1/Get":                  {Access: AccessOwner, Owner: requestID},
+	"/user_v1.UserV1/Update":               {Access: AccessOwner, Owner: requestID},
+	"/user_v1.UserV1/Delete":               {Access: AccessAdmin},
+	"/user_v1.UserV1/ChangePassword":       {Access: AccessSelf, Owner: requestID},
+	"/user_v1.UserV1/ResetPassword":        {Access: AccessAdmin},
+	"/user_v1.UserV1/RequestPasswordReset": {Access: AccessPublic},
+	"/user_v1.UserV1/ConfirmPasswordReset": {Access: AccessPublic},
+	"/user_v1.UserV1/VerifyEmail":          {Access: AccessPublic},
+
+	"/api.auth_v1.Auth/Login":           {Access: AccessPublic},
+	"/api.auth_v1.Auth/VerifyMFA":       {Access: AccessPublic},
+	"/api.auth_v1.Auth/GetRefreshToken": {Access: AccessPublic},
+	"/api.auth_v1.Auth/GetAccessToken":  {Access: AccessPublic},
+	"/api.auth_v1.Auth/Logout":          {Access: AccessPublic},
+	"/api.auth_v1.Auth/LogoutAll":       {Access: AccessPublic},
+	"/api.auth_v1.Auth/Check":           {Access: AccessPublic},
+	"/api.auth_v1.Auth/CheckMany":       {Access: AccessPublic},
+	"/api.auth_v1.Auth/EnrollTOTP":      {Access: AccessAuthenticated},
+	"/api.auth_v1.Auth/ConfirmTOTP":     {Access: AccessAuthenticated},
+	"/api.auth_v1.Auth/UnlockLogin":     {Access: AccessAdmin},
+	"/api.auth_v1.Auth/ExplainAccess":   {Access: AccessAdmin},
+
+	"/role_v1.RoleV1/Create":           {Access: AccessAdmin},
+	"/role_v1.RoleV1/List":             {Access: AccessAdmin},
+	"/role_v1.RoleV1/Delete":           {Access: AccessAdmin},
+	"/role_v1.RoleV1/AddPermission":    {Access: AccessAdmin},
+	"/role_v1.RoleV1/RemovePermission": {Access: AccessAdmin},
+	"/role_v1.RoleV1/AssignRole":       {Access: AccessAdmin},
+	"/role_v1.RoleV1/UnassignRole":     {Access: AccessAdmin},
+}
+
+// Revocations сообщает, завершена ли сессия (семейство refresh-токенов), в которой выдан токен
+type Revocations interface {
+	IsRevoked(ctx context.Context, familyID string) (bool, error)
+}
+
+// NewAuthInterceptor создает интерцептор, который проверяет access-токен, то, что его сессия
+// не завершена, и правила доступа метода. Методы, которых нет в rules, запрещены:
+// новый метод не станет публичным по недосмотру.
+func NewAuthInterceptor(keys keyring.Source, revocations Revocations, rules MethodRules) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		rule, ok := rules[info.FullMethod]
+		if !ok {
+			return nil, model.ErrMethodNotDeclared
+		}
+
+		claims, err := bearerClaims(ctx, keys, revocations)
+		if err != nil && rule.Access != AccessPublic {
+			return nil, err
+		}
+		if claims != nil {
+			ctx = requestctx.ContextWithClaims(ctx, claims)
+		}
+
+		if err = authorize(rule, claims, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func authorize(rule MethodRule, claims *model.UserClaims, req interface{}) error {
+	isAdmin := claims != nil && model.HasRole(claims.Roles, model.RoleAdmin)
+
+	switch rule.Access {
+	case AccessAdmin:
+		if !isAdmin {
+			return model.ErrAdminRequired
+		}
+	case AccessOwner, AccessSelf:
+		if rule.Access == AccessOwner && isAdmin {
+			break
+		}
+		// Токены без uid выданы до появления проверки владельца: доступ к своей записи — после повторного входа
+		if claims.UserID == 0 || rule.Owner == nil || rule.Owner(req) != claims.UserID {
+			return model.ErrNotAccountOwner
+		}
+	}
+
+	if rule.Check != nil {
+		return rule.Check(claims, req)
+	}
+
+	return nil
+}
+
+// bearerClaims проверяет access-токен из заголовка authorization и то, что его сессия не завершена
+func bearerClaims(ctx context.Context, keys keyring.Source, revocations Revocations) (*model.UserClaims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: metadata is not provided", model.ErrAccessTokenInvalid)
+	}
+
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 || !strings.HasPrefix(authHeader[0], "Bearer ") {
+		return nil, fmt.Errorf("%w: authorization header is not provided", model.ErrAccessTokenInvalid)
+	}
+
+	claims, err := utils.VerifyToken(strings.TrimPrefix(authHeader[0], "Bearer "), keys.Access())
+	if err != nil {
+		return nil, model.ErrAccessTokenInvalid
+	}
+
+	// Токены без семейства выданы до появления сессий и отозваны быть не могут
+	if claims.FamilyID != "" {
+		revoked, errRevoked := revocations.IsRevoked(ctx, claims.FamilyID)
+		if errRevoked != nil {
+			return nil, fmt.Errorf("failed to check token revocation: %w", errRevoked)
+		}
+		if revoked {
+			return nil, model.ErrTokenRevoked
+		}
+	}
+
+	return claims, nil
+}
+
+type idRequest interface {
+	GetId() int64
+}
+
+func requestID(req interface{}) int64 {
+	if r, ok := req.(idRequest); ok {
+		return r.GetId()
+	}
+
+	return 0
+}
+
+// checkSignupRoles запрещает самостоятельную регистрацию с ролями, отличными от user.
+// Создать пользователя с другими ролями может только администратор.
+func checkSignupRoles(claims *model.UserClaims, req interface{}) error {
+	if claims != nil && model.HasRole(claims.Roles, model.RoleAdmin) {
+		return nil
+	}
+
+	createReq, ok := req.(*user_v1.CreateRequest)
+	if !ok {
+		return nil
+	}
+
+	if createReq.GetInfo().GetRole() != user_v1.Role_USER {
+		return model.ErrAdminRequired
+	}
+	for _, role := range createReq.GetInfo().GetRoles() {
+		if role != model.RoleUser {
+			return model.ErrAdminRequired
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Ippolid/auth/internal/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var kindCodes = map[model.Kind]codes.Code{
+	model.KindNotFound:           codes.NotFound,
+	model.KindAlreadyExists:      codes.AlreadyExists,
+	model.KindUnauthenticated:    codes.Unauthenticated,
+	model.KindPermissionDenied:   codes.PermissionDenied,
+	model.KindInvalidArgument:    codes.InvalidArgument,
+	model.KindFailedPrecondition: codes.FailedPrecondition,
+	model.KindResourceExhausted:  codes.ResourceExhausted,
+}
+
+// ErrorCodesInterceptor переводит доменные ошибки из каталога model в статусы gRPC.
+// Готовые статусы (например, с errdetails) проходят без изменений, а прочие ошибки
+// превращаются в Internal без текста, чтобы не раскрывать клиенту детали хранилища.
+func ErrorCodesInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	res, err := handler(ctx, req)
+	if err == nil {
+		return res, nil
+	}
+
+	return res, ToStatus(err)
+}
+
+// ToStatus переводит ошибку в статус gRPC по правилам ErrorCodesInterceptor
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return st.Err()
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	if kind, ok := model.KindOf(err); ok {
+		if code, known := kindCodes[kind]; known {
+			return status.Error(code, err.Error())
+		}
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
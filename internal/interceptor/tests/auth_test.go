@@ -0,0 +1,277 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/keyring"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/requestctx"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/Ippolid/auth/pkg/user_v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var keys = keyring.NewStatic(utils.NewHMACKey([]byte("access-secret")), utils.NewHMACKey([]byte("refresh-secret")))
+
+const (
+	activeFamily  = "active-family"
+	revokedFamily = "revoked-family"
+	brokenFamily  = "broken-family"
+)
+
+// revocations отозвано только семейство revokedFamily; проверка brokenFamily завершается ошибкой
+type revocations struct{}
+
+func (revocations) IsRevoked(_ context.Context, familyID string) (bool, error) {
+	if familyID == brokenFamily {
+		return false, errors.New("database is unavailable")
+	}
+
+	return familyID == revokedFamily, nil
+}
+
+func incomingToken(t *testing.T, userID int64, roles ...string) context.Context {
+	return incomingSessionToken(t, activeFamily, userID, roles...)
+}
+
+func incomingSessionToken(t *testing.T, familyID string, userID int64, roles ...string) context.Context {
+	token, err := utils.GenerateToken(model.UserInfoJwt{
+		UserID:   userID,
+		Username: "user",
+		Roles:    roles,
+		FamilyID: familyID,
+	}, keys.Access().Active, time.Minute)
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	signup := func(role user_v1.Role, roles ...string) *user_v1.CreateRequest {
+		return &user_v1.CreateRequest{Info: &user_v1.UserInfoCreate{Role: role, Roles: roles}}
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		ctx      context.Context
+		req      interface{}
+		wantCode codes.Code
+	}{
+		{
+			name:     "anonymous signup as user",
+			method:   "/user_v1.UserV1/Create",
+			ctx:      context.Background(),
+			req:      signup(user_v1.Role_USER),
+			wantCode: codes.OK,
+		},
+		{
+			name:     "anonymous signup as admin",
+			method:   "/user_v1.UserV1/Create",
+			ctx:      context.Background(),
+			req:      signup(user_v1.Role_ADMIN),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "user signup with extra roles",
+			method:   "/user_v1.UserV1/Create",
+			ctx:      incomingToken(t, 7, model.RoleUser),
+			req:      signup(user_v1.Role_USER, model.RoleUser, "support"),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "admin creates admin",
+			method:   "/user_v1.UserV1/Create",
+			ctx:      incomingToken(t, 1, model.RoleAdmin),
+			req:      signup(user_v1.Role_ADMIN),
+			wantCode: codes.OK,
+		},
+		{
+			name:     "get without token",
+			method:   "/user_v1.UserV1/Get",
+			ctx:      context.Background(),
+			req:      &user_v1.GetRequest{Id: 7},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "get own account",
+			method:   "/user_v1.UserV1/Get",
+			ctx:      incomingToken(t, 7, model.RoleUser),
+			req:      &user_v1.GetRequest{Id: 7},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "update other account",
+			method:   "/user_v1.UserV1/Update",
+			ctx:      incomingToken(t, 7, model.RoleUser),
+			req:      &user_v1.UpdateRequest{Id: 8},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "token without user id",
+			method:   "/user_v1.UserV1/Get",
+			ctx:      incomingToken(t, 0, model.RoleUser),
+			req:      &user_v1.GetRequest{Id: 0},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "admin reads other account",
+			method:   "/user_v1.UserV1/Get",
+			ctx:      incomingToken(t, 1, model.RoleAdmin),
+			req:      &user_v1.GetRequest{Id: 8},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "change own password",
+			method:   "/user_v1.UserV1/ChangePassword",
+			ctx:      incomingToken(t, 7, model.RoleUser),
+			req:      &user_v1.ChangePasswordRequest{Id: 7},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "admin changes other account password",
+			method:   "/user_v1.UserV1/ChangePassword",
+			ctx:      incomingToken(t, 1, model.RoleAdmin),
+			req:      &user_v1.ChangePasswordRequest{Id: 8},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "user deletes own account",
+			method:   "/user_v1.UserV1/Delete",
+			ctx:      incomingToken(t, 7, model.RoleUser),
+			req:      &user_v1.DeleteRequest{Id: 7},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "admin deletes account",
+			method:   "/user_v1.UserV1/Delete",
+			ctx:      incomingToken(t, 1, model.RoleAdmin),
+			req:      &user_v1.DeleteRequest{Id: 7},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "invalid token on public method",
+			method:   "/api.auth_v1.Auth/Login",
+			ctx:      metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer garbage")),
+			req:      nil,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "user issues password reset token",
+			method:   "/user_v1.UserV1/ResetPassword",
+			ctx:      incomingToken(t, 7, model.RoleUser),
+			req:      &user_v1.ResetPasswordRequest{Id: 8},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "user grants permission",
+			method:   "/role_v1.RoleV1/AddPermission",
+			ctx:      incomingToken(t, 7, model.RoleUser),
+			req:      nil,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "support explains access",
+			method:   "/api.auth_v1.Auth/ExplainAccess",
+			ctx:      incomingToken(t, 7, "support"),
+			req:      nil,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "user unlocks login",
+			method:   "/api.auth_v1.Auth/UnlockLogin",
+			ctx:      incomingToken(t, 7, model.RoleUser),
+			req:      nil,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "owner with revoked session",
+			method:   "/user_v1.UserV1/Get",
+			ctx:      incomingSessionToken(t, revokedFamily, 7, model.RoleUser),
+			req:      &user_v1.GetRequest{Id: 7},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "admin with revoked session",
+			method:   "/role_v1.RoleV1/Create",
+			ctx:      incomingSessionToken(t, revokedFamily, 1, model.RoleAdmin),
+			req:      nil,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "revocation check fails",
+			method:   "/api.auth_v1.Auth/UnlockLogin",
+			ctx:      incomingSessionToken(t, brokenFamily, 1, model.RoleAdmin),
+			req:      nil,
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "revoked session on public method",
+			method:   "/api.auth_v1.Auth/Login",
+			ctx:      incomingSessionToken(t, revokedFamily, 7, model.RoleUser),
+			req:      nil,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "undeclared method",
+			method:   "/user_v1.UserV1/Unknown",
+			ctx:      incomingToken(t, 1, model.RoleAdmin),
+			req:      nil,
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	auth := interceptor.NewAuthInterceptor(keys, revocations{}, interceptor.ServerMethodRules)
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				return nil, nil
+			}
+
+			_, err := auth(tt.ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			require.Equal(t, tt.wantCode, status.Code(interceptor.ToStatus(err)))
+		})
+	}
+}
+
+func TestAuthInterceptorClaimsInContext(t *testing.T) {
+	auth := interceptor.NewAuthInterceptor(keys, revocations{}, interceptor.ServerMethodRules)
+
+	var got *model.UserClaims
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		got, _ = requestctx.ClaimsFromContext(ctx)
+		return nil, nil
+	}
+
+	_, err := auth(incomingToken(t, 7, model.RoleUser), &user_v1.GetRequest{Id: 7},
+		&grpc.UnaryServerInfo{FullMethod: "/user_v1.UserV1/Get"}, handler)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	require.Equal(t, int64(7), got.UserID)
+}
+
+func TestAuthInterceptorDropsRevokedClaimsOnPublicMethod(t *testing.T) {
+	auth := interceptor.NewAuthInterceptor(keys, revocations{}, interceptor.ServerMethodRules)
+
+	// Завершенная сессия не должна давать прав и в публичном методе, например при регистрации
+	var found bool
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		_, found = requestctx.ClaimsFromContext(ctx)
+		return nil, nil
+	}
+
+	_, err := auth(incomingSessionToken(t, revokedFamily, 1, model.RoleAdmin), &user_v1.CreateRequest{Info: &user_v1.UserInfoCreate{Role: user_v1.Role_USER}},
+		&grpc.UnaryServerInfo{FullMethod: "/user_v1.UserV1/Create"}, handler)
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorCodesInterceptor(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    codes.Code
+		wantMessage string
+	}{
+		{
+			name:        "wrapped not found",
+			err:         fmt.Errorf("%w: id %d", model.ErrUserNotFound, 42),
+			wantCode:    codes.NotFound,
+			wantMessage: "user not found: id 42",
+		},
+		{
+			name:        "already exists",
+			err:         model.ErrRoleAlreadyExists,
+			wantCode:    codes.AlreadyExists,
+			wantMessage: model.ErrRoleAlreadyExists.Error(),
+		},
+		{
+			name:        "unauthenticated",
+			err:         fmt.Errorf("error creating log: %w", model.ErrInvalidRefreshToken),
+			wantCode:    codes.Unauthenticated,
+			wantMessage: "error creating log: invalid refresh token",
+		},
+		{
+			name:        "permission denied",
+			err:         model.ErrAdminRequired,
+			wantCode:    codes.PermissionDenied,
+			wantMessage: model.ErrAdminRequired.Error(),
+		},
+		{
+			name:        "invalid argument",
+			err:         model.ErrUserInfoRequired,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: model.ErrUserInfoRequired.Error(),
+		},
+		{
+			name:        "status passes through",
+			err:         status.Error(codes.ResourceExhausted, "slow down"),
+			wantCode:    codes.ResourceExhausted,
+			wantMessage: "slow down",
+		},
+		{
+			name:        "unknown error is hidden",
+			err:         fmt.Errorf("failed to execute query: connection refused"),
+			wantCode:    codes.Internal,
+			wantMessage: "internal error",
+		},
+		{
+			name:        "context canceled",
+			err:         fmt.Errorf("query: %w", context.Canceled),
+			wantCode:    codes.Canceled,
+			wantMessage: "query: context canceled",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				return nil, tt.err
+			}
+
+			_, err := interceptor.ErrorCodesInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+			st := status.Convert(err)
+			require.Equal(t, tt.wantCode, st.Code())
+			require.Equal(t, tt.wantMessage, st.Message())
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package keyring
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// fileConfig формат файла кольца ключей:
+//
+//	access:
+//	  active: "2025-06"
+//	  keys:
+//	    - id: "2025-06"
+//	      alg: ES256
+//	      private_key_path: access-2025-06.pem
+//	    - id: "2025-05"
+//	      alg: HS256
+//	      secret: "..."
+//	      not_after: 2025-07-01T00:00:00Z
+//	refresh:
+//	  active: "r1"
+//	  keys:
+//	    - id: "r1"
+//	      alg: HS256
+//	      secret: "..."
+//
+// Относительные пути к ключам считаются от каталога файла.
+type fileConfig struct {
+	Access  fileKeySet `yaml:"access"`
+	Refresh fileKeySet `yaml:"refresh"`
+}
+
+type fileKeySet struct {
+	Active string    `yaml:"active"`
+	Keys   []fileKey `yaml:"keys"`
+}
+
+type fileKey struct {
+	ID             string    `yaml:"id"`
+	Alg            string    `yaml:"alg"`
+	Secret         string    `yaml:"secret"`
+	PrivateKeyPath string    `yaml:"private_key_path"`
+	NotAfter       time.Time `yaml:"not_after"`
+}
+
+func loadFile(path string, now time.Time) (*keys, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read key ring '%s'", path)
+	}
+
+	var cfg fileConfig
+	if err = yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse key ring '%s'", path)
+	}
+
+	dir := filepath.Dir(path)
+
+	access, err := cfg.Access.build(dir, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "access keys")
+	}
+
+	refresh, err := cfg.Refresh.build(dir, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "refresh keys")
+	}
+
+	return &keys{access: access, refresh: refresh}, nil
+}
+
+func (s fileKeySet) build(dir string, now time.Time) (utils.KeySet, error) {
+	if len(s.Keys) == 0 {
+		return utils.KeySet{}, errors.New("no keys configured")
+	}
+
+	set := utils.KeySet{Keys: make([]utils.SigningKey, 0, len(s.Keys))}
+	seen := make(map[string]struct{}, len(s.Keys))
+	activeFound := false
+
+	for _, k := range s.Keys {
+		if k.ID == "" {
+			return utils.KeySet{}, errors.New("key id must not be empty")
+		}
+		if _, ok := seen[k.ID]; ok {
+			return utils.KeySet{}, errors.Errorf("duplicate key id %q", k.ID)
+		}
+		seen[k.ID] = struct{}{}
+
+		key, err := k.build(dir)
+		if err != nil {
+			return utils.KeySet{}, errors.Wrapf(err, "key %q", k.ID)
+		}
+
+		if k.ID == s.Active {
+			if !key.NotAfter.IsZero() && now.After(key.NotAfter) {
+				return utils.KeySet{}, errors.Errorf("active key %q has expired", k.ID)
+			}
+			set.Active = key
+			activeFound = true
+		}
+
+		set.Keys = append(set.Keys, key)
+	}
+
+	if !activeFound {
+		return utils.KeySet{}, errors.Errorf("active key %q not found", s.Active)
+	}
+
+	return set, nil
+}
+
+func (k fileKey) build(dir string) (utils.SigningKey, error) {
+	var (
+		key utils.SigningKey
+		err error
+	)
+
+	if k.Alg == "" || k.Alg == utils.AlgHS256 {
+		if k.Secret == "" {
+			return utils.SigningKey{}, errors.New("secret must not be empty for HS256")
+		}
+		key = utils.NewHMACKey([]byte(k.Secret))
+	} else {
+		if k.PrivateKeyPath == "" {
+			return utils.SigningKey{}, errors.Errorf("private_key_path must be set for %s", k.Alg)
+		}
+
+		keyPath := k.PrivateKeyPath
+		if !filepath.IsAbs(keyPath) {
+			keyPath = filepath.Join(dir, keyPath)
+		}
+
+		data, errRead := os.ReadFile(keyPath) //nolint:gosec
+		if errRead != nil {
+			return utils.SigningKey{}, errors.Wrapf(errRead, "failed to read private key '%s'", keyPath)
+		}
+
+		key, err = utils.ParsePrivateKeyPEM(k.Alg, data)
+		if err != nil {
+			return utils.SigningKey{}, err
+		}
+	}
+
+	key.ID = k.ID
+	key.NotAfter = k.NotAfter
+
+	return key, nil
+}
@@ -0,0 +1,143 @@
+package keyring
+
+import (
+	"context"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/utils"
+	"go.uber.org/zap"
+)
+
+// Source источник ключей подписи access- и refresh-токенов
+type Source interface {
+	Access() utils.KeySet
+	Refresh() utils.KeySet
+}
+
+type keys struct {
+	access  utils.KeySet
+	refresh utils.KeySet
+}
+
+// Ring кольцо ключей. Наборы подменяются атомарно, поэтому читать их можно без блокировок
+// во время перезагрузки файла.
+type Ring struct {
+	path    string
+	current atomic.Pointer[keys]
+
+	// mu защищает modTime и сериализует перезагрузки
+	mu      sync.Mutex
+	modTime time.Time
+}
+
+// NewStatic создает кольцо из двух фиксированных ключей без перезагрузки
+func NewStatic(access, refresh utils.SigningKey) *Ring {
+	r := &Ring{}
+	r.current.Store(&keys{
+		access:  utils.NewStaticKeySet(access),
+		refresh: utils.NewStaticKeySet(refresh),
+	})
+
+	return r
+}
+
+// NewFromFile загружает кольцо из YAML-файла
+func NewFromFile(path string) (*Ring, error) {
+	r := &Ring{path: path}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Access возвращает текущий набор ключей access-токенов
+func (r *Ring) Access() utils.KeySet {
+	return r.current.Load().access
+}
+
+// Refresh возвращает текущий набор ключей refresh-токенов
+func (r *Ring) Refresh() utils.KeySet {
+	return r.current.Load().refresh
+}
+
+// Reload перечитывает файл кольца. Если файл невалиден, остается предыдущий набор ключей.
+func (r *Ring) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.reload()
+}
+
+func (r *Ring) reload() error {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return err
+	}
+
+	loaded, err := loadFile(r.path, time.Now())
+	if err != nil {
+		return err
+	}
+
+	r.current.Store(loaded)
+	r.modTime = info.ModTime()
+
+	return nil
+}
+
+// Watch раз в interval проверяет время изменения файла и перечитывает его.
+// Завершается вместе с ctx. Для статического кольца ничего не делает.
+func (r *Ring) Watch(ctx context.Context, interval time.Duration) {
+	if r.path == "" {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.reloadIfChanged() {
+				continue
+			}
+
+			logger.Info("key ring reloaded",
+				zap.String("path", r.path),
+				zap.String("access_kid", r.Access().Active.ID),
+				zap.String("refresh_kid", r.Refresh().Active.ID),
+			)
+		}
+	}
+}
+
+// reloadIfChanged перечитывает файл, если он изменился. Возвращает true при успешной перезагрузке.
+func (r *Ring) reloadIfChanged() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	info, err := os.Stat(r.path)
+	if err != nil {
+		logger.Error("failed to stat key ring file", zap.String("path", r.path), zap.Error(err))
+		return false
+	}
+	if info.ModTime().Equal(r.modTime) {
+		return false
+	}
+
+	if err = r.reload(); err != nil {
+		logger.Error("failed to reload key ring, keeping previous keys", zap.String("path", r.path), zap.Error(err))
+		// Не пытаемся перечитывать тот же сломанный файл на каждом тике
+		r.modTime = info.ModTime()
+		return false
+	}
+
+	return true
+}
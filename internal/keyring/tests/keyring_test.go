@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/keyring"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+const ringV1 = `
+access:
+  active: a1
+  keys:
+    - id: a1
+      alg: HS256
+      secret: access-one
+refresh:
+  active: r1
+  keys:
+    - id: r1
+      alg: HS256
+      secret: refresh-one
+`
+
+const ringV2 = `
+access:
+  active: a2
+  keys:
+    - id: a2
+      alg: HS256
+      secret: access-two
+    - id: a1
+      alg: HS256
+      secret: access-one
+      not_after: 2999-01-01T00:00:00Z
+refresh:
+  active: r1
+  keys:
+    - id: r1
+      alg: HS256
+      secret: refresh-one
+`
+
+const ringV3 = `
+access:
+  active: a2
+  keys:
+    - id: a2
+      alg: HS256
+      secret: access-two
+    - id: a1
+      alg: HS256
+      secret: access-one
+      not_after: 2000-01-01T00:00:00Z
+refresh:
+  active: r1
+  keys:
+    - id: r1
+      alg: HS256
+      secret: refresh-one
+`
+
+func writeRing(t *testing.T, path, content string) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestRingRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keyring.yaml")
+	writeRing(t, path, ringV1)
+
+	ring, err := keyring.NewFromFile(path)
+	require.NoError(t, err)
+
+	info := model.UserInfoJwt{Username: gofakeit.Username()}
+	oldToken, err := utils.GenerateToken(info, ring.Access().Active, time.Minute)
+	require.NoError(t, err)
+
+	// Новый активный ключ, старый еще принимается
+	writeRing(t, path, ringV2)
+	require.NoError(t, ring.Reload())
+	require.Equal(t, "a2", ring.Access().Active.ID)
+
+	_, err = utils.VerifyToken(oldToken, ring.Access())
+	require.NoError(t, err)
+
+	newToken, err := utils.GenerateToken(info, ring.Access().Active, time.Minute)
+	require.NoError(t, err)
+	_, err = utils.VerifyToken(newToken, ring.Access())
+	require.NoError(t, err)
+
+	// Сломанный файл отклоняется, предыдущие ключи остаются
+	writeRing(t, path, "access: [")
+	require.Error(t, ring.Reload())
+	require.Equal(t, "a2", ring.Access().Active.ID)
+
+	// Старый ключ вышел из срока действия
+	writeRing(t, path, ringV3)
+	require.NoError(t, ring.Reload())
+
+	_, err = utils.VerifyToken(oldToken, ring.Access())
+	require.Error(t, err)
+	_, err = utils.VerifyToken(newToken, ring.Access())
+	require.NoError(t, err)
+}
+
+func TestRingValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name: "active key missing",
+			content: `
+access:
+  active: nope
+  keys:
+    - {id: a1, alg: HS256, secret: s}
+refresh:
+  active: r1
+  keys:
+    - {id: r1, alg: HS256, secret: s}
+`,
+		},
+		{
+			name: "duplicate id",
+			content: `
+access:
+  active: a1
+  keys:
+    - {id: a1, alg: HS256, secret: s}
+    - {id: a1, alg: HS256, secret: t}
+refresh:
+  active: r1
+  keys:
+    - {id: r1, alg: HS256, secret: s}
+`,
+		},
+		{
+			name: "expired active key",
+			content: `
+access:
+  active: a1
+  keys:
+    - {id: a1, alg: HS256, secret: s, not_after: 2000-01-01T00:00:00Z}
+refresh:
+  active: r1
+  keys:
+    - {id: r1, alg: HS256, secret: s}
+`,
+		},
+		{
+			name: "no refresh keys",
+			content: `
+access:
+  active: a1
+  keys:
+    - {id: a1, alg: HS256, secret: s}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keyring.yaml")
+			writeRing(t, path, tt.content)
+
+			_, err := keyring.NewFromFile(path)
+			require.Error(t, err)
+		})
+	}
+}
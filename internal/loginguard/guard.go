@@ -0,0 +1,160 @@
+package loginguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/Ippolid/auth/internal/config"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/metric"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/repository"
+	"github.com/Ippolid/auth/internal/requestctx"
+	"github.com/Ippolid/auth/internal/utils"
+	"go.uber.org/zap"
+)
+
+// Области счетчиков неудачных попыток входа
+const (
+	scopeUser = "user"
+	scopeIP   = "ip"
+)
+
+// Защита от перебора паролей. Неудачи считаются в Redis отдельно по имени пользователя и по адресу клиента.
+// После каждой неудачи проверка пароля по имени откладывается на экспоненциально растущую паузу, а по достижении
+// порога имя или адрес блокируются на LockoutDuration. Если Redis недоступен, вход не блокируется: отказ кэша
+// не должен запирать всех пользователей.
+
+// Subject счетчик неудачных попыток: ключ в кэше и порог блокировки
+type Subject struct {
+	scope string
+	key   string
+	limit int64
+}
+
+// Guard защищает от перебора все методы, которые проверяют пароль: Login, VerifyMFA и ChangePassword
+// делят счетчики, поэтому перебор нельзя продолжить через другой метод.
+type Guard struct {
+	cache      repository.CacheInterface
+	protection config.LoginProtectionConfig
+}
+
+// NewGuard создает новый экземпляр Guard. protection равен nil — защита выключена.
+func NewGuard(cache repository.CacheInterface, protection config.LoginProtectionConfig) *Guard {
+	return &Guard{cache: cache, protection: protection}
+}
+
+func subjectKey(scope, value string) string {
+	return scope + ":" + value
+}
+
+// UserKey ключ счетчика по имени. Имена и email уникальны и сравниваются при входе без учета регистра
+// после нормализации, поэтому ключ строится так же: все варианты написания одной учетной записи
+// делят счетчик, а разные учетные записи не могут попасть в один.
+func UserKey(identifier string) string {
+	return subjectKey(scopeUser, strings.ToLower(utils.NormalizeIdentifier(identifier)))
+}
+
+// IPKey ключ счетчика по адресу клиента
+func IPKey(ip net.IP) string {
+	return subjectKey(scopeIP, ip.String())
+}
+
+// Subjects счетчики, к которым относится попытка входа; без защиты — пустой список
+func (g *Guard) Subjects(ctx context.Context, identifier string) []Subject {
+	if g.protection == nil {
+		return nil
+	}
+
+	subjects := []Subject{{
+		scope: scopeUser,
+		key:   UserKey(identifier),
+		limit: g.protection.MaxFailures(),
+	}}
+
+	if ip := requestctx.ClientIP(ctx); ip != nil {
+		subjects = append(subjects, Subject{
+			scope: scopeIP,
+			key:   IPKey(ip),
+			limit: g.protection.IPMaxFailures(),
+		})
+	}
+
+	return subjects
+}
+
+// CheckBlocked возвращает ErrLoginLocked, если вход по имени или с адреса сейчас запрещен
+func (g *Guard) CheckBlocked(ctx context.Context, subjects []Subject) error {
+	for _, subject := range subjects {
+		remaining, err := g.cache.GetLoginBlock(ctx, subject.key)
+		if err != nil {
+			logger.Warn("failed to check login block", zap.String("subject", subject.key), zap.Error(err))
+			continue
+		}
+		if remaining > 0 {
+			return fmt.Errorf("%w: retry after %s", model.ErrLoginLocked, remaining.Round(time.Second))
+		}
+	}
+
+	return nil
+}
+
+// RegisterFailure учитывает неудачную попытку и назначает паузу или блокировку
+func (g *Guard) RegisterFailure(ctx context.Context, subjects []Subject) {
+	for _, subject := range subjects {
+		failures, err := g.cache.IncLoginFailures(ctx, subject.key, g.protection.FailureWindow())
+		if err != nil {
+			logger.Warn("failed to count login failure", zap.String("subject", subject.key), zap.Error(err))
+			continue
+		}
+
+		var block time.Duration
+		switch {
+		case failures >= subject.limit:
+			block = g.protection.LockoutDuration()
+			metric.IncLoginLockoutCounter(subject.scope)
+			logger.Warn("login locked out", zap.String("subject", subject.key), zap.Int64("failures", failures))
+		case subject.scope == scopeUser:
+			// С общего адреса (NAT, прокси) ходит много пользователей, поэтому паузы только по имени
+			block = g.backoff(failures)
+		default:
+			continue
+		}
+
+		if err = g.cache.BlockLogin(ctx, subject.key, block); err != nil {
+			logger.Warn("failed to block login", zap.String("subject", subject.key), zap.Error(err))
+		}
+	}
+}
+
+// Reset сбрасывает счетчик по имени после верного пароля. Счетчик адреса не сбрасывается,
+// иначе перебор чужих паролей можно было бы перемежать входом в свою учетную запись.
+func (g *Guard) Reset(ctx context.Context, subjects []Subject) {
+	for _, subject := range subjects {
+		if subject.scope != scopeUser {
+			continue
+		}
+		if err := g.cache.ResetLoginFailures(ctx, subject.key); err != nil {
+			logger.Warn("failed to reset login failures", zap.String("subject", subject.key), zap.Error(err))
+		}
+	}
+}
+
+// backoff пауза после failures неудач подряд: BackoffBase·2^(failures-1), не больше BackoffMax
+func (g *Guard) backoff(failures int64) time.Duration {
+	backoff := g.protection.BackoffBase()
+	for i := int64(1); i < failures && backoff < g.protection.BackoffMax(); i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, g.protection.BackoffMax())
+}
+
+// IsFailure ошибки, которые считаются попыткой подбора пароля
+func IsFailure(err error) bool {
+	return errors.Is(err, model.ErrInvalidCredentials)
+}
@@ -0,0 +1,20 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	"github.com/Ippolid/auth/internal/loginguard"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserKey(t *testing.T) {
+	// Все написания одной учетной записи, под которыми Login ее находит, делят счетчик
+	key := loginguard.UserKey("alice")
+	for _, identifier := range []string{"Alice", " ALICE ", "ａｌｉｃｅ"} {
+		require.Equal(t, key, loginguard.UserKey(identifier), identifier)
+	}
+
+	require.NotEqual(t, key, loginguard.UserKey("alice2"))
+	require.NotEqual(t, loginguard.UserKey("127.0.0.1"), loginguard.IPKey(net.ParseIP("127.0.0.1")))
+}
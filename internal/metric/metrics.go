@@ -17,6 +17,10 @@ type Metrics struct {
 	requestCounter        prometheus.Counter
 	responseCounter       *prometheus.CounterVec
 	histogramResponseTime *prometheus.HistogramVec
+	accessReloadCounter   *prometheus.CounterVec
+	loginLockoutCounter   *prometheus.CounterVec
+	cacheLookupCounter    *prometheus.CounterVec
+	cacheDegradedGauge    prometheus.Gauge
 }
 
 var metrics *Metrics
@@ -51,6 +55,41 @@ func Init(_ context.Context) error {
 			},
 			[]string{"status"},
 		),
+		accessReloadCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "access",
+				Name:      appName + "_policy_reloads_total",
+				Help:      "Количество перезагрузок политики доступа",
+			},
+			[]string{"source", "result"},
+		),
+		loginLockoutCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "login",
+				Name:      appName + "_lockouts_total",
+				Help:      "Количество блокировок входа после неудачных попыток",
+			},
+			[]string{"scope"},
+		),
+		cacheLookupCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "cache",
+				Name:      appName + "_lookups_total",
+				Help:      "Количество обращений к кэшу по результату",
+			},
+			[]string{"cache", "result"},
+		),
+		cacheDegradedGauge: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "cache",
+				Name:      appName + "_degraded",
+				Help:      "1, если Redis недоступен и данные читаются из базы",
+			},
+		),
 	}
 
 	return nil
@@ -70,3 +109,42 @@ func IncResponseCounter(status string, method string) {
 func HistogramResponseTimeObserve(status string, time float64) {
 	metrics.histogramResponseTime.WithLabelValues(status).Observe(time)
 }
+
+// IncAccessReloadCounter увеличивает счетчик перезагрузок политики доступа.
+// source — file или db, result — ok или error.
+func IncAccessReloadCounter(source string, result string) {
+	if metrics == nil {
+		return
+	}
+	metrics.accessReloadCounter.WithLabelValues(source, result).Inc()
+}
+
+// IncLoginLockoutCounter увеличивает счетчик блокировок входа. scope — user или ip.
+func IncLoginLockoutCounter(scope string) {
+	if metrics == nil {
+		return
+	}
+	metrics.loginLockoutCounter.WithLabelValues(scope).Inc()
+}
+
+// IncCacheLookupCounter увеличивает счетчик обращений к кэшу. cache — user или roles;
+// result — hit, negative_hit (закэшировано отсутствие), miss (запрос ушел в базу)
+// или coalesced (дождался результата чужого запроса в базу).
+func IncCacheLookupCounter(cache string, result string) {
+	if metrics == nil {
+		return
+	}
+	metrics.cacheLookupCounter.WithLabelValues(cache, result).Inc()
+}
+
+// SetCacheDegraded отмечает, что Redis недоступен (degraded) или снова доступен
+func SetCacheDegraded(degraded bool) {
+	if metrics == nil {
+		return
+	}
+	value := 0.0
+	if degraded {
+		value = 1
+	}
+	metrics.cacheDegradedGauge.Set(value)
+}
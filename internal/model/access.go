@@ -0,0 +1,35 @@
+package model
+
+const (
+	// AccessAllow правило разрешает доступ
+	AccessAllow = "allow"
+	// AccessDeny правило запрещает доступ
+	AccessDeny = "deny"
+)
+
+// AccessRule правило доступа к эндпоинтам.
+// Pattern — точный адрес, glob (`*` и `?` в пределах сегмента, `[...]`) или префикс с `**` на конце.
+// Пустой Roles у allow-правила означает любого аутентифицированного пользователя, у deny-правила — всех.
+type AccessRule struct {
+	Pattern string
+	Effect  string
+	Roles   []string
+}
+
+// Причины решения о доступе к эндпоинту
+const (
+	// ReasonAdmin администратору доступно все, что не запрещено явным deny-правилом
+	ReasonAdmin = "admin"
+	// ReasonRuleAllow разрешено правилом политики
+	ReasonRuleAllow = "rule_allow"
+	// ReasonAccessGrant разрешено правом роли из таблицы access
+	ReasonAccessGrant = "access_grant"
+	// ReasonDefaultAllow ни одно правило не подошло, по умолчанию разрешено
+	ReasonDefaultAllow = "default_allow"
+	// ReasonRuleDeny запрещено явным deny-правилом
+	ReasonRuleDeny = "rule_deny"
+	// ReasonRoleNotAllowed правило есть, но ни одной из его ролей у пользователя нет
+	ReasonRoleNotAllowed = "role_not_allowed"
+	// ReasonDefaultDeny ни одно правило не подошло, по умолчанию запрещено
+	ReasonDefaultDeny = "default_deny"
+)
@@ -1,14 +1,18 @@
 package model
 
+import "time"
+
 // LoginRequest структура запроса для входа в систему
 type LoginRequest struct {
 	Username string
 	Password string
 }
 
-// LoginResponse структура ответа при входе в систему
+// LoginResponse структура ответа при входе в систему. Если у пользователя включена
+// двухфакторная аутентификация, Token пуст, а MFAToken нужно обменять на refresh-токен в VerifyMFA.
 type LoginResponse struct {
-	Token string
+	Token    string
+	MFAToken string
 }
 
 // GetRefreshTokenRequest структура запроса для получения нового refresh-токена
@@ -35,3 +39,86 @@ type GetAccessTokenResponse struct {
 type CheckRequest struct {
 	EndpointAddress string
 }
+
+// CheckManyRequest структура запроса для проверки нескольких конечных точек одним токеном
+type CheckManyRequest struct {
+	EndpointAddresses []string
+}
+
+// EndpointDecision решение о доступе к конечной точке с объяснением
+type EndpointDecision struct {
+	EndpointAddress string
+	Allowed         bool
+	Reason          string
+	// Rule самое конкретное подошедшее правило; nil — не подошло ни одно
+	Rule *AccessRule
+	// RequiredRoles роли, которые дали бы доступ по правилу
+	RequiredRoles []string
+	CallerRoles   []string
+	// Grant право из таблицы access, которым разрешен доступ
+	Grant *RolePermission
+}
+
+// ExplainAccessRequest структура запроса на пробную проверку доступа.
+// Если задан Username, проверяются его роли из базы, иначе — Roles.
+type ExplainAccessRequest struct {
+	EndpointAddress string
+	Username        string
+	Roles           []string
+}
+
+// RefreshToken запись о выданном refresh-токене
+type RefreshToken struct {
+	ID        string
+	FamilyID  string
+	Username  string
+	ExpiresAt time.Time
+}
+
+// LogoutRequest структура запроса для завершения сессии
+type LogoutRequest struct {
+	RefreshToken string
+}
+
+// LogoutAllRequest структура запроса для завершения всех сессий пользователя
+type LogoutAllRequest struct {
+	RefreshToken string
+}
+
+// UnlockLoginRequest структура запроса для снятия блокировки Login
+type UnlockLoginRequest struct {
+	Username string
+	IP       string
+}
+
+// EnrollTOTPResponse секрет TOTP для приложения-аутентификатора
+type EnrollTOTPResponse struct {
+	Secret string
+	URI    string
+}
+
+// VerifyMFARequest структура запроса второго шага входа. Code — код TOTP или код восстановления.
+type VerifyMFARequest struct {
+	MFAToken string
+	Code     string
+}
+
+// TOTP настройка двухфакторной аутентификации пользователя
+type TOTP struct {
+	UserID int64
+	Secret string
+	// ConfirmedAt nil, пока пользователь не подтвердил первый код
+	ConfirmedAt  *time.Time
+	LastUsedStep int64
+}
+
+// MFAChallenge незавершенный вход, ожидающий второго фактора; сохраняется только хеш токена
+type MFAChallenge struct {
+	TokenHash string
+	UserID    int64
+	Username  string
+	// Limited вход выполнен без подтвержденного email и дает только роль user
+	Limited   bool
+	Attempts  int
+	ExpiresAt time.Time
+}
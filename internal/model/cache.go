@@ -0,0 +1,17 @@
+package model
+
+// CacheInvalidation набор записей кэша, устаревших после изменения в базе.
+// Публикуется другим экземплярам, чтобы они сбросили свои локальные копии.
+type CacheInvalidation struct {
+	// UserIDs профили пользователей
+	UserIDs []int64 `json:"user_ids,omitempty"`
+	// Usernames роли пользователей, которые кэшируются по имени
+	Usernames []string `json:"usernames,omitempty"`
+	// Grants права ролей изменились: каждый экземпляр перечитывает их в свою политику доступа
+	Grants bool `json:"grants,omitempty"`
+}
+
+// Empty сообщает, что сбрасывать нечего
+func (i CacheInvalidation) Empty() bool {
+	return len(i.UserIDs) == 0 && len(i.Usernames) == 0 && !i.Grants
+}
@@ -5,6 +5,14 @@ import "github.com/golang-jwt/jwt/v5"
 // UserClaims структура для хранения информации о пользователе в JWT-токене
 type UserClaims struct {
 	jwt.RegisteredClaims
-	Username string `json:"username"`
-	Role     string `json:"role"`
+	Username string   `json:"username"`
+	Roles    []string `json:"roles"`
+	// Role устаревшее поле для сервисов, которые еще читают "admin"/"user"
+	Role string `json:"role,omitempty"`
+	// FamilyID идентификатор семейства refresh-токенов, к которому относится токен
+	FamilyID string `json:"fid,omitempty"`
+	// Limited токен выдан без подтвержденного email и дает только роль user
+	Limited bool `json:"lim,omitempty"`
+	// UserID идентификатор владельца токена; в токенах, выданных до его появления, равен 0
+	UserID int64 `json:"uid,omitempty"`
 }
@@ -4,7 +4,124 @@ import (
 	"errors"
 )
 
+// Kind категория доменной ошибки. По ней interceptor.ErrorCodesInterceptor выбирает код gRPC,
+// а grpc-gateway — HTTP-статус.
+type Kind int
+
+const (
+	// KindInternal внутренняя ошибка (codes.Internal)
+	KindInternal Kind = iota
+	// KindNotFound объект не найден (codes.NotFound)
+	KindNotFound
+	// KindAlreadyExists объект уже существует (codes.AlreadyExists)
+	KindAlreadyExists
+	// KindUnauthenticated вызывающий не аутентифицирован (codes.Unauthenticated)
+	KindUnauthenticated
+	// KindPermissionDenied недостаточно прав (codes.PermissionDenied)
+	KindPermissionDenied
+	// KindInvalidArgument некорректный запрос (codes.InvalidArgument)
+	KindInvalidArgument
+	// KindFailedPrecondition операция невозможна в текущем состоянии (codes.FailedPrecondition)
+	KindFailedPrecondition
+	// KindResourceExhausted превышен лимит попыток (codes.ResourceExhausted)
+	KindResourceExhausted
+)
+
+// Error доменная ошибка с категорией. Сравнивается через errors.Is с ошибками каталога ниже,
+// оборачивать ее можно через fmt.Errorf("...: %w", err).
+type Error struct {
+	Kind Kind
+	msg  string
+}
+
+// NewError создает доменную ошибку
+func NewError(kind Kind, msg string) *Error {
+	return &Error{Kind: kind, msg: msg}
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+// KindOf возвращает категорию первой доменной ошибки в цепочке; для прочих ошибок — KindInternal
+func KindOf(err error) (Kind, bool) {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.Kind, true
+	}
+
+	return KindInternal, false
+}
+
 var (
 	// ErrUserNotFound нет пользователя в хранилище.
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound = NewError(KindNotFound, "user not found")
+	// ErrCachedNotFound в кэше записано, что пользователя нет; идти в базу не нужно.
+	ErrCachedNotFound = NewError(KindNotFound, "user not found (cached)")
+	// ErrCacheMiss записи нет в кэше; нужно идти в базу.
+	ErrCacheMiss = NewError(KindNotFound, "cache miss")
+	// ErrCacheUnavailable Redis недоступен, запросы к нему не отправляются; данные берутся из базы.
+	ErrCacheUnavailable = NewError(KindInternal, "cache is unavailable")
+	// ErrUserAlreadyExists пользователь с таким именем уже есть (без учета регистра).
+	ErrUserAlreadyExists = NewError(KindAlreadyExists, "user already exists")
+	// ErrEmailAlreadyExists email уже занят другим пользователем (без учета регистра).
+	ErrEmailAlreadyExists = NewError(KindAlreadyExists, "email is already in use")
+	// ErrUserInfoRequired не переданы данные пользователя.
+	ErrUserInfoRequired = NewError(KindInvalidArgument, "user info is required")
+	// ErrInvalidUsername имя пользователя пустое или содержит '@': при входе такое имя считается email.
+	ErrInvalidUsername = NewError(KindInvalidArgument, "username must not be empty or contain '@'")
+	// ErrWeakPassword пароль не соответствует политике паролей.
+	ErrWeakPassword = NewError(KindInvalidArgument, "password does not meet the password policy")
+	// ErrUnknownRole назначается несуществующая роль.
+	ErrUnknownRole = NewError(KindInvalidArgument, "unknown role")
+
+	// ErrEmptyCredentials не переданы имя пользователя или пароль.
+	ErrEmptyCredentials = NewError(KindInvalidArgument, "username and password must not be empty")
+	// ErrInvalidCredentials неверное имя пользователя или пароль. Одна ошибка на оба случая,
+	// чтобы по ответу Login нельзя было узнать, есть ли такой пользователь.
+	ErrInvalidCredentials = NewError(KindUnauthenticated, "invalid username or password")
+	// ErrNotAccountOwner операция доступна только владельцу учетной записи.
+	ErrNotAccountOwner = NewError(KindPermissionDenied, "operation is allowed only for the account owner")
+	// ErrInvalidResetToken токен сброса пароля не найден, уже использован или истек.
+	ErrInvalidResetToken = NewError(KindInvalidArgument, "password reset token is invalid or expired")
+	// ErrEmailNotVerified вход невозможен до подтверждения email.
+	ErrEmailNotVerified = NewError(KindFailedPrecondition, "email is not verified")
+	// ErrInvalidVerificationToken токен подтверждения email не найден, уже использован, истек или выдан для другого адреса.
+	ErrInvalidVerificationToken = NewError(KindInvalidArgument, "email verification token is invalid or expired")
+	// ErrLoginLocked вход временно заблокирован после неудачных попыток.
+	ErrLoginLocked = NewError(KindResourceExhausted, "too many failed login attempts")
+	// ErrUnlockTargetRequired не указаны ни имя пользователя, ни адрес для снятия блокировки.
+	ErrUnlockTargetRequired = NewError(KindInvalidArgument, "username or ip is required")
+
+	// ErrTOTPAlreadyEnabled двухфакторная аутентификация уже подтверждена.
+	ErrTOTPAlreadyEnabled = NewError(KindFailedPrecondition, "two-factor authentication is already enabled")
+	// ErrTOTPNotEnrolled подключение TOTP не начато: сначала нужен EnrollTOTP.
+	ErrTOTPNotEnrolled = NewError(KindFailedPrecondition, "two-factor enrollment is not started")
+	// ErrInvalidMFACode код TOTP или восстановления неверен либо уже использован.
+	ErrInvalidMFACode = NewError(KindUnauthenticated, "invalid two-factor code")
+	// ErrInvalidMFAChallenge токен второго шага входа не найден, истек, использован или исчерпал попытки.
+	ErrInvalidMFAChallenge = NewError(KindUnauthenticated, "mfa challenge is invalid or expired")
+
+	// ErrInvalidRefreshToken refresh-токен не прошел проверку.
+	ErrInvalidRefreshToken = NewError(KindUnauthenticated, "invalid refresh token")
+	// ErrRefreshTokenReused refresh-токен уже был обменян или отозван.
+	ErrRefreshTokenReused = NewError(KindUnauthenticated, "refresh token reuse detected")
+	// ErrTokenRevoked сессия, к которой относится токен, завершена.
+	ErrTokenRevoked = NewError(KindUnauthenticated, "token is revoked")
+	// ErrAccessTokenInvalid access-токен отсутствует или не прошел проверку.
+	ErrAccessTokenInvalid = NewError(KindUnauthenticated, "access token is invalid")
+
+	// ErrAdminRequired операция доступна только администратору.
+	ErrAdminRequired = NewError(KindPermissionDenied, "admin role required")
+	// ErrMethodNotDeclared для метода не объявлено правило авторизации.
+	ErrMethodNotDeclared = NewError(KindPermissionDenied, "method has no authorization rule")
+
+	// ErrRoleNotFound нет роли с таким именем.
+	ErrRoleNotFound = NewError(KindNotFound, "role not found")
+	// ErrRoleAlreadyExists роль с таким именем уже есть.
+	ErrRoleAlreadyExists = NewError(KindAlreadyExists, "role already exists")
+	// ErrBuiltinRole встроенные роли нельзя удалить.
+	ErrBuiltinRole = NewError(KindFailedPrecondition, "builtin role cannot be deleted")
+	// ErrInvalidEndpointPattern некорректный шаблон эндпоинта.
+	ErrInvalidEndpointPattern = NewError(KindInvalidArgument, "invalid endpoint pattern")
 )
@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// Role роль с выданными ей эндпоинтами
+type Role struct {
+	ID        int64
+	Name      string
+	Endpoints []string
+	CreatedAt time.Time
+}
+
+// RolePermission право роли на эндпоинт
+type RolePermission struct {
+	Role     string
+	Endpoint string
+}
+
+// UserRole назначение роли пользователю
+type UserRole struct {
+	UserID int64
+	Role   string
+}
+
+// RoleHolder пользователь, которому назначена роль
+type RoleHolder struct {
+	UserID   int64
+	Username string
+}
+
+// UserRoles роли пользователя вместе с его именем (ключом кэша ролей)
+type UserRoles struct {
+	Username string
+	Roles    []string
+}
+
+// Audit запись журнала изменений ролей и прав
+type Audit struct {
+	Actor     string
+	Action    string
+	Target    string
+	CreatedAt time.Time
+}
@@ -4,13 +4,23 @@ import (
 	"time"
 )
 
+// Встроенные роли. Остальные роли заводятся в таблице roles.
+const (
+	// RoleUser роль обычного пользователя, выдается по умолчанию
+	RoleUser = "user"
+	// RoleAdmin роль администратора, имеет доступ ко всем эндпоинтам, кроме явно запрещенных
+	RoleAdmin = "admin"
+)
+
 // User структура пользователя
 type User struct {
 	ID        int64     `db:"id" redis:"id"`
 	User      UserInfo  `db:""`
-	Role      bool      `db:"role"`
+	Roles     []string  `db:"-"`
 	Password  string    `db:"password"`
 	CreatedAt time.Time `db:"created_at"`
+	// VerifiedAt время подтверждения email; nil — email не подтвержден
+	VerifiedAt *time.Time `db:"verified_at"`
 }
 
 // UserInfo информация о пользователя
@@ -28,6 +38,76 @@ type Log struct {
 
 // UserInfoJwt структура для хранения информации о пользователе в JWT
 type UserInfoJwt struct {
-	Username string `json:"username"`
-	Role     bool   `json:"role"`
+	Username string   `json:"username"`
+	Roles    []string `json:"roles"`
+	// TokenID и FamilyID заполняются только для refresh-токенов
+	TokenID  string `json:"jti,omitempty"`
+	FamilyID string `json:"fid,omitempty"`
+	// Limited токен выдан без подтвержденного email и дает только роль user
+	Limited bool `json:"lim,omitempty"`
+	// UserID нужен для проверки владельца учетной записи
+	UserID int64 `json:"uid,omitempty"`
+	// EmailVerified заполняется при входе и в токен не попадает
+	EmailVerified bool `json:"-"`
+}
+
+// HasRole проверяет, есть ли роль в списке
+func HasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ChangePasswordRequest структура запроса на смену пароля владельцем учетной записи
+type ChangePasswordRequest struct {
+	ID              int64
+	CurrentPassword string
+	NewPassword     string
+}
+
+// PasswordResetToken токен сброса пароля в хранилище; сам токен не сохраняется, только его хеш
+type PasswordResetToken struct {
+	TokenHash string
+	UserID    int64
+	ExpiresAt time.Time
+}
+
+// ResetPasswordResponse выданный токен сброса пароля
+type ResetPasswordResponse struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// ConfirmPasswordResetRequest структура запроса на установку пароля по токену сброса
+type ConfirmPasswordResetRequest struct {
+	Token       string
+	NewPassword string
+}
+
+// PasswordResetNotice уведомление пользователю со ссылкой для сброса пароля
+type PasswordResetNotice struct {
+	Email     string
+	Name      string
+	Token     string
+	ExpiresAt time.Time
+}
+
+// EmailVerificationToken токен подтверждения email в хранилище; сохраняется только хеш токена
+type EmailVerificationToken struct {
+	TokenHash string
+	UserID    int64
+	Email     string
+	ExpiresAt time.Time
+}
+
+// EmailVerificationNotice уведомление пользователю со ссылкой для подтверждения email
+type EmailVerificationNotice struct {
+	Email     string
+	Name      string
+	Token     string
+	ExpiresAt time.Time
 }
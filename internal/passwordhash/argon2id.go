@@ -0,0 +1,130 @@
+package passwordhash
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idPrefix = "$argon2id$"
+
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+// Argon2idParams параметры стоимости argon2id
+type Argon2idParams struct {
+	// Memory объем памяти в KiB
+	Memory uint32
+	// Iterations число проходов
+	Iterations uint32
+	// Parallelism число потоков
+	Parallelism uint8
+}
+
+// Argon2id хеширует пароли argon2id. Хеш хранится в формате PHC:
+// $argon2id$v=19$m=65536,t=3,p=2$<соль>$<ключ>, соль и ключ в base64 без дополнения.
+type Argon2id struct {
+	params Argon2idParams
+}
+
+// NewArgon2id создает хешер argon2id с заданными параметрами
+func NewArgon2id(params Argon2idParams) (*Argon2id, error) {
+	if params.Iterations == 0 || params.Parallelism == 0 {
+		return nil, errors.New("argon2id iterations and parallelism must be positive")
+	}
+	if params.Memory < 8*uint32(params.Parallelism) {
+		return nil, fmt.Errorf("argon2id memory must be at least %d KiB for parallelism %d", 8*uint32(params.Parallelism), params.Parallelism)
+	}
+
+	return &Argon2id{params: params}, nil
+}
+
+// Hash хеширует пароль
+func (a *Argon2id) Hash(password string) (string, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, a.params.Iterations, a.params.Memory, a.params.Parallelism, argon2KeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix, argon2.Version,
+		a.params.Memory, a.params.Iterations, a.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Supports узнает хеш argon2id
+func (a *Argon2id) Supports(hash string) bool {
+	return strings.HasPrefix(hash, argon2idPrefix)
+}
+
+// Verify сравнивает пароль с хешем за постоянное время. Ключ вычисляется с параметрами из хеша,
+// поэтому хеши, созданные до смены параметров, продолжают проверяться.
+func (a *Argon2id) Verify(hash string, password string) bool {
+	parsed, err := parseArgon2id(hash)
+	if err != nil {
+		return false
+	}
+
+	key := argon2.IDKey([]byte(password), parsed.salt,
+		parsed.params.Iterations, parsed.params.Memory, parsed.params.Parallelism, uint32(len(parsed.key))) //nolint:gosec // длина ключа из собственного хеша
+
+	return subtle.ConstantTimeCompare(key, parsed.key) == 1
+}
+
+// Outdated хеш создан с другими параметрами, длиной ключа или версией алгоритма
+func (a *Argon2id) Outdated(hash string) bool {
+	parsed, err := parseArgon2id(hash)
+	if err != nil {
+		return true
+	}
+
+	return parsed.version != argon2.Version || parsed.params != a.params || len(parsed.key) != argon2KeyLength
+}
+
+type argon2idHash struct {
+	version int
+	params  Argon2idParams
+	salt    []byte
+	key     []byte
+}
+
+func parseArgon2id(hash string) (*argon2idHash, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", соль, ключ
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, errors.New("malformed argon2id hash")
+	}
+
+	var parsed argon2idHash
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &parsed.version); err != nil {
+		return nil, fmt.Errorf("malformed argon2id version: %w", err)
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d",
+		&parsed.params.Memory, &parsed.params.Iterations, &parsed.params.Parallelism); err != nil {
+		return nil, fmt.Errorf("malformed argon2id params: %w", err)
+	}
+
+	var err error
+	if parsed.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, fmt.Errorf("malformed argon2id salt: %w", err)
+	}
+	if parsed.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return nil, fmt.Errorf("malformed argon2id key: %w", err)
+	}
+	if len(parsed.key) == 0 || parsed.params.Iterations == 0 || parsed.params.Parallelism == 0 {
+		return nil, errors.New("malformed argon2id hash")
+	}
+
+	return &parsed, nil
+}
@@ -0,0 +1,57 @@
+package passwordhash
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcryptPrefixes версии формата bcrypt
+var bcryptPrefixes = []string{"$2a$", "$2b$", "$2y$"}
+
+// Bcrypt хеширует пароли bcrypt. Пароль длиннее 72 байт bcrypt не принимает.
+type Bcrypt struct {
+	cost int
+}
+
+// NewBcrypt создает хешер bcrypt с заданной стоимостью
+func NewBcrypt(cost int) (*Bcrypt, error) {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("bcrypt cost %d is out of range [%d, %d]", cost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+
+	return &Bcrypt{cost: cost}, nil
+}
+
+// Hash хеширует пароль
+func (b *Bcrypt) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), b.cost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return string(hash), nil
+}
+
+// Supports узнает хеш bcrypt
+func (b *Bcrypt) Supports(hash string) bool {
+	for _, prefix := range bcryptPrefixes {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Verify сравнивает пароль с хешем
+func (b *Bcrypt) Verify(hash string, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// Outdated хеш создан с другой стоимостью
+func (b *Bcrypt) Outdated(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != b.cost
+}
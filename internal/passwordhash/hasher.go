@@ -0,0 +1,95 @@
+// Package passwordhash хеширует пароли. Новые пароли хешируются текущим алгоритмом, проверяются хеши
+// всех поддерживаемых алгоритмов, а хеши устаревшего алгоритма или стоимости помечаются для перехеширования.
+package passwordhash
+
+import "sync"
+
+// dummyPassword пароль фиктивного хеша для проверки несуществующих пользователей
+const dummyPassword = "dummy password for unknown users"
+
+// Hasher один алгоритм хеширования паролей
+type Hasher interface {
+	// Hash хеширует пароль со случайной солью
+	Hash(password string) (string, error)
+	// Supports узнает хеш своего формата
+	Supports(hash string) bool
+	// Verify сравнивает пароль с хешем
+	Verify(hash string, password string) bool
+	// Outdated хеш создан с параметрами стоимости, отличными от текущих
+	Outdated(hash string) bool
+}
+
+// Set хеширует пароли текущим алгоритмом и проверяет хеши текущего и прежних алгоритмов.
+// Одновременно вычисляется не больше заданного числа хешей: argon2id занимает много памяти,
+// и без ограничения поток входов мог бы ее исчерпать.
+type Set struct {
+	current Hasher
+	known   []Hasher
+	dummy   func() string
+	slots   chan struct{}
+}
+
+// New создает набор. limit ограничивает число одновременных вычислений хеша, меньше 1 — одно.
+// current хеширует новые пароли; legacy только проверяют уже сохраненные хеши.
+func New(limit int, current Hasher, legacy ...Hasher) *Set {
+	return &Set{
+		current: current,
+		known:   append([]Hasher{current}, legacy...),
+		dummy: sync.OnceValue(func() string {
+			hash, err := current.Hash(dummyPassword)
+			if err != nil {
+				panic(err)
+			}
+			return hash
+		}),
+		slots: make(chan struct{}, max(limit, 1)),
+	}
+}
+
+// Hash хеширует пароль текущим алгоритмом
+func (s *Set) Hash(password string) (string, error) {
+	defer s.acquire()()
+
+	return s.current.Hash(password)
+}
+
+// Verify проверяет пароль. rehash сообщает, что пароль верен, но хеш создан устаревшим алгоритмом
+// или с устаревшей стоимостью и его нужно заменить на Hash(password). Хеш неизвестного формата
+// проверяется так же долго, как несуществующий пользователь.
+func (s *Set) Verify(hash string, password string) (ok bool, rehash bool) {
+	for _, h := range s.known {
+		if !h.Supports(hash) {
+			continue
+		}
+
+		release := s.acquire()
+		ok = h.Verify(hash, password)
+		release()
+		if !ok {
+			return false, false
+		}
+
+		return true, h != s.current || h.Outdated(hash)
+	}
+
+	s.Simulate(password)
+
+	return false, false
+}
+
+// Simulate сравнивает пароль с фиктивным хешем текущего алгоритма и ничего не возвращает.
+// Вызывается, когда пользователя нет, чтобы время ответа не выдавало, существует ли имя.
+// Алгоритм всегда один: выбор по какому-либо правилу сделал бы время ответа для неизвестных
+// имен статистически отличимым.
+func (s *Set) Simulate(password string) {
+	defer s.acquire()()
+
+	_ = s.current.Verify(s.dummy(), password)
+}
+
+// acquire занимает место для вычисления хеша и возвращает функцию, которая его освобождает
+func (s *Set) acquire() func() {
+	s.slots <- struct{}{}
+
+	return func() { <-s.slots }
+}
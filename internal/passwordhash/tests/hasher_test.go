@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/passwordhash"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testArgon2 параметры меньше боевых, чтобы тесты шли быстро
+var testArgon2 = passwordhash.Argon2idParams{Memory: 1024, Iterations: 1, Parallelism: 1}
+
+// limit одновременных вычислений хеша в тестах
+const limit = 4
+
+func newArgon2id(t *testing.T, params passwordhash.Argon2idParams) *passwordhash.Argon2id {
+	h, err := passwordhash.NewArgon2id(params)
+	require.NoError(t, err)
+	return h
+}
+
+func newBcrypt(t *testing.T, cost int) *passwordhash.Bcrypt {
+	h, err := passwordhash.NewBcrypt(cost)
+	require.NoError(t, err)
+	return h
+}
+
+func TestHasherSet(t *testing.T) {
+	password := gofakeit.Password(true, true, true, false, false, 12)
+
+	t.Run("argon2id round trip", func(t *testing.T) {
+		set := passwordhash.New(limit, newArgon2id(t, testArgon2), newBcrypt(t, bcrypt.MinCost))
+
+		hash, err := set.Hash(password)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+		ok, rehash := set.Verify(hash, password)
+		require.True(t, ok)
+		require.False(t, rehash)
+
+		ok, rehash = set.Verify(hash, password+"x")
+		require.False(t, ok)
+		require.False(t, rehash)
+	})
+
+	t.Run("legacy bcrypt hash is upgraded", func(t *testing.T) {
+		legacy := newBcrypt(t, bcrypt.MinCost)
+		hash, err := legacy.Hash(password)
+		require.NoError(t, err)
+
+		set := passwordhash.New(limit, newArgon2id(t, testArgon2), legacy)
+
+		ok, rehash := set.Verify(hash, password)
+		require.True(t, ok)
+		require.True(t, rehash)
+
+		// Неверный пароль не перехешируется
+		ok, rehash = set.Verify(hash, password+"x")
+		require.False(t, ok)
+		require.False(t, rehash)
+	})
+
+	t.Run("outdated cost is upgraded", func(t *testing.T) {
+		old, err := newArgon2id(t, testArgon2).Hash(password)
+		require.NoError(t, err)
+
+		stronger := testArgon2
+		stronger.Iterations = 2
+		set := passwordhash.New(limit, newArgon2id(t, stronger))
+
+		ok, rehash := set.Verify(old, password)
+		require.True(t, ok)
+		require.True(t, rehash)
+
+		bcryptHash, err := newBcrypt(t, bcrypt.MinCost).Hash(password)
+		require.NoError(t, err)
+
+		ok, rehash = passwordhash.New(limit, newBcrypt(t, bcrypt.MinCost+1)).Verify(bcryptHash, password)
+		require.True(t, ok)
+		require.True(t, rehash)
+	})
+
+	t.Run("unknown or malformed hash is rejected", func(t *testing.T) {
+		set := passwordhash.New(limit, newArgon2id(t, testArgon2), newBcrypt(t, bcrypt.MinCost))
+
+		for _, hash := range []string{"", "plain", "$argon2id$v=19$m=1024,t=1,p=1$bad", "$argon2id$v=19$m=x$c2FsdA$a2V5"} {
+			ok, rehash := set.Verify(hash, password)
+			require.False(t, ok, hash)
+			require.False(t, rehash, hash)
+		}
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		_, err := passwordhash.NewArgon2id(passwordhash.Argon2idParams{Memory: 1024, Iterations: 0, Parallelism: 1})
+		require.Error(t, err)
+
+		_, err = passwordhash.NewBcrypt(bcrypt.MaxCost + 1)
+		require.Error(t, err)
+	})
+}
+
+// medianDuration медиана времени выполнения f: устойчива к единичным паузам планировщика и GC
+func medianDuration(runs int, f func()) time.Duration {
+	durations := make([]time.Duration, 0, runs)
+	for i := 0; i < runs; i++ {
+		start := time.Now()
+		f()
+		durations = append(durations, time.Since(start))
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	return durations[runs/2]
+}
+
+func TestSimulateTimingParity(t *testing.T) {
+	const runs = 7
+
+	argon2 := passwordhash.Argon2idParams{Memory: 16 * 1024, Iterations: 2, Parallelism: 1}
+	// Пользователи с хешами прежнего алгоритма тоже есть, но Simulate всегда проверяет хеш текущего
+	set := passwordhash.New(limit, newArgon2id(t, argon2), newBcrypt(t, bcrypt.MinCost))
+
+	hash, err := set.Hash(gofakeit.Password(true, true, true, false, false, 12))
+	require.NoError(t, err)
+
+	wrong := gofakeit.Password(true, true, true, false, false, 12)
+	wrongPassword := func() {
+		ok, _ := set.Verify(hash, wrong)
+		require.False(t, ok)
+	}
+
+	// Фиктивный хеш создается при первом Simulate: в замер это не должно попасть
+	set.Simulate(wrong)
+	wrongPasswordTime := medianDuration(runs, wrongPassword)
+
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{name: "unknown user", f: func() { set.Simulate(wrong) }},
+		{name: "unknown hash format", f: func() {
+			ok, _ := set.Verify("plain", wrong)
+			require.False(t, ok)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := medianDuration(runs, tt.f)
+
+			ratio := float64(got) / float64(wrongPasswordTime)
+			require.InDelta(t, 1, ratio, 0.5, "%s took %s, wrong password took %s", tt.name, got, wrongPasswordTime)
+		})
+	}
+}
+
+// slowHasher считает одновременные вычисления
+type slowHasher struct {
+	active atomic.Int32
+	peak   atomic.Int32
+}
+
+func (h *slowHasher) work() {
+	active := h.active.Add(1)
+	for {
+		peak := h.peak.Load()
+		if active <= peak || h.peak.CompareAndSwap(peak, active) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	h.active.Add(-1)
+}
+
+func (h *slowHasher) Hash(string) (string, error) { h.work(); return "slow$hash", nil }
+func (h *slowHasher) Supports(hash string) bool   { return strings.HasPrefix(hash, "slow$") }
+func (h *slowHasher) Verify(string, string) bool  { h.work(); return false }
+func (h *slowHasher) Outdated(string) bool        { return false }
+
+func TestHasherSetConcurrencyLimit(t *testing.T) {
+	hasher := &slowHasher{}
+	set := passwordhash.New(2, hasher)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 12; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			switch i % 3 {
+			case 0:
+				_, _ = set.Hash("password")
+			case 1:
+				set.Verify("slow$hash", "password")
+			default:
+				set.Simulate("password")
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(2), hasher.peak.Load())
+}
@@ -0,0 +1,51 @@
+package passwordpolicy
+
+import (
+	"strings"
+
+	"github.com/Ippolid/auth/internal/model"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error пароль не соответствует политике. Сравнивается с model.ErrWeakPassword через errors.Is,
+// а клиенту уходит InvalidArgument со списком нарушений в errdetails.BadRequest.
+type Error struct {
+	Violations []Violation
+}
+
+func (e *Error) Error() string {
+	descriptions := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		descriptions = append(descriptions, v.Description)
+	}
+
+	return model.ErrWeakPassword.Error() + ": " + strings.Join(descriptions, "; ")
+}
+
+// Unwrap позволяет проверять ошибку через errors.Is(err, model.ErrWeakPassword)
+func (e *Error) Unwrap() error {
+	return model.ErrWeakPassword
+}
+
+// GRPCStatus статус с подробностями; его использует status.FromError в interceptor.ToStatus
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, e.Error())
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range e.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+			Reason:      v.Code,
+		})
+	}
+
+	detailed, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st
+	}
+
+	return detailed
+}
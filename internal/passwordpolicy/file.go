@@ -0,0 +1,45 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// sha1HexLength длина SHA-1 в шестнадцатеричном виде
+const sha1HexLength = 40
+
+// LoadBlocklist читает файл утекших паролей. Каждая строка — SHA-1 пароля в hex, регистр не важен;
+// допускается формат Have I Been Pwned "HASH:COUNT". Пустые строки и строки с # пропускаются.
+func LoadBlocklist(path string) (map[string]struct{}, error) {
+	file, err := os.Open(path) //nolint:gosec // путь задается конфигурацией сервиса
+	if err != nil {
+		return nil, fmt.Errorf("failed to open password blocklist: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	breached := make(map[string]struct{})
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+
+		hash, _, _ := strings.Cut(entry, ":")
+		if _, errDecode := hex.DecodeString(hash); errDecode != nil || len(hash) != sha1HexLength {
+			return nil, fmt.Errorf("password blocklist %s, line %d: expected SHA-1 hex digest", path, line)
+		}
+
+		breached[strings.ToUpper(hash)] = struct{}{}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read password blocklist: %w", err)
+	}
+
+	return breached, nil
+}
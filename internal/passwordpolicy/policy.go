@@ -0,0 +1,201 @@
+// Package passwordpolicy проверяет пароли на соответствие политике: длина, классы символов,
+// отсутствие имени пользователя и email, отсутствие в списке утекших паролей.
+package passwordpolicy
+
+import (
+	"crypto/sha1" //nolint:gosec // списки утекших паролей распространяются в виде SHA-1
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Коды нарушений политики; передаются клиенту в errdetails.BadRequest
+const (
+	ViolationTooShort        = "TOO_SHORT"
+	ViolationTooLong         = "TOO_LONG"
+	ViolationCharClasses     = "NOT_ENOUGH_CHAR_CLASSES"
+	ViolationContainsName    = "CONTAINS_USERNAME"
+	ViolationContainsEmail   = "CONTAINS_EMAIL"
+	ViolationBreached        = "BREACHED"
+	ViolationConfirmMismatch = "CONFIRMATION_MISMATCH"
+)
+
+// minIdentityLength короче этого имя и часть email не ищутся в пароле: совпадения будут случайными
+const minIdentityLength = 3
+
+// Rules настраиваемые правила политики
+type Rules struct {
+	MinLength      int
+	MaxLength      int
+	MinCharClasses int
+}
+
+// Поля запроса, к которым относятся нарушения
+const (
+	fieldPassword        = "password"
+	fieldPasswordConfirm = "password_confirm"
+)
+
+// Violation одно нарушение политики
+type Violation struct {
+	Field       string
+	Code        string
+	Description string
+}
+
+// Policy политика паролей. Нулевой *Policy ничего не проверяет.
+type Policy struct {
+	rules    Rules
+	breached map[string]struct{}
+}
+
+// New создает политику. blocklistPath — файл с SHA-1 хешами утекших паролей (см. LoadBlocklist);
+// пустой путь выключает эту проверку.
+func New(rules Rules, blocklistPath string) (*Policy, error) {
+	if rules.MaxLength > 0 && rules.MinLength > rules.MaxLength {
+		return nil, fmt.Errorf("min password length %d is greater than max %d", rules.MinLength, rules.MaxLength)
+	}
+
+	policy := &Policy{rules: rules}
+	if blocklistPath == "" {
+		return policy, nil
+	}
+
+	breached, err := LoadBlocklist(blocklistPath)
+	if err != nil {
+		return nil, err
+	}
+	policy.breached = breached
+
+	return policy, nil
+}
+
+// Check возвращает все нарушения политики; пустой список — пароль подходит.
+// username и email не должны входить в пароль (без учета регистра).
+func (p *Policy) Check(password, username, email string) []Violation {
+	if p == nil {
+		return nil
+	}
+
+	var violations []Violation
+
+	if length := utf8.RuneCountInString(password); length < p.rules.MinLength {
+		violations = append(violations, Violation{
+			Field:       fieldPassword,
+			Code:        ViolationTooShort,
+			Description: fmt.Sprintf("password must be at least %d characters long", p.rules.MinLength),
+		})
+	}
+	if p.rules.MaxLength > 0 && len(password) > p.rules.MaxLength {
+		violations = append(violations, Violation{
+			Field:       fieldPassword,
+			Code:        ViolationTooLong,
+			Description: fmt.Sprintf("password must be at most %d bytes long", p.rules.MaxLength),
+		})
+	}
+
+	if classes := charClasses(password); classes < p.rules.MinCharClasses {
+		violations = append(violations, Violation{
+			Field: fieldPassword,
+			Code:  ViolationCharClasses,
+			Description: fmt.Sprintf("password must contain at least %d of: lowercase letters, uppercase letters, digits, other symbols",
+				p.rules.MinCharClasses),
+		})
+	}
+
+	lowered := strings.ToLower(password)
+	if containsIdentity(lowered, username) {
+		violations = append(violations, Violation{
+			Field:       fieldPassword,
+			Code:        ViolationContainsName,
+			Description: "password must not contain the username",
+		})
+	}
+	if local, _, _ := strings.Cut(email, "@"); containsIdentity(lowered, email) || containsIdentity(lowered, local) {
+		violations = append(violations, Violation{
+			Field:       fieldPassword,
+			Code:        ViolationContainsEmail,
+			Description: "password must not contain the email address",
+		})
+	}
+
+	if p.isBreached(password) {
+		violations = append(violations, Violation{
+			Field:       fieldPassword,
+			Code:        ViolationBreached,
+			Description: "password appears in a list of breached passwords",
+		})
+	}
+
+	return violations
+}
+
+// Validate проверяет пароль и возвращает *Error со всеми нарушениями или nil
+func (p *Policy) Validate(password, username, email string) error {
+	if violations := p.Check(password, username, email); len(violations) > 0 {
+		return &Error{Violations: violations}
+	}
+
+	return nil
+}
+
+// Confirm проверяет, что подтверждение совпадает с паролем
+func Confirm(password, confirm string) error {
+	if password == confirm {
+		return nil
+	}
+
+	return &Error{Violations: []Violation{{
+		Field:       fieldPasswordConfirm,
+		Code:        ViolationConfirmMismatch,
+		Description: "password confirmation does not match the password",
+	}}}
+}
+
+func (p *Policy) isBreached(password string) bool {
+	if len(p.breached) == 0 {
+		return false
+	}
+
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // формат списка утекших паролей
+	_, found := p.breached[strings.ToUpper(hex.EncodeToString(sum[:]))]
+
+	return found
+}
+
+func containsIdentity(loweredPassword, identity string) bool {
+	identity = strings.ToLower(strings.TrimSpace(identity))
+	if utf8.RuneCountInString(identity) < minIdentityLength {
+		return false
+	}
+
+	return strings.Contains(loweredPassword, identity)
+}
+
+// charClasses считает классы символов: строчные, заглавные, цифры, прочие
+func charClasses(password string) int {
+	var lower, upper, digit, other bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+
+	count := 0
+	for _, present := range []bool{lower, upper, digit, other} {
+		if present {
+			count++
+		}
+	}
+
+	return count
+}
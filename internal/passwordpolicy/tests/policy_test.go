@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"crypto/sha1" //nolint:gosec // формат списка утекших паролей
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/passwordpolicy"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var rules = passwordpolicy.Rules{MinLength: 8, MaxLength: 72, MinCharClasses: 3}
+
+func sha1Hex(password string) string {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // формат списка утекших паролей
+	return hex.EncodeToString(sum[:])
+}
+
+func violationCodes(violations []passwordpolicy.Violation) []string {
+	res := make([]string, 0, len(violations))
+	for _, v := range violations {
+		res = append(res, v.Code)
+	}
+	return res
+}
+
+func TestCheck(t *testing.T) {
+	blocklist := filepath.Join(t.TempDir(), "breached.txt")
+	content := "# Have I Been Pwned format\n" +
+		strings.ToUpper(sha1Hex("Passw0rd!")) + ":3861493\n\n" +
+		strings.ToLower(sha1Hex("Qwerty-123")) + "\n"
+	require.NoError(t, os.WriteFile(blocklist, []byte(content), 0o600))
+
+	policy, err := passwordpolicy.New(rules, blocklist)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		want     []string
+	}{
+		{name: "strong password", password: "Tr1cky-Lantern", want: []string{}},
+		{name: "too short", password: "Ab1!", want: []string{passwordpolicy.ViolationTooShort}},
+		{name: "too long", password: "Aa1" + strings.Repeat("x", 70), want: []string{passwordpolicy.ViolationTooLong}},
+		{name: "single class", password: "lowercaseonly", want: []string{passwordpolicy.ViolationCharClasses}},
+		{name: "contains username", password: "Xx-Alice-2024", want: []string{passwordpolicy.ViolationContainsName}},
+		{name: "contains email local part", password: "Wonder.Land-99", want: []string{passwordpolicy.ViolationContainsEmail}},
+		{name: "breached in upper case list entry", password: "Passw0rd!", want: []string{passwordpolicy.ViolationBreached}},
+		{name: "breached in lower case list entry", password: "Qwerty-123", want: []string{passwordpolicy.ViolationBreached}},
+		{
+			name:     "all violations reported together",
+			password: "alice",
+			want: []string{
+				passwordpolicy.ViolationTooShort,
+				passwordpolicy.ViolationCharClasses,
+				passwordpolicy.ViolationContainsName,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got := policy.Check(tt.password, "alice", "wonder.land@example.com")
+			require.Equal(t, tt.want, violationCodes(got))
+		})
+	}
+}
+
+func TestValidateStatus(t *testing.T) {
+	policy, err := passwordpolicy.New(rules, "")
+	require.NoError(t, err)
+
+	err = policy.Validate("short", "bob", "bob@example.com")
+	require.ErrorIs(t, err, model.ErrWeakPassword)
+
+	st := status.Convert(interceptor.ToStatus(err))
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.GetFieldViolations(), 2)
+	require.Equal(t, "password", badRequest.GetFieldViolations()[0].GetField())
+	require.Equal(t, passwordpolicy.ViolationTooShort, badRequest.GetFieldViolations()[0].GetReason())
+
+	require.NoError(t, passwordpolicy.Confirm("same", "same"))
+	require.ErrorIs(t, passwordpolicy.Confirm("one", "two"), model.ErrWeakPassword)
+
+	var nilPolicy *passwordpolicy.Policy
+	require.NoError(t, nilPolicy.Validate("x", "bob", "bob@example.com"))
+}
+
+func TestLoadBlocklistRejectsGarbage(t *testing.T) {
+	blocklist := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(blocklist, []byte("not-a-hash\n"), 0o600))
+
+	_, err := passwordpolicy.New(rules, blocklist)
+	require.Error(t, err)
+}
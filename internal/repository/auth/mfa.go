@@ -0,0 +1,362 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/platform_libary/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+const (
+	tableTOTPName          = "user_totp"
+	tableRecoveryCodesName = "recovery_codes"
+	tableMFAChallengesName = "mfa_challenges"
+
+	secretColumn       = "secret"
+	confirmedAtColumn  = "confirmed_at"
+	lastUsedStepColumn = "last_used_step"
+	codeHashColumn     = "code_hash"
+	tokenHashColumn    = "token_hash"
+	limitedColumn      = "limited"
+	attemptsColumn     = "attempts"
+	usedAtColumn       = "used_at"
+)
+
+// GetUserID возвращает ID пользователя по имени
+func (r *repo) GetUserID(ctx context.Context, username string) (int64, error) {
+	builder := sq.Select(idColumn).
+		From(tableName).
+		Where(sq.Eq{nameColumn: username}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.GetUserID",
+		QueryRaw: query,
+	}
+
+	var id int64
+	if err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, model.ErrUserNotFound
+		}
+		return 0, fmt.Errorf("failed to get user id: %w", err)
+	}
+
+	return id, nil
+}
+
+// GetTOTP возвращает настройку TOTP пользователя, в том числе неподтвержденную
+func (r *repo) GetTOTP(ctx context.Context, userID int64) (*model.TOTP, error) {
+	builder := sq.Select(secretColumn, confirmedAtColumn, lastUsedStepColumn).
+		From(tableTOTPName).
+		Where(sq.Eq{userIDColumn: userID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.GetTOTP",
+		QueryRaw: query,
+	}
+
+	totp := model.TOTP{UserID: userID}
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&totp.Secret, &totp.ConfirmedAt, &totp.LastUsedStep)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrTOTPNotEnrolled
+		}
+		return nil, fmt.Errorf("failed to get totp: %w", err)
+	}
+
+	return &totp, nil
+}
+
+// SaveTOTPSecret сохраняет новый неподтвержденный секрет. Подтвержденную настройку не заменяет.
+func (r *repo) SaveTOTPSecret(ctx context.Context, userID int64, secret string) error {
+	builder := sq.Insert(tableTOTPName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(userIDColumn, secretColumn).
+		Values(userID, secret).
+		Suffix("ON CONFLICT (" + userIDColumn + ") DO UPDATE SET " +
+			secretColumn + " = EXCLUDED." + secretColumn + ", " +
+			lastUsedStepColumn + " = 0, " +
+			createdAtColumn + " = NOW() " +
+			"WHERE " + tableTOTPName + "." + confirmedAtColumn + " IS NULL")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.SaveTOTPSecret",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to save totp secret: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrTOTPAlreadyEnabled
+	}
+
+	return nil
+}
+
+// ConfirmTOTP включает TOTP и запоминает шаг кода, которым он подтвержден
+func (r *repo) ConfirmTOTP(ctx context.Context, userID int64, step int64) error {
+	builder := sq.Update(tableTOTPName).
+		PlaceholderFormat(sq.Dollar).
+		Set(confirmedAtColumn, sq.Expr("NOW()")).
+		Set(lastUsedStepColumn, step).
+		Where(sq.Eq{userIDColumn: userID, confirmedAtColumn: nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.ConfirmTOTP",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to confirm totp: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrTOTPAlreadyEnabled
+	}
+
+	return nil
+}
+
+// UseTOTPStep запоминает шаг принятого кода. Код того же или более раннего шага отклоняется как повтор.
+func (r *repo) UseTOTPStep(ctx context.Context, userID int64, step int64) error {
+	builder := sq.Update(tableTOTPName).
+		PlaceholderFormat(sq.Dollar).
+		Set(lastUsedStepColumn, step).
+		Where(sq.Eq{userIDColumn: userID}).
+		Where(sq.Lt{lastUsedStepColumn: step})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.UseTOTPStep",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to use totp step: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+// ReplaceRecoveryCodes заменяет все коды восстановления пользователя новыми
+func (r *repo) ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) error {
+	deleteBuilder := sq.Delete(tableRecoveryCodesName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: userID})
+
+	query, args, err := deleteBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.DeleteRecoveryCodes",
+		QueryRaw: query,
+	}
+
+	if _, err = r.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	insertBuilder := sq.Insert(tableRecoveryCodesName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(codeHashColumn, userIDColumn)
+	for _, hash := range codeHashes {
+		insertBuilder = insertBuilder.Values(hash, userID)
+	}
+
+	query, args, err = insertBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	q = db.Query{
+		Name:     "auth_repository.CreateRecoveryCodes",
+		QueryRaw: query,
+	}
+
+	if _, err = r.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return fmt.Errorf("failed to create recovery codes: %w", err)
+	}
+
+	return nil
+}
+
+// UseRecoveryCode помечает код восстановления использованным
+func (r *repo) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	builder := sq.Update(tableRecoveryCodesName).
+		PlaceholderFormat(sq.Dollar).
+		Set(usedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{userIDColumn: userID, codeHashColumn: codeHash, usedAtColumn: nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.UseRecoveryCode",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+// CreateMFAChallenge сохраняет хеш токена второго шага входа
+func (r *repo) CreateMFAChallenge(ctx context.Context, challenge model.MFAChallenge) error {
+	builder := sq.Insert(tableMFAChallengesName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tokenHashColumn, userIDColumn, limitedColumn, expiresAtColumn).
+		Values(challenge.TokenHash, challenge.UserID, challenge.Limited, challenge.ExpiresAt)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.CreateMFAChallenge",
+		QueryRaw: query,
+	}
+
+	if _, err = r.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return fmt.Errorf("failed to create mfa challenge: %w", err)
+	}
+
+	return nil
+}
+
+// GetMFAChallenge возвращает неиспользованный и неистекший вход, ожидающий второго фактора,
+// и блокирует его до конца транзакции: параллельные проверки одного токена выполняются по очереди
+// и видят попытки, учтенные предыдущими.
+func (r *repo) GetMFAChallenge(ctx context.Context, tokenHash string) (*model.MFAChallenge, error) {
+	builder := sq.Select("c."+userIDColumn, "u."+nameColumn, "c."+limitedColumn, "c."+attemptsColumn, "c."+expiresAtColumn).
+		From(tableMFAChallengesName + " c").
+		Join(tableName + " u ON u." + idColumn + " = c." + userIDColumn).
+		Where(sq.Eq{"c." + tokenHashColumn: tokenHash, "c." + usedAtColumn: nil}).
+		Where(sq.Expr("c." + expiresAtColumn + " > NOW()")).
+		Suffix("FOR UPDATE OF c").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.GetMFAChallenge",
+		QueryRaw: query,
+	}
+
+	challenge := model.MFAChallenge{TokenHash: tokenHash}
+	err = r.db.DB().QueryRowContext(ctx, q, args...).
+		Scan(&challenge.UserID, &challenge.Username, &challenge.Limited, &challenge.Attempts, &challenge.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrInvalidMFAChallenge
+		}
+		return nil, fmt.Errorf("failed to get mfa challenge: %w", err)
+	}
+
+	return &challenge, nil
+}
+
+// IncMFAChallengeAttempts учитывает неверный код второго фактора
+func (r *repo) IncMFAChallengeAttempts(ctx context.Context, tokenHash string) error {
+	builder := sq.Update(tableMFAChallengesName).
+		PlaceholderFormat(sq.Dollar).
+		Set(attemptsColumn, sq.Expr(attemptsColumn+" + 1")).
+		Where(sq.Eq{tokenHashColumn: tokenHash})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.IncMFAChallengeAttempts",
+		QueryRaw: query,
+	}
+
+	if _, err = r.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return fmt.Errorf("failed to count mfa attempt: %w", err)
+	}
+
+	return nil
+}
+
+// UseMFAChallenge помечает вход завершенным; повторно токен не принимается
+func (r *repo) UseMFAChallenge(ctx context.Context, tokenHash string) error {
+	builder := sq.Update(tableMFAChallengesName).
+		PlaceholderFormat(sq.Dollar).
+		Set(usedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{tokenHashColumn: tokenHash, usedAtColumn: nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.UseMFAChallenge",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to use mfa challenge: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrInvalidMFAChallenge
+	}
+
+	return nil
+}
@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/passwordhash"
 	"github.com/Ippolid/auth/internal/repository"
 	"github.com/Ippolid/auth/internal/utils"
 	"github.com/Ippolid/platform_libary/pkg/db"
@@ -14,41 +16,64 @@ import (
 )
 
 const (
-	tableName       = "users_table"
-	tableAccessName = "access"
+	tableName        = "users_table"
+	tableAccessName  = "access"
+	tableRefreshName = "refresh_tokens"
+
+	tableRolesName     = "roles"
+	tableUserRolesName = "user_roles"
 
 	idColumn        = "id"
 	nameColumn      = "name"
 	emailColumn     = "email"
 	createdAtColumn = "created_at"
-	roleColumn      = "role"
 	passwordColumn  = "password"
 	tableLogName    = "logs"
 	methodColumn    = "method_name"
 	ctxColumn       = "ctx"
 	endpointColumn  = "endpoint"
+
+	familyIDColumn  = "family_id"
+	usernameColumn  = "username"
+	expiresAtColumn = "expires_at"
+	rotatedAtColumn = "rotated_at"
+	revokedAtColumn = "revoked_at"
+
+	verifiedAtColumn = "verified_at"
+
+	userIDColumn = "user_id"
+	roleIDColumn = "role_id"
 )
 
 type repo struct {
-	db db.Client
+	db     db.Client
+	hasher *passwordhash.Set
 }
 
-// NewRepository создает новый экземпляр репозитория
-func NewRepository(db db.Client) repository.AuthRepository {
-	return &repo{db: db}
+// NewRepository создает новый экземпляр репозитория. hasher проверяет пароли при входе
+// и перехеширует устаревшие хеши.
+func NewRepository(db db.Client, hasher *passwordhash.Set) repository.AuthRepository {
+	return &repo{db: db, hasher: hasher}
 }
 
 // InsertUser вставляет нового пользователя в базу данных и возвращает его ID
 
+// Login проверяет пароль пользователя. Username — имя пользователя или, если содержит '@', email.
+// Оба сравниваются без учета регистра после нормализации Unicode.
 func (r *repo) Login(ctx context.Context, user model.LoginRequest) (*model.UserInfoJwt, error) {
+	identifier := utils.NormalizeIdentifier(user.Username)
+	if identifier == "" || user.Password == "" {
+		return nil, model.ErrEmptyCredentials
+	}
 
-	if user.Username == "" || user.Password == "" {
-		return nil, fmt.Errorf("email and password must not be empty")
+	column := nameColumn
+	if utils.IsEmailIdentifier(identifier) {
+		column = emailColumn
 	}
 
-	builder := sq.Select(passwordColumn, roleColumn).
+	builder := sq.Select(idColumn, nameColumn, passwordColumn, verifiedAtColumn).
 		From(tableName).
-		Where(sq.Eq{nameColumn: user.Username}).
+		Where(sq.Expr("LOWER("+column+") = LOWER(?)", identifier)).
 		PlaceholderFormat(sq.Dollar)
 
 	query, args, err := builder.ToSql()
@@ -65,60 +90,128 @@ func (r *repo) Login(ctx context.Context, user model.LoginRequest) (*model.UserI
 
 	var userInfo model.UserInfoJwt
 	var password string
-	var role bool
+	var verifiedAt *time.Time
 
-	err = row.Scan(&password, &role)
+	err = row.Scan(&userInfo.UserID, &userInfo.Username, &password, &verifiedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("user not found")
+			// Сравниваем с фиктивным хешем, чтобы неизвестное имя отвечало так же долго, как неверный пароль
+			r.hasher.Simulate(user.Password)
+			return nil, model.ErrInvalidCredentials
 		}
 		return nil, fmt.Errorf("failed to scan user: %w", err)
 	}
 
-	if !utils.VerifyPassword(password, user.Password) {
-		return nil, fmt.Errorf("invalid password")
+	ok, rehash := r.hasher.Verify(password, user.Password)
+	if !ok {
+		return nil, model.ErrInvalidCredentials
+	}
+	if rehash {
+		if err = r.rehashPassword(ctx, userInfo.UserID, user.Password, password); err != nil {
+			return nil, err
+		}
+	}
+
+	roles, err := r.GetUserRoles(ctx, userInfo.Username)
+	if err != nil {
+		return nil, err
 	}
-	userInfo.Username = user.Username
-	userInfo.Role = role
+
+	userInfo.Roles = roles
+	userInfo.EmailVerified = verifiedAt != nil
 
 	return &userInfo, nil
 
 }
 
-func (r *repo) GetUserRole(ctx context.Context, username string) (bool, error) {
-	builder := sq.Select(roleColumn).
-		From(tableName).
-		Where(sq.Eq{nameColumn: username}).
-		PlaceholderFormat(sq.Dollar)
+// rehashPassword заменяет устаревший хеш на хеш текущего алгоритма. Выполняется в транзакции Login;
+// если пароль успели сменить, старый хеш не совпадет и обновление ничего не изменит.
+func (r *repo) rehashPassword(ctx context.Context, id int64, password string, oldHash string) error {
+	newHash, err := r.hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(passwordColumn, newHash).
+		Where(sq.Eq{idColumn: id, passwordColumn: oldHash})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return false, fmt.Errorf("failed to build query: %w", err)
+		return fmt.Errorf("failed to build update query: %w", err)
 	}
 
 	q := db.Query{
-		Name:     "auth_repository.GetUserRole",
+		Name:     "auth_repository.RehashPassword",
 		QueryRaw: query,
 	}
 
-	row := r.db.DB().QueryRowContext(ctx, q, args...)
+	if _, err = r.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return fmt.Errorf("failed to rehash password: %w", err)
+	}
+
+	return nil
+}
 
-	var role bool
-	err = row.Scan(&role)
+// GetUserRoles возвращает имена ролей пользователя
+func (r *repo) GetUserRoles(ctx context.Context, username string) ([]string, error) {
+	// LEFT JOIN, чтобы отличить пользователя без ролей от несуществующего
+	builder := sq.Select("r." + nameColumn).
+		From(tableName + " u").
+		LeftJoin(tableUserRolesName + " ur ON ur." + userIDColumn + " = u." + idColumn).
+		LeftJoin(tableRolesName + " r ON r." + idColumn + " = ur." + roleIDColumn).
+		Where(sq.Eq{"u." + nameColumn: username}).
+		OrderBy("r." + nameColumn).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return false, fmt.Errorf("user not found")
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.GetUserRoles",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	found := false
+	roles := make([]string, 0)
+	for rows.Next() {
+		found = true
+
+		var role sql.NullString
+		if err := rows.Scan(&role); err != nil {
+			return nil, fmt.Errorf("failed to scan user role: %w", err)
+		}
+		if role.Valid {
+			roles = append(roles, role.String)
 		}
-		return false, fmt.Errorf("failed to scan user role: %w", err)
 	}
 
-	return role, nil
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	if !found {
+		return nil, model.ErrUserNotFound
+	}
 
+	return roles, nil
 }
-func (r *repo) GetUsersAccess(ctx context.Context, isAdmin bool) ([]string, error) {
-	builder := sq.Select(endpointColumn).
-		From(tableAccessName).
-		Where(sq.Eq{roleColumn: isAdmin}).
+
+// GetAccessGrants возвращает все права ролей из таблицы access, упорядоченные по роли и эндпоинту
+func (r *repo) GetAccessGrants(ctx context.Context) ([]model.RolePermission, error) {
+	builder := sq.Select("r."+nameColumn, "a."+endpointColumn).
+		From(tableAccessName+" a").
+		Join(tableRolesName+" r ON r."+idColumn+" = a."+roleIDColumn).
+		OrderBy("r."+nameColumn, "a."+endpointColumn).
 		PlaceholderFormat(sq.Dollar)
 
 	query, args, err := builder.ToSql()
@@ -127,7 +220,7 @@ func (r *repo) GetUsersAccess(ctx context.Context, isAdmin bool) ([]string, erro
 	}
 
 	q := db.Query{
-		Name:     "auth_repository.GetUsersAccess",
+		Name:     "auth_repository.GetAccessGrants",
 		QueryRaw: query,
 	}
 
@@ -137,25 +230,187 @@ func (r *repo) GetUsersAccess(ctx context.Context, isAdmin bool) ([]string, erro
 	}
 	defer rows.Close()
 
-	endpoints := make([]string, 0)
+	grants := make([]model.RolePermission, 0)
 	for rows.Next() {
-		var endpoint string
-		if err := rows.Scan(&endpoint); err != nil {
-			return nil, fmt.Errorf("failed to scan endpoint: %w", err)
+		var grant model.RolePermission
+		if err := rows.Scan(&grant.Role, &grant.Endpoint); err != nil {
+			return nil, fmt.Errorf("failed to scan access grant: %w", err)
 		}
-		endpoints = append(endpoints, endpoint)
+		grants = append(grants, grant)
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, fmt.Errorf("error iterating rows: %w", err)
 	}
 
-	// Если для указанной роли нет эндпоинтов, возвращаем пустой слайс
-	if len(endpoints) == 0 {
-		return endpoints, nil
+	return grants, nil
+}
+
+// CreateRefreshToken сохраняет запись о выданном refresh-токене
+func (r *repo) CreateRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	builder := sq.Insert(tableRefreshName).
+		Columns(idColumn, familyIDColumn, usernameColumn, expiresAtColumn).
+		Values(token.ID, token.FamilyID, token.Username, token.ExpiresAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.CreateRefreshToken",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to save refresh token: %w", err)
+	}
+
+	return nil
+}
+
+// MarkRefreshTokenRotated помечает refresh-токен как обменянный.
+// Возвращает false, если токен уже был обменян, отозван, истек или неизвестен.
+func (r *repo) MarkRefreshTokenRotated(ctx context.Context, id string) (bool, error) {
+	builder := sq.Update(tableRefreshName).
+		Set(rotatedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{
+			idColumn:        id,
+			rotatedAtColumn: nil,
+			revokedAtColumn: nil,
+		}).
+		Where(sq.Expr(expiresAtColumn + " > NOW()")).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.MarkRefreshTokenRotated",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// RevokeRefreshTokenFamily отзывает все refresh-токены семейства
+func (r *repo) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	builder := sq.Update(tableRefreshName).
+		Set(revokedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{
+			familyIDColumn:  familyID,
+			revokedAtColumn: nil,
+		}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.RevokeRefreshTokenFamily",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeUserRefreshTokens отзывает все активные refresh-токены пользователя, кроме семейства keepFamilyID
+// (пустая строка — отозвать все). Возвращает идентификаторы затронутых семейств.
+func (r *repo) RevokeUserRefreshTokens(ctx context.Context, username string, keepFamilyID string) ([]string, error) {
+	builder := sq.Update(tableRefreshName).
+		Set(revokedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{
+			usernameColumn:  username,
+			revokedAtColumn: nil,
+		}).
+		Suffix("RETURNING " + familyIDColumn).
+		PlaceholderFormat(sq.Dollar)
+	if keepFamilyID != "" {
+		builder = builder.Where(sq.NotEq{familyIDColumn: keepFamilyID})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.RevokeUserRefreshTokens",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke user refresh tokens: %w", err)
+	}
+	defer rows.Close()
+
+	seen := make(map[string]struct{})
+	families := make([]string, 0)
+	for rows.Next() {
+		var familyID string
+		if err := rows.Scan(&familyID); err != nil {
+			return nil, fmt.Errorf("failed to scan family id: %w", err)
+		}
+		if _, ok := seen[familyID]; ok {
+			continue
+		}
+		seen[familyID] = struct{}{}
+		families = append(families, familyID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return families, nil
+}
+
+// IsRefreshTokenFamilyRevoked проверяет, отозвано ли семейство refresh-токенов
+func (r *repo) IsRefreshTokenFamilyRevoked(ctx context.Context, familyID string) (bool, error) {
+	builder := sq.Select("1").
+		From(tableRefreshName).
+		Where(sq.Eq{familyIDColumn: familyID}).
+		Where(sq.NotEq{revokedAtColumn: nil}).
+		Limit(1).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "auth_repository.IsRefreshTokenFamilyRevoked",
+		QueryRaw: query,
+	}
+
+	var exists int
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check refresh token family: %w", err)
 	}
 
-	return endpoints, nil
+	return true, nil
 }
 
 func (r *repo) MakeLog(ctx context.Context, info model.Log) error {
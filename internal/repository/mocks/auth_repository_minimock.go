@@ -0,0 +1,7326 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Ippolid/auth/internal/repository.AuthRepository -o auth_repository_minimock.go -n AuthRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuthRepositoryMock implements mm_repository.AuthRepository
+type AuthRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcConfirmTOTP          func(ctx context.Context, userID int64, step int64) (err error)
+	funcConfirmTOTPOrigin    string
+	inspectFuncConfirmTOTP   func(ctx context.Context, userID int64, step int64)
+	afterConfirmTOTPCounter  uint64
+	beforeConfirmTOTPCounter uint64
+	ConfirmTOTPMock          mAuthRepositoryMockConfirmTOTP
+
+	funcCreateMFAChallenge          func(ctx context.Context, challenge model.MFAChallenge) (err error)
+	funcCreateMFAChallengeOrigin    string
+	inspectFuncCreateMFAChallenge   func(ctx context.Context, challenge model.MFAChallenge)
+	afterCreateMFAChallengeCounter  uint64
+	beforeCreateMFAChallengeCounter uint64
+	CreateMFAChallengeMock          mAuthRepositoryMockCreateMFAChallenge
+
+	funcCreateRefreshToken          func(ctx context.Context, token model.RefreshToken) (err error)
+	funcCreateRefreshTokenOrigin    string
+	inspectFuncCreateRefreshToken   func(ctx context.Context, token model.RefreshToken)
+	afterCreateRefreshTokenCounter  uint64
+	beforeCreateRefreshTokenCounter uint64
+	CreateRefreshTokenMock          mAuthRepositoryMockCreateRefreshToken
+
+	funcGetAccessGrants          func(ctx context.Context) (ra1 []model.RolePermission, err error)
+	funcGetAccessGrantsOrigin    string
+	inspectFuncGetAccessGrants   func(ctx context.Context)
+	afterGetAccessGrantsCounter  uint64
+	beforeGetAccessGrantsCounter uint64
+	GetAccessGrantsMock          mAuthRepositoryMockGetAccessGrants
+
+	funcGetMFAChallenge          func(ctx context.Context, tokenHash string) (mp1 *model.MFAChallenge, err error)
+	funcGetMFAChallengeOrigin    string
+	inspectFuncGetMFAChallenge   func(ctx context.Context, tokenHash string)
+	afterGetMFAChallengeCounter  uint64
+	beforeGetMFAChallengeCounter uint64
+	GetMFAChallengeMock          mAuthRepositoryMockGetMFAChallenge
+
+	funcGetTOTP          func(ctx context.Context, userID int64) (tp1 *model.TOTP, err error)
+	funcGetTOTPOrigin    string
+	inspectFuncGetTOTP   func(ctx context.Context, userID int64)
+	afterGetTOTPCounter  uint64
+	beforeGetTOTPCounter uint64
+	GetTOTPMock          mAuthRepositoryMockGetTOTP
+
+	funcGetUserID          func(ctx context.Context, username string) (i1 int64, err error)
+	funcGetUserIDOrigin    string
+	inspectFuncGetUserID   func(ctx context.Context, username string)
+	afterGetUserIDCounter  uint64
+	beforeGetUserIDCounter uint64
+	GetUserIDMock          mAuthRepositoryMockGetUserID
+
+	funcGetUserRoles          func(ctx context.Context, username string) (sa1 []string, err error)
+	funcGetUserRolesOrigin    string
+	inspectFuncGetUserRoles   func(ctx context.Context, username string)
+	afterGetUserRolesCounter  uint64
+	beforeGetUserRolesCounter uint64
+	GetUserRolesMock          mAuthRepositoryMockGetUserRoles
+
+	funcIncMFAChallengeAttempts          func(ctx context.Context, tokenHash string) (err error)
+	funcIncMFAChallengeAttemptsOrigin    string
+	inspectFuncIncMFAChallengeAttempts   func(ctx context.Context, tokenHash string)
+	afterIncMFAChallengeAttemptsCounter  uint64
+	beforeIncMFAChallengeAttemptsCounter uint64
+	IncMFAChallengeAttemptsMock          mAuthRepositoryMockIncMFAChallengeAttempts
+
+	funcIsRefreshTokenFamilyRevoked          func(ctx context.Context, familyID string) (b1 bool, err error)
+	funcIsRefreshTokenFamilyRevokedOrigin    string
+	inspectFuncIsRefreshTokenFamilyRevoked   func(ctx context.Context, familyID string)
+	afterIsRefreshTokenFamilyRevokedCounter  uint64
+	beforeIsRefreshTokenFamilyRevokedCounter uint64
+	IsRefreshTokenFamilyRevokedMock          mAuthRepositoryMockIsRefreshTokenFamilyRevoked
+
+	funcLogin          func(ctx context.Context, user model.LoginRequest) (up1 *model.UserInfoJwt, err error)
+	funcLoginOrigin    string
+	inspectFuncLogin   func(ctx context.Context, user model.LoginRequest)
+	afterLoginCounter  uint64
+	beforeLoginCounter uint64
+	LoginMock          mAuthRepositoryMockLogin
+
+	funcMakeLog          func(ctx context.Context, log model.Log) (err error)
+	funcMakeLogOrigin    string
+	inspectFuncMakeLog   func(ctx context.Context, log model.Log)
+	afterMakeLogCounter  uint64
+	beforeMakeLogCounter uint64
+	MakeLogMock          mAuthRepositoryMockMakeLog
+
+	funcMarkRefreshTokenRotated          func(ctx context.Context, id string) (b1 bool, err error)
+	funcMarkRefreshTokenRotatedOrigin    string
+	inspectFuncMarkRefreshTokenRotated   func(ctx context.Context, id string)
+	afterMarkRefreshTokenRotatedCounter  uint64
+	beforeMarkRefreshTokenRotatedCounter uint64
+	MarkRefreshTokenRotatedMock          mAuthRepositoryMockMarkRefreshTokenRotated
+
+	funcReplaceRecoveryCodes          func(ctx context.Context, userID int64, codeHashes []string) (err error)
+	funcReplaceRecoveryCodesOrigin    string
+	inspectFuncReplaceRecoveryCodes   func(ctx context.Context, userID int64, codeHashes []string)
+	afterReplaceRecoveryCodesCounter  uint64
+	beforeReplaceRecoveryCodesCounter uint64
+	ReplaceRecoveryCodesMock          mAuthRepositoryMockReplaceRecoveryCodes
+
+	funcRevokeRefreshTokenFamily          func(ctx context.Context, familyID string) (err error)
+	funcRevokeRefreshTokenFamilyOrigin    string
+	inspectFuncRevokeRefreshTokenFamily   func(ctx context.Context, familyID string)
+	afterRevokeRefreshTokenFamilyCounter  uint64
+	beforeRevokeRefreshTokenFamilyCounter uint64
+	RevokeRefreshTokenFamilyMock          mAuthRepositoryMockRevokeRefreshTokenFamily
+
+	funcRevokeUserRefreshTokens          func(ctx context.Context, username string, keepFamilyID string) (sa1 []string, err error)
+	funcRevokeUserRefreshTokensOrigin    string
+	inspectFuncRevokeUserRefreshTokens   func(ctx context.Context, username string, keepFamilyID string)
+	afterRevokeUserRefreshTokensCounter  uint64
+	beforeRevokeUserRefreshTokensCounter uint64
+	RevokeUserRefreshTokensMock          mAuthRepositoryMockRevokeUserRefreshTokens
+
+	funcSaveTOTPSecret          func(ctx context.Context, userID int64, secret string) (err error)
+	funcSaveTOTPSecretOrigin    string
+	inspectFuncSaveTOTPSecret   func(ctx context.Context, userID int64, secret string)
+	afterSaveTOTPSecretCounter  uint64
+	beforeSaveTOTPSecretCounter uint64
+	SaveTOTPSecretMock          mAuthRepositoryMockSaveTOTPSecret
+
+	funcUseMFAChallenge          func(ctx context.Context, tokenHash string) (err error)
+	funcUseMFAChallengeOrigin    string
+	inspectFuncUseMFAChallenge   func(ctx context.Context, tokenHash string)
+	afterUseMFAChallengeCounter  uint64
+	beforeUseMFAChallengeCounter uint64
+	UseMFAChallengeMock          mAuthRepositoryMockUseMFAChallenge
+
+	funcUseRecoveryCode          func(ctx context.Context, userID int64, codeHash string) (err error)
+	funcUseRecoveryCodeOrigin    string
+	inspectFuncUseRecoveryCode   func(ctx context.Context, userID int64, codeHash string)
+	afterUseRecoveryCodeCounter  uint64
+	beforeUseRecoveryCodeCounter uint64
+	UseRecoveryCodeMock          mAuthRepositoryMockUseRecoveryCode
+
+	funcUseTOTPStep          func(ctx context.Context, userID int64, step int64) (err error)
+	funcUseTOTPStepOrigin    string
+	inspectFuncUseTOTPStep   func(ctx context.Context, userID int64, step int64)
+	afterUseTOTPStepCounter  uint64
+	beforeUseTOTPStepCounter uint64
+	UseTOTPStepMock          mAuthRepositoryMockUseTOTPStep
+}
+
+// NewAuthRepositoryMock returns a mock for mm_repository.AuthRepository
+func NewAuthRepositoryMock(t minimock.Tester) *AuthRepositoryMock {
+	m := &AuthRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ConfirmTOTPMock = mAuthRepositoryMockConfirmTOTP{mock: m}
+	m.ConfirmTOTPMock.callArgs = []*AuthRepositoryMockConfirmTOTPParams{}
+
+	m.CreateMFAChallengeMock = mAuthRepositoryMockCreateMFAChallenge{mock: m}
+	m.CreateMFAChallengeMock.callArgs = []*AuthRepositoryMockCreateMFAChallengeParams{}
+
+	m.CreateRefreshTokenMock = mAuthRepositoryMockCreateRefreshToken{mock: m}
+	m.CreateRefreshTokenMock.callArgs = []*AuthRepositoryMockCreateRefreshTokenParams{}
+
+	m.GetAccessGrantsMock = mAuthRepositoryMockGetAccessGrants{mock: m}
+	m.GetAccessGrantsMock.callArgs = []*AuthRepositoryMockGetAccessGrantsParams{}
+
+	m.GetMFAChallengeMock = mAuthRepositoryMockGetMFAChallenge{mock: m}
+	m.GetMFAChallengeMock.callArgs = []*AuthRepositoryMockGetMFAChallengeParams{}
+
+	m.GetTOTPMock = mAuthRepositoryMockGetTOTP{mock: m}
+	m.GetTOTPMock.callArgs = []*AuthRepositoryMockGetTOTPParams{}
+
+	m.GetUserIDMock = mAuthRepositoryMockGetUserID{mock: m}
+	m.GetUserIDMock.callArgs = []*AuthRepositoryMockGetUserIDParams{}
+
+	m.GetUserRolesMock = mAuthRepositoryMockGetUserRoles{mock: m}
+	m.GetUserRolesMock.callArgs = []*AuthRepositoryMockGetUserRolesParams{}
+
+	m.IncMFAChallengeAttemptsMock = mAuthRepositoryMockIncMFAChallengeAttempts{mock: m}
+	m.IncMFAChallengeAttemptsMock.callArgs = []*AuthRepositoryMockIncMFAChallengeAttemptsParams{}
+
+	m.IsRefreshTokenFamilyRevokedMock = mAuthRepositoryMockIsRefreshTokenFamilyRevoked{mock: m}
+	m.IsRefreshTokenFamilyRevokedMock.callArgs = []*AuthRepositoryMockIsRefreshTokenFamilyRevokedParams{}
+
+	m.LoginMock = mAuthRepositoryMockLogin{mock: m}
+	m.LoginMock.callArgs = []*AuthRepositoryMockLoginParams{}
+
+	m.MakeLogMock = mAuthRepositoryMockMakeLog{mock: m}
+	m.MakeLogMock.callArgs = []*AuthRepositoryMockMakeLogParams{}
+
+	m.MarkRefreshTokenRotatedMock = mAuthRepositoryMockMarkRefreshTokenRotated{mock: m}
+	m.MarkRefreshTokenRotatedMock.callArgs = []*AuthRepositoryMockMarkRefreshTokenRotatedParams{}
+
+	m.ReplaceRecoveryCodesMock = mAuthRepositoryMockReplaceRecoveryCodes{mock: m}
+	m.ReplaceRecoveryCodesMock.callArgs = []*AuthRepositoryMockReplaceRecoveryCodesParams{}
+
+	m.RevokeRefreshTokenFamilyMock = mAuthRepositoryMockRevokeRefreshTokenFamily{mock: m}
+	m.RevokeRefreshTokenFamilyMock.callArgs = []*AuthRepositoryMockRevokeRefreshTokenFamilyParams{}
+
+	m.RevokeUserRefreshTokensMock = mAuthRepositoryMockRevokeUserRefreshTokens{mock: m}
+	m.RevokeUserRefreshTokensMock.callArgs = []*AuthRepositoryMockRevokeUserRefreshTokensParams{}
+
+	m.SaveTOTPSecretMock = mAuthRepositoryMockSaveTOTPSecret{mock: m}
+	m.SaveTOTPSecretMock.callArgs = []*AuthRepositoryMockSaveTOTPSecretParams{}
+
+	m.UseMFAChallengeMock = mAuthRepositoryMockUseMFAChallenge{mock: m}
+	m.UseMFAChallengeMock.callArgs = []*AuthRepositoryMockUseMFAChallengeParams{}
+
+	m.UseRecoveryCodeMock = mAuthRepositoryMockUseRecoveryCode{mock: m}
+	m.UseRecoveryCodeMock.callArgs = []*AuthRepositoryMockUseRecoveryCodeParams{}
+
+	m.UseTOTPStepMock = mAuthRepositoryMockUseTOTPStep{mock: m}
+	m.UseTOTPStepMock.callArgs = []*AuthRepositoryMockUseTOTPStepParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuthRepositoryMockConfirmTOTP struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockConfirmTOTPExpectation
+	expectations       []*AuthRepositoryMockConfirmTOTPExpectation
+
+	callArgs []*AuthRepositoryMockConfirmTOTPParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockConfirmTOTPExpectation specifies expectation struct of the AuthRepository.ConfirmTOTP
+type AuthRepositoryMockConfirmTOTPExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockConfirmTOTPParams
+	paramPtrs          *AuthRepositoryMockConfirmTOTPParamPtrs
+	expectationOrigins AuthRepositoryMockConfirmTOTPExpectationOrigins
+	results            *AuthRepositoryMockConfirmTOTPResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockConfirmTOTPParams contains parameters of the AuthRepository.ConfirmTOTP
+type AuthRepositoryMockConfirmTOTPParams struct {
+	ctx    context.Context
+	userID int64
+	step   int64
+}
+
+// AuthRepositoryMockConfirmTOTPParamPtrs contains pointers to parameters of the AuthRepository.ConfirmTOTP
+type AuthRepositoryMockConfirmTOTPParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	step   *int64
+}
+
+// AuthRepositoryMockConfirmTOTPResults contains results of the AuthRepository.ConfirmTOTP
+type AuthRepositoryMockConfirmTOTPResults struct {
+	err error
+}
+
+// AuthRepositoryMockConfirmTOTPOrigins contains origins of expectations of the AuthRepository.ConfirmTOTP
+type AuthRepositoryMockConfirmTOTPExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originStep   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) Optional() *mAuthRepositoryMockConfirmTOTP {
+	mmConfirmTOTP.optional = true
+	return mmConfirmTOTP
+}
+
+// Expect sets up expected params for AuthRepository.ConfirmTOTP
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) Expect(ctx context.Context, userID int64, step int64) *mAuthRepositoryMockConfirmTOTP {
+	if mmConfirmTOTP.mock.funcConfirmTOTP != nil {
+		mmConfirmTOTP.mock.t.Fatalf("AuthRepositoryMock.ConfirmTOTP mock is already set by Set")
+	}
+
+	if mmConfirmTOTP.defaultExpectation == nil {
+		mmConfirmTOTP.defaultExpectation = &AuthRepositoryMockConfirmTOTPExpectation{}
+	}
+
+	if mmConfirmTOTP.defaultExpectation.paramPtrs != nil {
+		mmConfirmTOTP.mock.t.Fatalf("AuthRepositoryMock.ConfirmTOTP mock is already set by ExpectParams functions")
+	}
+
+	mmConfirmTOTP.defaultExpectation.params = &AuthRepositoryMockConfirmTOTPParams{ctx, userID, step}
+	mmConfirmTOTP.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConfirmTOTP.expectations {
+		if minimock.Equal(e.params, mmConfirmTOTP.defaultExpectation.params) {
+			mmConfirmTOTP.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConfirmTOTP.defaultExpectation.params)
+		}
+	}
+
+	return mmConfirmTOTP
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.ConfirmTOTP
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockConfirmTOTP {
+	if mmConfirmTOTP.mock.funcConfirmTOTP != nil {
+		mmConfirmTOTP.mock.t.Fatalf("AuthRepositoryMock.ConfirmTOTP mock is already set by Set")
+	}
+
+	if mmConfirmTOTP.defaultExpectation == nil {
+		mmConfirmTOTP.defaultExpectation = &AuthRepositoryMockConfirmTOTPExpectation{}
+	}
+
+	if mmConfirmTOTP.defaultExpectation.params != nil {
+		mmConfirmTOTP.mock.t.Fatalf("AuthRepositoryMock.ConfirmTOTP mock is already set by Expect")
+	}
+
+	if mmConfirmTOTP.defaultExpectation.paramPtrs == nil {
+		mmConfirmTOTP.defaultExpectation.paramPtrs = &AuthRepositoryMockConfirmTOTPParamPtrs{}
+	}
+	mmConfirmTOTP.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConfirmTOTP.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConfirmTOTP
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthRepository.ConfirmTOTP
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) ExpectUserIDParam2(userID int64) *mAuthRepositoryMockConfirmTOTP {
+	if mmConfirmTOTP.mock.funcConfirmTOTP != nil {
+		mmConfirmTOTP.mock.t.Fatalf("AuthRepositoryMock.ConfirmTOTP mock is already set by Set")
+	}
+
+	if mmConfirmTOTP.defaultExpectation == nil {
+		mmConfirmTOTP.defaultExpectation = &AuthRepositoryMockConfirmTOTPExpectation{}
+	}
+
+	if mmConfirmTOTP.defaultExpectation.params != nil {
+		mmConfirmTOTP.mock.t.Fatalf("AuthRepositoryMock.ConfirmTOTP mock is already set by Expect")
+	}
+
+	if mmConfirmTOTP.defaultExpectation.paramPtrs == nil {
+		mmConfirmTOTP.defaultExpectation.paramPtrs = &AuthRepositoryMockConfirmTOTPParamPtrs{}
+	}
+	mmConfirmTOTP.defaultExpectation.paramPtrs.userID = &userID
+	mmConfirmTOTP.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmConfirmTOTP
+}
+
+// ExpectStepParam3 sets up expected param step for AuthRepository.ConfirmTOTP
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) ExpectStepParam3(step int64) *mAuthRepositoryMockConfirmTOTP {
+	if mmConfirmTOTP.mock.funcConfirmTOTP != nil {
+		mmConfirmTOTP.mock.t.Fatalf("AuthRepositoryMock.ConfirmTOTP mock is already set by Set")
+	}
+
+	if mmConfirmTOTP.defaultExpectation == nil {
+		mmConfirmTOTP.defaultExpectation = &AuthRepositoryMockConfirmTOTPExpectation{}
+	}
+
+	if mmConfirmTOTP.defaultExpectation.params != nil {
+		mmConfirmTOTP.mock.t.Fatalf("AuthRepositoryMock.ConfirmTOTP mock is already set by Expect")
+	}
+
+	if mmConfirmTOTP.defaultExpectation.paramPtrs == nil {
+		mmConfirmTOTP.defaultExpectation.paramPtrs = &AuthRepositoryMockConfirmTOTPParamPtrs{}
+	}
+	mmConfirmTOTP.defaultExpectation.paramPtrs.step = &step
+	mmConfirmTOTP.defaultExpectation.expectationOrigins.originStep = minimock.CallerInfo(1)
+
+	return mmConfirmTOTP
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.ConfirmTOTP
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) Inspect(f func(ctx context.Context, userID int64, step int64)) *mAuthRepositoryMockConfirmTOTP {
+	if mmConfirmTOTP.mock.inspectFuncConfirmTOTP != nil {
+		mmConfirmTOTP.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.ConfirmTOTP")
+	}
+
+	mmConfirmTOTP.mock.inspectFuncConfirmTOTP = f
+
+	return mmConfirmTOTP
+}
+
+// Return sets up results that will be returned by AuthRepository.ConfirmTOTP
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) Return(err error) *AuthRepositoryMock {
+	if mmConfirmTOTP.mock.funcConfirmTOTP != nil {
+		mmConfirmTOTP.mock.t.Fatalf("AuthRepositoryMock.ConfirmTOTP mock is already set by Set")
+	}
+
+	if mmConfirmTOTP.defaultExpectation == nil {
+		mmConfirmTOTP.defaultExpectation = &AuthRepositoryMockConfirmTOTPExpectation{mock: mmConfirmTOTP.mock}
+	}
+	mmConfirmTOTP.defaultExpectation.results = &AuthRepositoryMockConfirmTOTPResults{err}
+	mmConfirmTOTP.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConfirmTOTP.mock
+}
+
+// Set uses given function f to mock the AuthRepository.ConfirmTOTP method
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) Set(f func(ctx context.Context, userID int64, step int64) (err error)) *AuthRepositoryMock {
+	if mmConfirmTOTP.defaultExpectation != nil {
+		mmConfirmTOTP.mock.t.Fatalf("Default expectation is already set for the AuthRepository.ConfirmTOTP method")
+	}
+
+	if len(mmConfirmTOTP.expectations) > 0 {
+		mmConfirmTOTP.mock.t.Fatalf("Some expectations are already set for the AuthRepository.ConfirmTOTP method")
+	}
+
+	mmConfirmTOTP.mock.funcConfirmTOTP = f
+	mmConfirmTOTP.mock.funcConfirmTOTPOrigin = minimock.CallerInfo(1)
+	return mmConfirmTOTP.mock
+}
+
+// When sets expectation for the AuthRepository.ConfirmTOTP which will trigger the result defined by the following
+// Then helper
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) When(ctx context.Context, userID int64, step int64) *AuthRepositoryMockConfirmTOTPExpectation {
+	if mmConfirmTOTP.mock.funcConfirmTOTP != nil {
+		mmConfirmTOTP.mock.t.Fatalf("AuthRepositoryMock.ConfirmTOTP mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockConfirmTOTPExpectation{
+		mock:               mmConfirmTOTP.mock,
+		params:             &AuthRepositoryMockConfirmTOTPParams{ctx, userID, step},
+		expectationOrigins: AuthRepositoryMockConfirmTOTPExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConfirmTOTP.expectations = append(mmConfirmTOTP.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.ConfirmTOTP return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockConfirmTOTPExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockConfirmTOTPResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.ConfirmTOTP should be invoked
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) Times(n uint64) *mAuthRepositoryMockConfirmTOTP {
+	if n == 0 {
+		mmConfirmTOTP.mock.t.Fatalf("Times of AuthRepositoryMock.ConfirmTOTP mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConfirmTOTP.expectedInvocations, n)
+	mmConfirmTOTP.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConfirmTOTP
+}
+
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) invocationsDone() bool {
+	if len(mmConfirmTOTP.expectations) == 0 && mmConfirmTOTP.defaultExpectation == nil && mmConfirmTOTP.mock.funcConfirmTOTP == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConfirmTOTP.mock.afterConfirmTOTPCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConfirmTOTP.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConfirmTOTP implements mm_repository.AuthRepository
+func (mmConfirmTOTP *AuthRepositoryMock) ConfirmTOTP(ctx context.Context, userID int64, step int64) (err error) {
+	mm_atomic.AddUint64(&mmConfirmTOTP.beforeConfirmTOTPCounter, 1)
+	defer mm_atomic.AddUint64(&mmConfirmTOTP.afterConfirmTOTPCounter, 1)
+
+	mmConfirmTOTP.t.Helper()
+
+	if mmConfirmTOTP.inspectFuncConfirmTOTP != nil {
+		mmConfirmTOTP.inspectFuncConfirmTOTP(ctx, userID, step)
+	}
+
+	mm_params := AuthRepositoryMockConfirmTOTPParams{ctx, userID, step}
+
+	// Record call args
+	mmConfirmTOTP.ConfirmTOTPMock.mutex.Lock()
+	mmConfirmTOTP.ConfirmTOTPMock.callArgs = append(mmConfirmTOTP.ConfirmTOTPMock.callArgs, &mm_params)
+	mmConfirmTOTP.ConfirmTOTPMock.mutex.Unlock()
+
+	for _, e := range mmConfirmTOTP.ConfirmTOTPMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConfirmTOTP.ConfirmTOTPMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConfirmTOTP.ConfirmTOTPMock.defaultExpectation.Counter, 1)
+		mm_want := mmConfirmTOTP.ConfirmTOTPMock.defaultExpectation.params
+		mm_want_ptrs := mmConfirmTOTP.ConfirmTOTPMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockConfirmTOTPParams{ctx, userID, step}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConfirmTOTP.t.Errorf("AuthRepositoryMock.ConfirmTOTP got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmTOTP.ConfirmTOTPMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmConfirmTOTP.t.Errorf("AuthRepositoryMock.ConfirmTOTP got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmTOTP.ConfirmTOTPMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.step != nil && !minimock.Equal(*mm_want_ptrs.step, mm_got.step) {
+				mmConfirmTOTP.t.Errorf("AuthRepositoryMock.ConfirmTOTP got unexpected parameter step, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmTOTP.ConfirmTOTPMock.defaultExpectation.expectationOrigins.originStep, *mm_want_ptrs.step, mm_got.step, minimock.Diff(*mm_want_ptrs.step, mm_got.step))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConfirmTOTP.t.Errorf("AuthRepositoryMock.ConfirmTOTP got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConfirmTOTP.ConfirmTOTPMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConfirmTOTP.ConfirmTOTPMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConfirmTOTP.t.Fatal("No results are set for the AuthRepositoryMock.ConfirmTOTP")
+		}
+		return (*mm_results).err
+	}
+	if mmConfirmTOTP.funcConfirmTOTP != nil {
+		return mmConfirmTOTP.funcConfirmTOTP(ctx, userID, step)
+	}
+	mmConfirmTOTP.t.Fatalf("Unexpected call to AuthRepositoryMock.ConfirmTOTP. %v %v %v", ctx, userID, step)
+	return
+}
+
+// ConfirmTOTPAfterCounter returns a count of finished AuthRepositoryMock.ConfirmTOTP invocations
+func (mmConfirmTOTP *AuthRepositoryMock) ConfirmTOTPAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmTOTP.afterConfirmTOTPCounter)
+}
+
+// ConfirmTOTPBeforeCounter returns a count of AuthRepositoryMock.ConfirmTOTP invocations
+func (mmConfirmTOTP *AuthRepositoryMock) ConfirmTOTPBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmTOTP.beforeConfirmTOTPCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.ConfirmTOTP.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConfirmTOTP *mAuthRepositoryMockConfirmTOTP) Calls() []*AuthRepositoryMockConfirmTOTPParams {
+	mmConfirmTOTP.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockConfirmTOTPParams, len(mmConfirmTOTP.callArgs))
+	copy(argCopy, mmConfirmTOTP.callArgs)
+
+	mmConfirmTOTP.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConfirmTOTPDone returns true if the count of the ConfirmTOTP invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockConfirmTOTPDone() bool {
+	if m.ConfirmTOTPMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConfirmTOTPMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConfirmTOTPMock.invocationsDone()
+}
+
+// MinimockConfirmTOTPInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockConfirmTOTPInspect() {
+	for _, e := range m.ConfirmTOTPMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.ConfirmTOTP at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConfirmTOTPCounter := mm_atomic.LoadUint64(&m.afterConfirmTOTPCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConfirmTOTPMock.defaultExpectation != nil && afterConfirmTOTPCounter < 1 {
+		if m.ConfirmTOTPMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.ConfirmTOTP at\n%s", m.ConfirmTOTPMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.ConfirmTOTP at\n%s with params: %#v", m.ConfirmTOTPMock.defaultExpectation.expectationOrigins.origin, *m.ConfirmTOTPMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConfirmTOTP != nil && afterConfirmTOTPCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.ConfirmTOTP at\n%s", m.funcConfirmTOTPOrigin)
+	}
+
+	if !m.ConfirmTOTPMock.invocationsDone() && afterConfirmTOTPCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.ConfirmTOTP at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConfirmTOTPMock.expectedInvocations), m.ConfirmTOTPMock.expectedInvocationsOrigin, afterConfirmTOTPCounter)
+	}
+}
+
+type mAuthRepositoryMockCreateMFAChallenge struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockCreateMFAChallengeExpectation
+	expectations       []*AuthRepositoryMockCreateMFAChallengeExpectation
+
+	callArgs []*AuthRepositoryMockCreateMFAChallengeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockCreateMFAChallengeExpectation specifies expectation struct of the AuthRepository.CreateMFAChallenge
+type AuthRepositoryMockCreateMFAChallengeExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockCreateMFAChallengeParams
+	paramPtrs          *AuthRepositoryMockCreateMFAChallengeParamPtrs
+	expectationOrigins AuthRepositoryMockCreateMFAChallengeExpectationOrigins
+	results            *AuthRepositoryMockCreateMFAChallengeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockCreateMFAChallengeParams contains parameters of the AuthRepository.CreateMFAChallenge
+type AuthRepositoryMockCreateMFAChallengeParams struct {
+	ctx       context.Context
+	challenge model.MFAChallenge
+}
+
+// AuthRepositoryMockCreateMFAChallengeParamPtrs contains pointers to parameters of the AuthRepository.CreateMFAChallenge
+type AuthRepositoryMockCreateMFAChallengeParamPtrs struct {
+	ctx       *context.Context
+	challenge *model.MFAChallenge
+}
+
+// AuthRepositoryMockCreateMFAChallengeResults contains results of the AuthRepository.CreateMFAChallenge
+type AuthRepositoryMockCreateMFAChallengeResults struct {
+	err error
+}
+
+// AuthRepositoryMockCreateMFAChallengeOrigins contains origins of expectations of the AuthRepository.CreateMFAChallenge
+type AuthRepositoryMockCreateMFAChallengeExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChallenge string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateMFAChallenge *mAuthRepositoryMockCreateMFAChallenge) Optional() *mAuthRepositoryMockCreateMFAChallenge {
+	mmCreateMFAChallenge.optional = true
+	return mmCreateMFAChallenge
+}
+
+// Expect sets up expected params for AuthRepository.CreateMFAChallenge
+func (mmCreateMFAChallenge *mAuthRepositoryMockCreateMFAChallenge) Expect(ctx context.Context, challenge model.MFAChallenge) *mAuthRepositoryMockCreateMFAChallenge {
+	if mmCreateMFAChallenge.mock.funcCreateMFAChallenge != nil {
+		mmCreateMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.CreateMFAChallenge mock is already set by Set")
+	}
+
+	if mmCreateMFAChallenge.defaultExpectation == nil {
+		mmCreateMFAChallenge.defaultExpectation = &AuthRepositoryMockCreateMFAChallengeExpectation{}
+	}
+
+	if mmCreateMFAChallenge.defaultExpectation.paramPtrs != nil {
+		mmCreateMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.CreateMFAChallenge mock is already set by ExpectParams functions")
+	}
+
+	mmCreateMFAChallenge.defaultExpectation.params = &AuthRepositoryMockCreateMFAChallengeParams{ctx, challenge}
+	mmCreateMFAChallenge.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateMFAChallenge.expectations {
+		if minimock.Equal(e.params, mmCreateMFAChallenge.defaultExpectation.params) {
+			mmCreateMFAChallenge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateMFAChallenge.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateMFAChallenge
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.CreateMFAChallenge
+func (mmCreateMFAChallenge *mAuthRepositoryMockCreateMFAChallenge) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockCreateMFAChallenge {
+	if mmCreateMFAChallenge.mock.funcCreateMFAChallenge != nil {
+		mmCreateMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.CreateMFAChallenge mock is already set by Set")
+	}
+
+	if mmCreateMFAChallenge.defaultExpectation == nil {
+		mmCreateMFAChallenge.defaultExpectation = &AuthRepositoryMockCreateMFAChallengeExpectation{}
+	}
+
+	if mmCreateMFAChallenge.defaultExpectation.params != nil {
+		mmCreateMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.CreateMFAChallenge mock is already set by Expect")
+	}
+
+	if mmCreateMFAChallenge.defaultExpectation.paramPtrs == nil {
+		mmCreateMFAChallenge.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateMFAChallengeParamPtrs{}
+	}
+	mmCreateMFAChallenge.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateMFAChallenge.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateMFAChallenge
+}
+
+// ExpectChallengeParam2 sets up expected param challenge for AuthRepository.CreateMFAChallenge
+func (mmCreateMFAChallenge *mAuthRepositoryMockCreateMFAChallenge) ExpectChallengeParam2(challenge model.MFAChallenge) *mAuthRepositoryMockCreateMFAChallenge {
+	if mmCreateMFAChallenge.mock.funcCreateMFAChallenge != nil {
+		mmCreateMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.CreateMFAChallenge mock is already set by Set")
+	}
+
+	if mmCreateMFAChallenge.defaultExpectation == nil {
+		mmCreateMFAChallenge.defaultExpectation = &AuthRepositoryMockCreateMFAChallengeExpectation{}
+	}
+
+	if mmCreateMFAChallenge.defaultExpectation.params != nil {
+		mmCreateMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.CreateMFAChallenge mock is already set by Expect")
+	}
+
+	if mmCreateMFAChallenge.defaultExpectation.paramPtrs == nil {
+		mmCreateMFAChallenge.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateMFAChallengeParamPtrs{}
+	}
+	mmCreateMFAChallenge.defaultExpectation.paramPtrs.challenge = &challenge
+	mmCreateMFAChallenge.defaultExpectation.expectationOrigins.originChallenge = minimock.CallerInfo(1)
+
+	return mmCreateMFAChallenge
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.CreateMFAChallenge
+func (mmCreateMFAChallenge *mAuthRepositoryMockCreateMFAChallenge) Inspect(f func(ctx context.Context, challenge model.MFAChallenge)) *mAuthRepositoryMockCreateMFAChallenge {
+	if mmCreateMFAChallenge.mock.inspectFuncCreateMFAChallenge != nil {
+		mmCreateMFAChallenge.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.CreateMFAChallenge")
+	}
+
+	mmCreateMFAChallenge.mock.inspectFuncCreateMFAChallenge = f
+
+	return mmCreateMFAChallenge
+}
+
+// Return sets up results that will be returned by AuthRepository.CreateMFAChallenge
+func (mmCreateMFAChallenge *mAuthRepositoryMockCreateMFAChallenge) Return(err error) *AuthRepositoryMock {
+	if mmCreateMFAChallenge.mock.funcCreateMFAChallenge != nil {
+		mmCreateMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.CreateMFAChallenge mock is already set by Set")
+	}
+
+	if mmCreateMFAChallenge.defaultExpectation == nil {
+		mmCreateMFAChallenge.defaultExpectation = &AuthRepositoryMockCreateMFAChallengeExpectation{mock: mmCreateMFAChallenge.mock}
+	}
+	mmCreateMFAChallenge.defaultExpectation.results = &AuthRepositoryMockCreateMFAChallengeResults{err}
+	mmCreateMFAChallenge.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateMFAChallenge.mock
+}
+
+// Set uses given function f to mock the AuthRepository.CreateMFAChallenge method
+func (mmCreateMFAChallenge *mAuthRepositoryMockCreateMFAChallenge) Set(f func(ctx context.Context, challenge model.MFAChallenge) (err error)) *AuthRepositoryMock {
+	if mmCreateMFAChallenge.defaultExpectation != nil {
+		mmCreateMFAChallenge.mock.t.Fatalf("Default expectation is already set for the AuthRepository.CreateMFAChallenge method")
+	}
+
+	if len(mmCreateMFAChallenge.expectations) > 0 {
+		mmCreateMFAChallenge.mock.t.Fatalf("Some expectations are already set for the AuthRepository.CreateMFAChallenge method")
+	}
+
+	mmCreateMFAChallenge.mock.funcCreateMFAChallenge = f
+	mmCreateMFAChallenge.mock.funcCreateMFAChallengeOrigin = minimock.CallerInfo(1)
+	return mmCreateMFAChallenge.mock
+}
+
+// When sets expectation for the AuthRepository.CreateMFAChallenge which will trigger the result defined by the following
+// Then helper
+func (mmCreateMFAChallenge *mAuthRepositoryMockCreateMFAChallenge) When(ctx context.Context, challenge model.MFAChallenge) *AuthRepositoryMockCreateMFAChallengeExpectation {
+	if mmCreateMFAChallenge.mock.funcCreateMFAChallenge != nil {
+		mmCreateMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.CreateMFAChallenge mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockCreateMFAChallengeExpectation{
+		mock:               mmCreateMFAChallenge.mock,
+		params:             &AuthRepositoryMockCreateMFAChallengeParams{ctx, challenge},
+		expectationOrigins: AuthRepositoryMockCreateMFAChallengeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateMFAChallenge.expectations = append(mmCreateMFAChallenge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.CreateMFAChallenge return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockCreateMFAChallengeExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockCreateMFAChallengeResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.CreateMFAChallenge should be invoked
+func (mmCreateMFAChallenge *mAuthRepositoryMockCreateMFAChallenge) Times(n uint64) *mAuthRepositoryMockCreateMFAChallenge {
+	if n == 0 {
+		mmCreateMFAChallenge.mock.t.Fatalf("Times of AuthRepositoryMock.CreateMFAChallenge mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateMFAChallenge.expectedInvocations, n)
+	mmCreateMFAChallenge.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateMFAChallenge
+}
+
+func (mmCreateMFAChallenge *mAuthRepositoryMockCreateMFAChallenge) invocationsDone() bool {
+	if len(mmCreateMFAChallenge.expectations) == 0 && mmCreateMFAChallenge.defaultExpectation == nil && mmCreateMFAChallenge.mock.funcCreateMFAChallenge == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateMFAChallenge.mock.afterCreateMFAChallengeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateMFAChallenge.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateMFAChallenge implements mm_repository.AuthRepository
+func (mmCreateMFAChallenge *AuthRepositoryMock) CreateMFAChallenge(ctx context.Context, challenge model.MFAChallenge) (err error) {
+	mm_atomic.AddUint64(&mmCreateMFAChallenge.beforeCreateMFAChallengeCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateMFAChallenge.afterCreateMFAChallengeCounter, 1)
+
+	mmCreateMFAChallenge.t.Helper()
+
+	if mmCreateMFAChallenge.inspectFuncCreateMFAChallenge != nil {
+		mmCreateMFAChallenge.inspectFuncCreateMFAChallenge(ctx, challenge)
+	}
+
+	mm_params := AuthRepositoryMockCreateMFAChallengeParams{ctx, challenge}
+
+	// Record call args
+	mmCreateMFAChallenge.CreateMFAChallengeMock.mutex.Lock()
+	mmCreateMFAChallenge.CreateMFAChallengeMock.callArgs = append(mmCreateMFAChallenge.CreateMFAChallengeMock.callArgs, &mm_params)
+	mmCreateMFAChallenge.CreateMFAChallengeMock.mutex.Unlock()
+
+	for _, e := range mmCreateMFAChallenge.CreateMFAChallengeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateMFAChallenge.CreateMFAChallengeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateMFAChallenge.CreateMFAChallengeMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateMFAChallenge.CreateMFAChallengeMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateMFAChallenge.CreateMFAChallengeMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockCreateMFAChallengeParams{ctx, challenge}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateMFAChallenge.t.Errorf("AuthRepositoryMock.CreateMFAChallenge got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateMFAChallenge.CreateMFAChallengeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.challenge != nil && !minimock.Equal(*mm_want_ptrs.challenge, mm_got.challenge) {
+				mmCreateMFAChallenge.t.Errorf("AuthRepositoryMock.CreateMFAChallenge got unexpected parameter challenge, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateMFAChallenge.CreateMFAChallengeMock.defaultExpectation.expectationOrigins.originChallenge, *mm_want_ptrs.challenge, mm_got.challenge, minimock.Diff(*mm_want_ptrs.challenge, mm_got.challenge))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateMFAChallenge.t.Errorf("AuthRepositoryMock.CreateMFAChallenge got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateMFAChallenge.CreateMFAChallengeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateMFAChallenge.CreateMFAChallengeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateMFAChallenge.t.Fatal("No results are set for the AuthRepositoryMock.CreateMFAChallenge")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateMFAChallenge.funcCreateMFAChallenge != nil {
+		return mmCreateMFAChallenge.funcCreateMFAChallenge(ctx, challenge)
+	}
+	mmCreateMFAChallenge.t.Fatalf("Unexpected call to AuthRepositoryMock.CreateMFAChallenge. %v %v", ctx, challenge)
+	return
+}
+
+// CreateMFAChallengeAfterCounter returns a count of finished AuthRepositoryMock.CreateMFAChallenge invocations
+func (mmCreateMFAChallenge *AuthRepositoryMock) CreateMFAChallengeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateMFAChallenge.afterCreateMFAChallengeCounter)
+}
+
+// CreateMFAChallengeBeforeCounter returns a count of AuthRepositoryMock.CreateMFAChallenge invocations
+func (mmCreateMFAChallenge *AuthRepositoryMock) CreateMFAChallengeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateMFAChallenge.beforeCreateMFAChallengeCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.CreateMFAChallenge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateMFAChallenge *mAuthRepositoryMockCreateMFAChallenge) Calls() []*AuthRepositoryMockCreateMFAChallengeParams {
+	mmCreateMFAChallenge.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockCreateMFAChallengeParams, len(mmCreateMFAChallenge.callArgs))
+	copy(argCopy, mmCreateMFAChallenge.callArgs)
+
+	mmCreateMFAChallenge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateMFAChallengeDone returns true if the count of the CreateMFAChallenge invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockCreateMFAChallengeDone() bool {
+	if m.CreateMFAChallengeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMFAChallengeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMFAChallengeMock.invocationsDone()
+}
+
+// MinimockCreateMFAChallengeInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockCreateMFAChallengeInspect() {
+	for _, e := range m.CreateMFAChallengeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateMFAChallenge at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateMFAChallengeCounter := mm_atomic.LoadUint64(&m.afterCreateMFAChallengeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMFAChallengeMock.defaultExpectation != nil && afterCreateMFAChallengeCounter < 1 {
+		if m.CreateMFAChallengeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateMFAChallenge at\n%s", m.CreateMFAChallengeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateMFAChallenge at\n%s with params: %#v", m.CreateMFAChallengeMock.defaultExpectation.expectationOrigins.origin, *m.CreateMFAChallengeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateMFAChallenge != nil && afterCreateMFAChallengeCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.CreateMFAChallenge at\n%s", m.funcCreateMFAChallengeOrigin)
+	}
+
+	if !m.CreateMFAChallengeMock.invocationsDone() && afterCreateMFAChallengeCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.CreateMFAChallenge at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMFAChallengeMock.expectedInvocations), m.CreateMFAChallengeMock.expectedInvocationsOrigin, afterCreateMFAChallengeCounter)
+	}
+}
+
+type mAuthRepositoryMockCreateRefreshToken struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockCreateRefreshTokenExpectation
+	expectations       []*AuthRepositoryMockCreateRefreshTokenExpectation
+
+	callArgs []*AuthRepositoryMockCreateRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockCreateRefreshTokenExpectation specifies expectation struct of the AuthRepository.CreateRefreshToken
+type AuthRepositoryMockCreateRefreshTokenExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockCreateRefreshTokenParams
+	paramPtrs          *AuthRepositoryMockCreateRefreshTokenParamPtrs
+	expectationOrigins AuthRepositoryMockCreateRefreshTokenExpectationOrigins
+	results            *AuthRepositoryMockCreateRefreshTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockCreateRefreshTokenParams contains parameters of the AuthRepository.CreateRefreshToken
+type AuthRepositoryMockCreateRefreshTokenParams struct {
+	ctx   context.Context
+	token model.RefreshToken
+}
+
+// AuthRepositoryMockCreateRefreshTokenParamPtrs contains pointers to parameters of the AuthRepository.CreateRefreshToken
+type AuthRepositoryMockCreateRefreshTokenParamPtrs struct {
+	ctx   *context.Context
+	token *model.RefreshToken
+}
+
+// AuthRepositoryMockCreateRefreshTokenResults contains results of the AuthRepository.CreateRefreshToken
+type AuthRepositoryMockCreateRefreshTokenResults struct {
+	err error
+}
+
+// AuthRepositoryMockCreateRefreshTokenOrigins contains origins of expectations of the AuthRepository.CreateRefreshToken
+type AuthRepositoryMockCreateRefreshTokenExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRefreshToken *mAuthRepositoryMockCreateRefreshToken) Optional() *mAuthRepositoryMockCreateRefreshToken {
+	mmCreateRefreshToken.optional = true
+	return mmCreateRefreshToken
+}
+
+// Expect sets up expected params for AuthRepository.CreateRefreshToken
+func (mmCreateRefreshToken *mAuthRepositoryMockCreateRefreshToken) Expect(ctx context.Context, token model.RefreshToken) *mAuthRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("AuthRepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &AuthRepositoryMockCreateRefreshTokenExpectation{}
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("AuthRepositoryMock.CreateRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRefreshToken.defaultExpectation.params = &AuthRepositoryMockCreateRefreshTokenParams{ctx, token}
+	mmCreateRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRefreshToken.expectations {
+		if minimock.Equal(e.params, mmCreateRefreshToken.defaultExpectation.params) {
+			mmCreateRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRefreshToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.CreateRefreshToken
+func (mmCreateRefreshToken *mAuthRepositoryMockCreateRefreshToken) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("AuthRepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &AuthRepositoryMockCreateRefreshTokenExpectation{}
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.params != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("AuthRepositoryMock.CreateRefreshToken mock is already set by Expect")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmCreateRefreshToken.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateRefreshTokenParamPtrs{}
+	}
+	mmCreateRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRefreshToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRefreshToken
+}
+
+// ExpectTokenParam2 sets up expected param token for AuthRepository.CreateRefreshToken
+func (mmCreateRefreshToken *mAuthRepositoryMockCreateRefreshToken) ExpectTokenParam2(token model.RefreshToken) *mAuthRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("AuthRepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &AuthRepositoryMockCreateRefreshTokenExpectation{}
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.params != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("AuthRepositoryMock.CreateRefreshToken mock is already set by Expect")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmCreateRefreshToken.defaultExpectation.paramPtrs = &AuthRepositoryMockCreateRefreshTokenParamPtrs{}
+	}
+	mmCreateRefreshToken.defaultExpectation.paramPtrs.token = &token
+	mmCreateRefreshToken.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmCreateRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.CreateRefreshToken
+func (mmCreateRefreshToken *mAuthRepositoryMockCreateRefreshToken) Inspect(f func(ctx context.Context, token model.RefreshToken)) *mAuthRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.inspectFuncCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.CreateRefreshToken")
+	}
+
+	mmCreateRefreshToken.mock.inspectFuncCreateRefreshToken = f
+
+	return mmCreateRefreshToken
+}
+
+// Return sets up results that will be returned by AuthRepository.CreateRefreshToken
+func (mmCreateRefreshToken *mAuthRepositoryMockCreateRefreshToken) Return(err error) *AuthRepositoryMock {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("AuthRepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &AuthRepositoryMockCreateRefreshTokenExpectation{mock: mmCreateRefreshToken.mock}
+	}
+	mmCreateRefreshToken.defaultExpectation.results = &AuthRepositoryMockCreateRefreshTokenResults{err}
+	mmCreateRefreshToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRefreshToken.mock
+}
+
+// Set uses given function f to mock the AuthRepository.CreateRefreshToken method
+func (mmCreateRefreshToken *mAuthRepositoryMockCreateRefreshToken) Set(f func(ctx context.Context, token model.RefreshToken) (err error)) *AuthRepositoryMock {
+	if mmCreateRefreshToken.defaultExpectation != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("Default expectation is already set for the AuthRepository.CreateRefreshToken method")
+	}
+
+	if len(mmCreateRefreshToken.expectations) > 0 {
+		mmCreateRefreshToken.mock.t.Fatalf("Some expectations are already set for the AuthRepository.CreateRefreshToken method")
+	}
+
+	mmCreateRefreshToken.mock.funcCreateRefreshToken = f
+	mmCreateRefreshToken.mock.funcCreateRefreshTokenOrigin = minimock.CallerInfo(1)
+	return mmCreateRefreshToken.mock
+}
+
+// When sets expectation for the AuthRepository.CreateRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmCreateRefreshToken *mAuthRepositoryMockCreateRefreshToken) When(ctx context.Context, token model.RefreshToken) *AuthRepositoryMockCreateRefreshTokenExpectation {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("AuthRepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockCreateRefreshTokenExpectation{
+		mock:               mmCreateRefreshToken.mock,
+		params:             &AuthRepositoryMockCreateRefreshTokenParams{ctx, token},
+		expectationOrigins: AuthRepositoryMockCreateRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRefreshToken.expectations = append(mmCreateRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.CreateRefreshToken return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockCreateRefreshTokenExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockCreateRefreshTokenResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.CreateRefreshToken should be invoked
+func (mmCreateRefreshToken *mAuthRepositoryMockCreateRefreshToken) Times(n uint64) *mAuthRepositoryMockCreateRefreshToken {
+	if n == 0 {
+		mmCreateRefreshToken.mock.t.Fatalf("Times of AuthRepositoryMock.CreateRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRefreshToken.expectedInvocations, n)
+	mmCreateRefreshToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRefreshToken
+}
+
+func (mmCreateRefreshToken *mAuthRepositoryMockCreateRefreshToken) invocationsDone() bool {
+	if len(mmCreateRefreshToken.expectations) == 0 && mmCreateRefreshToken.defaultExpectation == nil && mmCreateRefreshToken.mock.funcCreateRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRefreshToken.mock.afterCreateRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRefreshToken implements mm_repository.AuthRepository
+func (mmCreateRefreshToken *AuthRepositoryMock) CreateRefreshToken(ctx context.Context, token model.RefreshToken) (err error) {
+	mm_atomic.AddUint64(&mmCreateRefreshToken.beforeCreateRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRefreshToken.afterCreateRefreshTokenCounter, 1)
+
+	mmCreateRefreshToken.t.Helper()
+
+	if mmCreateRefreshToken.inspectFuncCreateRefreshToken != nil {
+		mmCreateRefreshToken.inspectFuncCreateRefreshToken(ctx, token)
+	}
+
+	mm_params := AuthRepositoryMockCreateRefreshTokenParams{ctx, token}
+
+	// Record call args
+	mmCreateRefreshToken.CreateRefreshTokenMock.mutex.Lock()
+	mmCreateRefreshToken.CreateRefreshTokenMock.callArgs = append(mmCreateRefreshToken.CreateRefreshTokenMock.callArgs, &mm_params)
+	mmCreateRefreshToken.CreateRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmCreateRefreshToken.CreateRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockCreateRefreshTokenParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRefreshToken.t.Errorf("AuthRepositoryMock.CreateRefreshToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmCreateRefreshToken.t.Errorf("AuthRepositoryMock.CreateRefreshToken got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRefreshToken.t.Errorf("AuthRepositoryMock.CreateRefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRefreshToken.t.Fatal("No results are set for the AuthRepositoryMock.CreateRefreshToken")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateRefreshToken.funcCreateRefreshToken != nil {
+		return mmCreateRefreshToken.funcCreateRefreshToken(ctx, token)
+	}
+	mmCreateRefreshToken.t.Fatalf("Unexpected call to AuthRepositoryMock.CreateRefreshToken. %v %v", ctx, token)
+	return
+}
+
+// CreateRefreshTokenAfterCounter returns a count of finished AuthRepositoryMock.CreateRefreshToken invocations
+func (mmCreateRefreshToken *AuthRepositoryMock) CreateRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRefreshToken.afterCreateRefreshTokenCounter)
+}
+
+// CreateRefreshTokenBeforeCounter returns a count of AuthRepositoryMock.CreateRefreshToken invocations
+func (mmCreateRefreshToken *AuthRepositoryMock) CreateRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRefreshToken.beforeCreateRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.CreateRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRefreshToken *mAuthRepositoryMockCreateRefreshToken) Calls() []*AuthRepositoryMockCreateRefreshTokenParams {
+	mmCreateRefreshToken.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockCreateRefreshTokenParams, len(mmCreateRefreshToken.callArgs))
+	copy(argCopy, mmCreateRefreshToken.callArgs)
+
+	mmCreateRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRefreshTokenDone returns true if the count of the CreateRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockCreateRefreshTokenDone() bool {
+	if m.CreateRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRefreshTokenMock.invocationsDone()
+}
+
+// MinimockCreateRefreshTokenInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockCreateRefreshTokenInspect() {
+	for _, e := range m.CreateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateRefreshToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterCreateRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRefreshTokenMock.defaultExpectation != nil && afterCreateRefreshTokenCounter < 1 {
+		if m.CreateRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateRefreshToken at\n%s", m.CreateRefreshTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.CreateRefreshToken at\n%s with params: %#v", m.CreateRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *m.CreateRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRefreshToken != nil && afterCreateRefreshTokenCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.CreateRefreshToken at\n%s", m.funcCreateRefreshTokenOrigin)
+	}
+
+	if !m.CreateRefreshTokenMock.invocationsDone() && afterCreateRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.CreateRefreshToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRefreshTokenMock.expectedInvocations), m.CreateRefreshTokenMock.expectedInvocationsOrigin, afterCreateRefreshTokenCounter)
+	}
+}
+
+type mAuthRepositoryMockGetAccessGrants struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockGetAccessGrantsExpectation
+	expectations       []*AuthRepositoryMockGetAccessGrantsExpectation
+
+	callArgs []*AuthRepositoryMockGetAccessGrantsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockGetAccessGrantsExpectation specifies expectation struct of the AuthRepository.GetAccessGrants
+type AuthRepositoryMockGetAccessGrantsExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockGetAccessGrantsParams
+	paramPtrs          *AuthRepositoryMockGetAccessGrantsParamPtrs
+	expectationOrigins AuthRepositoryMockGetAccessGrantsExpectationOrigins
+	results            *AuthRepositoryMockGetAccessGrantsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockGetAccessGrantsParams contains parameters of the AuthRepository.GetAccessGrants
+type AuthRepositoryMockGetAccessGrantsParams struct {
+	ctx context.Context
+}
+
+// AuthRepositoryMockGetAccessGrantsParamPtrs contains pointers to parameters of the AuthRepository.GetAccessGrants
+type AuthRepositoryMockGetAccessGrantsParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuthRepositoryMockGetAccessGrantsResults contains results of the AuthRepository.GetAccessGrants
+type AuthRepositoryMockGetAccessGrantsResults struct {
+	ra1 []model.RolePermission
+	err error
+}
+
+// AuthRepositoryMockGetAccessGrantsOrigins contains origins of expectations of the AuthRepository.GetAccessGrants
+type AuthRepositoryMockGetAccessGrantsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAccessGrants *mAuthRepositoryMockGetAccessGrants) Optional() *mAuthRepositoryMockGetAccessGrants {
+	mmGetAccessGrants.optional = true
+	return mmGetAccessGrants
+}
+
+// Expect sets up expected params for AuthRepository.GetAccessGrants
+func (mmGetAccessGrants *mAuthRepositoryMockGetAccessGrants) Expect(ctx context.Context) *mAuthRepositoryMockGetAccessGrants {
+	if mmGetAccessGrants.mock.funcGetAccessGrants != nil {
+		mmGetAccessGrants.mock.t.Fatalf("AuthRepositoryMock.GetAccessGrants mock is already set by Set")
+	}
+
+	if mmGetAccessGrants.defaultExpectation == nil {
+		mmGetAccessGrants.defaultExpectation = &AuthRepositoryMockGetAccessGrantsExpectation{}
+	}
+
+	if mmGetAccessGrants.defaultExpectation.paramPtrs != nil {
+		mmGetAccessGrants.mock.t.Fatalf("AuthRepositoryMock.GetAccessGrants mock is already set by ExpectParams functions")
+	}
+
+	mmGetAccessGrants.defaultExpectation.params = &AuthRepositoryMockGetAccessGrantsParams{ctx}
+	mmGetAccessGrants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAccessGrants.expectations {
+		if minimock.Equal(e.params, mmGetAccessGrants.defaultExpectation.params) {
+			mmGetAccessGrants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAccessGrants.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAccessGrants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.GetAccessGrants
+func (mmGetAccessGrants *mAuthRepositoryMockGetAccessGrants) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockGetAccessGrants {
+	if mmGetAccessGrants.mock.funcGetAccessGrants != nil {
+		mmGetAccessGrants.mock.t.Fatalf("AuthRepositoryMock.GetAccessGrants mock is already set by Set")
+	}
+
+	if mmGetAccessGrants.defaultExpectation == nil {
+		mmGetAccessGrants.defaultExpectation = &AuthRepositoryMockGetAccessGrantsExpectation{}
+	}
+
+	if mmGetAccessGrants.defaultExpectation.params != nil {
+		mmGetAccessGrants.mock.t.Fatalf("AuthRepositoryMock.GetAccessGrants mock is already set by Expect")
+	}
+
+	if mmGetAccessGrants.defaultExpectation.paramPtrs == nil {
+		mmGetAccessGrants.defaultExpectation.paramPtrs = &AuthRepositoryMockGetAccessGrantsParamPtrs{}
+	}
+	mmGetAccessGrants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAccessGrants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAccessGrants
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.GetAccessGrants
+func (mmGetAccessGrants *mAuthRepositoryMockGetAccessGrants) Inspect(f func(ctx context.Context)) *mAuthRepositoryMockGetAccessGrants {
+	if mmGetAccessGrants.mock.inspectFuncGetAccessGrants != nil {
+		mmGetAccessGrants.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.GetAccessGrants")
+	}
+
+	mmGetAccessGrants.mock.inspectFuncGetAccessGrants = f
+
+	return mmGetAccessGrants
+}
+
+// Return sets up results that will be returned by AuthRepository.GetAccessGrants
+func (mmGetAccessGrants *mAuthRepositoryMockGetAccessGrants) Return(ra1 []model.RolePermission, err error) *AuthRepositoryMock {
+	if mmGetAccessGrants.mock.funcGetAccessGrants != nil {
+		mmGetAccessGrants.mock.t.Fatalf("AuthRepositoryMock.GetAccessGrants mock is already set by Set")
+	}
+
+	if mmGetAccessGrants.defaultExpectation == nil {
+		mmGetAccessGrants.defaultExpectation = &AuthRepositoryMockGetAccessGrantsExpectation{mock: mmGetAccessGrants.mock}
+	}
+	mmGetAccessGrants.defaultExpectation.results = &AuthRepositoryMockGetAccessGrantsResults{ra1, err}
+	mmGetAccessGrants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAccessGrants.mock
+}
+
+// Set uses given function f to mock the AuthRepository.GetAccessGrants method
+func (mmGetAccessGrants *mAuthRepositoryMockGetAccessGrants) Set(f func(ctx context.Context) (ra1 []model.RolePermission, err error)) *AuthRepositoryMock {
+	if mmGetAccessGrants.defaultExpectation != nil {
+		mmGetAccessGrants.mock.t.Fatalf("Default expectation is already set for the AuthRepository.GetAccessGrants method")
+	}
+
+	if len(mmGetAccessGrants.expectations) > 0 {
+		mmGetAccessGrants.mock.t.Fatalf("Some expectations are already set for the AuthRepository.GetAccessGrants method")
+	}
+
+	mmGetAccessGrants.mock.funcGetAccessGrants = f
+	mmGetAccessGrants.mock.funcGetAccessGrantsOrigin = minimock.CallerInfo(1)
+	return mmGetAccessGrants.mock
+}
+
+// When sets expectation for the AuthRepository.GetAccessGrants which will trigger the result defined by the following
+// Then helper
+func (mmGetAccessGrants *mAuthRepositoryMockGetAccessGrants) When(ctx context.Context) *AuthRepositoryMockGetAccessGrantsExpectation {
+	if mmGetAccessGrants.mock.funcGetAccessGrants != nil {
+		mmGetAccessGrants.mock.t.Fatalf("AuthRepositoryMock.GetAccessGrants mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockGetAccessGrantsExpectation{
+		mock:               mmGetAccessGrants.mock,
+		params:             &AuthRepositoryMockGetAccessGrantsParams{ctx},
+		expectationOrigins: AuthRepositoryMockGetAccessGrantsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAccessGrants.expectations = append(mmGetAccessGrants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.GetAccessGrants return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockGetAccessGrantsExpectation) Then(ra1 []model.RolePermission, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockGetAccessGrantsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.GetAccessGrants should be invoked
+func (mmGetAccessGrants *mAuthRepositoryMockGetAccessGrants) Times(n uint64) *mAuthRepositoryMockGetAccessGrants {
+	if n == 0 {
+		mmGetAccessGrants.mock.t.Fatalf("Times of AuthRepositoryMock.GetAccessGrants mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAccessGrants.expectedInvocations, n)
+	mmGetAccessGrants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAccessGrants
+}
+
+func (mmGetAccessGrants *mAuthRepositoryMockGetAccessGrants) invocationsDone() bool {
+	if len(mmGetAccessGrants.expectations) == 0 && mmGetAccessGrants.defaultExpectation == nil && mmGetAccessGrants.mock.funcGetAccessGrants == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAccessGrants.mock.afterGetAccessGrantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAccessGrants.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAccessGrants implements mm_repository.AuthRepository
+func (mmGetAccessGrants *AuthRepositoryMock) GetAccessGrants(ctx context.Context) (ra1 []model.RolePermission, err error) {
+	mm_atomic.AddUint64(&mmGetAccessGrants.beforeGetAccessGrantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAccessGrants.afterGetAccessGrantsCounter, 1)
+
+	mmGetAccessGrants.t.Helper()
+
+	if mmGetAccessGrants.inspectFuncGetAccessGrants != nil {
+		mmGetAccessGrants.inspectFuncGetAccessGrants(ctx)
+	}
+
+	mm_params := AuthRepositoryMockGetAccessGrantsParams{ctx}
+
+	// Record call args
+	mmGetAccessGrants.GetAccessGrantsMock.mutex.Lock()
+	mmGetAccessGrants.GetAccessGrantsMock.callArgs = append(mmGetAccessGrants.GetAccessGrantsMock.callArgs, &mm_params)
+	mmGetAccessGrants.GetAccessGrantsMock.mutex.Unlock()
+
+	for _, e := range mmGetAccessGrants.GetAccessGrantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmGetAccessGrants.GetAccessGrantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAccessGrants.GetAccessGrantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAccessGrants.GetAccessGrantsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAccessGrants.GetAccessGrantsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockGetAccessGrantsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAccessGrants.t.Errorf("AuthRepositoryMock.GetAccessGrants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccessGrants.GetAccessGrantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAccessGrants.t.Errorf("AuthRepositoryMock.GetAccessGrants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAccessGrants.GetAccessGrantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAccessGrants.GetAccessGrantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAccessGrants.t.Fatal("No results are set for the AuthRepositoryMock.GetAccessGrants")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetAccessGrants.funcGetAccessGrants != nil {
+		return mmGetAccessGrants.funcGetAccessGrants(ctx)
+	}
+	mmGetAccessGrants.t.Fatalf("Unexpected call to AuthRepositoryMock.GetAccessGrants. %v", ctx)
+	return
+}
+
+// GetAccessGrantsAfterCounter returns a count of finished AuthRepositoryMock.GetAccessGrants invocations
+func (mmGetAccessGrants *AuthRepositoryMock) GetAccessGrantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessGrants.afterGetAccessGrantsCounter)
+}
+
+// GetAccessGrantsBeforeCounter returns a count of AuthRepositoryMock.GetAccessGrants invocations
+func (mmGetAccessGrants *AuthRepositoryMock) GetAccessGrantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessGrants.beforeGetAccessGrantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.GetAccessGrants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAccessGrants *mAuthRepositoryMockGetAccessGrants) Calls() []*AuthRepositoryMockGetAccessGrantsParams {
+	mmGetAccessGrants.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockGetAccessGrantsParams, len(mmGetAccessGrants.callArgs))
+	copy(argCopy, mmGetAccessGrants.callArgs)
+
+	mmGetAccessGrants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAccessGrantsDone returns true if the count of the GetAccessGrants invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockGetAccessGrantsDone() bool {
+	if m.GetAccessGrantsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAccessGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAccessGrantsMock.invocationsDone()
+}
+
+// MinimockGetAccessGrantsInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockGetAccessGrantsInspect() {
+	for _, e := range m.GetAccessGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetAccessGrants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAccessGrantsCounter := mm_atomic.LoadUint64(&m.afterGetAccessGrantsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAccessGrantsMock.defaultExpectation != nil && afterGetAccessGrantsCounter < 1 {
+		if m.GetAccessGrantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetAccessGrants at\n%s", m.GetAccessGrantsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetAccessGrants at\n%s with params: %#v", m.GetAccessGrantsMock.defaultExpectation.expectationOrigins.origin, *m.GetAccessGrantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAccessGrants != nil && afterGetAccessGrantsCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.GetAccessGrants at\n%s", m.funcGetAccessGrantsOrigin)
+	}
+
+	if !m.GetAccessGrantsMock.invocationsDone() && afterGetAccessGrantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.GetAccessGrants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAccessGrantsMock.expectedInvocations), m.GetAccessGrantsMock.expectedInvocationsOrigin, afterGetAccessGrantsCounter)
+	}
+}
+
+type mAuthRepositoryMockGetMFAChallenge struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockGetMFAChallengeExpectation
+	expectations       []*AuthRepositoryMockGetMFAChallengeExpectation
+
+	callArgs []*AuthRepositoryMockGetMFAChallengeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockGetMFAChallengeExpectation specifies expectation struct of the AuthRepository.GetMFAChallenge
+type AuthRepositoryMockGetMFAChallengeExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockGetMFAChallengeParams
+	paramPtrs          *AuthRepositoryMockGetMFAChallengeParamPtrs
+	expectationOrigins AuthRepositoryMockGetMFAChallengeExpectationOrigins
+	results            *AuthRepositoryMockGetMFAChallengeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockGetMFAChallengeParams contains parameters of the AuthRepository.GetMFAChallenge
+type AuthRepositoryMockGetMFAChallengeParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// AuthRepositoryMockGetMFAChallengeParamPtrs contains pointers to parameters of the AuthRepository.GetMFAChallenge
+type AuthRepositoryMockGetMFAChallengeParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// AuthRepositoryMockGetMFAChallengeResults contains results of the AuthRepository.GetMFAChallenge
+type AuthRepositoryMockGetMFAChallengeResults struct {
+	mp1 *model.MFAChallenge
+	err error
+}
+
+// AuthRepositoryMockGetMFAChallengeOrigins contains origins of expectations of the AuthRepository.GetMFAChallenge
+type AuthRepositoryMockGetMFAChallengeExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMFAChallenge *mAuthRepositoryMockGetMFAChallenge) Optional() *mAuthRepositoryMockGetMFAChallenge {
+	mmGetMFAChallenge.optional = true
+	return mmGetMFAChallenge
+}
+
+// Expect sets up expected params for AuthRepository.GetMFAChallenge
+func (mmGetMFAChallenge *mAuthRepositoryMockGetMFAChallenge) Expect(ctx context.Context, tokenHash string) *mAuthRepositoryMockGetMFAChallenge {
+	if mmGetMFAChallenge.mock.funcGetMFAChallenge != nil {
+		mmGetMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.GetMFAChallenge mock is already set by Set")
+	}
+
+	if mmGetMFAChallenge.defaultExpectation == nil {
+		mmGetMFAChallenge.defaultExpectation = &AuthRepositoryMockGetMFAChallengeExpectation{}
+	}
+
+	if mmGetMFAChallenge.defaultExpectation.paramPtrs != nil {
+		mmGetMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.GetMFAChallenge mock is already set by ExpectParams functions")
+	}
+
+	mmGetMFAChallenge.defaultExpectation.params = &AuthRepositoryMockGetMFAChallengeParams{ctx, tokenHash}
+	mmGetMFAChallenge.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMFAChallenge.expectations {
+		if minimock.Equal(e.params, mmGetMFAChallenge.defaultExpectation.params) {
+			mmGetMFAChallenge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMFAChallenge.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMFAChallenge
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.GetMFAChallenge
+func (mmGetMFAChallenge *mAuthRepositoryMockGetMFAChallenge) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockGetMFAChallenge {
+	if mmGetMFAChallenge.mock.funcGetMFAChallenge != nil {
+		mmGetMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.GetMFAChallenge mock is already set by Set")
+	}
+
+	if mmGetMFAChallenge.defaultExpectation == nil {
+		mmGetMFAChallenge.defaultExpectation = &AuthRepositoryMockGetMFAChallengeExpectation{}
+	}
+
+	if mmGetMFAChallenge.defaultExpectation.params != nil {
+		mmGetMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.GetMFAChallenge mock is already set by Expect")
+	}
+
+	if mmGetMFAChallenge.defaultExpectation.paramPtrs == nil {
+		mmGetMFAChallenge.defaultExpectation.paramPtrs = &AuthRepositoryMockGetMFAChallengeParamPtrs{}
+	}
+	mmGetMFAChallenge.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMFAChallenge.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMFAChallenge
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for AuthRepository.GetMFAChallenge
+func (mmGetMFAChallenge *mAuthRepositoryMockGetMFAChallenge) ExpectTokenHashParam2(tokenHash string) *mAuthRepositoryMockGetMFAChallenge {
+	if mmGetMFAChallenge.mock.funcGetMFAChallenge != nil {
+		mmGetMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.GetMFAChallenge mock is already set by Set")
+	}
+
+	if mmGetMFAChallenge.defaultExpectation == nil {
+		mmGetMFAChallenge.defaultExpectation = &AuthRepositoryMockGetMFAChallengeExpectation{}
+	}
+
+	if mmGetMFAChallenge.defaultExpectation.params != nil {
+		mmGetMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.GetMFAChallenge mock is already set by Expect")
+	}
+
+	if mmGetMFAChallenge.defaultExpectation.paramPtrs == nil {
+		mmGetMFAChallenge.defaultExpectation.paramPtrs = &AuthRepositoryMockGetMFAChallengeParamPtrs{}
+	}
+	mmGetMFAChallenge.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmGetMFAChallenge.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmGetMFAChallenge
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.GetMFAChallenge
+func (mmGetMFAChallenge *mAuthRepositoryMockGetMFAChallenge) Inspect(f func(ctx context.Context, tokenHash string)) *mAuthRepositoryMockGetMFAChallenge {
+	if mmGetMFAChallenge.mock.inspectFuncGetMFAChallenge != nil {
+		mmGetMFAChallenge.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.GetMFAChallenge")
+	}
+
+	mmGetMFAChallenge.mock.inspectFuncGetMFAChallenge = f
+
+	return mmGetMFAChallenge
+}
+
+// Return sets up results that will be returned by AuthRepository.GetMFAChallenge
+func (mmGetMFAChallenge *mAuthRepositoryMockGetMFAChallenge) Return(mp1 *model.MFAChallenge, err error) *AuthRepositoryMock {
+	if mmGetMFAChallenge.mock.funcGetMFAChallenge != nil {
+		mmGetMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.GetMFAChallenge mock is already set by Set")
+	}
+
+	if mmGetMFAChallenge.defaultExpectation == nil {
+		mmGetMFAChallenge.defaultExpectation = &AuthRepositoryMockGetMFAChallengeExpectation{mock: mmGetMFAChallenge.mock}
+	}
+	mmGetMFAChallenge.defaultExpectation.results = &AuthRepositoryMockGetMFAChallengeResults{mp1, err}
+	mmGetMFAChallenge.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMFAChallenge.mock
+}
+
+// Set uses given function f to mock the AuthRepository.GetMFAChallenge method
+func (mmGetMFAChallenge *mAuthRepositoryMockGetMFAChallenge) Set(f func(ctx context.Context, tokenHash string) (mp1 *model.MFAChallenge, err error)) *AuthRepositoryMock {
+	if mmGetMFAChallenge.defaultExpectation != nil {
+		mmGetMFAChallenge.mock.t.Fatalf("Default expectation is already set for the AuthRepository.GetMFAChallenge method")
+	}
+
+	if len(mmGetMFAChallenge.expectations) > 0 {
+		mmGetMFAChallenge.mock.t.Fatalf("Some expectations are already set for the AuthRepository.GetMFAChallenge method")
+	}
+
+	mmGetMFAChallenge.mock.funcGetMFAChallenge = f
+	mmGetMFAChallenge.mock.funcGetMFAChallengeOrigin = minimock.CallerInfo(1)
+	return mmGetMFAChallenge.mock
+}
+
+// When sets expectation for the AuthRepository.GetMFAChallenge which will trigger the result defined by the following
+// Then helper
+func (mmGetMFAChallenge *mAuthRepositoryMockGetMFAChallenge) When(ctx context.Context, tokenHash string) *AuthRepositoryMockGetMFAChallengeExpectation {
+	if mmGetMFAChallenge.mock.funcGetMFAChallenge != nil {
+		mmGetMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.GetMFAChallenge mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockGetMFAChallengeExpectation{
+		mock:               mmGetMFAChallenge.mock,
+		params:             &AuthRepositoryMockGetMFAChallengeParams{ctx, tokenHash},
+		expectationOrigins: AuthRepositoryMockGetMFAChallengeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMFAChallenge.expectations = append(mmGetMFAChallenge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.GetMFAChallenge return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockGetMFAChallengeExpectation) Then(mp1 *model.MFAChallenge, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockGetMFAChallengeResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.GetMFAChallenge should be invoked
+func (mmGetMFAChallenge *mAuthRepositoryMockGetMFAChallenge) Times(n uint64) *mAuthRepositoryMockGetMFAChallenge {
+	if n == 0 {
+		mmGetMFAChallenge.mock.t.Fatalf("Times of AuthRepositoryMock.GetMFAChallenge mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMFAChallenge.expectedInvocations, n)
+	mmGetMFAChallenge.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMFAChallenge
+}
+
+func (mmGetMFAChallenge *mAuthRepositoryMockGetMFAChallenge) invocationsDone() bool {
+	if len(mmGetMFAChallenge.expectations) == 0 && mmGetMFAChallenge.defaultExpectation == nil && mmGetMFAChallenge.mock.funcGetMFAChallenge == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMFAChallenge.mock.afterGetMFAChallengeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMFAChallenge.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMFAChallenge implements mm_repository.AuthRepository
+func (mmGetMFAChallenge *AuthRepositoryMock) GetMFAChallenge(ctx context.Context, tokenHash string) (mp1 *model.MFAChallenge, err error) {
+	mm_atomic.AddUint64(&mmGetMFAChallenge.beforeGetMFAChallengeCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMFAChallenge.afterGetMFAChallengeCounter, 1)
+
+	mmGetMFAChallenge.t.Helper()
+
+	if mmGetMFAChallenge.inspectFuncGetMFAChallenge != nil {
+		mmGetMFAChallenge.inspectFuncGetMFAChallenge(ctx, tokenHash)
+	}
+
+	mm_params := AuthRepositoryMockGetMFAChallengeParams{ctx, tokenHash}
+
+	// Record call args
+	mmGetMFAChallenge.GetMFAChallengeMock.mutex.Lock()
+	mmGetMFAChallenge.GetMFAChallengeMock.callArgs = append(mmGetMFAChallenge.GetMFAChallengeMock.callArgs, &mm_params)
+	mmGetMFAChallenge.GetMFAChallengeMock.mutex.Unlock()
+
+	for _, e := range mmGetMFAChallenge.GetMFAChallengeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGetMFAChallenge.GetMFAChallengeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMFAChallenge.GetMFAChallengeMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMFAChallenge.GetMFAChallengeMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMFAChallenge.GetMFAChallengeMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockGetMFAChallengeParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMFAChallenge.t.Errorf("AuthRepositoryMock.GetMFAChallenge got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMFAChallenge.GetMFAChallengeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmGetMFAChallenge.t.Errorf("AuthRepositoryMock.GetMFAChallenge got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMFAChallenge.GetMFAChallengeMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMFAChallenge.t.Errorf("AuthRepositoryMock.GetMFAChallenge got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMFAChallenge.GetMFAChallengeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMFAChallenge.GetMFAChallengeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMFAChallenge.t.Fatal("No results are set for the AuthRepositoryMock.GetMFAChallenge")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGetMFAChallenge.funcGetMFAChallenge != nil {
+		return mmGetMFAChallenge.funcGetMFAChallenge(ctx, tokenHash)
+	}
+	mmGetMFAChallenge.t.Fatalf("Unexpected call to AuthRepositoryMock.GetMFAChallenge. %v %v", ctx, tokenHash)
+	return
+}
+
+// GetMFAChallengeAfterCounter returns a count of finished AuthRepositoryMock.GetMFAChallenge invocations
+func (mmGetMFAChallenge *AuthRepositoryMock) GetMFAChallengeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMFAChallenge.afterGetMFAChallengeCounter)
+}
+
+// GetMFAChallengeBeforeCounter returns a count of AuthRepositoryMock.GetMFAChallenge invocations
+func (mmGetMFAChallenge *AuthRepositoryMock) GetMFAChallengeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMFAChallenge.beforeGetMFAChallengeCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.GetMFAChallenge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMFAChallenge *mAuthRepositoryMockGetMFAChallenge) Calls() []*AuthRepositoryMockGetMFAChallengeParams {
+	mmGetMFAChallenge.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockGetMFAChallengeParams, len(mmGetMFAChallenge.callArgs))
+	copy(argCopy, mmGetMFAChallenge.callArgs)
+
+	mmGetMFAChallenge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMFAChallengeDone returns true if the count of the GetMFAChallenge invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockGetMFAChallengeDone() bool {
+	if m.GetMFAChallengeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMFAChallengeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMFAChallengeMock.invocationsDone()
+}
+
+// MinimockGetMFAChallengeInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockGetMFAChallengeInspect() {
+	for _, e := range m.GetMFAChallengeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetMFAChallenge at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMFAChallengeCounter := mm_atomic.LoadUint64(&m.afterGetMFAChallengeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMFAChallengeMock.defaultExpectation != nil && afterGetMFAChallengeCounter < 1 {
+		if m.GetMFAChallengeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetMFAChallenge at\n%s", m.GetMFAChallengeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetMFAChallenge at\n%s with params: %#v", m.GetMFAChallengeMock.defaultExpectation.expectationOrigins.origin, *m.GetMFAChallengeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMFAChallenge != nil && afterGetMFAChallengeCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.GetMFAChallenge at\n%s", m.funcGetMFAChallengeOrigin)
+	}
+
+	if !m.GetMFAChallengeMock.invocationsDone() && afterGetMFAChallengeCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.GetMFAChallenge at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMFAChallengeMock.expectedInvocations), m.GetMFAChallengeMock.expectedInvocationsOrigin, afterGetMFAChallengeCounter)
+	}
+}
+
+type mAuthRepositoryMockGetTOTP struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockGetTOTPExpectation
+	expectations       []*AuthRepositoryMockGetTOTPExpectation
+
+	callArgs []*AuthRepositoryMockGetTOTPParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockGetTOTPExpectation specifies expectation struct of the AuthRepository.GetTOTP
+type AuthRepositoryMockGetTOTPExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockGetTOTPParams
+	paramPtrs          *AuthRepositoryMockGetTOTPParamPtrs
+	expectationOrigins AuthRepositoryMockGetTOTPExpectationOrigins
+	results            *AuthRepositoryMockGetTOTPResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockGetTOTPParams contains parameters of the AuthRepository.GetTOTP
+type AuthRepositoryMockGetTOTPParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// AuthRepositoryMockGetTOTPParamPtrs contains pointers to parameters of the AuthRepository.GetTOTP
+type AuthRepositoryMockGetTOTPParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// AuthRepositoryMockGetTOTPResults contains results of the AuthRepository.GetTOTP
+type AuthRepositoryMockGetTOTPResults struct {
+	tp1 *model.TOTP
+	err error
+}
+
+// AuthRepositoryMockGetTOTPOrigins contains origins of expectations of the AuthRepository.GetTOTP
+type AuthRepositoryMockGetTOTPExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTOTP *mAuthRepositoryMockGetTOTP) Optional() *mAuthRepositoryMockGetTOTP {
+	mmGetTOTP.optional = true
+	return mmGetTOTP
+}
+
+// Expect sets up expected params for AuthRepository.GetTOTP
+func (mmGetTOTP *mAuthRepositoryMockGetTOTP) Expect(ctx context.Context, userID int64) *mAuthRepositoryMockGetTOTP {
+	if mmGetTOTP.mock.funcGetTOTP != nil {
+		mmGetTOTP.mock.t.Fatalf("AuthRepositoryMock.GetTOTP mock is already set by Set")
+	}
+
+	if mmGetTOTP.defaultExpectation == nil {
+		mmGetTOTP.defaultExpectation = &AuthRepositoryMockGetTOTPExpectation{}
+	}
+
+	if mmGetTOTP.defaultExpectation.paramPtrs != nil {
+		mmGetTOTP.mock.t.Fatalf("AuthRepositoryMock.GetTOTP mock is already set by ExpectParams functions")
+	}
+
+	mmGetTOTP.defaultExpectation.params = &AuthRepositoryMockGetTOTPParams{ctx, userID}
+	mmGetTOTP.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTOTP.expectations {
+		if minimock.Equal(e.params, mmGetTOTP.defaultExpectation.params) {
+			mmGetTOTP.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTOTP.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTOTP
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.GetTOTP
+func (mmGetTOTP *mAuthRepositoryMockGetTOTP) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockGetTOTP {
+	if mmGetTOTP.mock.funcGetTOTP != nil {
+		mmGetTOTP.mock.t.Fatalf("AuthRepositoryMock.GetTOTP mock is already set by Set")
+	}
+
+	if mmGetTOTP.defaultExpectation == nil {
+		mmGetTOTP.defaultExpectation = &AuthRepositoryMockGetTOTPExpectation{}
+	}
+
+	if mmGetTOTP.defaultExpectation.params != nil {
+		mmGetTOTP.mock.t.Fatalf("AuthRepositoryMock.GetTOTP mock is already set by Expect")
+	}
+
+	if mmGetTOTP.defaultExpectation.paramPtrs == nil {
+		mmGetTOTP.defaultExpectation.paramPtrs = &AuthRepositoryMockGetTOTPParamPtrs{}
+	}
+	mmGetTOTP.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTOTP.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTOTP
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthRepository.GetTOTP
+func (mmGetTOTP *mAuthRepositoryMockGetTOTP) ExpectUserIDParam2(userID int64) *mAuthRepositoryMockGetTOTP {
+	if mmGetTOTP.mock.funcGetTOTP != nil {
+		mmGetTOTP.mock.t.Fatalf("AuthRepositoryMock.GetTOTP mock is already set by Set")
+	}
+
+	if mmGetTOTP.defaultExpectation == nil {
+		mmGetTOTP.defaultExpectation = &AuthRepositoryMockGetTOTPExpectation{}
+	}
+
+	if mmGetTOTP.defaultExpectation.params != nil {
+		mmGetTOTP.mock.t.Fatalf("AuthRepositoryMock.GetTOTP mock is already set by Expect")
+	}
+
+	if mmGetTOTP.defaultExpectation.paramPtrs == nil {
+		mmGetTOTP.defaultExpectation.paramPtrs = &AuthRepositoryMockGetTOTPParamPtrs{}
+	}
+	mmGetTOTP.defaultExpectation.paramPtrs.userID = &userID
+	mmGetTOTP.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetTOTP
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.GetTOTP
+func (mmGetTOTP *mAuthRepositoryMockGetTOTP) Inspect(f func(ctx context.Context, userID int64)) *mAuthRepositoryMockGetTOTP {
+	if mmGetTOTP.mock.inspectFuncGetTOTP != nil {
+		mmGetTOTP.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.GetTOTP")
+	}
+
+	mmGetTOTP.mock.inspectFuncGetTOTP = f
+
+	return mmGetTOTP
+}
+
+// Return sets up results that will be returned by AuthRepository.GetTOTP
+func (mmGetTOTP *mAuthRepositoryMockGetTOTP) Return(tp1 *model.TOTP, err error) *AuthRepositoryMock {
+	if mmGetTOTP.mock.funcGetTOTP != nil {
+		mmGetTOTP.mock.t.Fatalf("AuthRepositoryMock.GetTOTP mock is already set by Set")
+	}
+
+	if mmGetTOTP.defaultExpectation == nil {
+		mmGetTOTP.defaultExpectation = &AuthRepositoryMockGetTOTPExpectation{mock: mmGetTOTP.mock}
+	}
+	mmGetTOTP.defaultExpectation.results = &AuthRepositoryMockGetTOTPResults{tp1, err}
+	mmGetTOTP.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTOTP.mock
+}
+
+// Set uses given function f to mock the AuthRepository.GetTOTP method
+func (mmGetTOTP *mAuthRepositoryMockGetTOTP) Set(f func(ctx context.Context, userID int64) (tp1 *model.TOTP, err error)) *AuthRepositoryMock {
+	if mmGetTOTP.defaultExpectation != nil {
+		mmGetTOTP.mock.t.Fatalf("Default expectation is already set for the AuthRepository.GetTOTP method")
+	}
+
+	if len(mmGetTOTP.expectations) > 0 {
+		mmGetTOTP.mock.t.Fatalf("Some expectations are already set for the AuthRepository.GetTOTP method")
+	}
+
+	mmGetTOTP.mock.funcGetTOTP = f
+	mmGetTOTP.mock.funcGetTOTPOrigin = minimock.CallerInfo(1)
+	return mmGetTOTP.mock
+}
+
+// When sets expectation for the AuthRepository.GetTOTP which will trigger the result defined by the following
+// Then helper
+func (mmGetTOTP *mAuthRepositoryMockGetTOTP) When(ctx context.Context, userID int64) *AuthRepositoryMockGetTOTPExpectation {
+	if mmGetTOTP.mock.funcGetTOTP != nil {
+		mmGetTOTP.mock.t.Fatalf("AuthRepositoryMock.GetTOTP mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockGetTOTPExpectation{
+		mock:               mmGetTOTP.mock,
+		params:             &AuthRepositoryMockGetTOTPParams{ctx, userID},
+		expectationOrigins: AuthRepositoryMockGetTOTPExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTOTP.expectations = append(mmGetTOTP.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.GetTOTP return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockGetTOTPExpectation) Then(tp1 *model.TOTP, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockGetTOTPResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.GetTOTP should be invoked
+func (mmGetTOTP *mAuthRepositoryMockGetTOTP) Times(n uint64) *mAuthRepositoryMockGetTOTP {
+	if n == 0 {
+		mmGetTOTP.mock.t.Fatalf("Times of AuthRepositoryMock.GetTOTP mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTOTP.expectedInvocations, n)
+	mmGetTOTP.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTOTP
+}
+
+func (mmGetTOTP *mAuthRepositoryMockGetTOTP) invocationsDone() bool {
+	if len(mmGetTOTP.expectations) == 0 && mmGetTOTP.defaultExpectation == nil && mmGetTOTP.mock.funcGetTOTP == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTOTP.mock.afterGetTOTPCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTOTP.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTOTP implements mm_repository.AuthRepository
+func (mmGetTOTP *AuthRepositoryMock) GetTOTP(ctx context.Context, userID int64) (tp1 *model.TOTP, err error) {
+	mm_atomic.AddUint64(&mmGetTOTP.beforeGetTOTPCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTOTP.afterGetTOTPCounter, 1)
+
+	mmGetTOTP.t.Helper()
+
+	if mmGetTOTP.inspectFuncGetTOTP != nil {
+		mmGetTOTP.inspectFuncGetTOTP(ctx, userID)
+	}
+
+	mm_params := AuthRepositoryMockGetTOTPParams{ctx, userID}
+
+	// Record call args
+	mmGetTOTP.GetTOTPMock.mutex.Lock()
+	mmGetTOTP.GetTOTPMock.callArgs = append(mmGetTOTP.GetTOTPMock.callArgs, &mm_params)
+	mmGetTOTP.GetTOTPMock.mutex.Unlock()
+
+	for _, e := range mmGetTOTP.GetTOTPMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmGetTOTP.GetTOTPMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTOTP.GetTOTPMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTOTP.GetTOTPMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTOTP.GetTOTPMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockGetTOTPParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTOTP.t.Errorf("AuthRepositoryMock.GetTOTP got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTOTP.GetTOTPMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetTOTP.t.Errorf("AuthRepositoryMock.GetTOTP got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTOTP.GetTOTPMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTOTP.t.Errorf("AuthRepositoryMock.GetTOTP got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTOTP.GetTOTPMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTOTP.GetTOTPMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTOTP.t.Fatal("No results are set for the AuthRepositoryMock.GetTOTP")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmGetTOTP.funcGetTOTP != nil {
+		return mmGetTOTP.funcGetTOTP(ctx, userID)
+	}
+	mmGetTOTP.t.Fatalf("Unexpected call to AuthRepositoryMock.GetTOTP. %v %v", ctx, userID)
+	return
+}
+
+// GetTOTPAfterCounter returns a count of finished AuthRepositoryMock.GetTOTP invocations
+func (mmGetTOTP *AuthRepositoryMock) GetTOTPAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTOTP.afterGetTOTPCounter)
+}
+
+// GetTOTPBeforeCounter returns a count of AuthRepositoryMock.GetTOTP invocations
+func (mmGetTOTP *AuthRepositoryMock) GetTOTPBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTOTP.beforeGetTOTPCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.GetTOTP.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTOTP *mAuthRepositoryMockGetTOTP) Calls() []*AuthRepositoryMockGetTOTPParams {
+	mmGetTOTP.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockGetTOTPParams, len(mmGetTOTP.callArgs))
+	copy(argCopy, mmGetTOTP.callArgs)
+
+	mmGetTOTP.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTOTPDone returns true if the count of the GetTOTP invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockGetTOTPDone() bool {
+	if m.GetTOTPMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTOTPMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTOTPMock.invocationsDone()
+}
+
+// MinimockGetTOTPInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockGetTOTPInspect() {
+	for _, e := range m.GetTOTPMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetTOTP at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTOTPCounter := mm_atomic.LoadUint64(&m.afterGetTOTPCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTOTPMock.defaultExpectation != nil && afterGetTOTPCounter < 1 {
+		if m.GetTOTPMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetTOTP at\n%s", m.GetTOTPMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetTOTP at\n%s with params: %#v", m.GetTOTPMock.defaultExpectation.expectationOrigins.origin, *m.GetTOTPMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTOTP != nil && afterGetTOTPCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.GetTOTP at\n%s", m.funcGetTOTPOrigin)
+	}
+
+	if !m.GetTOTPMock.invocationsDone() && afterGetTOTPCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.GetTOTP at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTOTPMock.expectedInvocations), m.GetTOTPMock.expectedInvocationsOrigin, afterGetTOTPCounter)
+	}
+}
+
+type mAuthRepositoryMockGetUserID struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockGetUserIDExpectation
+	expectations       []*AuthRepositoryMockGetUserIDExpectation
+
+	callArgs []*AuthRepositoryMockGetUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockGetUserIDExpectation specifies expectation struct of the AuthRepository.GetUserID
+type AuthRepositoryMockGetUserIDExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockGetUserIDParams
+	paramPtrs          *AuthRepositoryMockGetUserIDParamPtrs
+	expectationOrigins AuthRepositoryMockGetUserIDExpectationOrigins
+	results            *AuthRepositoryMockGetUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockGetUserIDParams contains parameters of the AuthRepository.GetUserID
+type AuthRepositoryMockGetUserIDParams struct {
+	ctx      context.Context
+	username string
+}
+
+// AuthRepositoryMockGetUserIDParamPtrs contains pointers to parameters of the AuthRepository.GetUserID
+type AuthRepositoryMockGetUserIDParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// AuthRepositoryMockGetUserIDResults contains results of the AuthRepository.GetUserID
+type AuthRepositoryMockGetUserIDResults struct {
+	i1  int64
+	err error
+}
+
+// AuthRepositoryMockGetUserIDOrigins contains origins of expectations of the AuthRepository.GetUserID
+type AuthRepositoryMockGetUserIDExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserID *mAuthRepositoryMockGetUserID) Optional() *mAuthRepositoryMockGetUserID {
+	mmGetUserID.optional = true
+	return mmGetUserID
+}
+
+// Expect sets up expected params for AuthRepository.GetUserID
+func (mmGetUserID *mAuthRepositoryMockGetUserID) Expect(ctx context.Context, username string) *mAuthRepositoryMockGetUserID {
+	if mmGetUserID.mock.funcGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("AuthRepositoryMock.GetUserID mock is already set by Set")
+	}
+
+	if mmGetUserID.defaultExpectation == nil {
+		mmGetUserID.defaultExpectation = &AuthRepositoryMockGetUserIDExpectation{}
+	}
+
+	if mmGetUserID.defaultExpectation.paramPtrs != nil {
+		mmGetUserID.mock.t.Fatalf("AuthRepositoryMock.GetUserID mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserID.defaultExpectation.params = &AuthRepositoryMockGetUserIDParams{ctx, username}
+	mmGetUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserID.expectations {
+		if minimock.Equal(e.params, mmGetUserID.defaultExpectation.params) {
+			mmGetUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.GetUserID
+func (mmGetUserID *mAuthRepositoryMockGetUserID) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockGetUserID {
+	if mmGetUserID.mock.funcGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("AuthRepositoryMock.GetUserID mock is already set by Set")
+	}
+
+	if mmGetUserID.defaultExpectation == nil {
+		mmGetUserID.defaultExpectation = &AuthRepositoryMockGetUserIDExpectation{}
+	}
+
+	if mmGetUserID.defaultExpectation.params != nil {
+		mmGetUserID.mock.t.Fatalf("AuthRepositoryMock.GetUserID mock is already set by Expect")
+	}
+
+	if mmGetUserID.defaultExpectation.paramPtrs == nil {
+		mmGetUserID.defaultExpectation.paramPtrs = &AuthRepositoryMockGetUserIDParamPtrs{}
+	}
+	mmGetUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserID
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthRepository.GetUserID
+func (mmGetUserID *mAuthRepositoryMockGetUserID) ExpectUsernameParam2(username string) *mAuthRepositoryMockGetUserID {
+	if mmGetUserID.mock.funcGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("AuthRepositoryMock.GetUserID mock is already set by Set")
+	}
+
+	if mmGetUserID.defaultExpectation == nil {
+		mmGetUserID.defaultExpectation = &AuthRepositoryMockGetUserIDExpectation{}
+	}
+
+	if mmGetUserID.defaultExpectation.params != nil {
+		mmGetUserID.mock.t.Fatalf("AuthRepositoryMock.GetUserID mock is already set by Expect")
+	}
+
+	if mmGetUserID.defaultExpectation.paramPtrs == nil {
+		mmGetUserID.defaultExpectation.paramPtrs = &AuthRepositoryMockGetUserIDParamPtrs{}
+	}
+	mmGetUserID.defaultExpectation.paramPtrs.username = &username
+	mmGetUserID.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.GetUserID
+func (mmGetUserID *mAuthRepositoryMockGetUserID) Inspect(f func(ctx context.Context, username string)) *mAuthRepositoryMockGetUserID {
+	if mmGetUserID.mock.inspectFuncGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.GetUserID")
+	}
+
+	mmGetUserID.mock.inspectFuncGetUserID = f
+
+	return mmGetUserID
+}
+
+// Return sets up results that will be returned by AuthRepository.GetUserID
+func (mmGetUserID *mAuthRepositoryMockGetUserID) Return(i1 int64, err error) *AuthRepositoryMock {
+	if mmGetUserID.mock.funcGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("AuthRepositoryMock.GetUserID mock is already set by Set")
+	}
+
+	if mmGetUserID.defaultExpectation == nil {
+		mmGetUserID.defaultExpectation = &AuthRepositoryMockGetUserIDExpectation{mock: mmGetUserID.mock}
+	}
+	mmGetUserID.defaultExpectation.results = &AuthRepositoryMockGetUserIDResults{i1, err}
+	mmGetUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserID.mock
+}
+
+// Set uses given function f to mock the AuthRepository.GetUserID method
+func (mmGetUserID *mAuthRepositoryMockGetUserID) Set(f func(ctx context.Context, username string) (i1 int64, err error)) *AuthRepositoryMock {
+	if mmGetUserID.defaultExpectation != nil {
+		mmGetUserID.mock.t.Fatalf("Default expectation is already set for the AuthRepository.GetUserID method")
+	}
+
+	if len(mmGetUserID.expectations) > 0 {
+		mmGetUserID.mock.t.Fatalf("Some expectations are already set for the AuthRepository.GetUserID method")
+	}
+
+	mmGetUserID.mock.funcGetUserID = f
+	mmGetUserID.mock.funcGetUserIDOrigin = minimock.CallerInfo(1)
+	return mmGetUserID.mock
+}
+
+// When sets expectation for the AuthRepository.GetUserID which will trigger the result defined by the following
+// Then helper
+func (mmGetUserID *mAuthRepositoryMockGetUserID) When(ctx context.Context, username string) *AuthRepositoryMockGetUserIDExpectation {
+	if mmGetUserID.mock.funcGetUserID != nil {
+		mmGetUserID.mock.t.Fatalf("AuthRepositoryMock.GetUserID mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockGetUserIDExpectation{
+		mock:               mmGetUserID.mock,
+		params:             &AuthRepositoryMockGetUserIDParams{ctx, username},
+		expectationOrigins: AuthRepositoryMockGetUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserID.expectations = append(mmGetUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.GetUserID return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockGetUserIDExpectation) Then(i1 int64, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockGetUserIDResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.GetUserID should be invoked
+func (mmGetUserID *mAuthRepositoryMockGetUserID) Times(n uint64) *mAuthRepositoryMockGetUserID {
+	if n == 0 {
+		mmGetUserID.mock.t.Fatalf("Times of AuthRepositoryMock.GetUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserID.expectedInvocations, n)
+	mmGetUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserID
+}
+
+func (mmGetUserID *mAuthRepositoryMockGetUserID) invocationsDone() bool {
+	if len(mmGetUserID.expectations) == 0 && mmGetUserID.defaultExpectation == nil && mmGetUserID.mock.funcGetUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserID.mock.afterGetUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserID implements mm_repository.AuthRepository
+func (mmGetUserID *AuthRepositoryMock) GetUserID(ctx context.Context, username string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetUserID.beforeGetUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserID.afterGetUserIDCounter, 1)
+
+	mmGetUserID.t.Helper()
+
+	if mmGetUserID.inspectFuncGetUserID != nil {
+		mmGetUserID.inspectFuncGetUserID(ctx, username)
+	}
+
+	mm_params := AuthRepositoryMockGetUserIDParams{ctx, username}
+
+	// Record call args
+	mmGetUserID.GetUserIDMock.mutex.Lock()
+	mmGetUserID.GetUserIDMock.callArgs = append(mmGetUserID.GetUserIDMock.callArgs, &mm_params)
+	mmGetUserID.GetUserIDMock.mutex.Unlock()
+
+	for _, e := range mmGetUserID.GetUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetUserID.GetUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserID.GetUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserID.GetUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserID.GetUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockGetUserIDParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserID.t.Errorf("AuthRepositoryMock.GetUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserID.GetUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetUserID.t.Errorf("AuthRepositoryMock.GetUserID got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserID.GetUserIDMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserID.t.Errorf("AuthRepositoryMock.GetUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserID.GetUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserID.GetUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserID.t.Fatal("No results are set for the AuthRepositoryMock.GetUserID")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetUserID.funcGetUserID != nil {
+		return mmGetUserID.funcGetUserID(ctx, username)
+	}
+	mmGetUserID.t.Fatalf("Unexpected call to AuthRepositoryMock.GetUserID. %v %v", ctx, username)
+	return
+}
+
+// GetUserIDAfterCounter returns a count of finished AuthRepositoryMock.GetUserID invocations
+func (mmGetUserID *AuthRepositoryMock) GetUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserID.afterGetUserIDCounter)
+}
+
+// GetUserIDBeforeCounter returns a count of AuthRepositoryMock.GetUserID invocations
+func (mmGetUserID *AuthRepositoryMock) GetUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserID.beforeGetUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.GetUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserID *mAuthRepositoryMockGetUserID) Calls() []*AuthRepositoryMockGetUserIDParams {
+	mmGetUserID.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockGetUserIDParams, len(mmGetUserID.callArgs))
+	copy(argCopy, mmGetUserID.callArgs)
+
+	mmGetUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserIDDone returns true if the count of the GetUserID invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockGetUserIDDone() bool {
+	if m.GetUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserIDMock.invocationsDone()
+}
+
+// MinimockGetUserIDInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockGetUserIDInspect() {
+	for _, e := range m.GetUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserIDCounter := mm_atomic.LoadUint64(&m.afterGetUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserIDMock.defaultExpectation != nil && afterGetUserIDCounter < 1 {
+		if m.GetUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetUserID at\n%s", m.GetUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetUserID at\n%s with params: %#v", m.GetUserIDMock.defaultExpectation.expectationOrigins.origin, *m.GetUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserID != nil && afterGetUserIDCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.GetUserID at\n%s", m.funcGetUserIDOrigin)
+	}
+
+	if !m.GetUserIDMock.invocationsDone() && afterGetUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.GetUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserIDMock.expectedInvocations), m.GetUserIDMock.expectedInvocationsOrigin, afterGetUserIDCounter)
+	}
+}
+
+type mAuthRepositoryMockGetUserRoles struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockGetUserRolesExpectation
+	expectations       []*AuthRepositoryMockGetUserRolesExpectation
+
+	callArgs []*AuthRepositoryMockGetUserRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockGetUserRolesExpectation specifies expectation struct of the AuthRepository.GetUserRoles
+type AuthRepositoryMockGetUserRolesExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockGetUserRolesParams
+	paramPtrs          *AuthRepositoryMockGetUserRolesParamPtrs
+	expectationOrigins AuthRepositoryMockGetUserRolesExpectationOrigins
+	results            *AuthRepositoryMockGetUserRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockGetUserRolesParams contains parameters of the AuthRepository.GetUserRoles
+type AuthRepositoryMockGetUserRolesParams struct {
+	ctx      context.Context
+	username string
+}
+
+// AuthRepositoryMockGetUserRolesParamPtrs contains pointers to parameters of the AuthRepository.GetUserRoles
+type AuthRepositoryMockGetUserRolesParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// AuthRepositoryMockGetUserRolesResults contains results of the AuthRepository.GetUserRoles
+type AuthRepositoryMockGetUserRolesResults struct {
+	sa1 []string
+	err error
+}
+
+// AuthRepositoryMockGetUserRolesOrigins contains origins of expectations of the AuthRepository.GetUserRoles
+type AuthRepositoryMockGetUserRolesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserRoles *mAuthRepositoryMockGetUserRoles) Optional() *mAuthRepositoryMockGetUserRoles {
+	mmGetUserRoles.optional = true
+	return mmGetUserRoles
+}
+
+// Expect sets up expected params for AuthRepository.GetUserRoles
+func (mmGetUserRoles *mAuthRepositoryMockGetUserRoles) Expect(ctx context.Context, username string) *mAuthRepositoryMockGetUserRoles {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("AuthRepositoryMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &AuthRepositoryMockGetUserRolesExpectation{}
+	}
+
+	if mmGetUserRoles.defaultExpectation.paramPtrs != nil {
+		mmGetUserRoles.mock.t.Fatalf("AuthRepositoryMock.GetUserRoles mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserRoles.defaultExpectation.params = &AuthRepositoryMockGetUserRolesParams{ctx, username}
+	mmGetUserRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserRoles.expectations {
+		if minimock.Equal(e.params, mmGetUserRoles.defaultExpectation.params) {
+			mmGetUserRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.GetUserRoles
+func (mmGetUserRoles *mAuthRepositoryMockGetUserRoles) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockGetUserRoles {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("AuthRepositoryMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &AuthRepositoryMockGetUserRolesExpectation{}
+	}
+
+	if mmGetUserRoles.defaultExpectation.params != nil {
+		mmGetUserRoles.mock.t.Fatalf("AuthRepositoryMock.GetUserRoles mock is already set by Expect")
+	}
+
+	if mmGetUserRoles.defaultExpectation.paramPtrs == nil {
+		mmGetUserRoles.defaultExpectation.paramPtrs = &AuthRepositoryMockGetUserRolesParamPtrs{}
+	}
+	mmGetUserRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserRoles
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthRepository.GetUserRoles
+func (mmGetUserRoles *mAuthRepositoryMockGetUserRoles) ExpectUsernameParam2(username string) *mAuthRepositoryMockGetUserRoles {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("AuthRepositoryMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &AuthRepositoryMockGetUserRolesExpectation{}
+	}
+
+	if mmGetUserRoles.defaultExpectation.params != nil {
+		mmGetUserRoles.mock.t.Fatalf("AuthRepositoryMock.GetUserRoles mock is already set by Expect")
+	}
+
+	if mmGetUserRoles.defaultExpectation.paramPtrs == nil {
+		mmGetUserRoles.defaultExpectation.paramPtrs = &AuthRepositoryMockGetUserRolesParamPtrs{}
+	}
+	mmGetUserRoles.defaultExpectation.paramPtrs.username = &username
+	mmGetUserRoles.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetUserRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.GetUserRoles
+func (mmGetUserRoles *mAuthRepositoryMockGetUserRoles) Inspect(f func(ctx context.Context, username string)) *mAuthRepositoryMockGetUserRoles {
+	if mmGetUserRoles.mock.inspectFuncGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.GetUserRoles")
+	}
+
+	mmGetUserRoles.mock.inspectFuncGetUserRoles = f
+
+	return mmGetUserRoles
+}
+
+// Return sets up results that will be returned by AuthRepository.GetUserRoles
+func (mmGetUserRoles *mAuthRepositoryMockGetUserRoles) Return(sa1 []string, err error) *AuthRepositoryMock {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("AuthRepositoryMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &AuthRepositoryMockGetUserRolesExpectation{mock: mmGetUserRoles.mock}
+	}
+	mmGetUserRoles.defaultExpectation.results = &AuthRepositoryMockGetUserRolesResults{sa1, err}
+	mmGetUserRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserRoles.mock
+}
+
+// Set uses given function f to mock the AuthRepository.GetUserRoles method
+func (mmGetUserRoles *mAuthRepositoryMockGetUserRoles) Set(f func(ctx context.Context, username string) (sa1 []string, err error)) *AuthRepositoryMock {
+	if mmGetUserRoles.defaultExpectation != nil {
+		mmGetUserRoles.mock.t.Fatalf("Default expectation is already set for the AuthRepository.GetUserRoles method")
+	}
+
+	if len(mmGetUserRoles.expectations) > 0 {
+		mmGetUserRoles.mock.t.Fatalf("Some expectations are already set for the AuthRepository.GetUserRoles method")
+	}
+
+	mmGetUserRoles.mock.funcGetUserRoles = f
+	mmGetUserRoles.mock.funcGetUserRolesOrigin = minimock.CallerInfo(1)
+	return mmGetUserRoles.mock
+}
+
+// When sets expectation for the AuthRepository.GetUserRoles which will trigger the result defined by the following
+// Then helper
+func (mmGetUserRoles *mAuthRepositoryMockGetUserRoles) When(ctx context.Context, username string) *AuthRepositoryMockGetUserRolesExpectation {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("AuthRepositoryMock.GetUserRoles mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockGetUserRolesExpectation{
+		mock:               mmGetUserRoles.mock,
+		params:             &AuthRepositoryMockGetUserRolesParams{ctx, username},
+		expectationOrigins: AuthRepositoryMockGetUserRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserRoles.expectations = append(mmGetUserRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.GetUserRoles return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockGetUserRolesExpectation) Then(sa1 []string, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockGetUserRolesResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.GetUserRoles should be invoked
+func (mmGetUserRoles *mAuthRepositoryMockGetUserRoles) Times(n uint64) *mAuthRepositoryMockGetUserRoles {
+	if n == 0 {
+		mmGetUserRoles.mock.t.Fatalf("Times of AuthRepositoryMock.GetUserRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserRoles.expectedInvocations, n)
+	mmGetUserRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserRoles
+}
+
+func (mmGetUserRoles *mAuthRepositoryMockGetUserRoles) invocationsDone() bool {
+	if len(mmGetUserRoles.expectations) == 0 && mmGetUserRoles.defaultExpectation == nil && mmGetUserRoles.mock.funcGetUserRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserRoles.mock.afterGetUserRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserRoles implements mm_repository.AuthRepository
+func (mmGetUserRoles *AuthRepositoryMock) GetUserRoles(ctx context.Context, username string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmGetUserRoles.beforeGetUserRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserRoles.afterGetUserRolesCounter, 1)
+
+	mmGetUserRoles.t.Helper()
+
+	if mmGetUserRoles.inspectFuncGetUserRoles != nil {
+		mmGetUserRoles.inspectFuncGetUserRoles(ctx, username)
+	}
+
+	mm_params := AuthRepositoryMockGetUserRolesParams{ctx, username}
+
+	// Record call args
+	mmGetUserRoles.GetUserRolesMock.mutex.Lock()
+	mmGetUserRoles.GetUserRolesMock.callArgs = append(mmGetUserRoles.GetUserRolesMock.callArgs, &mm_params)
+	mmGetUserRoles.GetUserRolesMock.mutex.Unlock()
+
+	for _, e := range mmGetUserRoles.GetUserRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetUserRoles.GetUserRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserRoles.GetUserRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserRoles.GetUserRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserRoles.GetUserRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockGetUserRolesParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserRoles.t.Errorf("AuthRepositoryMock.GetUserRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetUserRoles.t.Errorf("AuthRepositoryMock.GetUserRoles got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserRoles.t.Errorf("AuthRepositoryMock.GetUserRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserRoles.GetUserRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserRoles.t.Fatal("No results are set for the AuthRepositoryMock.GetUserRoles")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetUserRoles.funcGetUserRoles != nil {
+		return mmGetUserRoles.funcGetUserRoles(ctx, username)
+	}
+	mmGetUserRoles.t.Fatalf("Unexpected call to AuthRepositoryMock.GetUserRoles. %v %v", ctx, username)
+	return
+}
+
+// GetUserRolesAfterCounter returns a count of finished AuthRepositoryMock.GetUserRoles invocations
+func (mmGetUserRoles *AuthRepositoryMock) GetUserRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserRoles.afterGetUserRolesCounter)
+}
+
+// GetUserRolesBeforeCounter returns a count of AuthRepositoryMock.GetUserRoles invocations
+func (mmGetUserRoles *AuthRepositoryMock) GetUserRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserRoles.beforeGetUserRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.GetUserRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserRoles *mAuthRepositoryMockGetUserRoles) Calls() []*AuthRepositoryMockGetUserRolesParams {
+	mmGetUserRoles.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockGetUserRolesParams, len(mmGetUserRoles.callArgs))
+	copy(argCopy, mmGetUserRoles.callArgs)
+
+	mmGetUserRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserRolesDone returns true if the count of the GetUserRoles invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockGetUserRolesDone() bool {
+	if m.GetUserRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserRolesMock.invocationsDone()
+}
+
+// MinimockGetUserRolesInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockGetUserRolesInspect() {
+	for _, e := range m.GetUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetUserRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserRolesCounter := mm_atomic.LoadUint64(&m.afterGetUserRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserRolesMock.defaultExpectation != nil && afterGetUserRolesCounter < 1 {
+		if m.GetUserRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetUserRoles at\n%s", m.GetUserRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.GetUserRoles at\n%s with params: %#v", m.GetUserRolesMock.defaultExpectation.expectationOrigins.origin, *m.GetUserRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserRoles != nil && afterGetUserRolesCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.GetUserRoles at\n%s", m.funcGetUserRolesOrigin)
+	}
+
+	if !m.GetUserRolesMock.invocationsDone() && afterGetUserRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.GetUserRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserRolesMock.expectedInvocations), m.GetUserRolesMock.expectedInvocationsOrigin, afterGetUserRolesCounter)
+	}
+}
+
+type mAuthRepositoryMockIncMFAChallengeAttempts struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockIncMFAChallengeAttemptsExpectation
+	expectations       []*AuthRepositoryMockIncMFAChallengeAttemptsExpectation
+
+	callArgs []*AuthRepositoryMockIncMFAChallengeAttemptsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockIncMFAChallengeAttemptsExpectation specifies expectation struct of the AuthRepository.IncMFAChallengeAttempts
+type AuthRepositoryMockIncMFAChallengeAttemptsExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockIncMFAChallengeAttemptsParams
+	paramPtrs          *AuthRepositoryMockIncMFAChallengeAttemptsParamPtrs
+	expectationOrigins AuthRepositoryMockIncMFAChallengeAttemptsExpectationOrigins
+	results            *AuthRepositoryMockIncMFAChallengeAttemptsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockIncMFAChallengeAttemptsParams contains parameters of the AuthRepository.IncMFAChallengeAttempts
+type AuthRepositoryMockIncMFAChallengeAttemptsParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// AuthRepositoryMockIncMFAChallengeAttemptsParamPtrs contains pointers to parameters of the AuthRepository.IncMFAChallengeAttempts
+type AuthRepositoryMockIncMFAChallengeAttemptsParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// AuthRepositoryMockIncMFAChallengeAttemptsResults contains results of the AuthRepository.IncMFAChallengeAttempts
+type AuthRepositoryMockIncMFAChallengeAttemptsResults struct {
+	err error
+}
+
+// AuthRepositoryMockIncMFAChallengeAttemptsOrigins contains origins of expectations of the AuthRepository.IncMFAChallengeAttempts
+type AuthRepositoryMockIncMFAChallengeAttemptsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIncMFAChallengeAttempts *mAuthRepositoryMockIncMFAChallengeAttempts) Optional() *mAuthRepositoryMockIncMFAChallengeAttempts {
+	mmIncMFAChallengeAttempts.optional = true
+	return mmIncMFAChallengeAttempts
+}
+
+// Expect sets up expected params for AuthRepository.IncMFAChallengeAttempts
+func (mmIncMFAChallengeAttempts *mAuthRepositoryMockIncMFAChallengeAttempts) Expect(ctx context.Context, tokenHash string) *mAuthRepositoryMockIncMFAChallengeAttempts {
+	if mmIncMFAChallengeAttempts.mock.funcIncMFAChallengeAttempts != nil {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("AuthRepositoryMock.IncMFAChallengeAttempts mock is already set by Set")
+	}
+
+	if mmIncMFAChallengeAttempts.defaultExpectation == nil {
+		mmIncMFAChallengeAttempts.defaultExpectation = &AuthRepositoryMockIncMFAChallengeAttemptsExpectation{}
+	}
+
+	if mmIncMFAChallengeAttempts.defaultExpectation.paramPtrs != nil {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("AuthRepositoryMock.IncMFAChallengeAttempts mock is already set by ExpectParams functions")
+	}
+
+	mmIncMFAChallengeAttempts.defaultExpectation.params = &AuthRepositoryMockIncMFAChallengeAttemptsParams{ctx, tokenHash}
+	mmIncMFAChallengeAttempts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIncMFAChallengeAttempts.expectations {
+		if minimock.Equal(e.params, mmIncMFAChallengeAttempts.defaultExpectation.params) {
+			mmIncMFAChallengeAttempts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIncMFAChallengeAttempts.defaultExpectation.params)
+		}
+	}
+
+	return mmIncMFAChallengeAttempts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.IncMFAChallengeAttempts
+func (mmIncMFAChallengeAttempts *mAuthRepositoryMockIncMFAChallengeAttempts) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockIncMFAChallengeAttempts {
+	if mmIncMFAChallengeAttempts.mock.funcIncMFAChallengeAttempts != nil {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("AuthRepositoryMock.IncMFAChallengeAttempts mock is already set by Set")
+	}
+
+	if mmIncMFAChallengeAttempts.defaultExpectation == nil {
+		mmIncMFAChallengeAttempts.defaultExpectation = &AuthRepositoryMockIncMFAChallengeAttemptsExpectation{}
+	}
+
+	if mmIncMFAChallengeAttempts.defaultExpectation.params != nil {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("AuthRepositoryMock.IncMFAChallengeAttempts mock is already set by Expect")
+	}
+
+	if mmIncMFAChallengeAttempts.defaultExpectation.paramPtrs == nil {
+		mmIncMFAChallengeAttempts.defaultExpectation.paramPtrs = &AuthRepositoryMockIncMFAChallengeAttemptsParamPtrs{}
+	}
+	mmIncMFAChallengeAttempts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIncMFAChallengeAttempts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIncMFAChallengeAttempts
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for AuthRepository.IncMFAChallengeAttempts
+func (mmIncMFAChallengeAttempts *mAuthRepositoryMockIncMFAChallengeAttempts) ExpectTokenHashParam2(tokenHash string) *mAuthRepositoryMockIncMFAChallengeAttempts {
+	if mmIncMFAChallengeAttempts.mock.funcIncMFAChallengeAttempts != nil {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("AuthRepositoryMock.IncMFAChallengeAttempts mock is already set by Set")
+	}
+
+	if mmIncMFAChallengeAttempts.defaultExpectation == nil {
+		mmIncMFAChallengeAttempts.defaultExpectation = &AuthRepositoryMockIncMFAChallengeAttemptsExpectation{}
+	}
+
+	if mmIncMFAChallengeAttempts.defaultExpectation.params != nil {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("AuthRepositoryMock.IncMFAChallengeAttempts mock is already set by Expect")
+	}
+
+	if mmIncMFAChallengeAttempts.defaultExpectation.paramPtrs == nil {
+		mmIncMFAChallengeAttempts.defaultExpectation.paramPtrs = &AuthRepositoryMockIncMFAChallengeAttemptsParamPtrs{}
+	}
+	mmIncMFAChallengeAttempts.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmIncMFAChallengeAttempts.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmIncMFAChallengeAttempts
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.IncMFAChallengeAttempts
+func (mmIncMFAChallengeAttempts *mAuthRepositoryMockIncMFAChallengeAttempts) Inspect(f func(ctx context.Context, tokenHash string)) *mAuthRepositoryMockIncMFAChallengeAttempts {
+	if mmIncMFAChallengeAttempts.mock.inspectFuncIncMFAChallengeAttempts != nil {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.IncMFAChallengeAttempts")
+	}
+
+	mmIncMFAChallengeAttempts.mock.inspectFuncIncMFAChallengeAttempts = f
+
+	return mmIncMFAChallengeAttempts
+}
+
+// Return sets up results that will be returned by AuthRepository.IncMFAChallengeAttempts
+func (mmIncMFAChallengeAttempts *mAuthRepositoryMockIncMFAChallengeAttempts) Return(err error) *AuthRepositoryMock {
+	if mmIncMFAChallengeAttempts.mock.funcIncMFAChallengeAttempts != nil {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("AuthRepositoryMock.IncMFAChallengeAttempts mock is already set by Set")
+	}
+
+	if mmIncMFAChallengeAttempts.defaultExpectation == nil {
+		mmIncMFAChallengeAttempts.defaultExpectation = &AuthRepositoryMockIncMFAChallengeAttemptsExpectation{mock: mmIncMFAChallengeAttempts.mock}
+	}
+	mmIncMFAChallengeAttempts.defaultExpectation.results = &AuthRepositoryMockIncMFAChallengeAttemptsResults{err}
+	mmIncMFAChallengeAttempts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIncMFAChallengeAttempts.mock
+}
+
+// Set uses given function f to mock the AuthRepository.IncMFAChallengeAttempts method
+func (mmIncMFAChallengeAttempts *mAuthRepositoryMockIncMFAChallengeAttempts) Set(f func(ctx context.Context, tokenHash string) (err error)) *AuthRepositoryMock {
+	if mmIncMFAChallengeAttempts.defaultExpectation != nil {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("Default expectation is already set for the AuthRepository.IncMFAChallengeAttempts method")
+	}
+
+	if len(mmIncMFAChallengeAttempts.expectations) > 0 {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("Some expectations are already set for the AuthRepository.IncMFAChallengeAttempts method")
+	}
+
+	mmIncMFAChallengeAttempts.mock.funcIncMFAChallengeAttempts = f
+	mmIncMFAChallengeAttempts.mock.funcIncMFAChallengeAttemptsOrigin = minimock.CallerInfo(1)
+	return mmIncMFAChallengeAttempts.mock
+}
+
+// When sets expectation for the AuthRepository.IncMFAChallengeAttempts which will trigger the result defined by the following
+// Then helper
+func (mmIncMFAChallengeAttempts *mAuthRepositoryMockIncMFAChallengeAttempts) When(ctx context.Context, tokenHash string) *AuthRepositoryMockIncMFAChallengeAttemptsExpectation {
+	if mmIncMFAChallengeAttempts.mock.funcIncMFAChallengeAttempts != nil {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("AuthRepositoryMock.IncMFAChallengeAttempts mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockIncMFAChallengeAttemptsExpectation{
+		mock:               mmIncMFAChallengeAttempts.mock,
+		params:             &AuthRepositoryMockIncMFAChallengeAttemptsParams{ctx, tokenHash},
+		expectationOrigins: AuthRepositoryMockIncMFAChallengeAttemptsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIncMFAChallengeAttempts.expectations = append(mmIncMFAChallengeAttempts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.IncMFAChallengeAttempts return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockIncMFAChallengeAttemptsExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockIncMFAChallengeAttemptsResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.IncMFAChallengeAttempts should be invoked
+func (mmIncMFAChallengeAttempts *mAuthRepositoryMockIncMFAChallengeAttempts) Times(n uint64) *mAuthRepositoryMockIncMFAChallengeAttempts {
+	if n == 0 {
+		mmIncMFAChallengeAttempts.mock.t.Fatalf("Times of AuthRepositoryMock.IncMFAChallengeAttempts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIncMFAChallengeAttempts.expectedInvocations, n)
+	mmIncMFAChallengeAttempts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIncMFAChallengeAttempts
+}
+
+func (mmIncMFAChallengeAttempts *mAuthRepositoryMockIncMFAChallengeAttempts) invocationsDone() bool {
+	if len(mmIncMFAChallengeAttempts.expectations) == 0 && mmIncMFAChallengeAttempts.defaultExpectation == nil && mmIncMFAChallengeAttempts.mock.funcIncMFAChallengeAttempts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIncMFAChallengeAttempts.mock.afterIncMFAChallengeAttemptsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIncMFAChallengeAttempts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IncMFAChallengeAttempts implements mm_repository.AuthRepository
+func (mmIncMFAChallengeAttempts *AuthRepositoryMock) IncMFAChallengeAttempts(ctx context.Context, tokenHash string) (err error) {
+	mm_atomic.AddUint64(&mmIncMFAChallengeAttempts.beforeIncMFAChallengeAttemptsCounter, 1)
+	defer mm_atomic.AddUint64(&mmIncMFAChallengeAttempts.afterIncMFAChallengeAttemptsCounter, 1)
+
+	mmIncMFAChallengeAttempts.t.Helper()
+
+	if mmIncMFAChallengeAttempts.inspectFuncIncMFAChallengeAttempts != nil {
+		mmIncMFAChallengeAttempts.inspectFuncIncMFAChallengeAttempts(ctx, tokenHash)
+	}
+
+	mm_params := AuthRepositoryMockIncMFAChallengeAttemptsParams{ctx, tokenHash}
+
+	// Record call args
+	mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.mutex.Lock()
+	mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.callArgs = append(mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.callArgs, &mm_params)
+	mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.mutex.Unlock()
+
+	for _, e := range mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.defaultExpectation.Counter, 1)
+		mm_want := mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.defaultExpectation.params
+		mm_want_ptrs := mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockIncMFAChallengeAttemptsParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIncMFAChallengeAttempts.t.Errorf("AuthRepositoryMock.IncMFAChallengeAttempts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmIncMFAChallengeAttempts.t.Errorf("AuthRepositoryMock.IncMFAChallengeAttempts got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIncMFAChallengeAttempts.t.Errorf("AuthRepositoryMock.IncMFAChallengeAttempts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIncMFAChallengeAttempts.IncMFAChallengeAttemptsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIncMFAChallengeAttempts.t.Fatal("No results are set for the AuthRepositoryMock.IncMFAChallengeAttempts")
+		}
+		return (*mm_results).err
+	}
+	if mmIncMFAChallengeAttempts.funcIncMFAChallengeAttempts != nil {
+		return mmIncMFAChallengeAttempts.funcIncMFAChallengeAttempts(ctx, tokenHash)
+	}
+	mmIncMFAChallengeAttempts.t.Fatalf("Unexpected call to AuthRepositoryMock.IncMFAChallengeAttempts. %v %v", ctx, tokenHash)
+	return
+}
+
+// IncMFAChallengeAttemptsAfterCounter returns a count of finished AuthRepositoryMock.IncMFAChallengeAttempts invocations
+func (mmIncMFAChallengeAttempts *AuthRepositoryMock) IncMFAChallengeAttemptsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncMFAChallengeAttempts.afterIncMFAChallengeAttemptsCounter)
+}
+
+// IncMFAChallengeAttemptsBeforeCounter returns a count of AuthRepositoryMock.IncMFAChallengeAttempts invocations
+func (mmIncMFAChallengeAttempts *AuthRepositoryMock) IncMFAChallengeAttemptsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncMFAChallengeAttempts.beforeIncMFAChallengeAttemptsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.IncMFAChallengeAttempts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIncMFAChallengeAttempts *mAuthRepositoryMockIncMFAChallengeAttempts) Calls() []*AuthRepositoryMockIncMFAChallengeAttemptsParams {
+	mmIncMFAChallengeAttempts.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockIncMFAChallengeAttemptsParams, len(mmIncMFAChallengeAttempts.callArgs))
+	copy(argCopy, mmIncMFAChallengeAttempts.callArgs)
+
+	mmIncMFAChallengeAttempts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIncMFAChallengeAttemptsDone returns true if the count of the IncMFAChallengeAttempts invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockIncMFAChallengeAttemptsDone() bool {
+	if m.IncMFAChallengeAttemptsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IncMFAChallengeAttemptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IncMFAChallengeAttemptsMock.invocationsDone()
+}
+
+// MinimockIncMFAChallengeAttemptsInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockIncMFAChallengeAttemptsInspect() {
+	for _, e := range m.IncMFAChallengeAttemptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.IncMFAChallengeAttempts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIncMFAChallengeAttemptsCounter := mm_atomic.LoadUint64(&m.afterIncMFAChallengeAttemptsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IncMFAChallengeAttemptsMock.defaultExpectation != nil && afterIncMFAChallengeAttemptsCounter < 1 {
+		if m.IncMFAChallengeAttemptsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.IncMFAChallengeAttempts at\n%s", m.IncMFAChallengeAttemptsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.IncMFAChallengeAttempts at\n%s with params: %#v", m.IncMFAChallengeAttemptsMock.defaultExpectation.expectationOrigins.origin, *m.IncMFAChallengeAttemptsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIncMFAChallengeAttempts != nil && afterIncMFAChallengeAttemptsCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.IncMFAChallengeAttempts at\n%s", m.funcIncMFAChallengeAttemptsOrigin)
+	}
+
+	if !m.IncMFAChallengeAttemptsMock.invocationsDone() && afterIncMFAChallengeAttemptsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.IncMFAChallengeAttempts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IncMFAChallengeAttemptsMock.expectedInvocations), m.IncMFAChallengeAttemptsMock.expectedInvocationsOrigin, afterIncMFAChallengeAttemptsCounter)
+	}
+}
+
+type mAuthRepositoryMockIsRefreshTokenFamilyRevoked struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectation
+	expectations       []*AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectation
+
+	callArgs []*AuthRepositoryMockIsRefreshTokenFamilyRevokedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectation specifies expectation struct of the AuthRepository.IsRefreshTokenFamilyRevoked
+type AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockIsRefreshTokenFamilyRevokedParams
+	paramPtrs          *AuthRepositoryMockIsRefreshTokenFamilyRevokedParamPtrs
+	expectationOrigins AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectationOrigins
+	results            *AuthRepositoryMockIsRefreshTokenFamilyRevokedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockIsRefreshTokenFamilyRevokedParams contains parameters of the AuthRepository.IsRefreshTokenFamilyRevoked
+type AuthRepositoryMockIsRefreshTokenFamilyRevokedParams struct {
+	ctx      context.Context
+	familyID string
+}
+
+// AuthRepositoryMockIsRefreshTokenFamilyRevokedParamPtrs contains pointers to parameters of the AuthRepository.IsRefreshTokenFamilyRevoked
+type AuthRepositoryMockIsRefreshTokenFamilyRevokedParamPtrs struct {
+	ctx      *context.Context
+	familyID *string
+}
+
+// AuthRepositoryMockIsRefreshTokenFamilyRevokedResults contains results of the AuthRepository.IsRefreshTokenFamilyRevoked
+type AuthRepositoryMockIsRefreshTokenFamilyRevokedResults struct {
+	b1  bool
+	err error
+}
+
+// AuthRepositoryMockIsRefreshTokenFamilyRevokedOrigins contains origins of expectations of the AuthRepository.IsRefreshTokenFamilyRevoked
+type AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originFamilyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIsRefreshTokenFamilyRevoked *mAuthRepositoryMockIsRefreshTokenFamilyRevoked) Optional() *mAuthRepositoryMockIsRefreshTokenFamilyRevoked {
+	mmIsRefreshTokenFamilyRevoked.optional = true
+	return mmIsRefreshTokenFamilyRevoked
+}
+
+// Expect sets up expected params for AuthRepository.IsRefreshTokenFamilyRevoked
+func (mmIsRefreshTokenFamilyRevoked *mAuthRepositoryMockIsRefreshTokenFamilyRevoked) Expect(ctx context.Context, familyID string) *mAuthRepositoryMockIsRefreshTokenFamilyRevoked {
+	if mmIsRefreshTokenFamilyRevoked.mock.funcIsRefreshTokenFamilyRevoked != nil {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("AuthRepositoryMock.IsRefreshTokenFamilyRevoked mock is already set by Set")
+	}
+
+	if mmIsRefreshTokenFamilyRevoked.defaultExpectation == nil {
+		mmIsRefreshTokenFamilyRevoked.defaultExpectation = &AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectation{}
+	}
+
+	if mmIsRefreshTokenFamilyRevoked.defaultExpectation.paramPtrs != nil {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("AuthRepositoryMock.IsRefreshTokenFamilyRevoked mock is already set by ExpectParams functions")
+	}
+
+	mmIsRefreshTokenFamilyRevoked.defaultExpectation.params = &AuthRepositoryMockIsRefreshTokenFamilyRevokedParams{ctx, familyID}
+	mmIsRefreshTokenFamilyRevoked.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIsRefreshTokenFamilyRevoked.expectations {
+		if minimock.Equal(e.params, mmIsRefreshTokenFamilyRevoked.defaultExpectation.params) {
+			mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsRefreshTokenFamilyRevoked.defaultExpectation.params)
+		}
+	}
+
+	return mmIsRefreshTokenFamilyRevoked
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.IsRefreshTokenFamilyRevoked
+func (mmIsRefreshTokenFamilyRevoked *mAuthRepositoryMockIsRefreshTokenFamilyRevoked) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockIsRefreshTokenFamilyRevoked {
+	if mmIsRefreshTokenFamilyRevoked.mock.funcIsRefreshTokenFamilyRevoked != nil {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("AuthRepositoryMock.IsRefreshTokenFamilyRevoked mock is already set by Set")
+	}
+
+	if mmIsRefreshTokenFamilyRevoked.defaultExpectation == nil {
+		mmIsRefreshTokenFamilyRevoked.defaultExpectation = &AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectation{}
+	}
+
+	if mmIsRefreshTokenFamilyRevoked.defaultExpectation.params != nil {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("AuthRepositoryMock.IsRefreshTokenFamilyRevoked mock is already set by Expect")
+	}
+
+	if mmIsRefreshTokenFamilyRevoked.defaultExpectation.paramPtrs == nil {
+		mmIsRefreshTokenFamilyRevoked.defaultExpectation.paramPtrs = &AuthRepositoryMockIsRefreshTokenFamilyRevokedParamPtrs{}
+	}
+	mmIsRefreshTokenFamilyRevoked.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIsRefreshTokenFamilyRevoked.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIsRefreshTokenFamilyRevoked
+}
+
+// ExpectFamilyIDParam2 sets up expected param familyID for AuthRepository.IsRefreshTokenFamilyRevoked
+func (mmIsRefreshTokenFamilyRevoked *mAuthRepositoryMockIsRefreshTokenFamilyRevoked) ExpectFamilyIDParam2(familyID string) *mAuthRepositoryMockIsRefreshTokenFamilyRevoked {
+	if mmIsRefreshTokenFamilyRevoked.mock.funcIsRefreshTokenFamilyRevoked != nil {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("AuthRepositoryMock.IsRefreshTokenFamilyRevoked mock is already set by Set")
+	}
+
+	if mmIsRefreshTokenFamilyRevoked.defaultExpectation == nil {
+		mmIsRefreshTokenFamilyRevoked.defaultExpectation = &AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectation{}
+	}
+
+	if mmIsRefreshTokenFamilyRevoked.defaultExpectation.params != nil {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("AuthRepositoryMock.IsRefreshTokenFamilyRevoked mock is already set by Expect")
+	}
+
+	if mmIsRefreshTokenFamilyRevoked.defaultExpectation.paramPtrs == nil {
+		mmIsRefreshTokenFamilyRevoked.defaultExpectation.paramPtrs = &AuthRepositoryMockIsRefreshTokenFamilyRevokedParamPtrs{}
+	}
+	mmIsRefreshTokenFamilyRevoked.defaultExpectation.paramPtrs.familyID = &familyID
+	mmIsRefreshTokenFamilyRevoked.defaultExpectation.expectationOrigins.originFamilyID = minimock.CallerInfo(1)
+
+	return mmIsRefreshTokenFamilyRevoked
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.IsRefreshTokenFamilyRevoked
+func (mmIsRefreshTokenFamilyRevoked *mAuthRepositoryMockIsRefreshTokenFamilyRevoked) Inspect(f func(ctx context.Context, familyID string)) *mAuthRepositoryMockIsRefreshTokenFamilyRevoked {
+	if mmIsRefreshTokenFamilyRevoked.mock.inspectFuncIsRefreshTokenFamilyRevoked != nil {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.IsRefreshTokenFamilyRevoked")
+	}
+
+	mmIsRefreshTokenFamilyRevoked.mock.inspectFuncIsRefreshTokenFamilyRevoked = f
+
+	return mmIsRefreshTokenFamilyRevoked
+}
+
+// Return sets up results that will be returned by AuthRepository.IsRefreshTokenFamilyRevoked
+func (mmIsRefreshTokenFamilyRevoked *mAuthRepositoryMockIsRefreshTokenFamilyRevoked) Return(b1 bool, err error) *AuthRepositoryMock {
+	if mmIsRefreshTokenFamilyRevoked.mock.funcIsRefreshTokenFamilyRevoked != nil {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("AuthRepositoryMock.IsRefreshTokenFamilyRevoked mock is already set by Set")
+	}
+
+	if mmIsRefreshTokenFamilyRevoked.defaultExpectation == nil {
+		mmIsRefreshTokenFamilyRevoked.defaultExpectation = &AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectation{mock: mmIsRefreshTokenFamilyRevoked.mock}
+	}
+	mmIsRefreshTokenFamilyRevoked.defaultExpectation.results = &AuthRepositoryMockIsRefreshTokenFamilyRevokedResults{b1, err}
+	mmIsRefreshTokenFamilyRevoked.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIsRefreshTokenFamilyRevoked.mock
+}
+
+// Set uses given function f to mock the AuthRepository.IsRefreshTokenFamilyRevoked method
+func (mmIsRefreshTokenFamilyRevoked *mAuthRepositoryMockIsRefreshTokenFamilyRevoked) Set(f func(ctx context.Context, familyID string) (b1 bool, err error)) *AuthRepositoryMock {
+	if mmIsRefreshTokenFamilyRevoked.defaultExpectation != nil {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("Default expectation is already set for the AuthRepository.IsRefreshTokenFamilyRevoked method")
+	}
+
+	if len(mmIsRefreshTokenFamilyRevoked.expectations) > 0 {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("Some expectations are already set for the AuthRepository.IsRefreshTokenFamilyRevoked method")
+	}
+
+	mmIsRefreshTokenFamilyRevoked.mock.funcIsRefreshTokenFamilyRevoked = f
+	mmIsRefreshTokenFamilyRevoked.mock.funcIsRefreshTokenFamilyRevokedOrigin = minimock.CallerInfo(1)
+	return mmIsRefreshTokenFamilyRevoked.mock
+}
+
+// When sets expectation for the AuthRepository.IsRefreshTokenFamilyRevoked which will trigger the result defined by the following
+// Then helper
+func (mmIsRefreshTokenFamilyRevoked *mAuthRepositoryMockIsRefreshTokenFamilyRevoked) When(ctx context.Context, familyID string) *AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectation {
+	if mmIsRefreshTokenFamilyRevoked.mock.funcIsRefreshTokenFamilyRevoked != nil {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("AuthRepositoryMock.IsRefreshTokenFamilyRevoked mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectation{
+		mock:               mmIsRefreshTokenFamilyRevoked.mock,
+		params:             &AuthRepositoryMockIsRefreshTokenFamilyRevokedParams{ctx, familyID},
+		expectationOrigins: AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIsRefreshTokenFamilyRevoked.expectations = append(mmIsRefreshTokenFamilyRevoked.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.IsRefreshTokenFamilyRevoked return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockIsRefreshTokenFamilyRevokedExpectation) Then(b1 bool, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockIsRefreshTokenFamilyRevokedResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.IsRefreshTokenFamilyRevoked should be invoked
+func (mmIsRefreshTokenFamilyRevoked *mAuthRepositoryMockIsRefreshTokenFamilyRevoked) Times(n uint64) *mAuthRepositoryMockIsRefreshTokenFamilyRevoked {
+	if n == 0 {
+		mmIsRefreshTokenFamilyRevoked.mock.t.Fatalf("Times of AuthRepositoryMock.IsRefreshTokenFamilyRevoked mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIsRefreshTokenFamilyRevoked.expectedInvocations, n)
+	mmIsRefreshTokenFamilyRevoked.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIsRefreshTokenFamilyRevoked
+}
+
+func (mmIsRefreshTokenFamilyRevoked *mAuthRepositoryMockIsRefreshTokenFamilyRevoked) invocationsDone() bool {
+	if len(mmIsRefreshTokenFamilyRevoked.expectations) == 0 && mmIsRefreshTokenFamilyRevoked.defaultExpectation == nil && mmIsRefreshTokenFamilyRevoked.mock.funcIsRefreshTokenFamilyRevoked == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIsRefreshTokenFamilyRevoked.mock.afterIsRefreshTokenFamilyRevokedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIsRefreshTokenFamilyRevoked.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IsRefreshTokenFamilyRevoked implements mm_repository.AuthRepository
+func (mmIsRefreshTokenFamilyRevoked *AuthRepositoryMock) IsRefreshTokenFamilyRevoked(ctx context.Context, familyID string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmIsRefreshTokenFamilyRevoked.beforeIsRefreshTokenFamilyRevokedCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsRefreshTokenFamilyRevoked.afterIsRefreshTokenFamilyRevokedCounter, 1)
+
+	mmIsRefreshTokenFamilyRevoked.t.Helper()
+
+	if mmIsRefreshTokenFamilyRevoked.inspectFuncIsRefreshTokenFamilyRevoked != nil {
+		mmIsRefreshTokenFamilyRevoked.inspectFuncIsRefreshTokenFamilyRevoked(ctx, familyID)
+	}
+
+	mm_params := AuthRepositoryMockIsRefreshTokenFamilyRevokedParams{ctx, familyID}
+
+	// Record call args
+	mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.mutex.Lock()
+	mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.callArgs = append(mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.callArgs, &mm_params)
+	mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.mutex.Unlock()
+
+	for _, e := range mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.defaultExpectation.params
+		mm_want_ptrs := mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockIsRefreshTokenFamilyRevokedParams{ctx, familyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsRefreshTokenFamilyRevoked.t.Errorf("AuthRepositoryMock.IsRefreshTokenFamilyRevoked got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.familyID != nil && !minimock.Equal(*mm_want_ptrs.familyID, mm_got.familyID) {
+				mmIsRefreshTokenFamilyRevoked.t.Errorf("AuthRepositoryMock.IsRefreshTokenFamilyRevoked got unexpected parameter familyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.defaultExpectation.expectationOrigins.originFamilyID, *mm_want_ptrs.familyID, mm_got.familyID, minimock.Diff(*mm_want_ptrs.familyID, mm_got.familyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsRefreshTokenFamilyRevoked.t.Errorf("AuthRepositoryMock.IsRefreshTokenFamilyRevoked got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsRefreshTokenFamilyRevoked.IsRefreshTokenFamilyRevokedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsRefreshTokenFamilyRevoked.t.Fatal("No results are set for the AuthRepositoryMock.IsRefreshTokenFamilyRevoked")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmIsRefreshTokenFamilyRevoked.funcIsRefreshTokenFamilyRevoked != nil {
+		return mmIsRefreshTokenFamilyRevoked.funcIsRefreshTokenFamilyRevoked(ctx, familyID)
+	}
+	mmIsRefreshTokenFamilyRevoked.t.Fatalf("Unexpected call to AuthRepositoryMock.IsRefreshTokenFamilyRevoked. %v %v", ctx, familyID)
+	return
+}
+
+// IsRefreshTokenFamilyRevokedAfterCounter returns a count of finished AuthRepositoryMock.IsRefreshTokenFamilyRevoked invocations
+func (mmIsRefreshTokenFamilyRevoked *AuthRepositoryMock) IsRefreshTokenFamilyRevokedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsRefreshTokenFamilyRevoked.afterIsRefreshTokenFamilyRevokedCounter)
+}
+
+// IsRefreshTokenFamilyRevokedBeforeCounter returns a count of AuthRepositoryMock.IsRefreshTokenFamilyRevoked invocations
+func (mmIsRefreshTokenFamilyRevoked *AuthRepositoryMock) IsRefreshTokenFamilyRevokedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsRefreshTokenFamilyRevoked.beforeIsRefreshTokenFamilyRevokedCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.IsRefreshTokenFamilyRevoked.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsRefreshTokenFamilyRevoked *mAuthRepositoryMockIsRefreshTokenFamilyRevoked) Calls() []*AuthRepositoryMockIsRefreshTokenFamilyRevokedParams {
+	mmIsRefreshTokenFamilyRevoked.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockIsRefreshTokenFamilyRevokedParams, len(mmIsRefreshTokenFamilyRevoked.callArgs))
+	copy(argCopy, mmIsRefreshTokenFamilyRevoked.callArgs)
+
+	mmIsRefreshTokenFamilyRevoked.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsRefreshTokenFamilyRevokedDone returns true if the count of the IsRefreshTokenFamilyRevoked invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockIsRefreshTokenFamilyRevokedDone() bool {
+	if m.IsRefreshTokenFamilyRevokedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IsRefreshTokenFamilyRevokedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IsRefreshTokenFamilyRevokedMock.invocationsDone()
+}
+
+// MinimockIsRefreshTokenFamilyRevokedInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockIsRefreshTokenFamilyRevokedInspect() {
+	for _, e := range m.IsRefreshTokenFamilyRevokedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.IsRefreshTokenFamilyRevoked at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIsRefreshTokenFamilyRevokedCounter := mm_atomic.LoadUint64(&m.afterIsRefreshTokenFamilyRevokedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsRefreshTokenFamilyRevokedMock.defaultExpectation != nil && afterIsRefreshTokenFamilyRevokedCounter < 1 {
+		if m.IsRefreshTokenFamilyRevokedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.IsRefreshTokenFamilyRevoked at\n%s", m.IsRefreshTokenFamilyRevokedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.IsRefreshTokenFamilyRevoked at\n%s with params: %#v", m.IsRefreshTokenFamilyRevokedMock.defaultExpectation.expectationOrigins.origin, *m.IsRefreshTokenFamilyRevokedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsRefreshTokenFamilyRevoked != nil && afterIsRefreshTokenFamilyRevokedCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.IsRefreshTokenFamilyRevoked at\n%s", m.funcIsRefreshTokenFamilyRevokedOrigin)
+	}
+
+	if !m.IsRefreshTokenFamilyRevokedMock.invocationsDone() && afterIsRefreshTokenFamilyRevokedCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.IsRefreshTokenFamilyRevoked at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IsRefreshTokenFamilyRevokedMock.expectedInvocations), m.IsRefreshTokenFamilyRevokedMock.expectedInvocationsOrigin, afterIsRefreshTokenFamilyRevokedCounter)
+	}
+}
+
+type mAuthRepositoryMockLogin struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockLoginExpectation
+	expectations       []*AuthRepositoryMockLoginExpectation
+
+	callArgs []*AuthRepositoryMockLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockLoginExpectation specifies expectation struct of the AuthRepository.Login
+type AuthRepositoryMockLoginExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockLoginParams
+	paramPtrs          *AuthRepositoryMockLoginParamPtrs
+	expectationOrigins AuthRepositoryMockLoginExpectationOrigins
+	results            *AuthRepositoryMockLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockLoginParams contains parameters of the AuthRepository.Login
+type AuthRepositoryMockLoginParams struct {
+	ctx  context.Context
+	user model.LoginRequest
+}
+
+// AuthRepositoryMockLoginParamPtrs contains pointers to parameters of the AuthRepository.Login
+type AuthRepositoryMockLoginParamPtrs struct {
+	ctx  *context.Context
+	user *model.LoginRequest
+}
+
+// AuthRepositoryMockLoginResults contains results of the AuthRepository.Login
+type AuthRepositoryMockLoginResults struct {
+	up1 *model.UserInfoJwt
+	err error
+}
+
+// AuthRepositoryMockLoginOrigins contains origins of expectations of the AuthRepository.Login
+type AuthRepositoryMockLoginExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogin *mAuthRepositoryMockLogin) Optional() *mAuthRepositoryMockLogin {
+	mmLogin.optional = true
+	return mmLogin
+}
+
+// Expect sets up expected params for AuthRepository.Login
+func (mmLogin *mAuthRepositoryMockLogin) Expect(ctx context.Context, user model.LoginRequest) *mAuthRepositoryMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthRepositoryMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthRepositoryMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs != nil {
+		mmLogin.mock.t.Fatalf("AuthRepositoryMock.Login mock is already set by ExpectParams functions")
+	}
+
+	mmLogin.defaultExpectation.params = &AuthRepositoryMockLoginParams{ctx, user}
+	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogin.expectations {
+		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
+			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.Login
+func (mmLogin *mAuthRepositoryMockLogin) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthRepositoryMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthRepositoryMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthRepositoryMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthRepositoryMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectUserParam2 sets up expected param user for AuthRepository.Login
+func (mmLogin *mAuthRepositoryMockLogin) ExpectUserParam2(user model.LoginRequest) *mAuthRepositoryMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthRepositoryMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthRepositoryMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthRepositoryMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthRepositoryMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.user = &user
+	mmLogin.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.Login
+func (mmLogin *mAuthRepositoryMockLogin) Inspect(f func(ctx context.Context, user model.LoginRequest)) *mAuthRepositoryMockLogin {
+	if mmLogin.mock.inspectFuncLogin != nil {
+		mmLogin.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.Login")
+	}
+
+	mmLogin.mock.inspectFuncLogin = f
+
+	return mmLogin
+}
+
+// Return sets up results that will be returned by AuthRepository.Login
+func (mmLogin *mAuthRepositoryMockLogin) Return(up1 *model.UserInfoJwt, err error) *AuthRepositoryMock {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthRepositoryMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthRepositoryMockLoginExpectation{mock: mmLogin.mock}
+	}
+	mmLogin.defaultExpectation.results = &AuthRepositoryMockLoginResults{up1, err}
+	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// Set uses given function f to mock the AuthRepository.Login method
+func (mmLogin *mAuthRepositoryMockLogin) Set(f func(ctx context.Context, user model.LoginRequest) (up1 *model.UserInfoJwt, err error)) *AuthRepositoryMock {
+	if mmLogin.defaultExpectation != nil {
+		mmLogin.mock.t.Fatalf("Default expectation is already set for the AuthRepository.Login method")
+	}
+
+	if len(mmLogin.expectations) > 0 {
+		mmLogin.mock.t.Fatalf("Some expectations are already set for the AuthRepository.Login method")
+	}
+
+	mmLogin.mock.funcLogin = f
+	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// When sets expectation for the AuthRepository.Login which will trigger the result defined by the following
+// Then helper
+func (mmLogin *mAuthRepositoryMockLogin) When(ctx context.Context, user model.LoginRequest) *AuthRepositoryMockLoginExpectation {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthRepositoryMock.Login mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockLoginExpectation{
+		mock:               mmLogin.mock,
+		params:             &AuthRepositoryMockLoginParams{ctx, user},
+		expectationOrigins: AuthRepositoryMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.Login return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockLoginExpectation) Then(up1 *model.UserInfoJwt, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockLoginResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.Login should be invoked
+func (mmLogin *mAuthRepositoryMockLogin) Times(n uint64) *mAuthRepositoryMockLogin {
+	if n == 0 {
+		mmLogin.mock.t.Fatalf("Times of AuthRepositoryMock.Login mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
+	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogin
+}
+
+func (mmLogin *mAuthRepositoryMockLogin) invocationsDone() bool {
+	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Login implements mm_repository.AuthRepository
+func (mmLogin *AuthRepositoryMock) Login(ctx context.Context, user model.LoginRequest) (up1 *model.UserInfoJwt, err error) {
+	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+
+	mmLogin.t.Helper()
+
+	if mmLogin.inspectFuncLogin != nil {
+		mmLogin.inspectFuncLogin(ctx, user)
+	}
+
+	mm_params := AuthRepositoryMockLoginParams{ctx, user}
+
+	// Record call args
+	mmLogin.LoginMock.mutex.Lock()
+	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
+	mmLogin.LoginMock.mutex.Unlock()
+
+	for _, e := range mmLogin.LoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmLogin.LoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogin.LoginMock.defaultExpectation.params
+		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockLoginParams{ctx, user}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogin.t.Errorf("AuthRepositoryMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmLogin.t.Errorf("AuthRepositoryMock.Login got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogin.t.Errorf("AuthRepositoryMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogin.t.Fatal("No results are set for the AuthRepositoryMock.Login")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmLogin.funcLogin != nil {
+		return mmLogin.funcLogin(ctx, user)
+	}
+	mmLogin.t.Fatalf("Unexpected call to AuthRepositoryMock.Login. %v %v", ctx, user)
+	return
+}
+
+// LoginAfterCounter returns a count of finished AuthRepositoryMock.Login invocations
+func (mmLogin *AuthRepositoryMock) LoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+}
+
+// LoginBeforeCounter returns a count of AuthRepositoryMock.Login invocations
+func (mmLogin *AuthRepositoryMock) LoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.Login.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogin *mAuthRepositoryMockLogin) Calls() []*AuthRepositoryMockLoginParams {
+	mmLogin.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockLoginParams, len(mmLogin.callArgs))
+	copy(argCopy, mmLogin.callArgs)
+
+	mmLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockLoginDone() bool {
+	if m.LoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoginMock.invocationsDone()
+}
+
+// MinimockLoginInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockLoginInspect() {
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
+		if m.LoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogin != nil && afterLoginCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.Login at\n%s", m.funcLoginOrigin)
+	}
+
+	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.Login at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	}
+}
+
+type mAuthRepositoryMockMakeLog struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockMakeLogExpectation
+	expectations       []*AuthRepositoryMockMakeLogExpectation
+
+	callArgs []*AuthRepositoryMockMakeLogParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockMakeLogExpectation specifies expectation struct of the AuthRepository.MakeLog
+type AuthRepositoryMockMakeLogExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockMakeLogParams
+	paramPtrs          *AuthRepositoryMockMakeLogParamPtrs
+	expectationOrigins AuthRepositoryMockMakeLogExpectationOrigins
+	results            *AuthRepositoryMockMakeLogResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockMakeLogParams contains parameters of the AuthRepository.MakeLog
+type AuthRepositoryMockMakeLogParams struct {
+	ctx context.Context
+	log model.Log
+}
+
+// AuthRepositoryMockMakeLogParamPtrs contains pointers to parameters of the AuthRepository.MakeLog
+type AuthRepositoryMockMakeLogParamPtrs struct {
+	ctx *context.Context
+	log *model.Log
+}
+
+// AuthRepositoryMockMakeLogResults contains results of the AuthRepository.MakeLog
+type AuthRepositoryMockMakeLogResults struct {
+	err error
+}
+
+// AuthRepositoryMockMakeLogOrigins contains origins of expectations of the AuthRepository.MakeLog
+type AuthRepositoryMockMakeLogExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originLog string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMakeLog *mAuthRepositoryMockMakeLog) Optional() *mAuthRepositoryMockMakeLog {
+	mmMakeLog.optional = true
+	return mmMakeLog
+}
+
+// Expect sets up expected params for AuthRepository.MakeLog
+func (mmMakeLog *mAuthRepositoryMockMakeLog) Expect(ctx context.Context, log model.Log) *mAuthRepositoryMockMakeLog {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("AuthRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &AuthRepositoryMockMakeLogExpectation{}
+	}
+
+	if mmMakeLog.defaultExpectation.paramPtrs != nil {
+		mmMakeLog.mock.t.Fatalf("AuthRepositoryMock.MakeLog mock is already set by ExpectParams functions")
+	}
+
+	mmMakeLog.defaultExpectation.params = &AuthRepositoryMockMakeLogParams{ctx, log}
+	mmMakeLog.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMakeLog.expectations {
+		if minimock.Equal(e.params, mmMakeLog.defaultExpectation.params) {
+			mmMakeLog.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMakeLog.defaultExpectation.params)
+		}
+	}
+
+	return mmMakeLog
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.MakeLog
+func (mmMakeLog *mAuthRepositoryMockMakeLog) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockMakeLog {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("AuthRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &AuthRepositoryMockMakeLogExpectation{}
+	}
+
+	if mmMakeLog.defaultExpectation.params != nil {
+		mmMakeLog.mock.t.Fatalf("AuthRepositoryMock.MakeLog mock is already set by Expect")
+	}
+
+	if mmMakeLog.defaultExpectation.paramPtrs == nil {
+		mmMakeLog.defaultExpectation.paramPtrs = &AuthRepositoryMockMakeLogParamPtrs{}
+	}
+	mmMakeLog.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMakeLog.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMakeLog
+}
+
+// ExpectLogParam2 sets up expected param log for AuthRepository.MakeLog
+func (mmMakeLog *mAuthRepositoryMockMakeLog) ExpectLogParam2(log model.Log) *mAuthRepositoryMockMakeLog {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("AuthRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &AuthRepositoryMockMakeLogExpectation{}
+	}
+
+	if mmMakeLog.defaultExpectation.params != nil {
+		mmMakeLog.mock.t.Fatalf("AuthRepositoryMock.MakeLog mock is already set by Expect")
+	}
+
+	if mmMakeLog.defaultExpectation.paramPtrs == nil {
+		mmMakeLog.defaultExpectation.paramPtrs = &AuthRepositoryMockMakeLogParamPtrs{}
+	}
+	mmMakeLog.defaultExpectation.paramPtrs.log = &log
+	mmMakeLog.defaultExpectation.expectationOrigins.originLog = minimock.CallerInfo(1)
+
+	return mmMakeLog
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.MakeLog
+func (mmMakeLog *mAuthRepositoryMockMakeLog) Inspect(f func(ctx context.Context, log model.Log)) *mAuthRepositoryMockMakeLog {
+	if mmMakeLog.mock.inspectFuncMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.MakeLog")
+	}
+
+	mmMakeLog.mock.inspectFuncMakeLog = f
+
+	return mmMakeLog
+}
+
+// Return sets up results that will be returned by AuthRepository.MakeLog
+func (mmMakeLog *mAuthRepositoryMockMakeLog) Return(err error) *AuthRepositoryMock {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("AuthRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &AuthRepositoryMockMakeLogExpectation{mock: mmMakeLog.mock}
+	}
+	mmMakeLog.defaultExpectation.results = &AuthRepositoryMockMakeLogResults{err}
+	mmMakeLog.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMakeLog.mock
+}
+
+// Set uses given function f to mock the AuthRepository.MakeLog method
+func (mmMakeLog *mAuthRepositoryMockMakeLog) Set(f func(ctx context.Context, log model.Log) (err error)) *AuthRepositoryMock {
+	if mmMakeLog.defaultExpectation != nil {
+		mmMakeLog.mock.t.Fatalf("Default expectation is already set for the AuthRepository.MakeLog method")
+	}
+
+	if len(mmMakeLog.expectations) > 0 {
+		mmMakeLog.mock.t.Fatalf("Some expectations are already set for the AuthRepository.MakeLog method")
+	}
+
+	mmMakeLog.mock.funcMakeLog = f
+	mmMakeLog.mock.funcMakeLogOrigin = minimock.CallerInfo(1)
+	return mmMakeLog.mock
+}
+
+// When sets expectation for the AuthRepository.MakeLog which will trigger the result defined by the following
+// Then helper
+func (mmMakeLog *mAuthRepositoryMockMakeLog) When(ctx context.Context, log model.Log) *AuthRepositoryMockMakeLogExpectation {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("AuthRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockMakeLogExpectation{
+		mock:               mmMakeLog.mock,
+		params:             &AuthRepositoryMockMakeLogParams{ctx, log},
+		expectationOrigins: AuthRepositoryMockMakeLogExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMakeLog.expectations = append(mmMakeLog.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.MakeLog return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockMakeLogExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockMakeLogResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.MakeLog should be invoked
+func (mmMakeLog *mAuthRepositoryMockMakeLog) Times(n uint64) *mAuthRepositoryMockMakeLog {
+	if n == 0 {
+		mmMakeLog.mock.t.Fatalf("Times of AuthRepositoryMock.MakeLog mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMakeLog.expectedInvocations, n)
+	mmMakeLog.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMakeLog
+}
+
+func (mmMakeLog *mAuthRepositoryMockMakeLog) invocationsDone() bool {
+	if len(mmMakeLog.expectations) == 0 && mmMakeLog.defaultExpectation == nil && mmMakeLog.mock.funcMakeLog == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMakeLog.mock.afterMakeLogCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMakeLog.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MakeLog implements mm_repository.AuthRepository
+func (mmMakeLog *AuthRepositoryMock) MakeLog(ctx context.Context, log model.Log) (err error) {
+	mm_atomic.AddUint64(&mmMakeLog.beforeMakeLogCounter, 1)
+	defer mm_atomic.AddUint64(&mmMakeLog.afterMakeLogCounter, 1)
+
+	mmMakeLog.t.Helper()
+
+	if mmMakeLog.inspectFuncMakeLog != nil {
+		mmMakeLog.inspectFuncMakeLog(ctx, log)
+	}
+
+	mm_params := AuthRepositoryMockMakeLogParams{ctx, log}
+
+	// Record call args
+	mmMakeLog.MakeLogMock.mutex.Lock()
+	mmMakeLog.MakeLogMock.callArgs = append(mmMakeLog.MakeLogMock.callArgs, &mm_params)
+	mmMakeLog.MakeLogMock.mutex.Unlock()
+
+	for _, e := range mmMakeLog.MakeLogMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMakeLog.MakeLogMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMakeLog.MakeLogMock.defaultExpectation.Counter, 1)
+		mm_want := mmMakeLog.MakeLogMock.defaultExpectation.params
+		mm_want_ptrs := mmMakeLog.MakeLogMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockMakeLogParams{ctx, log}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMakeLog.t.Errorf("AuthRepositoryMock.MakeLog got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.log != nil && !minimock.Equal(*mm_want_ptrs.log, mm_got.log) {
+				mmMakeLog.t.Errorf("AuthRepositoryMock.MakeLog got unexpected parameter log, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.originLog, *mm_want_ptrs.log, mm_got.log, minimock.Diff(*mm_want_ptrs.log, mm_got.log))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMakeLog.t.Errorf("AuthRepositoryMock.MakeLog got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMakeLog.MakeLogMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMakeLog.t.Fatal("No results are set for the AuthRepositoryMock.MakeLog")
+		}
+		return (*mm_results).err
+	}
+	if mmMakeLog.funcMakeLog != nil {
+		return mmMakeLog.funcMakeLog(ctx, log)
+	}
+	mmMakeLog.t.Fatalf("Unexpected call to AuthRepositoryMock.MakeLog. %v %v", ctx, log)
+	return
+}
+
+// MakeLogAfterCounter returns a count of finished AuthRepositoryMock.MakeLog invocations
+func (mmMakeLog *AuthRepositoryMock) MakeLogAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMakeLog.afterMakeLogCounter)
+}
+
+// MakeLogBeforeCounter returns a count of AuthRepositoryMock.MakeLog invocations
+func (mmMakeLog *AuthRepositoryMock) MakeLogBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMakeLog.beforeMakeLogCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.MakeLog.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMakeLog *mAuthRepositoryMockMakeLog) Calls() []*AuthRepositoryMockMakeLogParams {
+	mmMakeLog.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockMakeLogParams, len(mmMakeLog.callArgs))
+	copy(argCopy, mmMakeLog.callArgs)
+
+	mmMakeLog.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMakeLogDone returns true if the count of the MakeLog invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockMakeLogDone() bool {
+	if m.MakeLogMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MakeLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MakeLogMock.invocationsDone()
+}
+
+// MinimockMakeLogInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockMakeLogInspect() {
+	for _, e := range m.MakeLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.MakeLog at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMakeLogCounter := mm_atomic.LoadUint64(&m.afterMakeLogCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MakeLogMock.defaultExpectation != nil && afterMakeLogCounter < 1 {
+		if m.MakeLogMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.MakeLog at\n%s", m.MakeLogMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.MakeLog at\n%s with params: %#v", m.MakeLogMock.defaultExpectation.expectationOrigins.origin, *m.MakeLogMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMakeLog != nil && afterMakeLogCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.MakeLog at\n%s", m.funcMakeLogOrigin)
+	}
+
+	if !m.MakeLogMock.invocationsDone() && afterMakeLogCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.MakeLog at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MakeLogMock.expectedInvocations), m.MakeLogMock.expectedInvocationsOrigin, afterMakeLogCounter)
+	}
+}
+
+type mAuthRepositoryMockMarkRefreshTokenRotated struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockMarkRefreshTokenRotatedExpectation
+	expectations       []*AuthRepositoryMockMarkRefreshTokenRotatedExpectation
+
+	callArgs []*AuthRepositoryMockMarkRefreshTokenRotatedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockMarkRefreshTokenRotatedExpectation specifies expectation struct of the AuthRepository.MarkRefreshTokenRotated
+type AuthRepositoryMockMarkRefreshTokenRotatedExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockMarkRefreshTokenRotatedParams
+	paramPtrs          *AuthRepositoryMockMarkRefreshTokenRotatedParamPtrs
+	expectationOrigins AuthRepositoryMockMarkRefreshTokenRotatedExpectationOrigins
+	results            *AuthRepositoryMockMarkRefreshTokenRotatedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockMarkRefreshTokenRotatedParams contains parameters of the AuthRepository.MarkRefreshTokenRotated
+type AuthRepositoryMockMarkRefreshTokenRotatedParams struct {
+	ctx context.Context
+	id  string
+}
+
+// AuthRepositoryMockMarkRefreshTokenRotatedParamPtrs contains pointers to parameters of the AuthRepository.MarkRefreshTokenRotated
+type AuthRepositoryMockMarkRefreshTokenRotatedParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// AuthRepositoryMockMarkRefreshTokenRotatedResults contains results of the AuthRepository.MarkRefreshTokenRotated
+type AuthRepositoryMockMarkRefreshTokenRotatedResults struct {
+	b1  bool
+	err error
+}
+
+// AuthRepositoryMockMarkRefreshTokenRotatedOrigins contains origins of expectations of the AuthRepository.MarkRefreshTokenRotated
+type AuthRepositoryMockMarkRefreshTokenRotatedExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRefreshTokenRotated *mAuthRepositoryMockMarkRefreshTokenRotated) Optional() *mAuthRepositoryMockMarkRefreshTokenRotated {
+	mmMarkRefreshTokenRotated.optional = true
+	return mmMarkRefreshTokenRotated
+}
+
+// Expect sets up expected params for AuthRepository.MarkRefreshTokenRotated
+func (mmMarkRefreshTokenRotated *mAuthRepositoryMockMarkRefreshTokenRotated) Expect(ctx context.Context, id string) *mAuthRepositoryMockMarkRefreshTokenRotated {
+	if mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("AuthRepositoryMock.MarkRefreshTokenRotated mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation = &AuthRepositoryMockMarkRefreshTokenRotatedExpectation{}
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("AuthRepositoryMock.MarkRefreshTokenRotated mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRefreshTokenRotated.defaultExpectation.params = &AuthRepositoryMockMarkRefreshTokenRotatedParams{ctx, id}
+	mmMarkRefreshTokenRotated.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkRefreshTokenRotated.expectations {
+		if minimock.Equal(e.params, mmMarkRefreshTokenRotated.defaultExpectation.params) {
+			mmMarkRefreshTokenRotated.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRefreshTokenRotated.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRefreshTokenRotated
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.MarkRefreshTokenRotated
+func (mmMarkRefreshTokenRotated *mAuthRepositoryMockMarkRefreshTokenRotated) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockMarkRefreshTokenRotated {
+	if mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("AuthRepositoryMock.MarkRefreshTokenRotated mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation = &AuthRepositoryMockMarkRefreshTokenRotatedExpectation{}
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.params != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("AuthRepositoryMock.MarkRefreshTokenRotated mock is already set by Expect")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs = &AuthRepositoryMockMarkRefreshTokenRotatedParamPtrs{}
+	}
+	mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkRefreshTokenRotated.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkRefreshTokenRotated
+}
+
+// ExpectIdParam2 sets up expected param id for AuthRepository.MarkRefreshTokenRotated
+func (mmMarkRefreshTokenRotated *mAuthRepositoryMockMarkRefreshTokenRotated) ExpectIdParam2(id string) *mAuthRepositoryMockMarkRefreshTokenRotated {
+	if mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("AuthRepositoryMock.MarkRefreshTokenRotated mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation = &AuthRepositoryMockMarkRefreshTokenRotatedExpectation{}
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.params != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("AuthRepositoryMock.MarkRefreshTokenRotated mock is already set by Expect")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs = &AuthRepositoryMockMarkRefreshTokenRotatedParamPtrs{}
+	}
+	mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs.id = &id
+	mmMarkRefreshTokenRotated.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkRefreshTokenRotated
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.MarkRefreshTokenRotated
+func (mmMarkRefreshTokenRotated *mAuthRepositoryMockMarkRefreshTokenRotated) Inspect(f func(ctx context.Context, id string)) *mAuthRepositoryMockMarkRefreshTokenRotated {
+	if mmMarkRefreshTokenRotated.mock.inspectFuncMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.MarkRefreshTokenRotated")
+	}
+
+	mmMarkRefreshTokenRotated.mock.inspectFuncMarkRefreshTokenRotated = f
+
+	return mmMarkRefreshTokenRotated
+}
+
+// Return sets up results that will be returned by AuthRepository.MarkRefreshTokenRotated
+func (mmMarkRefreshTokenRotated *mAuthRepositoryMockMarkRefreshTokenRotated) Return(b1 bool, err error) *AuthRepositoryMock {
+	if mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("AuthRepositoryMock.MarkRefreshTokenRotated mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation = &AuthRepositoryMockMarkRefreshTokenRotatedExpectation{mock: mmMarkRefreshTokenRotated.mock}
+	}
+	mmMarkRefreshTokenRotated.defaultExpectation.results = &AuthRepositoryMockMarkRefreshTokenRotatedResults{b1, err}
+	mmMarkRefreshTokenRotated.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkRefreshTokenRotated.mock
+}
+
+// Set uses given function f to mock the AuthRepository.MarkRefreshTokenRotated method
+func (mmMarkRefreshTokenRotated *mAuthRepositoryMockMarkRefreshTokenRotated) Set(f func(ctx context.Context, id string) (b1 bool, err error)) *AuthRepositoryMock {
+	if mmMarkRefreshTokenRotated.defaultExpectation != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("Default expectation is already set for the AuthRepository.MarkRefreshTokenRotated method")
+	}
+
+	if len(mmMarkRefreshTokenRotated.expectations) > 0 {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("Some expectations are already set for the AuthRepository.MarkRefreshTokenRotated method")
+	}
+
+	mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated = f
+	mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotatedOrigin = minimock.CallerInfo(1)
+	return mmMarkRefreshTokenRotated.mock
+}
+
+// When sets expectation for the AuthRepository.MarkRefreshTokenRotated which will trigger the result defined by the following
+// Then helper
+func (mmMarkRefreshTokenRotated *mAuthRepositoryMockMarkRefreshTokenRotated) When(ctx context.Context, id string) *AuthRepositoryMockMarkRefreshTokenRotatedExpectation {
+	if mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("AuthRepositoryMock.MarkRefreshTokenRotated mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockMarkRefreshTokenRotatedExpectation{
+		mock:               mmMarkRefreshTokenRotated.mock,
+		params:             &AuthRepositoryMockMarkRefreshTokenRotatedParams{ctx, id},
+		expectationOrigins: AuthRepositoryMockMarkRefreshTokenRotatedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkRefreshTokenRotated.expectations = append(mmMarkRefreshTokenRotated.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.MarkRefreshTokenRotated return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockMarkRefreshTokenRotatedExpectation) Then(b1 bool, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockMarkRefreshTokenRotatedResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.MarkRefreshTokenRotated should be invoked
+func (mmMarkRefreshTokenRotated *mAuthRepositoryMockMarkRefreshTokenRotated) Times(n uint64) *mAuthRepositoryMockMarkRefreshTokenRotated {
+	if n == 0 {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("Times of AuthRepositoryMock.MarkRefreshTokenRotated mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRefreshTokenRotated.expectedInvocations, n)
+	mmMarkRefreshTokenRotated.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkRefreshTokenRotated
+}
+
+func (mmMarkRefreshTokenRotated *mAuthRepositoryMockMarkRefreshTokenRotated) invocationsDone() bool {
+	if len(mmMarkRefreshTokenRotated.expectations) == 0 && mmMarkRefreshTokenRotated.defaultExpectation == nil && mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRefreshTokenRotated.mock.afterMarkRefreshTokenRotatedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRefreshTokenRotated.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRefreshTokenRotated implements mm_repository.AuthRepository
+func (mmMarkRefreshTokenRotated *AuthRepositoryMock) MarkRefreshTokenRotated(ctx context.Context, id string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmMarkRefreshTokenRotated.beforeMarkRefreshTokenRotatedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRefreshTokenRotated.afterMarkRefreshTokenRotatedCounter, 1)
+
+	mmMarkRefreshTokenRotated.t.Helper()
+
+	if mmMarkRefreshTokenRotated.inspectFuncMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.inspectFuncMarkRefreshTokenRotated(ctx, id)
+	}
+
+	mm_params := AuthRepositoryMockMarkRefreshTokenRotatedParams{ctx, id}
+
+	// Record call args
+	mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.mutex.Lock()
+	mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.callArgs = append(mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.callArgs, &mm_params)
+	mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.mutex.Unlock()
+
+	for _, e := range mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockMarkRefreshTokenRotatedParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRefreshTokenRotated.t.Errorf("AuthRepositoryMock.MarkRefreshTokenRotated got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkRefreshTokenRotated.t.Errorf("AuthRepositoryMock.MarkRefreshTokenRotated got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRefreshTokenRotated.t.Errorf("AuthRepositoryMock.MarkRefreshTokenRotated got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRefreshTokenRotated.t.Fatal("No results are set for the AuthRepositoryMock.MarkRefreshTokenRotated")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmMarkRefreshTokenRotated.funcMarkRefreshTokenRotated != nil {
+		return mmMarkRefreshTokenRotated.funcMarkRefreshTokenRotated(ctx, id)
+	}
+	mmMarkRefreshTokenRotated.t.Fatalf("Unexpected call to AuthRepositoryMock.MarkRefreshTokenRotated. %v %v", ctx, id)
+	return
+}
+
+// MarkRefreshTokenRotatedAfterCounter returns a count of finished AuthRepositoryMock.MarkRefreshTokenRotated invocations
+func (mmMarkRefreshTokenRotated *AuthRepositoryMock) MarkRefreshTokenRotatedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRefreshTokenRotated.afterMarkRefreshTokenRotatedCounter)
+}
+
+// MarkRefreshTokenRotatedBeforeCounter returns a count of AuthRepositoryMock.MarkRefreshTokenRotated invocations
+func (mmMarkRefreshTokenRotated *AuthRepositoryMock) MarkRefreshTokenRotatedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRefreshTokenRotated.beforeMarkRefreshTokenRotatedCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.MarkRefreshTokenRotated.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRefreshTokenRotated *mAuthRepositoryMockMarkRefreshTokenRotated) Calls() []*AuthRepositoryMockMarkRefreshTokenRotatedParams {
+	mmMarkRefreshTokenRotated.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockMarkRefreshTokenRotatedParams, len(mmMarkRefreshTokenRotated.callArgs))
+	copy(argCopy, mmMarkRefreshTokenRotated.callArgs)
+
+	mmMarkRefreshTokenRotated.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkRefreshTokenRotatedDone returns true if the count of the MarkRefreshTokenRotated invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockMarkRefreshTokenRotatedDone() bool {
+	if m.MarkRefreshTokenRotatedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkRefreshTokenRotatedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkRefreshTokenRotatedMock.invocationsDone()
+}
+
+// MinimockMarkRefreshTokenRotatedInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockMarkRefreshTokenRotatedInspect() {
+	for _, e := range m.MarkRefreshTokenRotatedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.MarkRefreshTokenRotated at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkRefreshTokenRotatedCounter := mm_atomic.LoadUint64(&m.afterMarkRefreshTokenRotatedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkRefreshTokenRotatedMock.defaultExpectation != nil && afterMarkRefreshTokenRotatedCounter < 1 {
+		if m.MarkRefreshTokenRotatedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.MarkRefreshTokenRotated at\n%s", m.MarkRefreshTokenRotatedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.MarkRefreshTokenRotated at\n%s with params: %#v", m.MarkRefreshTokenRotatedMock.defaultExpectation.expectationOrigins.origin, *m.MarkRefreshTokenRotatedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRefreshTokenRotated != nil && afterMarkRefreshTokenRotatedCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.MarkRefreshTokenRotated at\n%s", m.funcMarkRefreshTokenRotatedOrigin)
+	}
+
+	if !m.MarkRefreshTokenRotatedMock.invocationsDone() && afterMarkRefreshTokenRotatedCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.MarkRefreshTokenRotated at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkRefreshTokenRotatedMock.expectedInvocations), m.MarkRefreshTokenRotatedMock.expectedInvocationsOrigin, afterMarkRefreshTokenRotatedCounter)
+	}
+}
+
+type mAuthRepositoryMockReplaceRecoveryCodes struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockReplaceRecoveryCodesExpectation
+	expectations       []*AuthRepositoryMockReplaceRecoveryCodesExpectation
+
+	callArgs []*AuthRepositoryMockReplaceRecoveryCodesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockReplaceRecoveryCodesExpectation specifies expectation struct of the AuthRepository.ReplaceRecoveryCodes
+type AuthRepositoryMockReplaceRecoveryCodesExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockReplaceRecoveryCodesParams
+	paramPtrs          *AuthRepositoryMockReplaceRecoveryCodesParamPtrs
+	expectationOrigins AuthRepositoryMockReplaceRecoveryCodesExpectationOrigins
+	results            *AuthRepositoryMockReplaceRecoveryCodesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockReplaceRecoveryCodesParams contains parameters of the AuthRepository.ReplaceRecoveryCodes
+type AuthRepositoryMockReplaceRecoveryCodesParams struct {
+	ctx        context.Context
+	userID     int64
+	codeHashes []string
+}
+
+// AuthRepositoryMockReplaceRecoveryCodesParamPtrs contains pointers to parameters of the AuthRepository.ReplaceRecoveryCodes
+type AuthRepositoryMockReplaceRecoveryCodesParamPtrs struct {
+	ctx        *context.Context
+	userID     *int64
+	codeHashes *[]string
+}
+
+// AuthRepositoryMockReplaceRecoveryCodesResults contains results of the AuthRepository.ReplaceRecoveryCodes
+type AuthRepositoryMockReplaceRecoveryCodesResults struct {
+	err error
+}
+
+// AuthRepositoryMockReplaceRecoveryCodesOrigins contains origins of expectations of the AuthRepository.ReplaceRecoveryCodes
+type AuthRepositoryMockReplaceRecoveryCodesExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originCodeHashes string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) Optional() *mAuthRepositoryMockReplaceRecoveryCodes {
+	mmReplaceRecoveryCodes.optional = true
+	return mmReplaceRecoveryCodes
+}
+
+// Expect sets up expected params for AuthRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) Expect(ctx context.Context, userID int64, codeHashes []string) *mAuthRepositoryMockReplaceRecoveryCodes {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("AuthRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation == nil {
+		mmReplaceRecoveryCodes.defaultExpectation = &AuthRepositoryMockReplaceRecoveryCodesExpectation{}
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.paramPtrs != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("AuthRepositoryMock.ReplaceRecoveryCodes mock is already set by ExpectParams functions")
+	}
+
+	mmReplaceRecoveryCodes.defaultExpectation.params = &AuthRepositoryMockReplaceRecoveryCodesParams{ctx, userID, codeHashes}
+	mmReplaceRecoveryCodes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReplaceRecoveryCodes.expectations {
+		if minimock.Equal(e.params, mmReplaceRecoveryCodes.defaultExpectation.params) {
+			mmReplaceRecoveryCodes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReplaceRecoveryCodes.defaultExpectation.params)
+		}
+	}
+
+	return mmReplaceRecoveryCodes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockReplaceRecoveryCodes {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("AuthRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation == nil {
+		mmReplaceRecoveryCodes.defaultExpectation = &AuthRepositoryMockReplaceRecoveryCodesExpectation{}
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.params != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("AuthRepositoryMock.ReplaceRecoveryCodes mock is already set by Expect")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.paramPtrs == nil {
+		mmReplaceRecoveryCodes.defaultExpectation.paramPtrs = &AuthRepositoryMockReplaceRecoveryCodesParamPtrs{}
+	}
+	mmReplaceRecoveryCodes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReplaceRecoveryCodes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReplaceRecoveryCodes
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) ExpectUserIDParam2(userID int64) *mAuthRepositoryMockReplaceRecoveryCodes {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("AuthRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation == nil {
+		mmReplaceRecoveryCodes.defaultExpectation = &AuthRepositoryMockReplaceRecoveryCodesExpectation{}
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.params != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("AuthRepositoryMock.ReplaceRecoveryCodes mock is already set by Expect")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.paramPtrs == nil {
+		mmReplaceRecoveryCodes.defaultExpectation.paramPtrs = &AuthRepositoryMockReplaceRecoveryCodesParamPtrs{}
+	}
+	mmReplaceRecoveryCodes.defaultExpectation.paramPtrs.userID = &userID
+	mmReplaceRecoveryCodes.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmReplaceRecoveryCodes
+}
+
+// ExpectCodeHashesParam3 sets up expected param codeHashes for AuthRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) ExpectCodeHashesParam3(codeHashes []string) *mAuthRepositoryMockReplaceRecoveryCodes {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("AuthRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation == nil {
+		mmReplaceRecoveryCodes.defaultExpectation = &AuthRepositoryMockReplaceRecoveryCodesExpectation{}
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.params != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("AuthRepositoryMock.ReplaceRecoveryCodes mock is already set by Expect")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation.paramPtrs == nil {
+		mmReplaceRecoveryCodes.defaultExpectation.paramPtrs = &AuthRepositoryMockReplaceRecoveryCodesParamPtrs{}
+	}
+	mmReplaceRecoveryCodes.defaultExpectation.paramPtrs.codeHashes = &codeHashes
+	mmReplaceRecoveryCodes.defaultExpectation.expectationOrigins.originCodeHashes = minimock.CallerInfo(1)
+
+	return mmReplaceRecoveryCodes
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) Inspect(f func(ctx context.Context, userID int64, codeHashes []string)) *mAuthRepositoryMockReplaceRecoveryCodes {
+	if mmReplaceRecoveryCodes.mock.inspectFuncReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.ReplaceRecoveryCodes")
+	}
+
+	mmReplaceRecoveryCodes.mock.inspectFuncReplaceRecoveryCodes = f
+
+	return mmReplaceRecoveryCodes
+}
+
+// Return sets up results that will be returned by AuthRepository.ReplaceRecoveryCodes
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) Return(err error) *AuthRepositoryMock {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("AuthRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	if mmReplaceRecoveryCodes.defaultExpectation == nil {
+		mmReplaceRecoveryCodes.defaultExpectation = &AuthRepositoryMockReplaceRecoveryCodesExpectation{mock: mmReplaceRecoveryCodes.mock}
+	}
+	mmReplaceRecoveryCodes.defaultExpectation.results = &AuthRepositoryMockReplaceRecoveryCodesResults{err}
+	mmReplaceRecoveryCodes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReplaceRecoveryCodes.mock
+}
+
+// Set uses given function f to mock the AuthRepository.ReplaceRecoveryCodes method
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) Set(f func(ctx context.Context, userID int64, codeHashes []string) (err error)) *AuthRepositoryMock {
+	if mmReplaceRecoveryCodes.defaultExpectation != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("Default expectation is already set for the AuthRepository.ReplaceRecoveryCodes method")
+	}
+
+	if len(mmReplaceRecoveryCodes.expectations) > 0 {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("Some expectations are already set for the AuthRepository.ReplaceRecoveryCodes method")
+	}
+
+	mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes = f
+	mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodesOrigin = minimock.CallerInfo(1)
+	return mmReplaceRecoveryCodes.mock
+}
+
+// When sets expectation for the AuthRepository.ReplaceRecoveryCodes which will trigger the result defined by the following
+// Then helper
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) When(ctx context.Context, userID int64, codeHashes []string) *AuthRepositoryMockReplaceRecoveryCodesExpectation {
+	if mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("AuthRepositoryMock.ReplaceRecoveryCodes mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockReplaceRecoveryCodesExpectation{
+		mock:               mmReplaceRecoveryCodes.mock,
+		params:             &AuthRepositoryMockReplaceRecoveryCodesParams{ctx, userID, codeHashes},
+		expectationOrigins: AuthRepositoryMockReplaceRecoveryCodesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReplaceRecoveryCodes.expectations = append(mmReplaceRecoveryCodes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.ReplaceRecoveryCodes return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockReplaceRecoveryCodesExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockReplaceRecoveryCodesResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.ReplaceRecoveryCodes should be invoked
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) Times(n uint64) *mAuthRepositoryMockReplaceRecoveryCodes {
+	if n == 0 {
+		mmReplaceRecoveryCodes.mock.t.Fatalf("Times of AuthRepositoryMock.ReplaceRecoveryCodes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReplaceRecoveryCodes.expectedInvocations, n)
+	mmReplaceRecoveryCodes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReplaceRecoveryCodes
+}
+
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) invocationsDone() bool {
+	if len(mmReplaceRecoveryCodes.expectations) == 0 && mmReplaceRecoveryCodes.defaultExpectation == nil && mmReplaceRecoveryCodes.mock.funcReplaceRecoveryCodes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReplaceRecoveryCodes.mock.afterReplaceRecoveryCodesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReplaceRecoveryCodes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReplaceRecoveryCodes implements mm_repository.AuthRepository
+func (mmReplaceRecoveryCodes *AuthRepositoryMock) ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) (err error) {
+	mm_atomic.AddUint64(&mmReplaceRecoveryCodes.beforeReplaceRecoveryCodesCounter, 1)
+	defer mm_atomic.AddUint64(&mmReplaceRecoveryCodes.afterReplaceRecoveryCodesCounter, 1)
+
+	mmReplaceRecoveryCodes.t.Helper()
+
+	if mmReplaceRecoveryCodes.inspectFuncReplaceRecoveryCodes != nil {
+		mmReplaceRecoveryCodes.inspectFuncReplaceRecoveryCodes(ctx, userID, codeHashes)
+	}
+
+	mm_params := AuthRepositoryMockReplaceRecoveryCodesParams{ctx, userID, codeHashes}
+
+	// Record call args
+	mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.mutex.Lock()
+	mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.callArgs = append(mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.callArgs, &mm_params)
+	mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.mutex.Unlock()
+
+	for _, e := range mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.Counter, 1)
+		mm_want := mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.params
+		mm_want_ptrs := mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockReplaceRecoveryCodesParams{ctx, userID, codeHashes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReplaceRecoveryCodes.t.Errorf("AuthRepositoryMock.ReplaceRecoveryCodes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReplaceRecoveryCodes.t.Errorf("AuthRepositoryMock.ReplaceRecoveryCodes got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.codeHashes != nil && !minimock.Equal(*mm_want_ptrs.codeHashes, mm_got.codeHashes) {
+				mmReplaceRecoveryCodes.t.Errorf("AuthRepositoryMock.ReplaceRecoveryCodes got unexpected parameter codeHashes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.expectationOrigins.originCodeHashes, *mm_want_ptrs.codeHashes, mm_got.codeHashes, minimock.Diff(*mm_want_ptrs.codeHashes, mm_got.codeHashes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReplaceRecoveryCodes.t.Errorf("AuthRepositoryMock.ReplaceRecoveryCodes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReplaceRecoveryCodes.ReplaceRecoveryCodesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReplaceRecoveryCodes.t.Fatal("No results are set for the AuthRepositoryMock.ReplaceRecoveryCodes")
+		}
+		return (*mm_results).err
+	}
+	if mmReplaceRecoveryCodes.funcReplaceRecoveryCodes != nil {
+		return mmReplaceRecoveryCodes.funcReplaceRecoveryCodes(ctx, userID, codeHashes)
+	}
+	mmReplaceRecoveryCodes.t.Fatalf("Unexpected call to AuthRepositoryMock.ReplaceRecoveryCodes. %v %v %v", ctx, userID, codeHashes)
+	return
+}
+
+// ReplaceRecoveryCodesAfterCounter returns a count of finished AuthRepositoryMock.ReplaceRecoveryCodes invocations
+func (mmReplaceRecoveryCodes *AuthRepositoryMock) ReplaceRecoveryCodesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplaceRecoveryCodes.afterReplaceRecoveryCodesCounter)
+}
+
+// ReplaceRecoveryCodesBeforeCounter returns a count of AuthRepositoryMock.ReplaceRecoveryCodes invocations
+func (mmReplaceRecoveryCodes *AuthRepositoryMock) ReplaceRecoveryCodesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplaceRecoveryCodes.beforeReplaceRecoveryCodesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.ReplaceRecoveryCodes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReplaceRecoveryCodes *mAuthRepositoryMockReplaceRecoveryCodes) Calls() []*AuthRepositoryMockReplaceRecoveryCodesParams {
+	mmReplaceRecoveryCodes.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockReplaceRecoveryCodesParams, len(mmReplaceRecoveryCodes.callArgs))
+	copy(argCopy, mmReplaceRecoveryCodes.callArgs)
+
+	mmReplaceRecoveryCodes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReplaceRecoveryCodesDone returns true if the count of the ReplaceRecoveryCodes invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockReplaceRecoveryCodesDone() bool {
+	if m.ReplaceRecoveryCodesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReplaceRecoveryCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReplaceRecoveryCodesMock.invocationsDone()
+}
+
+// MinimockReplaceRecoveryCodesInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockReplaceRecoveryCodesInspect() {
+	for _, e := range m.ReplaceRecoveryCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.ReplaceRecoveryCodes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReplaceRecoveryCodesCounter := mm_atomic.LoadUint64(&m.afterReplaceRecoveryCodesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReplaceRecoveryCodesMock.defaultExpectation != nil && afterReplaceRecoveryCodesCounter < 1 {
+		if m.ReplaceRecoveryCodesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.ReplaceRecoveryCodes at\n%s", m.ReplaceRecoveryCodesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.ReplaceRecoveryCodes at\n%s with params: %#v", m.ReplaceRecoveryCodesMock.defaultExpectation.expectationOrigins.origin, *m.ReplaceRecoveryCodesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReplaceRecoveryCodes != nil && afterReplaceRecoveryCodesCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.ReplaceRecoveryCodes at\n%s", m.funcReplaceRecoveryCodesOrigin)
+	}
+
+	if !m.ReplaceRecoveryCodesMock.invocationsDone() && afterReplaceRecoveryCodesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.ReplaceRecoveryCodes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReplaceRecoveryCodesMock.expectedInvocations), m.ReplaceRecoveryCodesMock.expectedInvocationsOrigin, afterReplaceRecoveryCodesCounter)
+	}
+}
+
+type mAuthRepositoryMockRevokeRefreshTokenFamily struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockRevokeRefreshTokenFamilyExpectation
+	expectations       []*AuthRepositoryMockRevokeRefreshTokenFamilyExpectation
+
+	callArgs []*AuthRepositoryMockRevokeRefreshTokenFamilyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockRevokeRefreshTokenFamilyExpectation specifies expectation struct of the AuthRepository.RevokeRefreshTokenFamily
+type AuthRepositoryMockRevokeRefreshTokenFamilyExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockRevokeRefreshTokenFamilyParams
+	paramPtrs          *AuthRepositoryMockRevokeRefreshTokenFamilyParamPtrs
+	expectationOrigins AuthRepositoryMockRevokeRefreshTokenFamilyExpectationOrigins
+	results            *AuthRepositoryMockRevokeRefreshTokenFamilyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockRevokeRefreshTokenFamilyParams contains parameters of the AuthRepository.RevokeRefreshTokenFamily
+type AuthRepositoryMockRevokeRefreshTokenFamilyParams struct {
+	ctx      context.Context
+	familyID string
+}
+
+// AuthRepositoryMockRevokeRefreshTokenFamilyParamPtrs contains pointers to parameters of the AuthRepository.RevokeRefreshTokenFamily
+type AuthRepositoryMockRevokeRefreshTokenFamilyParamPtrs struct {
+	ctx      *context.Context
+	familyID *string
+}
+
+// AuthRepositoryMockRevokeRefreshTokenFamilyResults contains results of the AuthRepository.RevokeRefreshTokenFamily
+type AuthRepositoryMockRevokeRefreshTokenFamilyResults struct {
+	err error
+}
+
+// AuthRepositoryMockRevokeRefreshTokenFamilyOrigins contains origins of expectations of the AuthRepository.RevokeRefreshTokenFamily
+type AuthRepositoryMockRevokeRefreshTokenFamilyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originFamilyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeRefreshTokenFamily *mAuthRepositoryMockRevokeRefreshTokenFamily) Optional() *mAuthRepositoryMockRevokeRefreshTokenFamily {
+	mmRevokeRefreshTokenFamily.optional = true
+	return mmRevokeRefreshTokenFamily
+}
+
+// Expect sets up expected params for AuthRepository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mAuthRepositoryMockRevokeRefreshTokenFamily) Expect(ctx context.Context, familyID string) *mAuthRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("AuthRepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &AuthRepositoryMockRevokeRefreshTokenFamilyExpectation{}
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("AuthRepositoryMock.RevokeRefreshTokenFamily mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeRefreshTokenFamily.defaultExpectation.params = &AuthRepositoryMockRevokeRefreshTokenFamilyParams{ctx, familyID}
+	mmRevokeRefreshTokenFamily.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeRefreshTokenFamily.expectations {
+		if minimock.Equal(e.params, mmRevokeRefreshTokenFamily.defaultExpectation.params) {
+			mmRevokeRefreshTokenFamily.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeRefreshTokenFamily.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mAuthRepositoryMockRevokeRefreshTokenFamily) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("AuthRepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &AuthRepositoryMockRevokeRefreshTokenFamilyExpectation{}
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.params != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("AuthRepositoryMock.RevokeRefreshTokenFamily mock is already set by Expect")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs = &AuthRepositoryMockRevokeRefreshTokenFamilyParamPtrs{}
+	}
+	mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeRefreshTokenFamily.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// ExpectFamilyIDParam2 sets up expected param familyID for AuthRepository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mAuthRepositoryMockRevokeRefreshTokenFamily) ExpectFamilyIDParam2(familyID string) *mAuthRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("AuthRepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &AuthRepositoryMockRevokeRefreshTokenFamilyExpectation{}
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.params != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("AuthRepositoryMock.RevokeRefreshTokenFamily mock is already set by Expect")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs = &AuthRepositoryMockRevokeRefreshTokenFamilyParamPtrs{}
+	}
+	mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs.familyID = &familyID
+	mmRevokeRefreshTokenFamily.defaultExpectation.expectationOrigins.originFamilyID = minimock.CallerInfo(1)
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mAuthRepositoryMockRevokeRefreshTokenFamily) Inspect(f func(ctx context.Context, familyID string)) *mAuthRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.inspectFuncRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.RevokeRefreshTokenFamily")
+	}
+
+	mmRevokeRefreshTokenFamily.mock.inspectFuncRevokeRefreshTokenFamily = f
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// Return sets up results that will be returned by AuthRepository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mAuthRepositoryMockRevokeRefreshTokenFamily) Return(err error) *AuthRepositoryMock {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("AuthRepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &AuthRepositoryMockRevokeRefreshTokenFamilyExpectation{mock: mmRevokeRefreshTokenFamily.mock}
+	}
+	mmRevokeRefreshTokenFamily.defaultExpectation.results = &AuthRepositoryMockRevokeRefreshTokenFamilyResults{err}
+	mmRevokeRefreshTokenFamily.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeRefreshTokenFamily.mock
+}
+
+// Set uses given function f to mock the AuthRepository.RevokeRefreshTokenFamily method
+func (mmRevokeRefreshTokenFamily *mAuthRepositoryMockRevokeRefreshTokenFamily) Set(f func(ctx context.Context, familyID string) (err error)) *AuthRepositoryMock {
+	if mmRevokeRefreshTokenFamily.defaultExpectation != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Default expectation is already set for the AuthRepository.RevokeRefreshTokenFamily method")
+	}
+
+	if len(mmRevokeRefreshTokenFamily.expectations) > 0 {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Some expectations are already set for the AuthRepository.RevokeRefreshTokenFamily method")
+	}
+
+	mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily = f
+	mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamilyOrigin = minimock.CallerInfo(1)
+	return mmRevokeRefreshTokenFamily.mock
+}
+
+// When sets expectation for the AuthRepository.RevokeRefreshTokenFamily which will trigger the result defined by the following
+// Then helper
+func (mmRevokeRefreshTokenFamily *mAuthRepositoryMockRevokeRefreshTokenFamily) When(ctx context.Context, familyID string) *AuthRepositoryMockRevokeRefreshTokenFamilyExpectation {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("AuthRepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockRevokeRefreshTokenFamilyExpectation{
+		mock:               mmRevokeRefreshTokenFamily.mock,
+		params:             &AuthRepositoryMockRevokeRefreshTokenFamilyParams{ctx, familyID},
+		expectationOrigins: AuthRepositoryMockRevokeRefreshTokenFamilyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeRefreshTokenFamily.expectations = append(mmRevokeRefreshTokenFamily.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.RevokeRefreshTokenFamily return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockRevokeRefreshTokenFamilyExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockRevokeRefreshTokenFamilyResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.RevokeRefreshTokenFamily should be invoked
+func (mmRevokeRefreshTokenFamily *mAuthRepositoryMockRevokeRefreshTokenFamily) Times(n uint64) *mAuthRepositoryMockRevokeRefreshTokenFamily {
+	if n == 0 {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Times of AuthRepositoryMock.RevokeRefreshTokenFamily mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeRefreshTokenFamily.expectedInvocations, n)
+	mmRevokeRefreshTokenFamily.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeRefreshTokenFamily
+}
+
+func (mmRevokeRefreshTokenFamily *mAuthRepositoryMockRevokeRefreshTokenFamily) invocationsDone() bool {
+	if len(mmRevokeRefreshTokenFamily.expectations) == 0 && mmRevokeRefreshTokenFamily.defaultExpectation == nil && mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.mock.afterRevokeRefreshTokenFamilyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeRefreshTokenFamily implements mm_repository.AuthRepository
+func (mmRevokeRefreshTokenFamily *AuthRepositoryMock) RevokeRefreshTokenFamily(ctx context.Context, familyID string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeRefreshTokenFamily.beforeRevokeRefreshTokenFamilyCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeRefreshTokenFamily.afterRevokeRefreshTokenFamilyCounter, 1)
+
+	mmRevokeRefreshTokenFamily.t.Helper()
+
+	if mmRevokeRefreshTokenFamily.inspectFuncRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.inspectFuncRevokeRefreshTokenFamily(ctx, familyID)
+	}
+
+	mm_params := AuthRepositoryMockRevokeRefreshTokenFamilyParams{ctx, familyID}
+
+	// Record call args
+	mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.mutex.Lock()
+	mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.callArgs = append(mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.callArgs, &mm_params)
+	mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.mutex.Unlock()
+
+	for _, e := range mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockRevokeRefreshTokenFamilyParams{ctx, familyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeRefreshTokenFamily.t.Errorf("AuthRepositoryMock.RevokeRefreshTokenFamily got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.familyID != nil && !minimock.Equal(*mm_want_ptrs.familyID, mm_got.familyID) {
+				mmRevokeRefreshTokenFamily.t.Errorf("AuthRepositoryMock.RevokeRefreshTokenFamily got unexpected parameter familyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.expectationOrigins.originFamilyID, *mm_want_ptrs.familyID, mm_got.familyID, minimock.Diff(*mm_want_ptrs.familyID, mm_got.familyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeRefreshTokenFamily.t.Errorf("AuthRepositoryMock.RevokeRefreshTokenFamily got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeRefreshTokenFamily.t.Fatal("No results are set for the AuthRepositoryMock.RevokeRefreshTokenFamily")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeRefreshTokenFamily.funcRevokeRefreshTokenFamily != nil {
+		return mmRevokeRefreshTokenFamily.funcRevokeRefreshTokenFamily(ctx, familyID)
+	}
+	mmRevokeRefreshTokenFamily.t.Fatalf("Unexpected call to AuthRepositoryMock.RevokeRefreshTokenFamily. %v %v", ctx, familyID)
+	return
+}
+
+// RevokeRefreshTokenFamilyAfterCounter returns a count of finished AuthRepositoryMock.RevokeRefreshTokenFamily invocations
+func (mmRevokeRefreshTokenFamily *AuthRepositoryMock) RevokeRefreshTokenFamilyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.afterRevokeRefreshTokenFamilyCounter)
+}
+
+// RevokeRefreshTokenFamilyBeforeCounter returns a count of AuthRepositoryMock.RevokeRefreshTokenFamily invocations
+func (mmRevokeRefreshTokenFamily *AuthRepositoryMock) RevokeRefreshTokenFamilyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.beforeRevokeRefreshTokenFamilyCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.RevokeRefreshTokenFamily.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeRefreshTokenFamily *mAuthRepositoryMockRevokeRefreshTokenFamily) Calls() []*AuthRepositoryMockRevokeRefreshTokenFamilyParams {
+	mmRevokeRefreshTokenFamily.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockRevokeRefreshTokenFamilyParams, len(mmRevokeRefreshTokenFamily.callArgs))
+	copy(argCopy, mmRevokeRefreshTokenFamily.callArgs)
+
+	mmRevokeRefreshTokenFamily.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeRefreshTokenFamilyDone returns true if the count of the RevokeRefreshTokenFamily invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockRevokeRefreshTokenFamilyDone() bool {
+	if m.RevokeRefreshTokenFamilyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeRefreshTokenFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeRefreshTokenFamilyMock.invocationsDone()
+}
+
+// MinimockRevokeRefreshTokenFamilyInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockRevokeRefreshTokenFamilyInspect() {
+	for _, e := range m.RevokeRefreshTokenFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.RevokeRefreshTokenFamily at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeRefreshTokenFamilyCounter := mm_atomic.LoadUint64(&m.afterRevokeRefreshTokenFamilyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeRefreshTokenFamilyMock.defaultExpectation != nil && afterRevokeRefreshTokenFamilyCounter < 1 {
+		if m.RevokeRefreshTokenFamilyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.RevokeRefreshTokenFamily at\n%s", m.RevokeRefreshTokenFamilyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.RevokeRefreshTokenFamily at\n%s with params: %#v", m.RevokeRefreshTokenFamilyMock.defaultExpectation.expectationOrigins.origin, *m.RevokeRefreshTokenFamilyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeRefreshTokenFamily != nil && afterRevokeRefreshTokenFamilyCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.RevokeRefreshTokenFamily at\n%s", m.funcRevokeRefreshTokenFamilyOrigin)
+	}
+
+	if !m.RevokeRefreshTokenFamilyMock.invocationsDone() && afterRevokeRefreshTokenFamilyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.RevokeRefreshTokenFamily at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeRefreshTokenFamilyMock.expectedInvocations), m.RevokeRefreshTokenFamilyMock.expectedInvocationsOrigin, afterRevokeRefreshTokenFamilyCounter)
+	}
+}
+
+type mAuthRepositoryMockRevokeUserRefreshTokens struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockRevokeUserRefreshTokensExpectation
+	expectations       []*AuthRepositoryMockRevokeUserRefreshTokensExpectation
+
+	callArgs []*AuthRepositoryMockRevokeUserRefreshTokensParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockRevokeUserRefreshTokensExpectation specifies expectation struct of the AuthRepository.RevokeUserRefreshTokens
+type AuthRepositoryMockRevokeUserRefreshTokensExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockRevokeUserRefreshTokensParams
+	paramPtrs          *AuthRepositoryMockRevokeUserRefreshTokensParamPtrs
+	expectationOrigins AuthRepositoryMockRevokeUserRefreshTokensExpectationOrigins
+	results            *AuthRepositoryMockRevokeUserRefreshTokensResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockRevokeUserRefreshTokensParams contains parameters of the AuthRepository.RevokeUserRefreshTokens
+type AuthRepositoryMockRevokeUserRefreshTokensParams struct {
+	ctx          context.Context
+	username     string
+	keepFamilyID string
+}
+
+// AuthRepositoryMockRevokeUserRefreshTokensParamPtrs contains pointers to parameters of the AuthRepository.RevokeUserRefreshTokens
+type AuthRepositoryMockRevokeUserRefreshTokensParamPtrs struct {
+	ctx          *context.Context
+	username     *string
+	keepFamilyID *string
+}
+
+// AuthRepositoryMockRevokeUserRefreshTokensResults contains results of the AuthRepository.RevokeUserRefreshTokens
+type AuthRepositoryMockRevokeUserRefreshTokensResults struct {
+	sa1 []string
+	err error
+}
+
+// AuthRepositoryMockRevokeUserRefreshTokensOrigins contains origins of expectations of the AuthRepository.RevokeUserRefreshTokens
+type AuthRepositoryMockRevokeUserRefreshTokensExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originUsername     string
+	originKeepFamilyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) Optional() *mAuthRepositoryMockRevokeUserRefreshTokens {
+	mmRevokeUserRefreshTokens.optional = true
+	return mmRevokeUserRefreshTokens
+}
+
+// Expect sets up expected params for AuthRepository.RevokeUserRefreshTokens
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) Expect(ctx context.Context, username string, keepFamilyID string) *mAuthRepositoryMockRevokeUserRefreshTokens {
+	if mmRevokeUserRefreshTokens.mock.funcRevokeUserRefreshTokens != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("AuthRepositoryMock.RevokeUserRefreshTokens mock is already set by Set")
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation == nil {
+		mmRevokeUserRefreshTokens.defaultExpectation = &AuthRepositoryMockRevokeUserRefreshTokensExpectation{}
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation.paramPtrs != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("AuthRepositoryMock.RevokeUserRefreshTokens mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeUserRefreshTokens.defaultExpectation.params = &AuthRepositoryMockRevokeUserRefreshTokensParams{ctx, username, keepFamilyID}
+	mmRevokeUserRefreshTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeUserRefreshTokens.expectations {
+		if minimock.Equal(e.params, mmRevokeUserRefreshTokens.defaultExpectation.params) {
+			mmRevokeUserRefreshTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeUserRefreshTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeUserRefreshTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.RevokeUserRefreshTokens
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockRevokeUserRefreshTokens {
+	if mmRevokeUserRefreshTokens.mock.funcRevokeUserRefreshTokens != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("AuthRepositoryMock.RevokeUserRefreshTokens mock is already set by Set")
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation == nil {
+		mmRevokeUserRefreshTokens.defaultExpectation = &AuthRepositoryMockRevokeUserRefreshTokensExpectation{}
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation.params != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("AuthRepositoryMock.RevokeUserRefreshTokens mock is already set by Expect")
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeUserRefreshTokens.defaultExpectation.paramPtrs = &AuthRepositoryMockRevokeUserRefreshTokensParamPtrs{}
+	}
+	mmRevokeUserRefreshTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeUserRefreshTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeUserRefreshTokens
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthRepository.RevokeUserRefreshTokens
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) ExpectUsernameParam2(username string) *mAuthRepositoryMockRevokeUserRefreshTokens {
+	if mmRevokeUserRefreshTokens.mock.funcRevokeUserRefreshTokens != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("AuthRepositoryMock.RevokeUserRefreshTokens mock is already set by Set")
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation == nil {
+		mmRevokeUserRefreshTokens.defaultExpectation = &AuthRepositoryMockRevokeUserRefreshTokensExpectation{}
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation.params != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("AuthRepositoryMock.RevokeUserRefreshTokens mock is already set by Expect")
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeUserRefreshTokens.defaultExpectation.paramPtrs = &AuthRepositoryMockRevokeUserRefreshTokensParamPtrs{}
+	}
+	mmRevokeUserRefreshTokens.defaultExpectation.paramPtrs.username = &username
+	mmRevokeUserRefreshTokens.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmRevokeUserRefreshTokens
+}
+
+// ExpectKeepFamilyIDParam3 sets up expected param keepFamilyID for AuthRepository.RevokeUserRefreshTokens
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) ExpectKeepFamilyIDParam3(keepFamilyID string) *mAuthRepositoryMockRevokeUserRefreshTokens {
+	if mmRevokeUserRefreshTokens.mock.funcRevokeUserRefreshTokens != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("AuthRepositoryMock.RevokeUserRefreshTokens mock is already set by Set")
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation == nil {
+		mmRevokeUserRefreshTokens.defaultExpectation = &AuthRepositoryMockRevokeUserRefreshTokensExpectation{}
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation.params != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("AuthRepositoryMock.RevokeUserRefreshTokens mock is already set by Expect")
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmRevokeUserRefreshTokens.defaultExpectation.paramPtrs = &AuthRepositoryMockRevokeUserRefreshTokensParamPtrs{}
+	}
+	mmRevokeUserRefreshTokens.defaultExpectation.paramPtrs.keepFamilyID = &keepFamilyID
+	mmRevokeUserRefreshTokens.defaultExpectation.expectationOrigins.originKeepFamilyID = minimock.CallerInfo(1)
+
+	return mmRevokeUserRefreshTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.RevokeUserRefreshTokens
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) Inspect(f func(ctx context.Context, username string, keepFamilyID string)) *mAuthRepositoryMockRevokeUserRefreshTokens {
+	if mmRevokeUserRefreshTokens.mock.inspectFuncRevokeUserRefreshTokens != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.RevokeUserRefreshTokens")
+	}
+
+	mmRevokeUserRefreshTokens.mock.inspectFuncRevokeUserRefreshTokens = f
+
+	return mmRevokeUserRefreshTokens
+}
+
+// Return sets up results that will be returned by AuthRepository.RevokeUserRefreshTokens
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) Return(sa1 []string, err error) *AuthRepositoryMock {
+	if mmRevokeUserRefreshTokens.mock.funcRevokeUserRefreshTokens != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("AuthRepositoryMock.RevokeUserRefreshTokens mock is already set by Set")
+	}
+
+	if mmRevokeUserRefreshTokens.defaultExpectation == nil {
+		mmRevokeUserRefreshTokens.defaultExpectation = &AuthRepositoryMockRevokeUserRefreshTokensExpectation{mock: mmRevokeUserRefreshTokens.mock}
+	}
+	mmRevokeUserRefreshTokens.defaultExpectation.results = &AuthRepositoryMockRevokeUserRefreshTokensResults{sa1, err}
+	mmRevokeUserRefreshTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeUserRefreshTokens.mock
+}
+
+// Set uses given function f to mock the AuthRepository.RevokeUserRefreshTokens method
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) Set(f func(ctx context.Context, username string, keepFamilyID string) (sa1 []string, err error)) *AuthRepositoryMock {
+	if mmRevokeUserRefreshTokens.defaultExpectation != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("Default expectation is already set for the AuthRepository.RevokeUserRefreshTokens method")
+	}
+
+	if len(mmRevokeUserRefreshTokens.expectations) > 0 {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("Some expectations are already set for the AuthRepository.RevokeUserRefreshTokens method")
+	}
+
+	mmRevokeUserRefreshTokens.mock.funcRevokeUserRefreshTokens = f
+	mmRevokeUserRefreshTokens.mock.funcRevokeUserRefreshTokensOrigin = minimock.CallerInfo(1)
+	return mmRevokeUserRefreshTokens.mock
+}
+
+// When sets expectation for the AuthRepository.RevokeUserRefreshTokens which will trigger the result defined by the following
+// Then helper
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) When(ctx context.Context, username string, keepFamilyID string) *AuthRepositoryMockRevokeUserRefreshTokensExpectation {
+	if mmRevokeUserRefreshTokens.mock.funcRevokeUserRefreshTokens != nil {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("AuthRepositoryMock.RevokeUserRefreshTokens mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockRevokeUserRefreshTokensExpectation{
+		mock:               mmRevokeUserRefreshTokens.mock,
+		params:             &AuthRepositoryMockRevokeUserRefreshTokensParams{ctx, username, keepFamilyID},
+		expectationOrigins: AuthRepositoryMockRevokeUserRefreshTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeUserRefreshTokens.expectations = append(mmRevokeUserRefreshTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.RevokeUserRefreshTokens return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockRevokeUserRefreshTokensExpectation) Then(sa1 []string, err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockRevokeUserRefreshTokensResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.RevokeUserRefreshTokens should be invoked
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) Times(n uint64) *mAuthRepositoryMockRevokeUserRefreshTokens {
+	if n == 0 {
+		mmRevokeUserRefreshTokens.mock.t.Fatalf("Times of AuthRepositoryMock.RevokeUserRefreshTokens mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeUserRefreshTokens.expectedInvocations, n)
+	mmRevokeUserRefreshTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeUserRefreshTokens
+}
+
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) invocationsDone() bool {
+	if len(mmRevokeUserRefreshTokens.expectations) == 0 && mmRevokeUserRefreshTokens.defaultExpectation == nil && mmRevokeUserRefreshTokens.mock.funcRevokeUserRefreshTokens == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeUserRefreshTokens.mock.afterRevokeUserRefreshTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeUserRefreshTokens.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeUserRefreshTokens implements mm_repository.AuthRepository
+func (mmRevokeUserRefreshTokens *AuthRepositoryMock) RevokeUserRefreshTokens(ctx context.Context, username string, keepFamilyID string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmRevokeUserRefreshTokens.beforeRevokeUserRefreshTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeUserRefreshTokens.afterRevokeUserRefreshTokensCounter, 1)
+
+	mmRevokeUserRefreshTokens.t.Helper()
+
+	if mmRevokeUserRefreshTokens.inspectFuncRevokeUserRefreshTokens != nil {
+		mmRevokeUserRefreshTokens.inspectFuncRevokeUserRefreshTokens(ctx, username, keepFamilyID)
+	}
+
+	mm_params := AuthRepositoryMockRevokeUserRefreshTokensParams{ctx, username, keepFamilyID}
+
+	// Record call args
+	mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.mutex.Lock()
+	mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.callArgs = append(mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.callArgs, &mm_params)
+	mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.mutex.Unlock()
+
+	for _, e := range mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockRevokeUserRefreshTokensParams{ctx, username, keepFamilyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeUserRefreshTokens.t.Errorf("AuthRepositoryMock.RevokeUserRefreshTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRevokeUserRefreshTokens.t.Errorf("AuthRepositoryMock.RevokeUserRefreshTokens got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.keepFamilyID != nil && !minimock.Equal(*mm_want_ptrs.keepFamilyID, mm_got.keepFamilyID) {
+				mmRevokeUserRefreshTokens.t.Errorf("AuthRepositoryMock.RevokeUserRefreshTokens got unexpected parameter keepFamilyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.defaultExpectation.expectationOrigins.originKeepFamilyID, *mm_want_ptrs.keepFamilyID, mm_got.keepFamilyID, minimock.Diff(*mm_want_ptrs.keepFamilyID, mm_got.keepFamilyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeUserRefreshTokens.t.Errorf("AuthRepositoryMock.RevokeUserRefreshTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeUserRefreshTokens.RevokeUserRefreshTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeUserRefreshTokens.t.Fatal("No results are set for the AuthRepositoryMock.RevokeUserRefreshTokens")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmRevokeUserRefreshTokens.funcRevokeUserRefreshTokens != nil {
+		return mmRevokeUserRefreshTokens.funcRevokeUserRefreshTokens(ctx, username, keepFamilyID)
+	}
+	mmRevokeUserRefreshTokens.t.Fatalf("Unexpected call to AuthRepositoryMock.RevokeUserRefreshTokens. %v %v %v", ctx, username, keepFamilyID)
+	return
+}
+
+// RevokeUserRefreshTokensAfterCounter returns a count of finished AuthRepositoryMock.RevokeUserRefreshTokens invocations
+func (mmRevokeUserRefreshTokens *AuthRepositoryMock) RevokeUserRefreshTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeUserRefreshTokens.afterRevokeUserRefreshTokensCounter)
+}
+
+// RevokeUserRefreshTokensBeforeCounter returns a count of AuthRepositoryMock.RevokeUserRefreshTokens invocations
+func (mmRevokeUserRefreshTokens *AuthRepositoryMock) RevokeUserRefreshTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeUserRefreshTokens.beforeRevokeUserRefreshTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.RevokeUserRefreshTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeUserRefreshTokens *mAuthRepositoryMockRevokeUserRefreshTokens) Calls() []*AuthRepositoryMockRevokeUserRefreshTokensParams {
+	mmRevokeUserRefreshTokens.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockRevokeUserRefreshTokensParams, len(mmRevokeUserRefreshTokens.callArgs))
+	copy(argCopy, mmRevokeUserRefreshTokens.callArgs)
+
+	mmRevokeUserRefreshTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeUserRefreshTokensDone returns true if the count of the RevokeUserRefreshTokens invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockRevokeUserRefreshTokensDone() bool {
+	if m.RevokeUserRefreshTokensMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeUserRefreshTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeUserRefreshTokensMock.invocationsDone()
+}
+
+// MinimockRevokeUserRefreshTokensInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockRevokeUserRefreshTokensInspect() {
+	for _, e := range m.RevokeUserRefreshTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.RevokeUserRefreshTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeUserRefreshTokensCounter := mm_atomic.LoadUint64(&m.afterRevokeUserRefreshTokensCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeUserRefreshTokensMock.defaultExpectation != nil && afterRevokeUserRefreshTokensCounter < 1 {
+		if m.RevokeUserRefreshTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.RevokeUserRefreshTokens at\n%s", m.RevokeUserRefreshTokensMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.RevokeUserRefreshTokens at\n%s with params: %#v", m.RevokeUserRefreshTokensMock.defaultExpectation.expectationOrigins.origin, *m.RevokeUserRefreshTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeUserRefreshTokens != nil && afterRevokeUserRefreshTokensCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.RevokeUserRefreshTokens at\n%s", m.funcRevokeUserRefreshTokensOrigin)
+	}
+
+	if !m.RevokeUserRefreshTokensMock.invocationsDone() && afterRevokeUserRefreshTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.RevokeUserRefreshTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeUserRefreshTokensMock.expectedInvocations), m.RevokeUserRefreshTokensMock.expectedInvocationsOrigin, afterRevokeUserRefreshTokensCounter)
+	}
+}
+
+type mAuthRepositoryMockSaveTOTPSecret struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockSaveTOTPSecretExpectation
+	expectations       []*AuthRepositoryMockSaveTOTPSecretExpectation
+
+	callArgs []*AuthRepositoryMockSaveTOTPSecretParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockSaveTOTPSecretExpectation specifies expectation struct of the AuthRepository.SaveTOTPSecret
+type AuthRepositoryMockSaveTOTPSecretExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockSaveTOTPSecretParams
+	paramPtrs          *AuthRepositoryMockSaveTOTPSecretParamPtrs
+	expectationOrigins AuthRepositoryMockSaveTOTPSecretExpectationOrigins
+	results            *AuthRepositoryMockSaveTOTPSecretResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockSaveTOTPSecretParams contains parameters of the AuthRepository.SaveTOTPSecret
+type AuthRepositoryMockSaveTOTPSecretParams struct {
+	ctx    context.Context
+	userID int64
+	secret string
+}
+
+// AuthRepositoryMockSaveTOTPSecretParamPtrs contains pointers to parameters of the AuthRepository.SaveTOTPSecret
+type AuthRepositoryMockSaveTOTPSecretParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	secret *string
+}
+
+// AuthRepositoryMockSaveTOTPSecretResults contains results of the AuthRepository.SaveTOTPSecret
+type AuthRepositoryMockSaveTOTPSecretResults struct {
+	err error
+}
+
+// AuthRepositoryMockSaveTOTPSecretOrigins contains origins of expectations of the AuthRepository.SaveTOTPSecret
+type AuthRepositoryMockSaveTOTPSecretExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSecret string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) Optional() *mAuthRepositoryMockSaveTOTPSecret {
+	mmSaveTOTPSecret.optional = true
+	return mmSaveTOTPSecret
+}
+
+// Expect sets up expected params for AuthRepository.SaveTOTPSecret
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) Expect(ctx context.Context, userID int64, secret string) *mAuthRepositoryMockSaveTOTPSecret {
+	if mmSaveTOTPSecret.mock.funcSaveTOTPSecret != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("AuthRepositoryMock.SaveTOTPSecret mock is already set by Set")
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation == nil {
+		mmSaveTOTPSecret.defaultExpectation = &AuthRepositoryMockSaveTOTPSecretExpectation{}
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation.paramPtrs != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("AuthRepositoryMock.SaveTOTPSecret mock is already set by ExpectParams functions")
+	}
+
+	mmSaveTOTPSecret.defaultExpectation.params = &AuthRepositoryMockSaveTOTPSecretParams{ctx, userID, secret}
+	mmSaveTOTPSecret.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveTOTPSecret.expectations {
+		if minimock.Equal(e.params, mmSaveTOTPSecret.defaultExpectation.params) {
+			mmSaveTOTPSecret.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveTOTPSecret.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveTOTPSecret
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.SaveTOTPSecret
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockSaveTOTPSecret {
+	if mmSaveTOTPSecret.mock.funcSaveTOTPSecret != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("AuthRepositoryMock.SaveTOTPSecret mock is already set by Set")
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation == nil {
+		mmSaveTOTPSecret.defaultExpectation = &AuthRepositoryMockSaveTOTPSecretExpectation{}
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation.params != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("AuthRepositoryMock.SaveTOTPSecret mock is already set by Expect")
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation.paramPtrs == nil {
+		mmSaveTOTPSecret.defaultExpectation.paramPtrs = &AuthRepositoryMockSaveTOTPSecretParamPtrs{}
+	}
+	mmSaveTOTPSecret.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveTOTPSecret.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveTOTPSecret
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthRepository.SaveTOTPSecret
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) ExpectUserIDParam2(userID int64) *mAuthRepositoryMockSaveTOTPSecret {
+	if mmSaveTOTPSecret.mock.funcSaveTOTPSecret != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("AuthRepositoryMock.SaveTOTPSecret mock is already set by Set")
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation == nil {
+		mmSaveTOTPSecret.defaultExpectation = &AuthRepositoryMockSaveTOTPSecretExpectation{}
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation.params != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("AuthRepositoryMock.SaveTOTPSecret mock is already set by Expect")
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation.paramPtrs == nil {
+		mmSaveTOTPSecret.defaultExpectation.paramPtrs = &AuthRepositoryMockSaveTOTPSecretParamPtrs{}
+	}
+	mmSaveTOTPSecret.defaultExpectation.paramPtrs.userID = &userID
+	mmSaveTOTPSecret.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSaveTOTPSecret
+}
+
+// ExpectSecretParam3 sets up expected param secret for AuthRepository.SaveTOTPSecret
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) ExpectSecretParam3(secret string) *mAuthRepositoryMockSaveTOTPSecret {
+	if mmSaveTOTPSecret.mock.funcSaveTOTPSecret != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("AuthRepositoryMock.SaveTOTPSecret mock is already set by Set")
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation == nil {
+		mmSaveTOTPSecret.defaultExpectation = &AuthRepositoryMockSaveTOTPSecretExpectation{}
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation.params != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("AuthRepositoryMock.SaveTOTPSecret mock is already set by Expect")
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation.paramPtrs == nil {
+		mmSaveTOTPSecret.defaultExpectation.paramPtrs = &AuthRepositoryMockSaveTOTPSecretParamPtrs{}
+	}
+	mmSaveTOTPSecret.defaultExpectation.paramPtrs.secret = &secret
+	mmSaveTOTPSecret.defaultExpectation.expectationOrigins.originSecret = minimock.CallerInfo(1)
+
+	return mmSaveTOTPSecret
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.SaveTOTPSecret
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) Inspect(f func(ctx context.Context, userID int64, secret string)) *mAuthRepositoryMockSaveTOTPSecret {
+	if mmSaveTOTPSecret.mock.inspectFuncSaveTOTPSecret != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.SaveTOTPSecret")
+	}
+
+	mmSaveTOTPSecret.mock.inspectFuncSaveTOTPSecret = f
+
+	return mmSaveTOTPSecret
+}
+
+// Return sets up results that will be returned by AuthRepository.SaveTOTPSecret
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) Return(err error) *AuthRepositoryMock {
+	if mmSaveTOTPSecret.mock.funcSaveTOTPSecret != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("AuthRepositoryMock.SaveTOTPSecret mock is already set by Set")
+	}
+
+	if mmSaveTOTPSecret.defaultExpectation == nil {
+		mmSaveTOTPSecret.defaultExpectation = &AuthRepositoryMockSaveTOTPSecretExpectation{mock: mmSaveTOTPSecret.mock}
+	}
+	mmSaveTOTPSecret.defaultExpectation.results = &AuthRepositoryMockSaveTOTPSecretResults{err}
+	mmSaveTOTPSecret.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveTOTPSecret.mock
+}
+
+// Set uses given function f to mock the AuthRepository.SaveTOTPSecret method
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) Set(f func(ctx context.Context, userID int64, secret string) (err error)) *AuthRepositoryMock {
+	if mmSaveTOTPSecret.defaultExpectation != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("Default expectation is already set for the AuthRepository.SaveTOTPSecret method")
+	}
+
+	if len(mmSaveTOTPSecret.expectations) > 0 {
+		mmSaveTOTPSecret.mock.t.Fatalf("Some expectations are already set for the AuthRepository.SaveTOTPSecret method")
+	}
+
+	mmSaveTOTPSecret.mock.funcSaveTOTPSecret = f
+	mmSaveTOTPSecret.mock.funcSaveTOTPSecretOrigin = minimock.CallerInfo(1)
+	return mmSaveTOTPSecret.mock
+}
+
+// When sets expectation for the AuthRepository.SaveTOTPSecret which will trigger the result defined by the following
+// Then helper
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) When(ctx context.Context, userID int64, secret string) *AuthRepositoryMockSaveTOTPSecretExpectation {
+	if mmSaveTOTPSecret.mock.funcSaveTOTPSecret != nil {
+		mmSaveTOTPSecret.mock.t.Fatalf("AuthRepositoryMock.SaveTOTPSecret mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockSaveTOTPSecretExpectation{
+		mock:               mmSaveTOTPSecret.mock,
+		params:             &AuthRepositoryMockSaveTOTPSecretParams{ctx, userID, secret},
+		expectationOrigins: AuthRepositoryMockSaveTOTPSecretExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveTOTPSecret.expectations = append(mmSaveTOTPSecret.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.SaveTOTPSecret return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockSaveTOTPSecretExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockSaveTOTPSecretResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.SaveTOTPSecret should be invoked
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) Times(n uint64) *mAuthRepositoryMockSaveTOTPSecret {
+	if n == 0 {
+		mmSaveTOTPSecret.mock.t.Fatalf("Times of AuthRepositoryMock.SaveTOTPSecret mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveTOTPSecret.expectedInvocations, n)
+	mmSaveTOTPSecret.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveTOTPSecret
+}
+
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) invocationsDone() bool {
+	if len(mmSaveTOTPSecret.expectations) == 0 && mmSaveTOTPSecret.defaultExpectation == nil && mmSaveTOTPSecret.mock.funcSaveTOTPSecret == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveTOTPSecret.mock.afterSaveTOTPSecretCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveTOTPSecret.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveTOTPSecret implements mm_repository.AuthRepository
+func (mmSaveTOTPSecret *AuthRepositoryMock) SaveTOTPSecret(ctx context.Context, userID int64, secret string) (err error) {
+	mm_atomic.AddUint64(&mmSaveTOTPSecret.beforeSaveTOTPSecretCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveTOTPSecret.afterSaveTOTPSecretCounter, 1)
+
+	mmSaveTOTPSecret.t.Helper()
+
+	if mmSaveTOTPSecret.inspectFuncSaveTOTPSecret != nil {
+		mmSaveTOTPSecret.inspectFuncSaveTOTPSecret(ctx, userID, secret)
+	}
+
+	mm_params := AuthRepositoryMockSaveTOTPSecretParams{ctx, userID, secret}
+
+	// Record call args
+	mmSaveTOTPSecret.SaveTOTPSecretMock.mutex.Lock()
+	mmSaveTOTPSecret.SaveTOTPSecretMock.callArgs = append(mmSaveTOTPSecret.SaveTOTPSecretMock.callArgs, &mm_params)
+	mmSaveTOTPSecret.SaveTOTPSecretMock.mutex.Unlock()
+
+	for _, e := range mmSaveTOTPSecret.SaveTOTPSecretMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveTOTPSecret.SaveTOTPSecretMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveTOTPSecret.SaveTOTPSecretMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveTOTPSecret.SaveTOTPSecretMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveTOTPSecret.SaveTOTPSecretMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockSaveTOTPSecretParams{ctx, userID, secret}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveTOTPSecret.t.Errorf("AuthRepositoryMock.SaveTOTPSecret got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveTOTPSecret.SaveTOTPSecretMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSaveTOTPSecret.t.Errorf("AuthRepositoryMock.SaveTOTPSecret got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveTOTPSecret.SaveTOTPSecretMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.secret != nil && !minimock.Equal(*mm_want_ptrs.secret, mm_got.secret) {
+				mmSaveTOTPSecret.t.Errorf("AuthRepositoryMock.SaveTOTPSecret got unexpected parameter secret, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveTOTPSecret.SaveTOTPSecretMock.defaultExpectation.expectationOrigins.originSecret, *mm_want_ptrs.secret, mm_got.secret, minimock.Diff(*mm_want_ptrs.secret, mm_got.secret))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveTOTPSecret.t.Errorf("AuthRepositoryMock.SaveTOTPSecret got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveTOTPSecret.SaveTOTPSecretMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveTOTPSecret.SaveTOTPSecretMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveTOTPSecret.t.Fatal("No results are set for the AuthRepositoryMock.SaveTOTPSecret")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveTOTPSecret.funcSaveTOTPSecret != nil {
+		return mmSaveTOTPSecret.funcSaveTOTPSecret(ctx, userID, secret)
+	}
+	mmSaveTOTPSecret.t.Fatalf("Unexpected call to AuthRepositoryMock.SaveTOTPSecret. %v %v %v", ctx, userID, secret)
+	return
+}
+
+// SaveTOTPSecretAfterCounter returns a count of finished AuthRepositoryMock.SaveTOTPSecret invocations
+func (mmSaveTOTPSecret *AuthRepositoryMock) SaveTOTPSecretAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveTOTPSecret.afterSaveTOTPSecretCounter)
+}
+
+// SaveTOTPSecretBeforeCounter returns a count of AuthRepositoryMock.SaveTOTPSecret invocations
+func (mmSaveTOTPSecret *AuthRepositoryMock) SaveTOTPSecretBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveTOTPSecret.beforeSaveTOTPSecretCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.SaveTOTPSecret.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveTOTPSecret *mAuthRepositoryMockSaveTOTPSecret) Calls() []*AuthRepositoryMockSaveTOTPSecretParams {
+	mmSaveTOTPSecret.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockSaveTOTPSecretParams, len(mmSaveTOTPSecret.callArgs))
+	copy(argCopy, mmSaveTOTPSecret.callArgs)
+
+	mmSaveTOTPSecret.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveTOTPSecretDone returns true if the count of the SaveTOTPSecret invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockSaveTOTPSecretDone() bool {
+	if m.SaveTOTPSecretMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveTOTPSecretMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveTOTPSecretMock.invocationsDone()
+}
+
+// MinimockSaveTOTPSecretInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockSaveTOTPSecretInspect() {
+	for _, e := range m.SaveTOTPSecretMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.SaveTOTPSecret at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveTOTPSecretCounter := mm_atomic.LoadUint64(&m.afterSaveTOTPSecretCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveTOTPSecretMock.defaultExpectation != nil && afterSaveTOTPSecretCounter < 1 {
+		if m.SaveTOTPSecretMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.SaveTOTPSecret at\n%s", m.SaveTOTPSecretMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.SaveTOTPSecret at\n%s with params: %#v", m.SaveTOTPSecretMock.defaultExpectation.expectationOrigins.origin, *m.SaveTOTPSecretMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveTOTPSecret != nil && afterSaveTOTPSecretCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.SaveTOTPSecret at\n%s", m.funcSaveTOTPSecretOrigin)
+	}
+
+	if !m.SaveTOTPSecretMock.invocationsDone() && afterSaveTOTPSecretCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.SaveTOTPSecret at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveTOTPSecretMock.expectedInvocations), m.SaveTOTPSecretMock.expectedInvocationsOrigin, afterSaveTOTPSecretCounter)
+	}
+}
+
+type mAuthRepositoryMockUseMFAChallenge struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockUseMFAChallengeExpectation
+	expectations       []*AuthRepositoryMockUseMFAChallengeExpectation
+
+	callArgs []*AuthRepositoryMockUseMFAChallengeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockUseMFAChallengeExpectation specifies expectation struct of the AuthRepository.UseMFAChallenge
+type AuthRepositoryMockUseMFAChallengeExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockUseMFAChallengeParams
+	paramPtrs          *AuthRepositoryMockUseMFAChallengeParamPtrs
+	expectationOrigins AuthRepositoryMockUseMFAChallengeExpectationOrigins
+	results            *AuthRepositoryMockUseMFAChallengeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockUseMFAChallengeParams contains parameters of the AuthRepository.UseMFAChallenge
+type AuthRepositoryMockUseMFAChallengeParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// AuthRepositoryMockUseMFAChallengeParamPtrs contains pointers to parameters of the AuthRepository.UseMFAChallenge
+type AuthRepositoryMockUseMFAChallengeParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// AuthRepositoryMockUseMFAChallengeResults contains results of the AuthRepository.UseMFAChallenge
+type AuthRepositoryMockUseMFAChallengeResults struct {
+	err error
+}
+
+// AuthRepositoryMockUseMFAChallengeOrigins contains origins of expectations of the AuthRepository.UseMFAChallenge
+type AuthRepositoryMockUseMFAChallengeExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUseMFAChallenge *mAuthRepositoryMockUseMFAChallenge) Optional() *mAuthRepositoryMockUseMFAChallenge {
+	mmUseMFAChallenge.optional = true
+	return mmUseMFAChallenge
+}
+
+// Expect sets up expected params for AuthRepository.UseMFAChallenge
+func (mmUseMFAChallenge *mAuthRepositoryMockUseMFAChallenge) Expect(ctx context.Context, tokenHash string) *mAuthRepositoryMockUseMFAChallenge {
+	if mmUseMFAChallenge.mock.funcUseMFAChallenge != nil {
+		mmUseMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.UseMFAChallenge mock is already set by Set")
+	}
+
+	if mmUseMFAChallenge.defaultExpectation == nil {
+		mmUseMFAChallenge.defaultExpectation = &AuthRepositoryMockUseMFAChallengeExpectation{}
+	}
+
+	if mmUseMFAChallenge.defaultExpectation.paramPtrs != nil {
+		mmUseMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.UseMFAChallenge mock is already set by ExpectParams functions")
+	}
+
+	mmUseMFAChallenge.defaultExpectation.params = &AuthRepositoryMockUseMFAChallengeParams{ctx, tokenHash}
+	mmUseMFAChallenge.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUseMFAChallenge.expectations {
+		if minimock.Equal(e.params, mmUseMFAChallenge.defaultExpectation.params) {
+			mmUseMFAChallenge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUseMFAChallenge.defaultExpectation.params)
+		}
+	}
+
+	return mmUseMFAChallenge
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.UseMFAChallenge
+func (mmUseMFAChallenge *mAuthRepositoryMockUseMFAChallenge) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockUseMFAChallenge {
+	if mmUseMFAChallenge.mock.funcUseMFAChallenge != nil {
+		mmUseMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.UseMFAChallenge mock is already set by Set")
+	}
+
+	if mmUseMFAChallenge.defaultExpectation == nil {
+		mmUseMFAChallenge.defaultExpectation = &AuthRepositoryMockUseMFAChallengeExpectation{}
+	}
+
+	if mmUseMFAChallenge.defaultExpectation.params != nil {
+		mmUseMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.UseMFAChallenge mock is already set by Expect")
+	}
+
+	if mmUseMFAChallenge.defaultExpectation.paramPtrs == nil {
+		mmUseMFAChallenge.defaultExpectation.paramPtrs = &AuthRepositoryMockUseMFAChallengeParamPtrs{}
+	}
+	mmUseMFAChallenge.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUseMFAChallenge.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUseMFAChallenge
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for AuthRepository.UseMFAChallenge
+func (mmUseMFAChallenge *mAuthRepositoryMockUseMFAChallenge) ExpectTokenHashParam2(tokenHash string) *mAuthRepositoryMockUseMFAChallenge {
+	if mmUseMFAChallenge.mock.funcUseMFAChallenge != nil {
+		mmUseMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.UseMFAChallenge mock is already set by Set")
+	}
+
+	if mmUseMFAChallenge.defaultExpectation == nil {
+		mmUseMFAChallenge.defaultExpectation = &AuthRepositoryMockUseMFAChallengeExpectation{}
+	}
+
+	if mmUseMFAChallenge.defaultExpectation.params != nil {
+		mmUseMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.UseMFAChallenge mock is already set by Expect")
+	}
+
+	if mmUseMFAChallenge.defaultExpectation.paramPtrs == nil {
+		mmUseMFAChallenge.defaultExpectation.paramPtrs = &AuthRepositoryMockUseMFAChallengeParamPtrs{}
+	}
+	mmUseMFAChallenge.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmUseMFAChallenge.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmUseMFAChallenge
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.UseMFAChallenge
+func (mmUseMFAChallenge *mAuthRepositoryMockUseMFAChallenge) Inspect(f func(ctx context.Context, tokenHash string)) *mAuthRepositoryMockUseMFAChallenge {
+	if mmUseMFAChallenge.mock.inspectFuncUseMFAChallenge != nil {
+		mmUseMFAChallenge.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.UseMFAChallenge")
+	}
+
+	mmUseMFAChallenge.mock.inspectFuncUseMFAChallenge = f
+
+	return mmUseMFAChallenge
+}
+
+// Return sets up results that will be returned by AuthRepository.UseMFAChallenge
+func (mmUseMFAChallenge *mAuthRepositoryMockUseMFAChallenge) Return(err error) *AuthRepositoryMock {
+	if mmUseMFAChallenge.mock.funcUseMFAChallenge != nil {
+		mmUseMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.UseMFAChallenge mock is already set by Set")
+	}
+
+	if mmUseMFAChallenge.defaultExpectation == nil {
+		mmUseMFAChallenge.defaultExpectation = &AuthRepositoryMockUseMFAChallengeExpectation{mock: mmUseMFAChallenge.mock}
+	}
+	mmUseMFAChallenge.defaultExpectation.results = &AuthRepositoryMockUseMFAChallengeResults{err}
+	mmUseMFAChallenge.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUseMFAChallenge.mock
+}
+
+// Set uses given function f to mock the AuthRepository.UseMFAChallenge method
+func (mmUseMFAChallenge *mAuthRepositoryMockUseMFAChallenge) Set(f func(ctx context.Context, tokenHash string) (err error)) *AuthRepositoryMock {
+	if mmUseMFAChallenge.defaultExpectation != nil {
+		mmUseMFAChallenge.mock.t.Fatalf("Default expectation is already set for the AuthRepository.UseMFAChallenge method")
+	}
+
+	if len(mmUseMFAChallenge.expectations) > 0 {
+		mmUseMFAChallenge.mock.t.Fatalf("Some expectations are already set for the AuthRepository.UseMFAChallenge method")
+	}
+
+	mmUseMFAChallenge.mock.funcUseMFAChallenge = f
+	mmUseMFAChallenge.mock.funcUseMFAChallengeOrigin = minimock.CallerInfo(1)
+	return mmUseMFAChallenge.mock
+}
+
+// When sets expectation for the AuthRepository.UseMFAChallenge which will trigger the result defined by the following
+// Then helper
+func (mmUseMFAChallenge *mAuthRepositoryMockUseMFAChallenge) When(ctx context.Context, tokenHash string) *AuthRepositoryMockUseMFAChallengeExpectation {
+	if mmUseMFAChallenge.mock.funcUseMFAChallenge != nil {
+		mmUseMFAChallenge.mock.t.Fatalf("AuthRepositoryMock.UseMFAChallenge mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockUseMFAChallengeExpectation{
+		mock:               mmUseMFAChallenge.mock,
+		params:             &AuthRepositoryMockUseMFAChallengeParams{ctx, tokenHash},
+		expectationOrigins: AuthRepositoryMockUseMFAChallengeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUseMFAChallenge.expectations = append(mmUseMFAChallenge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.UseMFAChallenge return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockUseMFAChallengeExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockUseMFAChallengeResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.UseMFAChallenge should be invoked
+func (mmUseMFAChallenge *mAuthRepositoryMockUseMFAChallenge) Times(n uint64) *mAuthRepositoryMockUseMFAChallenge {
+	if n == 0 {
+		mmUseMFAChallenge.mock.t.Fatalf("Times of AuthRepositoryMock.UseMFAChallenge mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUseMFAChallenge.expectedInvocations, n)
+	mmUseMFAChallenge.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUseMFAChallenge
+}
+
+func (mmUseMFAChallenge *mAuthRepositoryMockUseMFAChallenge) invocationsDone() bool {
+	if len(mmUseMFAChallenge.expectations) == 0 && mmUseMFAChallenge.defaultExpectation == nil && mmUseMFAChallenge.mock.funcUseMFAChallenge == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUseMFAChallenge.mock.afterUseMFAChallengeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUseMFAChallenge.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UseMFAChallenge implements mm_repository.AuthRepository
+func (mmUseMFAChallenge *AuthRepositoryMock) UseMFAChallenge(ctx context.Context, tokenHash string) (err error) {
+	mm_atomic.AddUint64(&mmUseMFAChallenge.beforeUseMFAChallengeCounter, 1)
+	defer mm_atomic.AddUint64(&mmUseMFAChallenge.afterUseMFAChallengeCounter, 1)
+
+	mmUseMFAChallenge.t.Helper()
+
+	if mmUseMFAChallenge.inspectFuncUseMFAChallenge != nil {
+		mmUseMFAChallenge.inspectFuncUseMFAChallenge(ctx, tokenHash)
+	}
+
+	mm_params := AuthRepositoryMockUseMFAChallengeParams{ctx, tokenHash}
+
+	// Record call args
+	mmUseMFAChallenge.UseMFAChallengeMock.mutex.Lock()
+	mmUseMFAChallenge.UseMFAChallengeMock.callArgs = append(mmUseMFAChallenge.UseMFAChallengeMock.callArgs, &mm_params)
+	mmUseMFAChallenge.UseMFAChallengeMock.mutex.Unlock()
+
+	for _, e := range mmUseMFAChallenge.UseMFAChallengeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUseMFAChallenge.UseMFAChallengeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUseMFAChallenge.UseMFAChallengeMock.defaultExpectation.Counter, 1)
+		mm_want := mmUseMFAChallenge.UseMFAChallengeMock.defaultExpectation.params
+		mm_want_ptrs := mmUseMFAChallenge.UseMFAChallengeMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockUseMFAChallengeParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUseMFAChallenge.t.Errorf("AuthRepositoryMock.UseMFAChallenge got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseMFAChallenge.UseMFAChallengeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmUseMFAChallenge.t.Errorf("AuthRepositoryMock.UseMFAChallenge got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseMFAChallenge.UseMFAChallengeMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUseMFAChallenge.t.Errorf("AuthRepositoryMock.UseMFAChallenge got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUseMFAChallenge.UseMFAChallengeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUseMFAChallenge.UseMFAChallengeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUseMFAChallenge.t.Fatal("No results are set for the AuthRepositoryMock.UseMFAChallenge")
+		}
+		return (*mm_results).err
+	}
+	if mmUseMFAChallenge.funcUseMFAChallenge != nil {
+		return mmUseMFAChallenge.funcUseMFAChallenge(ctx, tokenHash)
+	}
+	mmUseMFAChallenge.t.Fatalf("Unexpected call to AuthRepositoryMock.UseMFAChallenge. %v %v", ctx, tokenHash)
+	return
+}
+
+// UseMFAChallengeAfterCounter returns a count of finished AuthRepositoryMock.UseMFAChallenge invocations
+func (mmUseMFAChallenge *AuthRepositoryMock) UseMFAChallengeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseMFAChallenge.afterUseMFAChallengeCounter)
+}
+
+// UseMFAChallengeBeforeCounter returns a count of AuthRepositoryMock.UseMFAChallenge invocations
+func (mmUseMFAChallenge *AuthRepositoryMock) UseMFAChallengeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseMFAChallenge.beforeUseMFAChallengeCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.UseMFAChallenge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUseMFAChallenge *mAuthRepositoryMockUseMFAChallenge) Calls() []*AuthRepositoryMockUseMFAChallengeParams {
+	mmUseMFAChallenge.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockUseMFAChallengeParams, len(mmUseMFAChallenge.callArgs))
+	copy(argCopy, mmUseMFAChallenge.callArgs)
+
+	mmUseMFAChallenge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseMFAChallengeDone returns true if the count of the UseMFAChallenge invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockUseMFAChallengeDone() bool {
+	if m.UseMFAChallengeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseMFAChallengeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseMFAChallengeMock.invocationsDone()
+}
+
+// MinimockUseMFAChallengeInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockUseMFAChallengeInspect() {
+	for _, e := range m.UseMFAChallengeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.UseMFAChallenge at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUseMFAChallengeCounter := mm_atomic.LoadUint64(&m.afterUseMFAChallengeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseMFAChallengeMock.defaultExpectation != nil && afterUseMFAChallengeCounter < 1 {
+		if m.UseMFAChallengeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.UseMFAChallenge at\n%s", m.UseMFAChallengeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.UseMFAChallenge at\n%s with params: %#v", m.UseMFAChallengeMock.defaultExpectation.expectationOrigins.origin, *m.UseMFAChallengeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUseMFAChallenge != nil && afterUseMFAChallengeCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.UseMFAChallenge at\n%s", m.funcUseMFAChallengeOrigin)
+	}
+
+	if !m.UseMFAChallengeMock.invocationsDone() && afterUseMFAChallengeCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.UseMFAChallenge at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UseMFAChallengeMock.expectedInvocations), m.UseMFAChallengeMock.expectedInvocationsOrigin, afterUseMFAChallengeCounter)
+	}
+}
+
+type mAuthRepositoryMockUseRecoveryCode struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockUseRecoveryCodeExpectation
+	expectations       []*AuthRepositoryMockUseRecoveryCodeExpectation
+
+	callArgs []*AuthRepositoryMockUseRecoveryCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockUseRecoveryCodeExpectation specifies expectation struct of the AuthRepository.UseRecoveryCode
+type AuthRepositoryMockUseRecoveryCodeExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockUseRecoveryCodeParams
+	paramPtrs          *AuthRepositoryMockUseRecoveryCodeParamPtrs
+	expectationOrigins AuthRepositoryMockUseRecoveryCodeExpectationOrigins
+	results            *AuthRepositoryMockUseRecoveryCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockUseRecoveryCodeParams contains parameters of the AuthRepository.UseRecoveryCode
+type AuthRepositoryMockUseRecoveryCodeParams struct {
+	ctx      context.Context
+	userID   int64
+	codeHash string
+}
+
+// AuthRepositoryMockUseRecoveryCodeParamPtrs contains pointers to parameters of the AuthRepository.UseRecoveryCode
+type AuthRepositoryMockUseRecoveryCodeParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	codeHash *string
+}
+
+// AuthRepositoryMockUseRecoveryCodeResults contains results of the AuthRepository.UseRecoveryCode
+type AuthRepositoryMockUseRecoveryCodeResults struct {
+	err error
+}
+
+// AuthRepositoryMockUseRecoveryCodeOrigins contains origins of expectations of the AuthRepository.UseRecoveryCode
+type AuthRepositoryMockUseRecoveryCodeExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originCodeHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) Optional() *mAuthRepositoryMockUseRecoveryCode {
+	mmUseRecoveryCode.optional = true
+	return mmUseRecoveryCode
+}
+
+// Expect sets up expected params for AuthRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) Expect(ctx context.Context, userID int64, codeHash string) *mAuthRepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("AuthRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &AuthRepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("AuthRepositoryMock.UseRecoveryCode mock is already set by ExpectParams functions")
+	}
+
+	mmUseRecoveryCode.defaultExpectation.params = &AuthRepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash}
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUseRecoveryCode.expectations {
+		if minimock.Equal(e.params, mmUseRecoveryCode.defaultExpectation.params) {
+			mmUseRecoveryCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUseRecoveryCode.defaultExpectation.params)
+		}
+	}
+
+	return mmUseRecoveryCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("AuthRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &AuthRepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.params != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("AuthRepositoryMock.UseRecoveryCode mock is already set by Expect")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmUseRecoveryCode.defaultExpectation.paramPtrs = &AuthRepositoryMockUseRecoveryCodeParamPtrs{}
+	}
+	mmUseRecoveryCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUseRecoveryCode
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) ExpectUserIDParam2(userID int64) *mAuthRepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("AuthRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &AuthRepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.params != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("AuthRepositoryMock.UseRecoveryCode mock is already set by Expect")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmUseRecoveryCode.defaultExpectation.paramPtrs = &AuthRepositoryMockUseRecoveryCodeParamPtrs{}
+	}
+	mmUseRecoveryCode.defaultExpectation.paramPtrs.userID = &userID
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUseRecoveryCode
+}
+
+// ExpectCodeHashParam3 sets up expected param codeHash for AuthRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) ExpectCodeHashParam3(codeHash string) *mAuthRepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("AuthRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &AuthRepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.params != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("AuthRepositoryMock.UseRecoveryCode mock is already set by Expect")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmUseRecoveryCode.defaultExpectation.paramPtrs = &AuthRepositoryMockUseRecoveryCodeParamPtrs{}
+	}
+	mmUseRecoveryCode.defaultExpectation.paramPtrs.codeHash = &codeHash
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.originCodeHash = minimock.CallerInfo(1)
+
+	return mmUseRecoveryCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) Inspect(f func(ctx context.Context, userID int64, codeHash string)) *mAuthRepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.inspectFuncUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.UseRecoveryCode")
+	}
+
+	mmUseRecoveryCode.mock.inspectFuncUseRecoveryCode = f
+
+	return mmUseRecoveryCode
+}
+
+// Return sets up results that will be returned by AuthRepository.UseRecoveryCode
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) Return(err error) *AuthRepositoryMock {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("AuthRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &AuthRepositoryMockUseRecoveryCodeExpectation{mock: mmUseRecoveryCode.mock}
+	}
+	mmUseRecoveryCode.defaultExpectation.results = &AuthRepositoryMockUseRecoveryCodeResults{err}
+	mmUseRecoveryCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUseRecoveryCode.mock
+}
+
+// Set uses given function f to mock the AuthRepository.UseRecoveryCode method
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) Set(f func(ctx context.Context, userID int64, codeHash string) (err error)) *AuthRepositoryMock {
+	if mmUseRecoveryCode.defaultExpectation != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("Default expectation is already set for the AuthRepository.UseRecoveryCode method")
+	}
+
+	if len(mmUseRecoveryCode.expectations) > 0 {
+		mmUseRecoveryCode.mock.t.Fatalf("Some expectations are already set for the AuthRepository.UseRecoveryCode method")
+	}
+
+	mmUseRecoveryCode.mock.funcUseRecoveryCode = f
+	mmUseRecoveryCode.mock.funcUseRecoveryCodeOrigin = minimock.CallerInfo(1)
+	return mmUseRecoveryCode.mock
+}
+
+// When sets expectation for the AuthRepository.UseRecoveryCode which will trigger the result defined by the following
+// Then helper
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) When(ctx context.Context, userID int64, codeHash string) *AuthRepositoryMockUseRecoveryCodeExpectation {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("AuthRepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockUseRecoveryCodeExpectation{
+		mock:               mmUseRecoveryCode.mock,
+		params:             &AuthRepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash},
+		expectationOrigins: AuthRepositoryMockUseRecoveryCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUseRecoveryCode.expectations = append(mmUseRecoveryCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.UseRecoveryCode return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockUseRecoveryCodeExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockUseRecoveryCodeResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.UseRecoveryCode should be invoked
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) Times(n uint64) *mAuthRepositoryMockUseRecoveryCode {
+	if n == 0 {
+		mmUseRecoveryCode.mock.t.Fatalf("Times of AuthRepositoryMock.UseRecoveryCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUseRecoveryCode.expectedInvocations, n)
+	mmUseRecoveryCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUseRecoveryCode
+}
+
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) invocationsDone() bool {
+	if len(mmUseRecoveryCode.expectations) == 0 && mmUseRecoveryCode.defaultExpectation == nil && mmUseRecoveryCode.mock.funcUseRecoveryCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUseRecoveryCode.mock.afterUseRecoveryCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUseRecoveryCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UseRecoveryCode implements mm_repository.AuthRepository
+func (mmUseRecoveryCode *AuthRepositoryMock) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) (err error) {
+	mm_atomic.AddUint64(&mmUseRecoveryCode.beforeUseRecoveryCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmUseRecoveryCode.afterUseRecoveryCodeCounter, 1)
+
+	mmUseRecoveryCode.t.Helper()
+
+	if mmUseRecoveryCode.inspectFuncUseRecoveryCode != nil {
+		mmUseRecoveryCode.inspectFuncUseRecoveryCode(ctx, userID, codeHash)
+	}
+
+	mm_params := AuthRepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash}
+
+	// Record call args
+	mmUseRecoveryCode.UseRecoveryCodeMock.mutex.Lock()
+	mmUseRecoveryCode.UseRecoveryCodeMock.callArgs = append(mmUseRecoveryCode.UseRecoveryCodeMock.callArgs, &mm_params)
+	mmUseRecoveryCode.UseRecoveryCodeMock.mutex.Unlock()
+
+	for _, e := range mmUseRecoveryCode.UseRecoveryCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUseRecoveryCode.t.Errorf("AuthRepositoryMock.UseRecoveryCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUseRecoveryCode.t.Errorf("AuthRepositoryMock.UseRecoveryCode got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.codeHash != nil && !minimock.Equal(*mm_want_ptrs.codeHash, mm_got.codeHash) {
+				mmUseRecoveryCode.t.Errorf("AuthRepositoryMock.UseRecoveryCode got unexpected parameter codeHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.originCodeHash, *mm_want_ptrs.codeHash, mm_got.codeHash, minimock.Diff(*mm_want_ptrs.codeHash, mm_got.codeHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUseRecoveryCode.t.Errorf("AuthRepositoryMock.UseRecoveryCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUseRecoveryCode.t.Fatal("No results are set for the AuthRepositoryMock.UseRecoveryCode")
+		}
+		return (*mm_results).err
+	}
+	if mmUseRecoveryCode.funcUseRecoveryCode != nil {
+		return mmUseRecoveryCode.funcUseRecoveryCode(ctx, userID, codeHash)
+	}
+	mmUseRecoveryCode.t.Fatalf("Unexpected call to AuthRepositoryMock.UseRecoveryCode. %v %v %v", ctx, userID, codeHash)
+	return
+}
+
+// UseRecoveryCodeAfterCounter returns a count of finished AuthRepositoryMock.UseRecoveryCode invocations
+func (mmUseRecoveryCode *AuthRepositoryMock) UseRecoveryCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseRecoveryCode.afterUseRecoveryCodeCounter)
+}
+
+// UseRecoveryCodeBeforeCounter returns a count of AuthRepositoryMock.UseRecoveryCode invocations
+func (mmUseRecoveryCode *AuthRepositoryMock) UseRecoveryCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseRecoveryCode.beforeUseRecoveryCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.UseRecoveryCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUseRecoveryCode *mAuthRepositoryMockUseRecoveryCode) Calls() []*AuthRepositoryMockUseRecoveryCodeParams {
+	mmUseRecoveryCode.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockUseRecoveryCodeParams, len(mmUseRecoveryCode.callArgs))
+	copy(argCopy, mmUseRecoveryCode.callArgs)
+
+	mmUseRecoveryCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseRecoveryCodeDone returns true if the count of the UseRecoveryCode invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockUseRecoveryCodeDone() bool {
+	if m.UseRecoveryCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseRecoveryCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseRecoveryCodeMock.invocationsDone()
+}
+
+// MinimockUseRecoveryCodeInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockUseRecoveryCodeInspect() {
+	for _, e := range m.UseRecoveryCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.UseRecoveryCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUseRecoveryCodeCounter := mm_atomic.LoadUint64(&m.afterUseRecoveryCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseRecoveryCodeMock.defaultExpectation != nil && afterUseRecoveryCodeCounter < 1 {
+		if m.UseRecoveryCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.UseRecoveryCode at\n%s", m.UseRecoveryCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.UseRecoveryCode at\n%s with params: %#v", m.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.origin, *m.UseRecoveryCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUseRecoveryCode != nil && afterUseRecoveryCodeCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.UseRecoveryCode at\n%s", m.funcUseRecoveryCodeOrigin)
+	}
+
+	if !m.UseRecoveryCodeMock.invocationsDone() && afterUseRecoveryCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.UseRecoveryCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UseRecoveryCodeMock.expectedInvocations), m.UseRecoveryCodeMock.expectedInvocationsOrigin, afterUseRecoveryCodeCounter)
+	}
+}
+
+type mAuthRepositoryMockUseTOTPStep struct {
+	optional           bool
+	mock               *AuthRepositoryMock
+	defaultExpectation *AuthRepositoryMockUseTOTPStepExpectation
+	expectations       []*AuthRepositoryMockUseTOTPStepExpectation
+
+	callArgs []*AuthRepositoryMockUseTOTPStepParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthRepositoryMockUseTOTPStepExpectation specifies expectation struct of the AuthRepository.UseTOTPStep
+type AuthRepositoryMockUseTOTPStepExpectation struct {
+	mock               *AuthRepositoryMock
+	params             *AuthRepositoryMockUseTOTPStepParams
+	paramPtrs          *AuthRepositoryMockUseTOTPStepParamPtrs
+	expectationOrigins AuthRepositoryMockUseTOTPStepExpectationOrigins
+	results            *AuthRepositoryMockUseTOTPStepResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthRepositoryMockUseTOTPStepParams contains parameters of the AuthRepository.UseTOTPStep
+type AuthRepositoryMockUseTOTPStepParams struct {
+	ctx    context.Context
+	userID int64
+	step   int64
+}
+
+// AuthRepositoryMockUseTOTPStepParamPtrs contains pointers to parameters of the AuthRepository.UseTOTPStep
+type AuthRepositoryMockUseTOTPStepParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	step   *int64
+}
+
+// AuthRepositoryMockUseTOTPStepResults contains results of the AuthRepository.UseTOTPStep
+type AuthRepositoryMockUseTOTPStepResults struct {
+	err error
+}
+
+// AuthRepositoryMockUseTOTPStepOrigins contains origins of expectations of the AuthRepository.UseTOTPStep
+type AuthRepositoryMockUseTOTPStepExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originStep   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) Optional() *mAuthRepositoryMockUseTOTPStep {
+	mmUseTOTPStep.optional = true
+	return mmUseTOTPStep
+}
+
+// Expect sets up expected params for AuthRepository.UseTOTPStep
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) Expect(ctx context.Context, userID int64, step int64) *mAuthRepositoryMockUseTOTPStep {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("AuthRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	if mmUseTOTPStep.defaultExpectation == nil {
+		mmUseTOTPStep.defaultExpectation = &AuthRepositoryMockUseTOTPStepExpectation{}
+	}
+
+	if mmUseTOTPStep.defaultExpectation.paramPtrs != nil {
+		mmUseTOTPStep.mock.t.Fatalf("AuthRepositoryMock.UseTOTPStep mock is already set by ExpectParams functions")
+	}
+
+	mmUseTOTPStep.defaultExpectation.params = &AuthRepositoryMockUseTOTPStepParams{ctx, userID, step}
+	mmUseTOTPStep.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUseTOTPStep.expectations {
+		if minimock.Equal(e.params, mmUseTOTPStep.defaultExpectation.params) {
+			mmUseTOTPStep.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUseTOTPStep.defaultExpectation.params)
+		}
+	}
+
+	return mmUseTOTPStep
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthRepository.UseTOTPStep
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) ExpectCtxParam1(ctx context.Context) *mAuthRepositoryMockUseTOTPStep {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("AuthRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	if mmUseTOTPStep.defaultExpectation == nil {
+		mmUseTOTPStep.defaultExpectation = &AuthRepositoryMockUseTOTPStepExpectation{}
+	}
+
+	if mmUseTOTPStep.defaultExpectation.params != nil {
+		mmUseTOTPStep.mock.t.Fatalf("AuthRepositoryMock.UseTOTPStep mock is already set by Expect")
+	}
+
+	if mmUseTOTPStep.defaultExpectation.paramPtrs == nil {
+		mmUseTOTPStep.defaultExpectation.paramPtrs = &AuthRepositoryMockUseTOTPStepParamPtrs{}
+	}
+	mmUseTOTPStep.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUseTOTPStep.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUseTOTPStep
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthRepository.UseTOTPStep
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) ExpectUserIDParam2(userID int64) *mAuthRepositoryMockUseTOTPStep {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("AuthRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	if mmUseTOTPStep.defaultExpectation == nil {
+		mmUseTOTPStep.defaultExpectation = &AuthRepositoryMockUseTOTPStepExpectation{}
+	}
+
+	if mmUseTOTPStep.defaultExpectation.params != nil {
+		mmUseTOTPStep.mock.t.Fatalf("AuthRepositoryMock.UseTOTPStep mock is already set by Expect")
+	}
+
+	if mmUseTOTPStep.defaultExpectation.paramPtrs == nil {
+		mmUseTOTPStep.defaultExpectation.paramPtrs = &AuthRepositoryMockUseTOTPStepParamPtrs{}
+	}
+	mmUseTOTPStep.defaultExpectation.paramPtrs.userID = &userID
+	mmUseTOTPStep.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUseTOTPStep
+}
+
+// ExpectStepParam3 sets up expected param step for AuthRepository.UseTOTPStep
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) ExpectStepParam3(step int64) *mAuthRepositoryMockUseTOTPStep {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("AuthRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	if mmUseTOTPStep.defaultExpectation == nil {
+		mmUseTOTPStep.defaultExpectation = &AuthRepositoryMockUseTOTPStepExpectation{}
+	}
+
+	if mmUseTOTPStep.defaultExpectation.params != nil {
+		mmUseTOTPStep.mock.t.Fatalf("AuthRepositoryMock.UseTOTPStep mock is already set by Expect")
+	}
+
+	if mmUseTOTPStep.defaultExpectation.paramPtrs == nil {
+		mmUseTOTPStep.defaultExpectation.paramPtrs = &AuthRepositoryMockUseTOTPStepParamPtrs{}
+	}
+	mmUseTOTPStep.defaultExpectation.paramPtrs.step = &step
+	mmUseTOTPStep.defaultExpectation.expectationOrigins.originStep = minimock.CallerInfo(1)
+
+	return mmUseTOTPStep
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthRepository.UseTOTPStep
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) Inspect(f func(ctx context.Context, userID int64, step int64)) *mAuthRepositoryMockUseTOTPStep {
+	if mmUseTOTPStep.mock.inspectFuncUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("Inspect function is already set for AuthRepositoryMock.UseTOTPStep")
+	}
+
+	mmUseTOTPStep.mock.inspectFuncUseTOTPStep = f
+
+	return mmUseTOTPStep
+}
+
+// Return sets up results that will be returned by AuthRepository.UseTOTPStep
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) Return(err error) *AuthRepositoryMock {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("AuthRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	if mmUseTOTPStep.defaultExpectation == nil {
+		mmUseTOTPStep.defaultExpectation = &AuthRepositoryMockUseTOTPStepExpectation{mock: mmUseTOTPStep.mock}
+	}
+	mmUseTOTPStep.defaultExpectation.results = &AuthRepositoryMockUseTOTPStepResults{err}
+	mmUseTOTPStep.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUseTOTPStep.mock
+}
+
+// Set uses given function f to mock the AuthRepository.UseTOTPStep method
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) Set(f func(ctx context.Context, userID int64, step int64) (err error)) *AuthRepositoryMock {
+	if mmUseTOTPStep.defaultExpectation != nil {
+		mmUseTOTPStep.mock.t.Fatalf("Default expectation is already set for the AuthRepository.UseTOTPStep method")
+	}
+
+	if len(mmUseTOTPStep.expectations) > 0 {
+		mmUseTOTPStep.mock.t.Fatalf("Some expectations are already set for the AuthRepository.UseTOTPStep method")
+	}
+
+	mmUseTOTPStep.mock.funcUseTOTPStep = f
+	mmUseTOTPStep.mock.funcUseTOTPStepOrigin = minimock.CallerInfo(1)
+	return mmUseTOTPStep.mock
+}
+
+// When sets expectation for the AuthRepository.UseTOTPStep which will trigger the result defined by the following
+// Then helper
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) When(ctx context.Context, userID int64, step int64) *AuthRepositoryMockUseTOTPStepExpectation {
+	if mmUseTOTPStep.mock.funcUseTOTPStep != nil {
+		mmUseTOTPStep.mock.t.Fatalf("AuthRepositoryMock.UseTOTPStep mock is already set by Set")
+	}
+
+	expectation := &AuthRepositoryMockUseTOTPStepExpectation{
+		mock:               mmUseTOTPStep.mock,
+		params:             &AuthRepositoryMockUseTOTPStepParams{ctx, userID, step},
+		expectationOrigins: AuthRepositoryMockUseTOTPStepExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUseTOTPStep.expectations = append(mmUseTOTPStep.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthRepository.UseTOTPStep return parameters for the expectation previously defined by the When method
+func (e *AuthRepositoryMockUseTOTPStepExpectation) Then(err error) *AuthRepositoryMock {
+	e.results = &AuthRepositoryMockUseTOTPStepResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthRepository.UseTOTPStep should be invoked
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) Times(n uint64) *mAuthRepositoryMockUseTOTPStep {
+	if n == 0 {
+		mmUseTOTPStep.mock.t.Fatalf("Times of AuthRepositoryMock.UseTOTPStep mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUseTOTPStep.expectedInvocations, n)
+	mmUseTOTPStep.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUseTOTPStep
+}
+
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) invocationsDone() bool {
+	if len(mmUseTOTPStep.expectations) == 0 && mmUseTOTPStep.defaultExpectation == nil && mmUseTOTPStep.mock.funcUseTOTPStep == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUseTOTPStep.mock.afterUseTOTPStepCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUseTOTPStep.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UseTOTPStep implements mm_repository.AuthRepository
+func (mmUseTOTPStep *AuthRepositoryMock) UseTOTPStep(ctx context.Context, userID int64, step int64) (err error) {
+	mm_atomic.AddUint64(&mmUseTOTPStep.beforeUseTOTPStepCounter, 1)
+	defer mm_atomic.AddUint64(&mmUseTOTPStep.afterUseTOTPStepCounter, 1)
+
+	mmUseTOTPStep.t.Helper()
+
+	if mmUseTOTPStep.inspectFuncUseTOTPStep != nil {
+		mmUseTOTPStep.inspectFuncUseTOTPStep(ctx, userID, step)
+	}
+
+	mm_params := AuthRepositoryMockUseTOTPStepParams{ctx, userID, step}
+
+	// Record call args
+	mmUseTOTPStep.UseTOTPStepMock.mutex.Lock()
+	mmUseTOTPStep.UseTOTPStepMock.callArgs = append(mmUseTOTPStep.UseTOTPStepMock.callArgs, &mm_params)
+	mmUseTOTPStep.UseTOTPStepMock.mutex.Unlock()
+
+	for _, e := range mmUseTOTPStep.UseTOTPStepMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUseTOTPStep.UseTOTPStepMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.Counter, 1)
+		mm_want := mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.params
+		mm_want_ptrs := mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthRepositoryMockUseTOTPStepParams{ctx, userID, step}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUseTOTPStep.t.Errorf("AuthRepositoryMock.UseTOTPStep got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUseTOTPStep.t.Errorf("AuthRepositoryMock.UseTOTPStep got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.step != nil && !minimock.Equal(*mm_want_ptrs.step, mm_got.step) {
+				mmUseTOTPStep.t.Errorf("AuthRepositoryMock.UseTOTPStep got unexpected parameter step, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.expectationOrigins.originStep, *mm_want_ptrs.step, mm_got.step, minimock.Diff(*mm_want_ptrs.step, mm_got.step))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUseTOTPStep.t.Errorf("AuthRepositoryMock.UseTOTPStep got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUseTOTPStep.UseTOTPStepMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUseTOTPStep.t.Fatal("No results are set for the AuthRepositoryMock.UseTOTPStep")
+		}
+		return (*mm_results).err
+	}
+	if mmUseTOTPStep.funcUseTOTPStep != nil {
+		return mmUseTOTPStep.funcUseTOTPStep(ctx, userID, step)
+	}
+	mmUseTOTPStep.t.Fatalf("Unexpected call to AuthRepositoryMock.UseTOTPStep. %v %v %v", ctx, userID, step)
+	return
+}
+
+// UseTOTPStepAfterCounter returns a count of finished AuthRepositoryMock.UseTOTPStep invocations
+func (mmUseTOTPStep *AuthRepositoryMock) UseTOTPStepAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseTOTPStep.afterUseTOTPStepCounter)
+}
+
+// UseTOTPStepBeforeCounter returns a count of AuthRepositoryMock.UseTOTPStep invocations
+func (mmUseTOTPStep *AuthRepositoryMock) UseTOTPStepBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseTOTPStep.beforeUseTOTPStepCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthRepositoryMock.UseTOTPStep.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUseTOTPStep *mAuthRepositoryMockUseTOTPStep) Calls() []*AuthRepositoryMockUseTOTPStepParams {
+	mmUseTOTPStep.mutex.RLock()
+
+	argCopy := make([]*AuthRepositoryMockUseTOTPStepParams, len(mmUseTOTPStep.callArgs))
+	copy(argCopy, mmUseTOTPStep.callArgs)
+
+	mmUseTOTPStep.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseTOTPStepDone returns true if the count of the UseTOTPStep invocations corresponds
+// the number of defined expectations
+func (m *AuthRepositoryMock) MinimockUseTOTPStepDone() bool {
+	if m.UseTOTPStepMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseTOTPStepMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseTOTPStepMock.invocationsDone()
+}
+
+// MinimockUseTOTPStepInspect logs each unmet expectation
+func (m *AuthRepositoryMock) MinimockUseTOTPStepInspect() {
+	for _, e := range m.UseTOTPStepMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthRepositoryMock.UseTOTPStep at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUseTOTPStepCounter := mm_atomic.LoadUint64(&m.afterUseTOTPStepCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseTOTPStepMock.defaultExpectation != nil && afterUseTOTPStepCounter < 1 {
+		if m.UseTOTPStepMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthRepositoryMock.UseTOTPStep at\n%s", m.UseTOTPStepMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthRepositoryMock.UseTOTPStep at\n%s with params: %#v", m.UseTOTPStepMock.defaultExpectation.expectationOrigins.origin, *m.UseTOTPStepMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUseTOTPStep != nil && afterUseTOTPStepCounter < 1 {
+		m.t.Errorf("Expected call to AuthRepositoryMock.UseTOTPStep at\n%s", m.funcUseTOTPStepOrigin)
+	}
+
+	if !m.UseTOTPStepMock.invocationsDone() && afterUseTOTPStepCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthRepositoryMock.UseTOTPStep at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UseTOTPStepMock.expectedInvocations), m.UseTOTPStepMock.expectedInvocationsOrigin, afterUseTOTPStepCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuthRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockConfirmTOTPInspect()
+
+			m.MinimockCreateMFAChallengeInspect()
+
+			m.MinimockCreateRefreshTokenInspect()
+
+			m.MinimockGetAccessGrantsInspect()
+
+			m.MinimockGetMFAChallengeInspect()
+
+			m.MinimockGetTOTPInspect()
+
+			m.MinimockGetUserIDInspect()
+
+			m.MinimockGetUserRolesInspect()
+
+			m.MinimockIncMFAChallengeAttemptsInspect()
+
+			m.MinimockIsRefreshTokenFamilyRevokedInspect()
+
+			m.MinimockLoginInspect()
+
+			m.MinimockMakeLogInspect()
+
+			m.MinimockMarkRefreshTokenRotatedInspect()
+
+			m.MinimockReplaceRecoveryCodesInspect()
+
+			m.MinimockRevokeRefreshTokenFamilyInspect()
+
+			m.MinimockRevokeUserRefreshTokensInspect()
+
+			m.MinimockSaveTOTPSecretInspect()
+
+			m.MinimockUseMFAChallengeInspect()
+
+			m.MinimockUseRecoveryCodeInspect()
+
+			m.MinimockUseTOTPStepInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuthRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuthRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConfirmTOTPDone() &&
+		m.MinimockCreateMFAChallengeDone() &&
+		m.MinimockCreateRefreshTokenDone() &&
+		m.MinimockGetAccessGrantsDone() &&
+		m.MinimockGetMFAChallengeDone() &&
+		m.MinimockGetTOTPDone() &&
+		m.MinimockGetUserIDDone() &&
+		m.MinimockGetUserRolesDone() &&
+		m.MinimockIncMFAChallengeAttemptsDone() &&
+		m.MinimockIsRefreshTokenFamilyRevokedDone() &&
+		m.MinimockLoginDone() &&
+		m.MinimockMakeLogDone() &&
+		m.MinimockMarkRefreshTokenRotatedDone() &&
+		m.MinimockReplaceRecoveryCodesDone() &&
+		m.MinimockRevokeRefreshTokenFamilyDone() &&
+		m.MinimockRevokeUserRefreshTokensDone() &&
+		m.MinimockSaveTOTPSecretDone() &&
+		m.MinimockUseMFAChallengeDone() &&
+		m.MinimockUseRecoveryCodeDone() &&
+		m.MinimockUseTOTPStepDone()
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/Ippolid/auth/internal/model"
@@ -19,6 +20,13 @@ type CacheInterfaceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcBlockLogin          func(ctx context.Context, subject string, ttl time.Duration) (err error)
+	funcBlockLoginOrigin    string
+	inspectFuncBlockLogin   func(ctx context.Context, subject string, ttl time.Duration)
+	afterBlockLoginCounter  uint64
+	beforeBlockLoginCounter uint64
+	BlockLoginMock          mCacheInterfaceMockBlockLogin
+
 	funcCreate          func(ctx context.Context, id int64, user model.User) (err error)
 	funcCreateOrigin    string
 	inspectFuncCreate   func(ctx context.Context, id int64, user model.User)
@@ -26,19 +34,47 @@ type CacheInterfaceMock struct {
 	beforeCreateCounter uint64
 	CreateMock          mCacheInterfaceMockCreate
 
-	funcCreateRole          func(ctx context.Context, username string, role bool) (err error)
-	funcCreateRoleOrigin    string
-	inspectFuncCreateRole   func(ctx context.Context, username string, role bool)
-	afterCreateRoleCounter  uint64
-	beforeCreateRoleCounter uint64
-	CreateRoleMock          mCacheInterfaceMockCreateRole
-
-	funcCreateRoleEndpoints          func(ctx context.Context, isAdmin bool, endpoints []string) (err error)
-	funcCreateRoleEndpointsOrigin    string
-	inspectFuncCreateRoleEndpoints   func(ctx context.Context, isAdmin bool, endpoints []string)
-	afterCreateRoleEndpointsCounter  uint64
-	beforeCreateRoleEndpointsCounter uint64
-	CreateRoleEndpointsMock          mCacheInterfaceMockCreateRoleEndpoints
+	funcCreateActiveFamily          func(ctx context.Context, familyID string) (err error)
+	funcCreateActiveFamilyOrigin    string
+	inspectFuncCreateActiveFamily   func(ctx context.Context, familyID string)
+	afterCreateActiveFamilyCounter  uint64
+	beforeCreateActiveFamilyCounter uint64
+	CreateActiveFamilyMock          mCacheInterfaceMockCreateActiveFamily
+
+	funcCreateRevokedFamily          func(ctx context.Context, familyID string) (err error)
+	funcCreateRevokedFamilyOrigin    string
+	inspectFuncCreateRevokedFamily   func(ctx context.Context, familyID string)
+	afterCreateRevokedFamilyCounter  uint64
+	beforeCreateRevokedFamilyCounter uint64
+	CreateRevokedFamilyMock          mCacheInterfaceMockCreateRevokedFamily
+
+	funcCreateRoles          func(ctx context.Context, username string, roles []string) (err error)
+	funcCreateRolesOrigin    string
+	inspectFuncCreateRoles   func(ctx context.Context, username string, roles []string)
+	afterCreateRolesCounter  uint64
+	beforeCreateRolesCounter uint64
+	CreateRolesMock          mCacheInterfaceMockCreateRoles
+
+	funcCreateRolesNotFound          func(ctx context.Context, username string) (err error)
+	funcCreateRolesNotFoundOrigin    string
+	inspectFuncCreateRolesNotFound   func(ctx context.Context, username string)
+	afterCreateRolesNotFoundCounter  uint64
+	beforeCreateRolesNotFoundCounter uint64
+	CreateRolesNotFoundMock          mCacheInterfaceMockCreateRolesNotFound
+
+	funcCreateUserNotFound          func(ctx context.Context, id int64) (err error)
+	funcCreateUserNotFoundOrigin    string
+	inspectFuncCreateUserNotFound   func(ctx context.Context, id int64)
+	afterCreateUserNotFoundCounter  uint64
+	beforeCreateUserNotFoundCounter uint64
+	CreateUserNotFoundMock          mCacheInterfaceMockCreateUserNotFound
+
+	funcDelete          func(ctx context.Context, id int64) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, id int64)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mCacheInterfaceMockDelete
 
 	funcGet          func(ctx context.Context, id int64) (up1 *model.User, err error)
 	funcGetOrigin    string
@@ -47,19 +83,47 @@ type CacheInterfaceMock struct {
 	beforeGetCounter uint64
 	GetMock          mCacheInterfaceMockGet
 
-	funcGetRole          func(ctx context.Context, username string) (bp1 *bool, err error)
-	funcGetRoleOrigin    string
-	inspectFuncGetRole   func(ctx context.Context, username string)
-	afterGetRoleCounter  uint64
-	beforeGetRoleCounter uint64
-	GetRoleMock          mCacheInterfaceMockGetRole
-
-	funcGetRoleEndpoints          func(ctx context.Context, isAdmin bool) (sa1 []string, err error)
-	funcGetRoleEndpointsOrigin    string
-	inspectFuncGetRoleEndpoints   func(ctx context.Context, isAdmin bool)
-	afterGetRoleEndpointsCounter  uint64
-	beforeGetRoleEndpointsCounter uint64
-	GetRoleEndpointsMock          mCacheInterfaceMockGetRoleEndpoints
+	funcGetLoginBlock          func(ctx context.Context, subject string) (d1 time.Duration, err error)
+	funcGetLoginBlockOrigin    string
+	inspectFuncGetLoginBlock   func(ctx context.Context, subject string)
+	afterGetLoginBlockCounter  uint64
+	beforeGetLoginBlockCounter uint64
+	GetLoginBlockMock          mCacheInterfaceMockGetLoginBlock
+
+	funcGetRevokedFamily          func(ctx context.Context, familyID string) (b1 bool, err error)
+	funcGetRevokedFamilyOrigin    string
+	inspectFuncGetRevokedFamily   func(ctx context.Context, familyID string)
+	afterGetRevokedFamilyCounter  uint64
+	beforeGetRevokedFamilyCounter uint64
+	GetRevokedFamilyMock          mCacheInterfaceMockGetRevokedFamily
+
+	funcGetRoles          func(ctx context.Context, username string) (sa1 []string, err error)
+	funcGetRolesOrigin    string
+	inspectFuncGetRoles   func(ctx context.Context, username string)
+	afterGetRolesCounter  uint64
+	beforeGetRolesCounter uint64
+	GetRolesMock          mCacheInterfaceMockGetRoles
+
+	funcIncLoginFailures          func(ctx context.Context, subject string, window time.Duration) (i1 int64, err error)
+	funcIncLoginFailuresOrigin    string
+	inspectFuncIncLoginFailures   func(ctx context.Context, subject string, window time.Duration)
+	afterIncLoginFailuresCounter  uint64
+	beforeIncLoginFailuresCounter uint64
+	IncLoginFailuresMock          mCacheInterfaceMockIncLoginFailures
+
+	funcInvalidate          func(ctx context.Context, inv model.CacheInvalidation) (err error)
+	funcInvalidateOrigin    string
+	inspectFuncInvalidate   func(ctx context.Context, inv model.CacheInvalidation)
+	afterInvalidateCounter  uint64
+	beforeInvalidateCounter uint64
+	InvalidateMock          mCacheInterfaceMockInvalidate
+
+	funcResetLoginFailures          func(ctx context.Context, subject string) (err error)
+	funcResetLoginFailuresOrigin    string
+	inspectFuncResetLoginFailures   func(ctx context.Context, subject string)
+	afterResetLoginFailuresCounter  uint64
+	beforeResetLoginFailuresCounter uint64
+	ResetLoginFailuresMock          mCacheInterfaceMockResetLoginFailures
 }
 
 // NewCacheInterfaceMock returns a mock for mm_repository.CacheInterface
@@ -70,29 +134,429 @@ func NewCacheInterfaceMock(t minimock.Tester) *CacheInterfaceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.BlockLoginMock = mCacheInterfaceMockBlockLogin{mock: m}
+	m.BlockLoginMock.callArgs = []*CacheInterfaceMockBlockLoginParams{}
+
 	m.CreateMock = mCacheInterfaceMockCreate{mock: m}
 	m.CreateMock.callArgs = []*CacheInterfaceMockCreateParams{}
 
-	m.CreateRoleMock = mCacheInterfaceMockCreateRole{mock: m}
-	m.CreateRoleMock.callArgs = []*CacheInterfaceMockCreateRoleParams{}
+	m.CreateActiveFamilyMock = mCacheInterfaceMockCreateActiveFamily{mock: m}
+	m.CreateActiveFamilyMock.callArgs = []*CacheInterfaceMockCreateActiveFamilyParams{}
+
+	m.CreateRevokedFamilyMock = mCacheInterfaceMockCreateRevokedFamily{mock: m}
+	m.CreateRevokedFamilyMock.callArgs = []*CacheInterfaceMockCreateRevokedFamilyParams{}
+
+	m.CreateRolesMock = mCacheInterfaceMockCreateRoles{mock: m}
+	m.CreateRolesMock.callArgs = []*CacheInterfaceMockCreateRolesParams{}
+
+	m.CreateRolesNotFoundMock = mCacheInterfaceMockCreateRolesNotFound{mock: m}
+	m.CreateRolesNotFoundMock.callArgs = []*CacheInterfaceMockCreateRolesNotFoundParams{}
+
+	m.CreateUserNotFoundMock = mCacheInterfaceMockCreateUserNotFound{mock: m}
+	m.CreateUserNotFoundMock.callArgs = []*CacheInterfaceMockCreateUserNotFoundParams{}
 
-	m.CreateRoleEndpointsMock = mCacheInterfaceMockCreateRoleEndpoints{mock: m}
-	m.CreateRoleEndpointsMock.callArgs = []*CacheInterfaceMockCreateRoleEndpointsParams{}
+	m.DeleteMock = mCacheInterfaceMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*CacheInterfaceMockDeleteParams{}
 
 	m.GetMock = mCacheInterfaceMockGet{mock: m}
 	m.GetMock.callArgs = []*CacheInterfaceMockGetParams{}
 
-	m.GetRoleMock = mCacheInterfaceMockGetRole{mock: m}
-	m.GetRoleMock.callArgs = []*CacheInterfaceMockGetRoleParams{}
+	m.GetLoginBlockMock = mCacheInterfaceMockGetLoginBlock{mock: m}
+	m.GetLoginBlockMock.callArgs = []*CacheInterfaceMockGetLoginBlockParams{}
 
-	m.GetRoleEndpointsMock = mCacheInterfaceMockGetRoleEndpoints{mock: m}
-	m.GetRoleEndpointsMock.callArgs = []*CacheInterfaceMockGetRoleEndpointsParams{}
+	m.GetRevokedFamilyMock = mCacheInterfaceMockGetRevokedFamily{mock: m}
+	m.GetRevokedFamilyMock.callArgs = []*CacheInterfaceMockGetRevokedFamilyParams{}
+
+	m.GetRolesMock = mCacheInterfaceMockGetRoles{mock: m}
+	m.GetRolesMock.callArgs = []*CacheInterfaceMockGetRolesParams{}
+
+	m.IncLoginFailuresMock = mCacheInterfaceMockIncLoginFailures{mock: m}
+	m.IncLoginFailuresMock.callArgs = []*CacheInterfaceMockIncLoginFailuresParams{}
+
+	m.InvalidateMock = mCacheInterfaceMockInvalidate{mock: m}
+	m.InvalidateMock.callArgs = []*CacheInterfaceMockInvalidateParams{}
+
+	m.ResetLoginFailuresMock = mCacheInterfaceMockResetLoginFailures{mock: m}
+	m.ResetLoginFailuresMock.callArgs = []*CacheInterfaceMockResetLoginFailuresParams{}
 
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mCacheInterfaceMockBlockLogin struct {
+	optional           bool
+	mock               *CacheInterfaceMock
+	defaultExpectation *CacheInterfaceMockBlockLoginExpectation
+	expectations       []*CacheInterfaceMockBlockLoginExpectation
+
+	callArgs []*CacheInterfaceMockBlockLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheInterfaceMockBlockLoginExpectation specifies expectation struct of the CacheInterface.BlockLogin
+type CacheInterfaceMockBlockLoginExpectation struct {
+	mock               *CacheInterfaceMock
+	params             *CacheInterfaceMockBlockLoginParams
+	paramPtrs          *CacheInterfaceMockBlockLoginParamPtrs
+	expectationOrigins CacheInterfaceMockBlockLoginExpectationOrigins
+	results            *CacheInterfaceMockBlockLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheInterfaceMockBlockLoginParams contains parameters of the CacheInterface.BlockLogin
+type CacheInterfaceMockBlockLoginParams struct {
+	ctx     context.Context
+	subject string
+	ttl     time.Duration
+}
+
+// CacheInterfaceMockBlockLoginParamPtrs contains pointers to parameters of the CacheInterface.BlockLogin
+type CacheInterfaceMockBlockLoginParamPtrs struct {
+	ctx     *context.Context
+	subject *string
+	ttl     *time.Duration
+}
+
+// CacheInterfaceMockBlockLoginResults contains results of the CacheInterface.BlockLogin
+type CacheInterfaceMockBlockLoginResults struct {
+	err error
+}
+
+// CacheInterfaceMockBlockLoginOrigins contains origins of expectations of the CacheInterface.BlockLogin
+type CacheInterfaceMockBlockLoginExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originSubject string
+	originTtl     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) Optional() *mCacheInterfaceMockBlockLogin {
+	mmBlockLogin.optional = true
+	return mmBlockLogin
+}
+
+// Expect sets up expected params for CacheInterface.BlockLogin
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) Expect(ctx context.Context, subject string, ttl time.Duration) *mCacheInterfaceMockBlockLogin {
+	if mmBlockLogin.mock.funcBlockLogin != nil {
+		mmBlockLogin.mock.t.Fatalf("CacheInterfaceMock.BlockLogin mock is already set by Set")
+	}
+
+	if mmBlockLogin.defaultExpectation == nil {
+		mmBlockLogin.defaultExpectation = &CacheInterfaceMockBlockLoginExpectation{}
+	}
+
+	if mmBlockLogin.defaultExpectation.paramPtrs != nil {
+		mmBlockLogin.mock.t.Fatalf("CacheInterfaceMock.BlockLogin mock is already set by ExpectParams functions")
+	}
+
+	mmBlockLogin.defaultExpectation.params = &CacheInterfaceMockBlockLoginParams{ctx, subject, ttl}
+	mmBlockLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBlockLogin.expectations {
+		if minimock.Equal(e.params, mmBlockLogin.defaultExpectation.params) {
+			mmBlockLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBlockLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmBlockLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.BlockLogin
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockBlockLogin {
+	if mmBlockLogin.mock.funcBlockLogin != nil {
+		mmBlockLogin.mock.t.Fatalf("CacheInterfaceMock.BlockLogin mock is already set by Set")
+	}
+
+	if mmBlockLogin.defaultExpectation == nil {
+		mmBlockLogin.defaultExpectation = &CacheInterfaceMockBlockLoginExpectation{}
+	}
+
+	if mmBlockLogin.defaultExpectation.params != nil {
+		mmBlockLogin.mock.t.Fatalf("CacheInterfaceMock.BlockLogin mock is already set by Expect")
+	}
+
+	if mmBlockLogin.defaultExpectation.paramPtrs == nil {
+		mmBlockLogin.defaultExpectation.paramPtrs = &CacheInterfaceMockBlockLoginParamPtrs{}
+	}
+	mmBlockLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBlockLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmBlockLogin
+}
+
+// ExpectSubjectParam2 sets up expected param subject for CacheInterface.BlockLogin
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) ExpectSubjectParam2(subject string) *mCacheInterfaceMockBlockLogin {
+	if mmBlockLogin.mock.funcBlockLogin != nil {
+		mmBlockLogin.mock.t.Fatalf("CacheInterfaceMock.BlockLogin mock is already set by Set")
+	}
+
+	if mmBlockLogin.defaultExpectation == nil {
+		mmBlockLogin.defaultExpectation = &CacheInterfaceMockBlockLoginExpectation{}
+	}
+
+	if mmBlockLogin.defaultExpectation.params != nil {
+		mmBlockLogin.mock.t.Fatalf("CacheInterfaceMock.BlockLogin mock is already set by Expect")
+	}
+
+	if mmBlockLogin.defaultExpectation.paramPtrs == nil {
+		mmBlockLogin.defaultExpectation.paramPtrs = &CacheInterfaceMockBlockLoginParamPtrs{}
+	}
+	mmBlockLogin.defaultExpectation.paramPtrs.subject = &subject
+	mmBlockLogin.defaultExpectation.expectationOrigins.originSubject = minimock.CallerInfo(1)
+
+	return mmBlockLogin
+}
+
+// ExpectTtlParam3 sets up expected param ttl for CacheInterface.BlockLogin
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) ExpectTtlParam3(ttl time.Duration) *mCacheInterfaceMockBlockLogin {
+	if mmBlockLogin.mock.funcBlockLogin != nil {
+		mmBlockLogin.mock.t.Fatalf("CacheInterfaceMock.BlockLogin mock is already set by Set")
+	}
+
+	if mmBlockLogin.defaultExpectation == nil {
+		mmBlockLogin.defaultExpectation = &CacheInterfaceMockBlockLoginExpectation{}
+	}
+
+	if mmBlockLogin.defaultExpectation.params != nil {
+		mmBlockLogin.mock.t.Fatalf("CacheInterfaceMock.BlockLogin mock is already set by Expect")
+	}
+
+	if mmBlockLogin.defaultExpectation.paramPtrs == nil {
+		mmBlockLogin.defaultExpectation.paramPtrs = &CacheInterfaceMockBlockLoginParamPtrs{}
+	}
+	mmBlockLogin.defaultExpectation.paramPtrs.ttl = &ttl
+	mmBlockLogin.defaultExpectation.expectationOrigins.originTtl = minimock.CallerInfo(1)
+
+	return mmBlockLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.BlockLogin
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) Inspect(f func(ctx context.Context, subject string, ttl time.Duration)) *mCacheInterfaceMockBlockLogin {
+	if mmBlockLogin.mock.inspectFuncBlockLogin != nil {
+		mmBlockLogin.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.BlockLogin")
+	}
+
+	mmBlockLogin.mock.inspectFuncBlockLogin = f
+
+	return mmBlockLogin
+}
+
+// Return sets up results that will be returned by CacheInterface.BlockLogin
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) Return(err error) *CacheInterfaceMock {
+	if mmBlockLogin.mock.funcBlockLogin != nil {
+		mmBlockLogin.mock.t.Fatalf("CacheInterfaceMock.BlockLogin mock is already set by Set")
+	}
+
+	if mmBlockLogin.defaultExpectation == nil {
+		mmBlockLogin.defaultExpectation = &CacheInterfaceMockBlockLoginExpectation{mock: mmBlockLogin.mock}
+	}
+	mmBlockLogin.defaultExpectation.results = &CacheInterfaceMockBlockLoginResults{err}
+	mmBlockLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBlockLogin.mock
+}
+
+// Set uses given function f to mock the CacheInterface.BlockLogin method
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) Set(f func(ctx context.Context, subject string, ttl time.Duration) (err error)) *CacheInterfaceMock {
+	if mmBlockLogin.defaultExpectation != nil {
+		mmBlockLogin.mock.t.Fatalf("Default expectation is already set for the CacheInterface.BlockLogin method")
+	}
+
+	if len(mmBlockLogin.expectations) > 0 {
+		mmBlockLogin.mock.t.Fatalf("Some expectations are already set for the CacheInterface.BlockLogin method")
+	}
+
+	mmBlockLogin.mock.funcBlockLogin = f
+	mmBlockLogin.mock.funcBlockLoginOrigin = minimock.CallerInfo(1)
+	return mmBlockLogin.mock
+}
+
+// When sets expectation for the CacheInterface.BlockLogin which will trigger the result defined by the following
+// Then helper
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) When(ctx context.Context, subject string, ttl time.Duration) *CacheInterfaceMockBlockLoginExpectation {
+	if mmBlockLogin.mock.funcBlockLogin != nil {
+		mmBlockLogin.mock.t.Fatalf("CacheInterfaceMock.BlockLogin mock is already set by Set")
+	}
+
+	expectation := &CacheInterfaceMockBlockLoginExpectation{
+		mock:               mmBlockLogin.mock,
+		params:             &CacheInterfaceMockBlockLoginParams{ctx, subject, ttl},
+		expectationOrigins: CacheInterfaceMockBlockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmBlockLogin.expectations = append(mmBlockLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CacheInterface.BlockLogin return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockBlockLoginExpectation) Then(err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockBlockLoginResults{err}
+	return e.mock
+}
+
+// Times sets number of times CacheInterface.BlockLogin should be invoked
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) Times(n uint64) *mCacheInterfaceMockBlockLogin {
+	if n == 0 {
+		mmBlockLogin.mock.t.Fatalf("Times of CacheInterfaceMock.BlockLogin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBlockLogin.expectedInvocations, n)
+	mmBlockLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBlockLogin
+}
+
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) invocationsDone() bool {
+	if len(mmBlockLogin.expectations) == 0 && mmBlockLogin.defaultExpectation == nil && mmBlockLogin.mock.funcBlockLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBlockLogin.mock.afterBlockLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBlockLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BlockLogin implements mm_repository.CacheInterface
+func (mmBlockLogin *CacheInterfaceMock) BlockLogin(ctx context.Context, subject string, ttl time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmBlockLogin.beforeBlockLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmBlockLogin.afterBlockLoginCounter, 1)
+
+	mmBlockLogin.t.Helper()
+
+	if mmBlockLogin.inspectFuncBlockLogin != nil {
+		mmBlockLogin.inspectFuncBlockLogin(ctx, subject, ttl)
+	}
+
+	mm_params := CacheInterfaceMockBlockLoginParams{ctx, subject, ttl}
+
+	// Record call args
+	mmBlockLogin.BlockLoginMock.mutex.Lock()
+	mmBlockLogin.BlockLoginMock.callArgs = append(mmBlockLogin.BlockLoginMock.callArgs, &mm_params)
+	mmBlockLogin.BlockLoginMock.mutex.Unlock()
+
+	for _, e := range mmBlockLogin.BlockLoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmBlockLogin.BlockLoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBlockLogin.BlockLoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmBlockLogin.BlockLoginMock.defaultExpectation.params
+		mm_want_ptrs := mmBlockLogin.BlockLoginMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheInterfaceMockBlockLoginParams{ctx, subject, ttl}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBlockLogin.t.Errorf("CacheInterfaceMock.BlockLogin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBlockLogin.BlockLoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmBlockLogin.t.Errorf("CacheInterfaceMock.BlockLogin got unexpected parameter subject, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBlockLogin.BlockLoginMock.defaultExpectation.expectationOrigins.originSubject, *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+			if mm_want_ptrs.ttl != nil && !minimock.Equal(*mm_want_ptrs.ttl, mm_got.ttl) {
+				mmBlockLogin.t.Errorf("CacheInterfaceMock.BlockLogin got unexpected parameter ttl, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBlockLogin.BlockLoginMock.defaultExpectation.expectationOrigins.originTtl, *mm_want_ptrs.ttl, mm_got.ttl, minimock.Diff(*mm_want_ptrs.ttl, mm_got.ttl))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBlockLogin.t.Errorf("CacheInterfaceMock.BlockLogin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBlockLogin.BlockLoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBlockLogin.BlockLoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBlockLogin.t.Fatal("No results are set for the CacheInterfaceMock.BlockLogin")
+		}
+		return (*mm_results).err
+	}
+	if mmBlockLogin.funcBlockLogin != nil {
+		return mmBlockLogin.funcBlockLogin(ctx, subject, ttl)
+	}
+	mmBlockLogin.t.Fatalf("Unexpected call to CacheInterfaceMock.BlockLogin. %v %v %v", ctx, subject, ttl)
+	return
+}
+
+// BlockLoginAfterCounter returns a count of finished CacheInterfaceMock.BlockLogin invocations
+func (mmBlockLogin *CacheInterfaceMock) BlockLoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlockLogin.afterBlockLoginCounter)
+}
+
+// BlockLoginBeforeCounter returns a count of CacheInterfaceMock.BlockLogin invocations
+func (mmBlockLogin *CacheInterfaceMock) BlockLoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlockLogin.beforeBlockLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.BlockLogin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBlockLogin *mCacheInterfaceMockBlockLogin) Calls() []*CacheInterfaceMockBlockLoginParams {
+	mmBlockLogin.mutex.RLock()
+
+	argCopy := make([]*CacheInterfaceMockBlockLoginParams, len(mmBlockLogin.callArgs))
+	copy(argCopy, mmBlockLogin.callArgs)
+
+	mmBlockLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBlockLoginDone returns true if the count of the BlockLogin invocations corresponds
+// the number of defined expectations
+func (m *CacheInterfaceMock) MinimockBlockLoginDone() bool {
+	if m.BlockLoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BlockLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BlockLoginMock.invocationsDone()
+}
+
+// MinimockBlockLoginInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockBlockLoginInspect() {
+	for _, e := range m.BlockLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheInterfaceMock.BlockLogin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBlockLoginCounter := mm_atomic.LoadUint64(&m.afterBlockLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BlockLoginMock.defaultExpectation != nil && afterBlockLoginCounter < 1 {
+		if m.BlockLoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.BlockLogin at\n%s", m.BlockLoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheInterfaceMock.BlockLogin at\n%s with params: %#v", m.BlockLoginMock.defaultExpectation.expectationOrigins.origin, *m.BlockLoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBlockLogin != nil && afterBlockLoginCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.BlockLogin at\n%s", m.funcBlockLoginOrigin)
+	}
+
+	if !m.BlockLoginMock.invocationsDone() && afterBlockLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.BlockLogin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BlockLoginMock.expectedInvocations), m.BlockLoginMock.expectedInvocationsOrigin, afterBlockLoginCounter)
+	}
+}
+
 type mCacheInterfaceMockCreate struct {
 	optional           bool
 	mock               *CacheInterfaceMock
@@ -466,55 +930,52 @@ func (m *CacheInterfaceMock) MinimockCreateInspect() {
 	}
 }
 
-type mCacheInterfaceMockCreateRole struct {
+type mCacheInterfaceMockCreateActiveFamily struct {
 	optional           bool
 	mock               *CacheInterfaceMock
-	defaultExpectation *CacheInterfaceMockCreateRoleExpectation
-	expectations       []*CacheInterfaceMockCreateRoleExpectation
+	defaultExpectation *CacheInterfaceMockCreateActiveFamilyExpectation
+	expectations       []*CacheInterfaceMockCreateActiveFamilyExpectation
 
-	callArgs []*CacheInterfaceMockCreateRoleParams
+	callArgs []*CacheInterfaceMockCreateActiveFamilyParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CacheInterfaceMockCreateRoleExpectation specifies expectation struct of the CacheInterface.CreateRole
-type CacheInterfaceMockCreateRoleExpectation struct {
+// CacheInterfaceMockCreateActiveFamilyExpectation specifies expectation struct of the CacheInterface.CreateActiveFamily
+type CacheInterfaceMockCreateActiveFamilyExpectation struct {
 	mock               *CacheInterfaceMock
-	params             *CacheInterfaceMockCreateRoleParams
-	paramPtrs          *CacheInterfaceMockCreateRoleParamPtrs
-	expectationOrigins CacheInterfaceMockCreateRoleExpectationOrigins
-	results            *CacheInterfaceMockCreateRoleResults
+	params             *CacheInterfaceMockCreateActiveFamilyParams
+	paramPtrs          *CacheInterfaceMockCreateActiveFamilyParamPtrs
+	expectationOrigins CacheInterfaceMockCreateActiveFamilyExpectationOrigins
+	results            *CacheInterfaceMockCreateActiveFamilyResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CacheInterfaceMockCreateRoleParams contains parameters of the CacheInterface.CreateRole
-type CacheInterfaceMockCreateRoleParams struct {
+// CacheInterfaceMockCreateActiveFamilyParams contains parameters of the CacheInterface.CreateActiveFamily
+type CacheInterfaceMockCreateActiveFamilyParams struct {
 	ctx      context.Context
-	username string
-	role     bool
+	familyID string
 }
 
-// CacheInterfaceMockCreateRoleParamPtrs contains pointers to parameters of the CacheInterface.CreateRole
-type CacheInterfaceMockCreateRoleParamPtrs struct {
+// CacheInterfaceMockCreateActiveFamilyParamPtrs contains pointers to parameters of the CacheInterface.CreateActiveFamily
+type CacheInterfaceMockCreateActiveFamilyParamPtrs struct {
 	ctx      *context.Context
-	username *string
-	role     *bool
+	familyID *string
 }
 
-// CacheInterfaceMockCreateRoleResults contains results of the CacheInterface.CreateRole
-type CacheInterfaceMockCreateRoleResults struct {
+// CacheInterfaceMockCreateActiveFamilyResults contains results of the CacheInterface.CreateActiveFamily
+type CacheInterfaceMockCreateActiveFamilyResults struct {
 	err error
 }
 
-// CacheInterfaceMockCreateRoleOrigins contains origins of expectations of the CacheInterface.CreateRole
-type CacheInterfaceMockCreateRoleExpectationOrigins struct {
+// CacheInterfaceMockCreateActiveFamilyOrigins contains origins of expectations of the CacheInterface.CreateActiveFamily
+type CacheInterfaceMockCreateActiveFamilyExpectationOrigins struct {
 	origin         string
 	originCtx      string
-	originUsername string
-	originRole     string
+	originFamilyID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -522,372 +983,3112 @@ type CacheInterfaceMockCreateRoleExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateRole *mCacheInterfaceMockCreateRole) Optional() *mCacheInterfaceMockCreateRole {
-	mmCreateRole.optional = true
-	return mmCreateRole
+func (mmCreateActiveFamily *mCacheInterfaceMockCreateActiveFamily) Optional() *mCacheInterfaceMockCreateActiveFamily {
+	mmCreateActiveFamily.optional = true
+	return mmCreateActiveFamily
 }
 
-// Expect sets up expected params for CacheInterface.CreateRole
-func (mmCreateRole *mCacheInterfaceMockCreateRole) Expect(ctx context.Context, username string, role bool) *mCacheInterfaceMockCreateRole {
-	if mmCreateRole.mock.funcCreateRole != nil {
-		mmCreateRole.mock.t.Fatalf("CacheInterfaceMock.CreateRole mock is already set by Set")
+// Expect sets up expected params for CacheInterface.CreateActiveFamily
+func (mmCreateActiveFamily *mCacheInterfaceMockCreateActiveFamily) Expect(ctx context.Context, familyID string) *mCacheInterfaceMockCreateActiveFamily {
+	if mmCreateActiveFamily.mock.funcCreateActiveFamily != nil {
+		mmCreateActiveFamily.mock.t.Fatalf("CacheInterfaceMock.CreateActiveFamily mock is already set by Set")
 	}
 
-	if mmCreateRole.defaultExpectation == nil {
-		mmCreateRole.defaultExpectation = &CacheInterfaceMockCreateRoleExpectation{}
+	if mmCreateActiveFamily.defaultExpectation == nil {
+		mmCreateActiveFamily.defaultExpectation = &CacheInterfaceMockCreateActiveFamilyExpectation{}
 	}
 
-	if mmCreateRole.defaultExpectation.paramPtrs != nil {
-		mmCreateRole.mock.t.Fatalf("CacheInterfaceMock.CreateRole mock is already set by ExpectParams functions")
+	if mmCreateActiveFamily.defaultExpectation.paramPtrs != nil {
+		mmCreateActiveFamily.mock.t.Fatalf("CacheInterfaceMock.CreateActiveFamily mock is already set by ExpectParams functions")
 	}
 
-	mmCreateRole.defaultExpectation.params = &CacheInterfaceMockCreateRoleParams{ctx, username, role}
-	mmCreateRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateRole.expectations {
-		if minimock.Equal(e.params, mmCreateRole.defaultExpectation.params) {
-			mmCreateRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRole.defaultExpectation.params)
+	mmCreateActiveFamily.defaultExpectation.params = &CacheInterfaceMockCreateActiveFamilyParams{ctx, familyID}
+	mmCreateActiveFamily.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateActiveFamily.expectations {
+		if minimock.Equal(e.params, mmCreateActiveFamily.defaultExpectation.params) {
+			mmCreateActiveFamily.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateActiveFamily.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateRole
+	return mmCreateActiveFamily
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.CreateActiveFamily
+func (mmCreateActiveFamily *mCacheInterfaceMockCreateActiveFamily) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockCreateActiveFamily {
+	if mmCreateActiveFamily.mock.funcCreateActiveFamily != nil {
+		mmCreateActiveFamily.mock.t.Fatalf("CacheInterfaceMock.CreateActiveFamily mock is already set by Set")
+	}
+
+	if mmCreateActiveFamily.defaultExpectation == nil {
+		mmCreateActiveFamily.defaultExpectation = &CacheInterfaceMockCreateActiveFamilyExpectation{}
+	}
+
+	if mmCreateActiveFamily.defaultExpectation.params != nil {
+		mmCreateActiveFamily.mock.t.Fatalf("CacheInterfaceMock.CreateActiveFamily mock is already set by Expect")
+	}
+
+	if mmCreateActiveFamily.defaultExpectation.paramPtrs == nil {
+		mmCreateActiveFamily.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateActiveFamilyParamPtrs{}
+	}
+	mmCreateActiveFamily.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateActiveFamily.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateActiveFamily
+}
+
+// ExpectFamilyIDParam2 sets up expected param familyID for CacheInterface.CreateActiveFamily
+func (mmCreateActiveFamily *mCacheInterfaceMockCreateActiveFamily) ExpectFamilyIDParam2(familyID string) *mCacheInterfaceMockCreateActiveFamily {
+	if mmCreateActiveFamily.mock.funcCreateActiveFamily != nil {
+		mmCreateActiveFamily.mock.t.Fatalf("CacheInterfaceMock.CreateActiveFamily mock is already set by Set")
+	}
+
+	if mmCreateActiveFamily.defaultExpectation == nil {
+		mmCreateActiveFamily.defaultExpectation = &CacheInterfaceMockCreateActiveFamilyExpectation{}
+	}
+
+	if mmCreateActiveFamily.defaultExpectation.params != nil {
+		mmCreateActiveFamily.mock.t.Fatalf("CacheInterfaceMock.CreateActiveFamily mock is already set by Expect")
+	}
+
+	if mmCreateActiveFamily.defaultExpectation.paramPtrs == nil {
+		mmCreateActiveFamily.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateActiveFamilyParamPtrs{}
+	}
+	mmCreateActiveFamily.defaultExpectation.paramPtrs.familyID = &familyID
+	mmCreateActiveFamily.defaultExpectation.expectationOrigins.originFamilyID = minimock.CallerInfo(1)
+
+	return mmCreateActiveFamily
+}
+
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.CreateActiveFamily
+func (mmCreateActiveFamily *mCacheInterfaceMockCreateActiveFamily) Inspect(f func(ctx context.Context, familyID string)) *mCacheInterfaceMockCreateActiveFamily {
+	if mmCreateActiveFamily.mock.inspectFuncCreateActiveFamily != nil {
+		mmCreateActiveFamily.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.CreateActiveFamily")
+	}
+
+	mmCreateActiveFamily.mock.inspectFuncCreateActiveFamily = f
+
+	return mmCreateActiveFamily
+}
+
+// Return sets up results that will be returned by CacheInterface.CreateActiveFamily
+func (mmCreateActiveFamily *mCacheInterfaceMockCreateActiveFamily) Return(err error) *CacheInterfaceMock {
+	if mmCreateActiveFamily.mock.funcCreateActiveFamily != nil {
+		mmCreateActiveFamily.mock.t.Fatalf("CacheInterfaceMock.CreateActiveFamily mock is already set by Set")
+	}
+
+	if mmCreateActiveFamily.defaultExpectation == nil {
+		mmCreateActiveFamily.defaultExpectation = &CacheInterfaceMockCreateActiveFamilyExpectation{mock: mmCreateActiveFamily.mock}
+	}
+	mmCreateActiveFamily.defaultExpectation.results = &CacheInterfaceMockCreateActiveFamilyResults{err}
+	mmCreateActiveFamily.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateActiveFamily.mock
+}
+
+// Set uses given function f to mock the CacheInterface.CreateActiveFamily method
+func (mmCreateActiveFamily *mCacheInterfaceMockCreateActiveFamily) Set(f func(ctx context.Context, familyID string) (err error)) *CacheInterfaceMock {
+	if mmCreateActiveFamily.defaultExpectation != nil {
+		mmCreateActiveFamily.mock.t.Fatalf("Default expectation is already set for the CacheInterface.CreateActiveFamily method")
+	}
+
+	if len(mmCreateActiveFamily.expectations) > 0 {
+		mmCreateActiveFamily.mock.t.Fatalf("Some expectations are already set for the CacheInterface.CreateActiveFamily method")
+	}
+
+	mmCreateActiveFamily.mock.funcCreateActiveFamily = f
+	mmCreateActiveFamily.mock.funcCreateActiveFamilyOrigin = minimock.CallerInfo(1)
+	return mmCreateActiveFamily.mock
+}
+
+// When sets expectation for the CacheInterface.CreateActiveFamily which will trigger the result defined by the following
+// Then helper
+func (mmCreateActiveFamily *mCacheInterfaceMockCreateActiveFamily) When(ctx context.Context, familyID string) *CacheInterfaceMockCreateActiveFamilyExpectation {
+	if mmCreateActiveFamily.mock.funcCreateActiveFamily != nil {
+		mmCreateActiveFamily.mock.t.Fatalf("CacheInterfaceMock.CreateActiveFamily mock is already set by Set")
+	}
+
+	expectation := &CacheInterfaceMockCreateActiveFamilyExpectation{
+		mock:               mmCreateActiveFamily.mock,
+		params:             &CacheInterfaceMockCreateActiveFamilyParams{ctx, familyID},
+		expectationOrigins: CacheInterfaceMockCreateActiveFamilyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateActiveFamily.expectations = append(mmCreateActiveFamily.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CacheInterface.CreateActiveFamily return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockCreateActiveFamilyExpectation) Then(err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockCreateActiveFamilyResults{err}
+	return e.mock
+}
+
+// Times sets number of times CacheInterface.CreateActiveFamily should be invoked
+func (mmCreateActiveFamily *mCacheInterfaceMockCreateActiveFamily) Times(n uint64) *mCacheInterfaceMockCreateActiveFamily {
+	if n == 0 {
+		mmCreateActiveFamily.mock.t.Fatalf("Times of CacheInterfaceMock.CreateActiveFamily mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateActiveFamily.expectedInvocations, n)
+	mmCreateActiveFamily.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateActiveFamily
+}
+
+func (mmCreateActiveFamily *mCacheInterfaceMockCreateActiveFamily) invocationsDone() bool {
+	if len(mmCreateActiveFamily.expectations) == 0 && mmCreateActiveFamily.defaultExpectation == nil && mmCreateActiveFamily.mock.funcCreateActiveFamily == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateActiveFamily.mock.afterCreateActiveFamilyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateActiveFamily.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateActiveFamily implements mm_repository.CacheInterface
+func (mmCreateActiveFamily *CacheInterfaceMock) CreateActiveFamily(ctx context.Context, familyID string) (err error) {
+	mm_atomic.AddUint64(&mmCreateActiveFamily.beforeCreateActiveFamilyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateActiveFamily.afterCreateActiveFamilyCounter, 1)
+
+	mmCreateActiveFamily.t.Helper()
+
+	if mmCreateActiveFamily.inspectFuncCreateActiveFamily != nil {
+		mmCreateActiveFamily.inspectFuncCreateActiveFamily(ctx, familyID)
+	}
+
+	mm_params := CacheInterfaceMockCreateActiveFamilyParams{ctx, familyID}
+
+	// Record call args
+	mmCreateActiveFamily.CreateActiveFamilyMock.mutex.Lock()
+	mmCreateActiveFamily.CreateActiveFamilyMock.callArgs = append(mmCreateActiveFamily.CreateActiveFamilyMock.callArgs, &mm_params)
+	mmCreateActiveFamily.CreateActiveFamilyMock.mutex.Unlock()
+
+	for _, e := range mmCreateActiveFamily.CreateActiveFamilyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateActiveFamily.CreateActiveFamilyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateActiveFamily.CreateActiveFamilyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateActiveFamily.CreateActiveFamilyMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateActiveFamily.CreateActiveFamilyMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheInterfaceMockCreateActiveFamilyParams{ctx, familyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateActiveFamily.t.Errorf("CacheInterfaceMock.CreateActiveFamily got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateActiveFamily.CreateActiveFamilyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.familyID != nil && !minimock.Equal(*mm_want_ptrs.familyID, mm_got.familyID) {
+				mmCreateActiveFamily.t.Errorf("CacheInterfaceMock.CreateActiveFamily got unexpected parameter familyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateActiveFamily.CreateActiveFamilyMock.defaultExpectation.expectationOrigins.originFamilyID, *mm_want_ptrs.familyID, mm_got.familyID, minimock.Diff(*mm_want_ptrs.familyID, mm_got.familyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateActiveFamily.t.Errorf("CacheInterfaceMock.CreateActiveFamily got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateActiveFamily.CreateActiveFamilyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateActiveFamily.CreateActiveFamilyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateActiveFamily.t.Fatal("No results are set for the CacheInterfaceMock.CreateActiveFamily")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateActiveFamily.funcCreateActiveFamily != nil {
+		return mmCreateActiveFamily.funcCreateActiveFamily(ctx, familyID)
+	}
+	mmCreateActiveFamily.t.Fatalf("Unexpected call to CacheInterfaceMock.CreateActiveFamily. %v %v", ctx, familyID)
+	return
+}
+
+// CreateActiveFamilyAfterCounter returns a count of finished CacheInterfaceMock.CreateActiveFamily invocations
+func (mmCreateActiveFamily *CacheInterfaceMock) CreateActiveFamilyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateActiveFamily.afterCreateActiveFamilyCounter)
+}
+
+// CreateActiveFamilyBeforeCounter returns a count of CacheInterfaceMock.CreateActiveFamily invocations
+func (mmCreateActiveFamily *CacheInterfaceMock) CreateActiveFamilyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateActiveFamily.beforeCreateActiveFamilyCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.CreateActiveFamily.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateActiveFamily *mCacheInterfaceMockCreateActiveFamily) Calls() []*CacheInterfaceMockCreateActiveFamilyParams {
+	mmCreateActiveFamily.mutex.RLock()
+
+	argCopy := make([]*CacheInterfaceMockCreateActiveFamilyParams, len(mmCreateActiveFamily.callArgs))
+	copy(argCopy, mmCreateActiveFamily.callArgs)
+
+	mmCreateActiveFamily.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateActiveFamilyDone returns true if the count of the CreateActiveFamily invocations corresponds
+// the number of defined expectations
+func (m *CacheInterfaceMock) MinimockCreateActiveFamilyDone() bool {
+	if m.CreateActiveFamilyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateActiveFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateActiveFamilyMock.invocationsDone()
+}
+
+// MinimockCreateActiveFamilyInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockCreateActiveFamilyInspect() {
+	for _, e := range m.CreateActiveFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateActiveFamily at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateActiveFamilyCounter := mm_atomic.LoadUint64(&m.afterCreateActiveFamilyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateActiveFamilyMock.defaultExpectation != nil && afterCreateActiveFamilyCounter < 1 {
+		if m.CreateActiveFamilyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateActiveFamily at\n%s", m.CreateActiveFamilyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateActiveFamily at\n%s with params: %#v", m.CreateActiveFamilyMock.defaultExpectation.expectationOrigins.origin, *m.CreateActiveFamilyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateActiveFamily != nil && afterCreateActiveFamilyCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.CreateActiveFamily at\n%s", m.funcCreateActiveFamilyOrigin)
+	}
+
+	if !m.CreateActiveFamilyMock.invocationsDone() && afterCreateActiveFamilyCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.CreateActiveFamily at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateActiveFamilyMock.expectedInvocations), m.CreateActiveFamilyMock.expectedInvocationsOrigin, afterCreateActiveFamilyCounter)
+	}
+}
+
+type mCacheInterfaceMockCreateRevokedFamily struct {
+	optional           bool
+	mock               *CacheInterfaceMock
+	defaultExpectation *CacheInterfaceMockCreateRevokedFamilyExpectation
+	expectations       []*CacheInterfaceMockCreateRevokedFamilyExpectation
+
+	callArgs []*CacheInterfaceMockCreateRevokedFamilyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheInterfaceMockCreateRevokedFamilyExpectation specifies expectation struct of the CacheInterface.CreateRevokedFamily
+type CacheInterfaceMockCreateRevokedFamilyExpectation struct {
+	mock               *CacheInterfaceMock
+	params             *CacheInterfaceMockCreateRevokedFamilyParams
+	paramPtrs          *CacheInterfaceMockCreateRevokedFamilyParamPtrs
+	expectationOrigins CacheInterfaceMockCreateRevokedFamilyExpectationOrigins
+	results            *CacheInterfaceMockCreateRevokedFamilyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheInterfaceMockCreateRevokedFamilyParams contains parameters of the CacheInterface.CreateRevokedFamily
+type CacheInterfaceMockCreateRevokedFamilyParams struct {
+	ctx      context.Context
+	familyID string
+}
+
+// CacheInterfaceMockCreateRevokedFamilyParamPtrs contains pointers to parameters of the CacheInterface.CreateRevokedFamily
+type CacheInterfaceMockCreateRevokedFamilyParamPtrs struct {
+	ctx      *context.Context
+	familyID *string
+}
+
+// CacheInterfaceMockCreateRevokedFamilyResults contains results of the CacheInterface.CreateRevokedFamily
+type CacheInterfaceMockCreateRevokedFamilyResults struct {
+	err error
+}
+
+// CacheInterfaceMockCreateRevokedFamilyOrigins contains origins of expectations of the CacheInterface.CreateRevokedFamily
+type CacheInterfaceMockCreateRevokedFamilyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originFamilyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRevokedFamily *mCacheInterfaceMockCreateRevokedFamily) Optional() *mCacheInterfaceMockCreateRevokedFamily {
+	mmCreateRevokedFamily.optional = true
+	return mmCreateRevokedFamily
+}
+
+// Expect sets up expected params for CacheInterface.CreateRevokedFamily
+func (mmCreateRevokedFamily *mCacheInterfaceMockCreateRevokedFamily) Expect(ctx context.Context, familyID string) *mCacheInterfaceMockCreateRevokedFamily {
+	if mmCreateRevokedFamily.mock.funcCreateRevokedFamily != nil {
+		mmCreateRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.CreateRevokedFamily mock is already set by Set")
+	}
+
+	if mmCreateRevokedFamily.defaultExpectation == nil {
+		mmCreateRevokedFamily.defaultExpectation = &CacheInterfaceMockCreateRevokedFamilyExpectation{}
+	}
+
+	if mmCreateRevokedFamily.defaultExpectation.paramPtrs != nil {
+		mmCreateRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.CreateRevokedFamily mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRevokedFamily.defaultExpectation.params = &CacheInterfaceMockCreateRevokedFamilyParams{ctx, familyID}
+	mmCreateRevokedFamily.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRevokedFamily.expectations {
+		if minimock.Equal(e.params, mmCreateRevokedFamily.defaultExpectation.params) {
+			mmCreateRevokedFamily.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRevokedFamily.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRevokedFamily
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.CreateRevokedFamily
+func (mmCreateRevokedFamily *mCacheInterfaceMockCreateRevokedFamily) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockCreateRevokedFamily {
+	if mmCreateRevokedFamily.mock.funcCreateRevokedFamily != nil {
+		mmCreateRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.CreateRevokedFamily mock is already set by Set")
+	}
+
+	if mmCreateRevokedFamily.defaultExpectation == nil {
+		mmCreateRevokedFamily.defaultExpectation = &CacheInterfaceMockCreateRevokedFamilyExpectation{}
+	}
+
+	if mmCreateRevokedFamily.defaultExpectation.params != nil {
+		mmCreateRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.CreateRevokedFamily mock is already set by Expect")
+	}
+
+	if mmCreateRevokedFamily.defaultExpectation.paramPtrs == nil {
+		mmCreateRevokedFamily.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRevokedFamilyParamPtrs{}
+	}
+	mmCreateRevokedFamily.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRevokedFamily.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRevokedFamily
+}
+
+// ExpectFamilyIDParam2 sets up expected param familyID for CacheInterface.CreateRevokedFamily
+func (mmCreateRevokedFamily *mCacheInterfaceMockCreateRevokedFamily) ExpectFamilyIDParam2(familyID string) *mCacheInterfaceMockCreateRevokedFamily {
+	if mmCreateRevokedFamily.mock.funcCreateRevokedFamily != nil {
+		mmCreateRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.CreateRevokedFamily mock is already set by Set")
+	}
+
+	if mmCreateRevokedFamily.defaultExpectation == nil {
+		mmCreateRevokedFamily.defaultExpectation = &CacheInterfaceMockCreateRevokedFamilyExpectation{}
+	}
+
+	if mmCreateRevokedFamily.defaultExpectation.params != nil {
+		mmCreateRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.CreateRevokedFamily mock is already set by Expect")
+	}
+
+	if mmCreateRevokedFamily.defaultExpectation.paramPtrs == nil {
+		mmCreateRevokedFamily.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRevokedFamilyParamPtrs{}
+	}
+	mmCreateRevokedFamily.defaultExpectation.paramPtrs.familyID = &familyID
+	mmCreateRevokedFamily.defaultExpectation.expectationOrigins.originFamilyID = minimock.CallerInfo(1)
+
+	return mmCreateRevokedFamily
+}
+
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.CreateRevokedFamily
+func (mmCreateRevokedFamily *mCacheInterfaceMockCreateRevokedFamily) Inspect(f func(ctx context.Context, familyID string)) *mCacheInterfaceMockCreateRevokedFamily {
+	if mmCreateRevokedFamily.mock.inspectFuncCreateRevokedFamily != nil {
+		mmCreateRevokedFamily.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.CreateRevokedFamily")
+	}
+
+	mmCreateRevokedFamily.mock.inspectFuncCreateRevokedFamily = f
+
+	return mmCreateRevokedFamily
+}
+
+// Return sets up results that will be returned by CacheInterface.CreateRevokedFamily
+func (mmCreateRevokedFamily *mCacheInterfaceMockCreateRevokedFamily) Return(err error) *CacheInterfaceMock {
+	if mmCreateRevokedFamily.mock.funcCreateRevokedFamily != nil {
+		mmCreateRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.CreateRevokedFamily mock is already set by Set")
+	}
+
+	if mmCreateRevokedFamily.defaultExpectation == nil {
+		mmCreateRevokedFamily.defaultExpectation = &CacheInterfaceMockCreateRevokedFamilyExpectation{mock: mmCreateRevokedFamily.mock}
+	}
+	mmCreateRevokedFamily.defaultExpectation.results = &CacheInterfaceMockCreateRevokedFamilyResults{err}
+	mmCreateRevokedFamily.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRevokedFamily.mock
+}
+
+// Set uses given function f to mock the CacheInterface.CreateRevokedFamily method
+func (mmCreateRevokedFamily *mCacheInterfaceMockCreateRevokedFamily) Set(f func(ctx context.Context, familyID string) (err error)) *CacheInterfaceMock {
+	if mmCreateRevokedFamily.defaultExpectation != nil {
+		mmCreateRevokedFamily.mock.t.Fatalf("Default expectation is already set for the CacheInterface.CreateRevokedFamily method")
+	}
+
+	if len(mmCreateRevokedFamily.expectations) > 0 {
+		mmCreateRevokedFamily.mock.t.Fatalf("Some expectations are already set for the CacheInterface.CreateRevokedFamily method")
+	}
+
+	mmCreateRevokedFamily.mock.funcCreateRevokedFamily = f
+	mmCreateRevokedFamily.mock.funcCreateRevokedFamilyOrigin = minimock.CallerInfo(1)
+	return mmCreateRevokedFamily.mock
+}
+
+// When sets expectation for the CacheInterface.CreateRevokedFamily which will trigger the result defined by the following
+// Then helper
+func (mmCreateRevokedFamily *mCacheInterfaceMockCreateRevokedFamily) When(ctx context.Context, familyID string) *CacheInterfaceMockCreateRevokedFamilyExpectation {
+	if mmCreateRevokedFamily.mock.funcCreateRevokedFamily != nil {
+		mmCreateRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.CreateRevokedFamily mock is already set by Set")
+	}
+
+	expectation := &CacheInterfaceMockCreateRevokedFamilyExpectation{
+		mock:               mmCreateRevokedFamily.mock,
+		params:             &CacheInterfaceMockCreateRevokedFamilyParams{ctx, familyID},
+		expectationOrigins: CacheInterfaceMockCreateRevokedFamilyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRevokedFamily.expectations = append(mmCreateRevokedFamily.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CacheInterface.CreateRevokedFamily return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockCreateRevokedFamilyExpectation) Then(err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockCreateRevokedFamilyResults{err}
+	return e.mock
+}
+
+// Times sets number of times CacheInterface.CreateRevokedFamily should be invoked
+func (mmCreateRevokedFamily *mCacheInterfaceMockCreateRevokedFamily) Times(n uint64) *mCacheInterfaceMockCreateRevokedFamily {
+	if n == 0 {
+		mmCreateRevokedFamily.mock.t.Fatalf("Times of CacheInterfaceMock.CreateRevokedFamily mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRevokedFamily.expectedInvocations, n)
+	mmCreateRevokedFamily.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRevokedFamily
+}
+
+func (mmCreateRevokedFamily *mCacheInterfaceMockCreateRevokedFamily) invocationsDone() bool {
+	if len(mmCreateRevokedFamily.expectations) == 0 && mmCreateRevokedFamily.defaultExpectation == nil && mmCreateRevokedFamily.mock.funcCreateRevokedFamily == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRevokedFamily.mock.afterCreateRevokedFamilyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRevokedFamily.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRevokedFamily implements mm_repository.CacheInterface
+func (mmCreateRevokedFamily *CacheInterfaceMock) CreateRevokedFamily(ctx context.Context, familyID string) (err error) {
+	mm_atomic.AddUint64(&mmCreateRevokedFamily.beforeCreateRevokedFamilyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRevokedFamily.afterCreateRevokedFamilyCounter, 1)
+
+	mmCreateRevokedFamily.t.Helper()
+
+	if mmCreateRevokedFamily.inspectFuncCreateRevokedFamily != nil {
+		mmCreateRevokedFamily.inspectFuncCreateRevokedFamily(ctx, familyID)
+	}
+
+	mm_params := CacheInterfaceMockCreateRevokedFamilyParams{ctx, familyID}
+
+	// Record call args
+	mmCreateRevokedFamily.CreateRevokedFamilyMock.mutex.Lock()
+	mmCreateRevokedFamily.CreateRevokedFamilyMock.callArgs = append(mmCreateRevokedFamily.CreateRevokedFamilyMock.callArgs, &mm_params)
+	mmCreateRevokedFamily.CreateRevokedFamilyMock.mutex.Unlock()
+
+	for _, e := range mmCreateRevokedFamily.CreateRevokedFamilyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateRevokedFamily.CreateRevokedFamilyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRevokedFamily.CreateRevokedFamilyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRevokedFamily.CreateRevokedFamilyMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRevokedFamily.CreateRevokedFamilyMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheInterfaceMockCreateRevokedFamilyParams{ctx, familyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRevokedFamily.t.Errorf("CacheInterfaceMock.CreateRevokedFamily got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRevokedFamily.CreateRevokedFamilyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.familyID != nil && !minimock.Equal(*mm_want_ptrs.familyID, mm_got.familyID) {
+				mmCreateRevokedFamily.t.Errorf("CacheInterfaceMock.CreateRevokedFamily got unexpected parameter familyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRevokedFamily.CreateRevokedFamilyMock.defaultExpectation.expectationOrigins.originFamilyID, *mm_want_ptrs.familyID, mm_got.familyID, minimock.Diff(*mm_want_ptrs.familyID, mm_got.familyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRevokedFamily.t.Errorf("CacheInterfaceMock.CreateRevokedFamily got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRevokedFamily.CreateRevokedFamilyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRevokedFamily.CreateRevokedFamilyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRevokedFamily.t.Fatal("No results are set for the CacheInterfaceMock.CreateRevokedFamily")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateRevokedFamily.funcCreateRevokedFamily != nil {
+		return mmCreateRevokedFamily.funcCreateRevokedFamily(ctx, familyID)
+	}
+	mmCreateRevokedFamily.t.Fatalf("Unexpected call to CacheInterfaceMock.CreateRevokedFamily. %v %v", ctx, familyID)
+	return
+}
+
+// CreateRevokedFamilyAfterCounter returns a count of finished CacheInterfaceMock.CreateRevokedFamily invocations
+func (mmCreateRevokedFamily *CacheInterfaceMock) CreateRevokedFamilyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRevokedFamily.afterCreateRevokedFamilyCounter)
+}
+
+// CreateRevokedFamilyBeforeCounter returns a count of CacheInterfaceMock.CreateRevokedFamily invocations
+func (mmCreateRevokedFamily *CacheInterfaceMock) CreateRevokedFamilyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRevokedFamily.beforeCreateRevokedFamilyCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.CreateRevokedFamily.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRevokedFamily *mCacheInterfaceMockCreateRevokedFamily) Calls() []*CacheInterfaceMockCreateRevokedFamilyParams {
+	mmCreateRevokedFamily.mutex.RLock()
+
+	argCopy := make([]*CacheInterfaceMockCreateRevokedFamilyParams, len(mmCreateRevokedFamily.callArgs))
+	copy(argCopy, mmCreateRevokedFamily.callArgs)
+
+	mmCreateRevokedFamily.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRevokedFamilyDone returns true if the count of the CreateRevokedFamily invocations corresponds
+// the number of defined expectations
+func (m *CacheInterfaceMock) MinimockCreateRevokedFamilyDone() bool {
+	if m.CreateRevokedFamilyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRevokedFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRevokedFamilyMock.invocationsDone()
+}
+
+// MinimockCreateRevokedFamilyInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockCreateRevokedFamilyInspect() {
+	for _, e := range m.CreateRevokedFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRevokedFamily at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRevokedFamilyCounter := mm_atomic.LoadUint64(&m.afterCreateRevokedFamilyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRevokedFamilyMock.defaultExpectation != nil && afterCreateRevokedFamilyCounter < 1 {
+		if m.CreateRevokedFamilyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRevokedFamily at\n%s", m.CreateRevokedFamilyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRevokedFamily at\n%s with params: %#v", m.CreateRevokedFamilyMock.defaultExpectation.expectationOrigins.origin, *m.CreateRevokedFamilyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRevokedFamily != nil && afterCreateRevokedFamilyCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.CreateRevokedFamily at\n%s", m.funcCreateRevokedFamilyOrigin)
+	}
+
+	if !m.CreateRevokedFamilyMock.invocationsDone() && afterCreateRevokedFamilyCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.CreateRevokedFamily at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRevokedFamilyMock.expectedInvocations), m.CreateRevokedFamilyMock.expectedInvocationsOrigin, afterCreateRevokedFamilyCounter)
+	}
+}
+
+type mCacheInterfaceMockCreateRoles struct {
+	optional           bool
+	mock               *CacheInterfaceMock
+	defaultExpectation *CacheInterfaceMockCreateRolesExpectation
+	expectations       []*CacheInterfaceMockCreateRolesExpectation
+
+	callArgs []*CacheInterfaceMockCreateRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheInterfaceMockCreateRolesExpectation specifies expectation struct of the CacheInterface.CreateRoles
+type CacheInterfaceMockCreateRolesExpectation struct {
+	mock               *CacheInterfaceMock
+	params             *CacheInterfaceMockCreateRolesParams
+	paramPtrs          *CacheInterfaceMockCreateRolesParamPtrs
+	expectationOrigins CacheInterfaceMockCreateRolesExpectationOrigins
+	results            *CacheInterfaceMockCreateRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheInterfaceMockCreateRolesParams contains parameters of the CacheInterface.CreateRoles
+type CacheInterfaceMockCreateRolesParams struct {
+	ctx      context.Context
+	username string
+	roles    []string
+}
+
+// CacheInterfaceMockCreateRolesParamPtrs contains pointers to parameters of the CacheInterface.CreateRoles
+type CacheInterfaceMockCreateRolesParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	roles    *[]string
+}
+
+// CacheInterfaceMockCreateRolesResults contains results of the CacheInterface.CreateRoles
+type CacheInterfaceMockCreateRolesResults struct {
+	err error
+}
+
+// CacheInterfaceMockCreateRolesOrigins contains origins of expectations of the CacheInterface.CreateRoles
+type CacheInterfaceMockCreateRolesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originRoles    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) Optional() *mCacheInterfaceMockCreateRoles {
+	mmCreateRoles.optional = true
+	return mmCreateRoles
+}
+
+// Expect sets up expected params for CacheInterface.CreateRoles
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) Expect(ctx context.Context, username string, roles []string) *mCacheInterfaceMockCreateRoles {
+	if mmCreateRoles.mock.funcCreateRoles != nil {
+		mmCreateRoles.mock.t.Fatalf("CacheInterfaceMock.CreateRoles mock is already set by Set")
+	}
+
+	if mmCreateRoles.defaultExpectation == nil {
+		mmCreateRoles.defaultExpectation = &CacheInterfaceMockCreateRolesExpectation{}
+	}
+
+	if mmCreateRoles.defaultExpectation.paramPtrs != nil {
+		mmCreateRoles.mock.t.Fatalf("CacheInterfaceMock.CreateRoles mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRoles.defaultExpectation.params = &CacheInterfaceMockCreateRolesParams{ctx, username, roles}
+	mmCreateRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRoles.expectations {
+		if minimock.Equal(e.params, mmCreateRoles.defaultExpectation.params) {
+			mmCreateRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.CreateRoles
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockCreateRoles {
+	if mmCreateRoles.mock.funcCreateRoles != nil {
+		mmCreateRoles.mock.t.Fatalf("CacheInterfaceMock.CreateRoles mock is already set by Set")
+	}
+
+	if mmCreateRoles.defaultExpectation == nil {
+		mmCreateRoles.defaultExpectation = &CacheInterfaceMockCreateRolesExpectation{}
+	}
+
+	if mmCreateRoles.defaultExpectation.params != nil {
+		mmCreateRoles.mock.t.Fatalf("CacheInterfaceMock.CreateRoles mock is already set by Expect")
+	}
+
+	if mmCreateRoles.defaultExpectation.paramPtrs == nil {
+		mmCreateRoles.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRolesParamPtrs{}
+	}
+	mmCreateRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRoles
+}
+
+// ExpectUsernameParam2 sets up expected param username for CacheInterface.CreateRoles
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) ExpectUsernameParam2(username string) *mCacheInterfaceMockCreateRoles {
+	if mmCreateRoles.mock.funcCreateRoles != nil {
+		mmCreateRoles.mock.t.Fatalf("CacheInterfaceMock.CreateRoles mock is already set by Set")
+	}
+
+	if mmCreateRoles.defaultExpectation == nil {
+		mmCreateRoles.defaultExpectation = &CacheInterfaceMockCreateRolesExpectation{}
+	}
+
+	if mmCreateRoles.defaultExpectation.params != nil {
+		mmCreateRoles.mock.t.Fatalf("CacheInterfaceMock.CreateRoles mock is already set by Expect")
+	}
+
+	if mmCreateRoles.defaultExpectation.paramPtrs == nil {
+		mmCreateRoles.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRolesParamPtrs{}
+	}
+	mmCreateRoles.defaultExpectation.paramPtrs.username = &username
+	mmCreateRoles.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmCreateRoles
+}
+
+// ExpectRolesParam3 sets up expected param roles for CacheInterface.CreateRoles
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) ExpectRolesParam3(roles []string) *mCacheInterfaceMockCreateRoles {
+	if mmCreateRoles.mock.funcCreateRoles != nil {
+		mmCreateRoles.mock.t.Fatalf("CacheInterfaceMock.CreateRoles mock is already set by Set")
+	}
+
+	if mmCreateRoles.defaultExpectation == nil {
+		mmCreateRoles.defaultExpectation = &CacheInterfaceMockCreateRolesExpectation{}
+	}
+
+	if mmCreateRoles.defaultExpectation.params != nil {
+		mmCreateRoles.mock.t.Fatalf("CacheInterfaceMock.CreateRoles mock is already set by Expect")
+	}
+
+	if mmCreateRoles.defaultExpectation.paramPtrs == nil {
+		mmCreateRoles.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRolesParamPtrs{}
+	}
+	mmCreateRoles.defaultExpectation.paramPtrs.roles = &roles
+	mmCreateRoles.defaultExpectation.expectationOrigins.originRoles = minimock.CallerInfo(1)
+
+	return mmCreateRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.CreateRoles
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) Inspect(f func(ctx context.Context, username string, roles []string)) *mCacheInterfaceMockCreateRoles {
+	if mmCreateRoles.mock.inspectFuncCreateRoles != nil {
+		mmCreateRoles.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.CreateRoles")
+	}
+
+	mmCreateRoles.mock.inspectFuncCreateRoles = f
+
+	return mmCreateRoles
+}
+
+// Return sets up results that will be returned by CacheInterface.CreateRoles
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) Return(err error) *CacheInterfaceMock {
+	if mmCreateRoles.mock.funcCreateRoles != nil {
+		mmCreateRoles.mock.t.Fatalf("CacheInterfaceMock.CreateRoles mock is already set by Set")
+	}
+
+	if mmCreateRoles.defaultExpectation == nil {
+		mmCreateRoles.defaultExpectation = &CacheInterfaceMockCreateRolesExpectation{mock: mmCreateRoles.mock}
+	}
+	mmCreateRoles.defaultExpectation.results = &CacheInterfaceMockCreateRolesResults{err}
+	mmCreateRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRoles.mock
+}
+
+// Set uses given function f to mock the CacheInterface.CreateRoles method
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) Set(f func(ctx context.Context, username string, roles []string) (err error)) *CacheInterfaceMock {
+	if mmCreateRoles.defaultExpectation != nil {
+		mmCreateRoles.mock.t.Fatalf("Default expectation is already set for the CacheInterface.CreateRoles method")
+	}
+
+	if len(mmCreateRoles.expectations) > 0 {
+		mmCreateRoles.mock.t.Fatalf("Some expectations are already set for the CacheInterface.CreateRoles method")
+	}
+
+	mmCreateRoles.mock.funcCreateRoles = f
+	mmCreateRoles.mock.funcCreateRolesOrigin = minimock.CallerInfo(1)
+	return mmCreateRoles.mock
+}
+
+// When sets expectation for the CacheInterface.CreateRoles which will trigger the result defined by the following
+// Then helper
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) When(ctx context.Context, username string, roles []string) *CacheInterfaceMockCreateRolesExpectation {
+	if mmCreateRoles.mock.funcCreateRoles != nil {
+		mmCreateRoles.mock.t.Fatalf("CacheInterfaceMock.CreateRoles mock is already set by Set")
+	}
+
+	expectation := &CacheInterfaceMockCreateRolesExpectation{
+		mock:               mmCreateRoles.mock,
+		params:             &CacheInterfaceMockCreateRolesParams{ctx, username, roles},
+		expectationOrigins: CacheInterfaceMockCreateRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRoles.expectations = append(mmCreateRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CacheInterface.CreateRoles return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockCreateRolesExpectation) Then(err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockCreateRolesResults{err}
+	return e.mock
+}
+
+// Times sets number of times CacheInterface.CreateRoles should be invoked
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) Times(n uint64) *mCacheInterfaceMockCreateRoles {
+	if n == 0 {
+		mmCreateRoles.mock.t.Fatalf("Times of CacheInterfaceMock.CreateRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRoles.expectedInvocations, n)
+	mmCreateRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRoles
+}
+
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) invocationsDone() bool {
+	if len(mmCreateRoles.expectations) == 0 && mmCreateRoles.defaultExpectation == nil && mmCreateRoles.mock.funcCreateRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRoles.mock.afterCreateRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRoles implements mm_repository.CacheInterface
+func (mmCreateRoles *CacheInterfaceMock) CreateRoles(ctx context.Context, username string, roles []string) (err error) {
+	mm_atomic.AddUint64(&mmCreateRoles.beforeCreateRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRoles.afterCreateRolesCounter, 1)
+
+	mmCreateRoles.t.Helper()
+
+	if mmCreateRoles.inspectFuncCreateRoles != nil {
+		mmCreateRoles.inspectFuncCreateRoles(ctx, username, roles)
+	}
+
+	mm_params := CacheInterfaceMockCreateRolesParams{ctx, username, roles}
+
+	// Record call args
+	mmCreateRoles.CreateRolesMock.mutex.Lock()
+	mmCreateRoles.CreateRolesMock.callArgs = append(mmCreateRoles.CreateRolesMock.callArgs, &mm_params)
+	mmCreateRoles.CreateRolesMock.mutex.Unlock()
+
+	for _, e := range mmCreateRoles.CreateRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateRoles.CreateRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRoles.CreateRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRoles.CreateRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRoles.CreateRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheInterfaceMockCreateRolesParams{ctx, username, roles}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRoles.t.Errorf("CacheInterfaceMock.CreateRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRoles.CreateRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmCreateRoles.t.Errorf("CacheInterfaceMock.CreateRoles got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRoles.CreateRolesMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmCreateRoles.t.Errorf("CacheInterfaceMock.CreateRoles got unexpected parameter roles, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRoles.CreateRolesMock.defaultExpectation.expectationOrigins.originRoles, *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRoles.t.Errorf("CacheInterfaceMock.CreateRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRoles.CreateRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRoles.CreateRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRoles.t.Fatal("No results are set for the CacheInterfaceMock.CreateRoles")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateRoles.funcCreateRoles != nil {
+		return mmCreateRoles.funcCreateRoles(ctx, username, roles)
+	}
+	mmCreateRoles.t.Fatalf("Unexpected call to CacheInterfaceMock.CreateRoles. %v %v %v", ctx, username, roles)
+	return
+}
+
+// CreateRolesAfterCounter returns a count of finished CacheInterfaceMock.CreateRoles invocations
+func (mmCreateRoles *CacheInterfaceMock) CreateRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRoles.afterCreateRolesCounter)
+}
+
+// CreateRolesBeforeCounter returns a count of CacheInterfaceMock.CreateRoles invocations
+func (mmCreateRoles *CacheInterfaceMock) CreateRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRoles.beforeCreateRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.CreateRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRoles *mCacheInterfaceMockCreateRoles) Calls() []*CacheInterfaceMockCreateRolesParams {
+	mmCreateRoles.mutex.RLock()
+
+	argCopy := make([]*CacheInterfaceMockCreateRolesParams, len(mmCreateRoles.callArgs))
+	copy(argCopy, mmCreateRoles.callArgs)
+
+	mmCreateRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRolesDone returns true if the count of the CreateRoles invocations corresponds
+// the number of defined expectations
+func (m *CacheInterfaceMock) MinimockCreateRolesDone() bool {
+	if m.CreateRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRolesMock.invocationsDone()
+}
+
+// MinimockCreateRolesInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockCreateRolesInspect() {
+	for _, e := range m.CreateRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRolesCounter := mm_atomic.LoadUint64(&m.afterCreateRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRolesMock.defaultExpectation != nil && afterCreateRolesCounter < 1 {
+		if m.CreateRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRoles at\n%s", m.CreateRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRoles at\n%s with params: %#v", m.CreateRolesMock.defaultExpectation.expectationOrigins.origin, *m.CreateRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRoles != nil && afterCreateRolesCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.CreateRoles at\n%s", m.funcCreateRolesOrigin)
+	}
+
+	if !m.CreateRolesMock.invocationsDone() && afterCreateRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.CreateRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRolesMock.expectedInvocations), m.CreateRolesMock.expectedInvocationsOrigin, afterCreateRolesCounter)
+	}
+}
+
+type mCacheInterfaceMockCreateRolesNotFound struct {
+	optional           bool
+	mock               *CacheInterfaceMock
+	defaultExpectation *CacheInterfaceMockCreateRolesNotFoundExpectation
+	expectations       []*CacheInterfaceMockCreateRolesNotFoundExpectation
+
+	callArgs []*CacheInterfaceMockCreateRolesNotFoundParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheInterfaceMockCreateRolesNotFoundExpectation specifies expectation struct of the CacheInterface.CreateRolesNotFound
+type CacheInterfaceMockCreateRolesNotFoundExpectation struct {
+	mock               *CacheInterfaceMock
+	params             *CacheInterfaceMockCreateRolesNotFoundParams
+	paramPtrs          *CacheInterfaceMockCreateRolesNotFoundParamPtrs
+	expectationOrigins CacheInterfaceMockCreateRolesNotFoundExpectationOrigins
+	results            *CacheInterfaceMockCreateRolesNotFoundResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheInterfaceMockCreateRolesNotFoundParams contains parameters of the CacheInterface.CreateRolesNotFound
+type CacheInterfaceMockCreateRolesNotFoundParams struct {
+	ctx      context.Context
+	username string
+}
+
+// CacheInterfaceMockCreateRolesNotFoundParamPtrs contains pointers to parameters of the CacheInterface.CreateRolesNotFound
+type CacheInterfaceMockCreateRolesNotFoundParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// CacheInterfaceMockCreateRolesNotFoundResults contains results of the CacheInterface.CreateRolesNotFound
+type CacheInterfaceMockCreateRolesNotFoundResults struct {
+	err error
+}
+
+// CacheInterfaceMockCreateRolesNotFoundOrigins contains origins of expectations of the CacheInterface.CreateRolesNotFound
+type CacheInterfaceMockCreateRolesNotFoundExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRolesNotFound *mCacheInterfaceMockCreateRolesNotFound) Optional() *mCacheInterfaceMockCreateRolesNotFound {
+	mmCreateRolesNotFound.optional = true
+	return mmCreateRolesNotFound
+}
+
+// Expect sets up expected params for CacheInterface.CreateRolesNotFound
+func (mmCreateRolesNotFound *mCacheInterfaceMockCreateRolesNotFound) Expect(ctx context.Context, username string) *mCacheInterfaceMockCreateRolesNotFound {
+	if mmCreateRolesNotFound.mock.funcCreateRolesNotFound != nil {
+		mmCreateRolesNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateRolesNotFound mock is already set by Set")
+	}
+
+	if mmCreateRolesNotFound.defaultExpectation == nil {
+		mmCreateRolesNotFound.defaultExpectation = &CacheInterfaceMockCreateRolesNotFoundExpectation{}
+	}
+
+	if mmCreateRolesNotFound.defaultExpectation.paramPtrs != nil {
+		mmCreateRolesNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateRolesNotFound mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRolesNotFound.defaultExpectation.params = &CacheInterfaceMockCreateRolesNotFoundParams{ctx, username}
+	mmCreateRolesNotFound.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRolesNotFound.expectations {
+		if minimock.Equal(e.params, mmCreateRolesNotFound.defaultExpectation.params) {
+			mmCreateRolesNotFound.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRolesNotFound.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRolesNotFound
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.CreateRolesNotFound
+func (mmCreateRolesNotFound *mCacheInterfaceMockCreateRolesNotFound) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockCreateRolesNotFound {
+	if mmCreateRolesNotFound.mock.funcCreateRolesNotFound != nil {
+		mmCreateRolesNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateRolesNotFound mock is already set by Set")
+	}
+
+	if mmCreateRolesNotFound.defaultExpectation == nil {
+		mmCreateRolesNotFound.defaultExpectation = &CacheInterfaceMockCreateRolesNotFoundExpectation{}
+	}
+
+	if mmCreateRolesNotFound.defaultExpectation.params != nil {
+		mmCreateRolesNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateRolesNotFound mock is already set by Expect")
+	}
+
+	if mmCreateRolesNotFound.defaultExpectation.paramPtrs == nil {
+		mmCreateRolesNotFound.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRolesNotFoundParamPtrs{}
+	}
+	mmCreateRolesNotFound.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRolesNotFound.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRolesNotFound
+}
+
+// ExpectUsernameParam2 sets up expected param username for CacheInterface.CreateRolesNotFound
+func (mmCreateRolesNotFound *mCacheInterfaceMockCreateRolesNotFound) ExpectUsernameParam2(username string) *mCacheInterfaceMockCreateRolesNotFound {
+	if mmCreateRolesNotFound.mock.funcCreateRolesNotFound != nil {
+		mmCreateRolesNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateRolesNotFound mock is already set by Set")
+	}
+
+	if mmCreateRolesNotFound.defaultExpectation == nil {
+		mmCreateRolesNotFound.defaultExpectation = &CacheInterfaceMockCreateRolesNotFoundExpectation{}
+	}
+
+	if mmCreateRolesNotFound.defaultExpectation.params != nil {
+		mmCreateRolesNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateRolesNotFound mock is already set by Expect")
+	}
+
+	if mmCreateRolesNotFound.defaultExpectation.paramPtrs == nil {
+		mmCreateRolesNotFound.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRolesNotFoundParamPtrs{}
+	}
+	mmCreateRolesNotFound.defaultExpectation.paramPtrs.username = &username
+	mmCreateRolesNotFound.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmCreateRolesNotFound
+}
+
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.CreateRolesNotFound
+func (mmCreateRolesNotFound *mCacheInterfaceMockCreateRolesNotFound) Inspect(f func(ctx context.Context, username string)) *mCacheInterfaceMockCreateRolesNotFound {
+	if mmCreateRolesNotFound.mock.inspectFuncCreateRolesNotFound != nil {
+		mmCreateRolesNotFound.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.CreateRolesNotFound")
+	}
+
+	mmCreateRolesNotFound.mock.inspectFuncCreateRolesNotFound = f
+
+	return mmCreateRolesNotFound
+}
+
+// Return sets up results that will be returned by CacheInterface.CreateRolesNotFound
+func (mmCreateRolesNotFound *mCacheInterfaceMockCreateRolesNotFound) Return(err error) *CacheInterfaceMock {
+	if mmCreateRolesNotFound.mock.funcCreateRolesNotFound != nil {
+		mmCreateRolesNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateRolesNotFound mock is already set by Set")
+	}
+
+	if mmCreateRolesNotFound.defaultExpectation == nil {
+		mmCreateRolesNotFound.defaultExpectation = &CacheInterfaceMockCreateRolesNotFoundExpectation{mock: mmCreateRolesNotFound.mock}
+	}
+	mmCreateRolesNotFound.defaultExpectation.results = &CacheInterfaceMockCreateRolesNotFoundResults{err}
+	mmCreateRolesNotFound.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRolesNotFound.mock
+}
+
+// Set uses given function f to mock the CacheInterface.CreateRolesNotFound method
+func (mmCreateRolesNotFound *mCacheInterfaceMockCreateRolesNotFound) Set(f func(ctx context.Context, username string) (err error)) *CacheInterfaceMock {
+	if mmCreateRolesNotFound.defaultExpectation != nil {
+		mmCreateRolesNotFound.mock.t.Fatalf("Default expectation is already set for the CacheInterface.CreateRolesNotFound method")
+	}
+
+	if len(mmCreateRolesNotFound.expectations) > 0 {
+		mmCreateRolesNotFound.mock.t.Fatalf("Some expectations are already set for the CacheInterface.CreateRolesNotFound method")
+	}
+
+	mmCreateRolesNotFound.mock.funcCreateRolesNotFound = f
+	mmCreateRolesNotFound.mock.funcCreateRolesNotFoundOrigin = minimock.CallerInfo(1)
+	return mmCreateRolesNotFound.mock
+}
+
+// When sets expectation for the CacheInterface.CreateRolesNotFound which will trigger the result defined by the following
+// Then helper
+func (mmCreateRolesNotFound *mCacheInterfaceMockCreateRolesNotFound) When(ctx context.Context, username string) *CacheInterfaceMockCreateRolesNotFoundExpectation {
+	if mmCreateRolesNotFound.mock.funcCreateRolesNotFound != nil {
+		mmCreateRolesNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateRolesNotFound mock is already set by Set")
+	}
+
+	expectation := &CacheInterfaceMockCreateRolesNotFoundExpectation{
+		mock:               mmCreateRolesNotFound.mock,
+		params:             &CacheInterfaceMockCreateRolesNotFoundParams{ctx, username},
+		expectationOrigins: CacheInterfaceMockCreateRolesNotFoundExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRolesNotFound.expectations = append(mmCreateRolesNotFound.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CacheInterface.CreateRolesNotFound return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockCreateRolesNotFoundExpectation) Then(err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockCreateRolesNotFoundResults{err}
+	return e.mock
+}
+
+// Times sets number of times CacheInterface.CreateRolesNotFound should be invoked
+func (mmCreateRolesNotFound *mCacheInterfaceMockCreateRolesNotFound) Times(n uint64) *mCacheInterfaceMockCreateRolesNotFound {
+	if n == 0 {
+		mmCreateRolesNotFound.mock.t.Fatalf("Times of CacheInterfaceMock.CreateRolesNotFound mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRolesNotFound.expectedInvocations, n)
+	mmCreateRolesNotFound.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRolesNotFound
+}
+
+func (mmCreateRolesNotFound *mCacheInterfaceMockCreateRolesNotFound) invocationsDone() bool {
+	if len(mmCreateRolesNotFound.expectations) == 0 && mmCreateRolesNotFound.defaultExpectation == nil && mmCreateRolesNotFound.mock.funcCreateRolesNotFound == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRolesNotFound.mock.afterCreateRolesNotFoundCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRolesNotFound.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRolesNotFound implements mm_repository.CacheInterface
+func (mmCreateRolesNotFound *CacheInterfaceMock) CreateRolesNotFound(ctx context.Context, username string) (err error) {
+	mm_atomic.AddUint64(&mmCreateRolesNotFound.beforeCreateRolesNotFoundCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRolesNotFound.afterCreateRolesNotFoundCounter, 1)
+
+	mmCreateRolesNotFound.t.Helper()
+
+	if mmCreateRolesNotFound.inspectFuncCreateRolesNotFound != nil {
+		mmCreateRolesNotFound.inspectFuncCreateRolesNotFound(ctx, username)
+	}
+
+	mm_params := CacheInterfaceMockCreateRolesNotFoundParams{ctx, username}
+
+	// Record call args
+	mmCreateRolesNotFound.CreateRolesNotFoundMock.mutex.Lock()
+	mmCreateRolesNotFound.CreateRolesNotFoundMock.callArgs = append(mmCreateRolesNotFound.CreateRolesNotFoundMock.callArgs, &mm_params)
+	mmCreateRolesNotFound.CreateRolesNotFoundMock.mutex.Unlock()
+
+	for _, e := range mmCreateRolesNotFound.CreateRolesNotFoundMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateRolesNotFound.CreateRolesNotFoundMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRolesNotFound.CreateRolesNotFoundMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRolesNotFound.CreateRolesNotFoundMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRolesNotFound.CreateRolesNotFoundMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheInterfaceMockCreateRolesNotFoundParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRolesNotFound.t.Errorf("CacheInterfaceMock.CreateRolesNotFound got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRolesNotFound.CreateRolesNotFoundMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmCreateRolesNotFound.t.Errorf("CacheInterfaceMock.CreateRolesNotFound got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRolesNotFound.CreateRolesNotFoundMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRolesNotFound.t.Errorf("CacheInterfaceMock.CreateRolesNotFound got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRolesNotFound.CreateRolesNotFoundMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRolesNotFound.CreateRolesNotFoundMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRolesNotFound.t.Fatal("No results are set for the CacheInterfaceMock.CreateRolesNotFound")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateRolesNotFound.funcCreateRolesNotFound != nil {
+		return mmCreateRolesNotFound.funcCreateRolesNotFound(ctx, username)
+	}
+	mmCreateRolesNotFound.t.Fatalf("Unexpected call to CacheInterfaceMock.CreateRolesNotFound. %v %v", ctx, username)
+	return
+}
+
+// CreateRolesNotFoundAfterCounter returns a count of finished CacheInterfaceMock.CreateRolesNotFound invocations
+func (mmCreateRolesNotFound *CacheInterfaceMock) CreateRolesNotFoundAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRolesNotFound.afterCreateRolesNotFoundCounter)
+}
+
+// CreateRolesNotFoundBeforeCounter returns a count of CacheInterfaceMock.CreateRolesNotFound invocations
+func (mmCreateRolesNotFound *CacheInterfaceMock) CreateRolesNotFoundBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRolesNotFound.beforeCreateRolesNotFoundCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.CreateRolesNotFound.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRolesNotFound *mCacheInterfaceMockCreateRolesNotFound) Calls() []*CacheInterfaceMockCreateRolesNotFoundParams {
+	mmCreateRolesNotFound.mutex.RLock()
+
+	argCopy := make([]*CacheInterfaceMockCreateRolesNotFoundParams, len(mmCreateRolesNotFound.callArgs))
+	copy(argCopy, mmCreateRolesNotFound.callArgs)
+
+	mmCreateRolesNotFound.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRolesNotFoundDone returns true if the count of the CreateRolesNotFound invocations corresponds
+// the number of defined expectations
+func (m *CacheInterfaceMock) MinimockCreateRolesNotFoundDone() bool {
+	if m.CreateRolesNotFoundMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRolesNotFoundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRolesNotFoundMock.invocationsDone()
+}
+
+// MinimockCreateRolesNotFoundInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockCreateRolesNotFoundInspect() {
+	for _, e := range m.CreateRolesNotFoundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRolesNotFound at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRolesNotFoundCounter := mm_atomic.LoadUint64(&m.afterCreateRolesNotFoundCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRolesNotFoundMock.defaultExpectation != nil && afterCreateRolesNotFoundCounter < 1 {
+		if m.CreateRolesNotFoundMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRolesNotFound at\n%s", m.CreateRolesNotFoundMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRolesNotFound at\n%s with params: %#v", m.CreateRolesNotFoundMock.defaultExpectation.expectationOrigins.origin, *m.CreateRolesNotFoundMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRolesNotFound != nil && afterCreateRolesNotFoundCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.CreateRolesNotFound at\n%s", m.funcCreateRolesNotFoundOrigin)
+	}
+
+	if !m.CreateRolesNotFoundMock.invocationsDone() && afterCreateRolesNotFoundCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.CreateRolesNotFound at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRolesNotFoundMock.expectedInvocations), m.CreateRolesNotFoundMock.expectedInvocationsOrigin, afterCreateRolesNotFoundCounter)
+	}
+}
+
+type mCacheInterfaceMockCreateUserNotFound struct {
+	optional           bool
+	mock               *CacheInterfaceMock
+	defaultExpectation *CacheInterfaceMockCreateUserNotFoundExpectation
+	expectations       []*CacheInterfaceMockCreateUserNotFoundExpectation
+
+	callArgs []*CacheInterfaceMockCreateUserNotFoundParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheInterfaceMockCreateUserNotFoundExpectation specifies expectation struct of the CacheInterface.CreateUserNotFound
+type CacheInterfaceMockCreateUserNotFoundExpectation struct {
+	mock               *CacheInterfaceMock
+	params             *CacheInterfaceMockCreateUserNotFoundParams
+	paramPtrs          *CacheInterfaceMockCreateUserNotFoundParamPtrs
+	expectationOrigins CacheInterfaceMockCreateUserNotFoundExpectationOrigins
+	results            *CacheInterfaceMockCreateUserNotFoundResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheInterfaceMockCreateUserNotFoundParams contains parameters of the CacheInterface.CreateUserNotFound
+type CacheInterfaceMockCreateUserNotFoundParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// CacheInterfaceMockCreateUserNotFoundParamPtrs contains pointers to parameters of the CacheInterface.CreateUserNotFound
+type CacheInterfaceMockCreateUserNotFoundParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// CacheInterfaceMockCreateUserNotFoundResults contains results of the CacheInterface.CreateUserNotFound
+type CacheInterfaceMockCreateUserNotFoundResults struct {
+	err error
+}
+
+// CacheInterfaceMockCreateUserNotFoundOrigins contains origins of expectations of the CacheInterface.CreateUserNotFound
+type CacheInterfaceMockCreateUserNotFoundExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateUserNotFound *mCacheInterfaceMockCreateUserNotFound) Optional() *mCacheInterfaceMockCreateUserNotFound {
+	mmCreateUserNotFound.optional = true
+	return mmCreateUserNotFound
+}
+
+// Expect sets up expected params for CacheInterface.CreateUserNotFound
+func (mmCreateUserNotFound *mCacheInterfaceMockCreateUserNotFound) Expect(ctx context.Context, id int64) *mCacheInterfaceMockCreateUserNotFound {
+	if mmCreateUserNotFound.mock.funcCreateUserNotFound != nil {
+		mmCreateUserNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateUserNotFound mock is already set by Set")
+	}
+
+	if mmCreateUserNotFound.defaultExpectation == nil {
+		mmCreateUserNotFound.defaultExpectation = &CacheInterfaceMockCreateUserNotFoundExpectation{}
+	}
+
+	if mmCreateUserNotFound.defaultExpectation.paramPtrs != nil {
+		mmCreateUserNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateUserNotFound mock is already set by ExpectParams functions")
+	}
+
+	mmCreateUserNotFound.defaultExpectation.params = &CacheInterfaceMockCreateUserNotFoundParams{ctx, id}
+	mmCreateUserNotFound.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateUserNotFound.expectations {
+		if minimock.Equal(e.params, mmCreateUserNotFound.defaultExpectation.params) {
+			mmCreateUserNotFound.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUserNotFound.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateUserNotFound
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.CreateUserNotFound
+func (mmCreateUserNotFound *mCacheInterfaceMockCreateUserNotFound) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockCreateUserNotFound {
+	if mmCreateUserNotFound.mock.funcCreateUserNotFound != nil {
+		mmCreateUserNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateUserNotFound mock is already set by Set")
+	}
+
+	if mmCreateUserNotFound.defaultExpectation == nil {
+		mmCreateUserNotFound.defaultExpectation = &CacheInterfaceMockCreateUserNotFoundExpectation{}
+	}
+
+	if mmCreateUserNotFound.defaultExpectation.params != nil {
+		mmCreateUserNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateUserNotFound mock is already set by Expect")
+	}
+
+	if mmCreateUserNotFound.defaultExpectation.paramPtrs == nil {
+		mmCreateUserNotFound.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateUserNotFoundParamPtrs{}
+	}
+	mmCreateUserNotFound.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateUserNotFound.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateUserNotFound
+}
+
+// ExpectIdParam2 sets up expected param id for CacheInterface.CreateUserNotFound
+func (mmCreateUserNotFound *mCacheInterfaceMockCreateUserNotFound) ExpectIdParam2(id int64) *mCacheInterfaceMockCreateUserNotFound {
+	if mmCreateUserNotFound.mock.funcCreateUserNotFound != nil {
+		mmCreateUserNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateUserNotFound mock is already set by Set")
+	}
+
+	if mmCreateUserNotFound.defaultExpectation == nil {
+		mmCreateUserNotFound.defaultExpectation = &CacheInterfaceMockCreateUserNotFoundExpectation{}
+	}
+
+	if mmCreateUserNotFound.defaultExpectation.params != nil {
+		mmCreateUserNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateUserNotFound mock is already set by Expect")
+	}
+
+	if mmCreateUserNotFound.defaultExpectation.paramPtrs == nil {
+		mmCreateUserNotFound.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateUserNotFoundParamPtrs{}
+	}
+	mmCreateUserNotFound.defaultExpectation.paramPtrs.id = &id
+	mmCreateUserNotFound.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmCreateUserNotFound
+}
+
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.CreateUserNotFound
+func (mmCreateUserNotFound *mCacheInterfaceMockCreateUserNotFound) Inspect(f func(ctx context.Context, id int64)) *mCacheInterfaceMockCreateUserNotFound {
+	if mmCreateUserNotFound.mock.inspectFuncCreateUserNotFound != nil {
+		mmCreateUserNotFound.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.CreateUserNotFound")
+	}
+
+	mmCreateUserNotFound.mock.inspectFuncCreateUserNotFound = f
+
+	return mmCreateUserNotFound
+}
+
+// Return sets up results that will be returned by CacheInterface.CreateUserNotFound
+func (mmCreateUserNotFound *mCacheInterfaceMockCreateUserNotFound) Return(err error) *CacheInterfaceMock {
+	if mmCreateUserNotFound.mock.funcCreateUserNotFound != nil {
+		mmCreateUserNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateUserNotFound mock is already set by Set")
+	}
+
+	if mmCreateUserNotFound.defaultExpectation == nil {
+		mmCreateUserNotFound.defaultExpectation = &CacheInterfaceMockCreateUserNotFoundExpectation{mock: mmCreateUserNotFound.mock}
+	}
+	mmCreateUserNotFound.defaultExpectation.results = &CacheInterfaceMockCreateUserNotFoundResults{err}
+	mmCreateUserNotFound.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateUserNotFound.mock
+}
+
+// Set uses given function f to mock the CacheInterface.CreateUserNotFound method
+func (mmCreateUserNotFound *mCacheInterfaceMockCreateUserNotFound) Set(f func(ctx context.Context, id int64) (err error)) *CacheInterfaceMock {
+	if mmCreateUserNotFound.defaultExpectation != nil {
+		mmCreateUserNotFound.mock.t.Fatalf("Default expectation is already set for the CacheInterface.CreateUserNotFound method")
+	}
+
+	if len(mmCreateUserNotFound.expectations) > 0 {
+		mmCreateUserNotFound.mock.t.Fatalf("Some expectations are already set for the CacheInterface.CreateUserNotFound method")
+	}
+
+	mmCreateUserNotFound.mock.funcCreateUserNotFound = f
+	mmCreateUserNotFound.mock.funcCreateUserNotFoundOrigin = minimock.CallerInfo(1)
+	return mmCreateUserNotFound.mock
+}
+
+// When sets expectation for the CacheInterface.CreateUserNotFound which will trigger the result defined by the following
+// Then helper
+func (mmCreateUserNotFound *mCacheInterfaceMockCreateUserNotFound) When(ctx context.Context, id int64) *CacheInterfaceMockCreateUserNotFoundExpectation {
+	if mmCreateUserNotFound.mock.funcCreateUserNotFound != nil {
+		mmCreateUserNotFound.mock.t.Fatalf("CacheInterfaceMock.CreateUserNotFound mock is already set by Set")
+	}
+
+	expectation := &CacheInterfaceMockCreateUserNotFoundExpectation{
+		mock:               mmCreateUserNotFound.mock,
+		params:             &CacheInterfaceMockCreateUserNotFoundParams{ctx, id},
+		expectationOrigins: CacheInterfaceMockCreateUserNotFoundExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateUserNotFound.expectations = append(mmCreateUserNotFound.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CacheInterface.CreateUserNotFound return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockCreateUserNotFoundExpectation) Then(err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockCreateUserNotFoundResults{err}
+	return e.mock
+}
+
+// Times sets number of times CacheInterface.CreateUserNotFound should be invoked
+func (mmCreateUserNotFound *mCacheInterfaceMockCreateUserNotFound) Times(n uint64) *mCacheInterfaceMockCreateUserNotFound {
+	if n == 0 {
+		mmCreateUserNotFound.mock.t.Fatalf("Times of CacheInterfaceMock.CreateUserNotFound mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateUserNotFound.expectedInvocations, n)
+	mmCreateUserNotFound.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateUserNotFound
+}
+
+func (mmCreateUserNotFound *mCacheInterfaceMockCreateUserNotFound) invocationsDone() bool {
+	if len(mmCreateUserNotFound.expectations) == 0 && mmCreateUserNotFound.defaultExpectation == nil && mmCreateUserNotFound.mock.funcCreateUserNotFound == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateUserNotFound.mock.afterCreateUserNotFoundCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUserNotFound.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateUserNotFound implements mm_repository.CacheInterface
+func (mmCreateUserNotFound *CacheInterfaceMock) CreateUserNotFound(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmCreateUserNotFound.beforeCreateUserNotFoundCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateUserNotFound.afterCreateUserNotFoundCounter, 1)
+
+	mmCreateUserNotFound.t.Helper()
+
+	if mmCreateUserNotFound.inspectFuncCreateUserNotFound != nil {
+		mmCreateUserNotFound.inspectFuncCreateUserNotFound(ctx, id)
+	}
+
+	mm_params := CacheInterfaceMockCreateUserNotFoundParams{ctx, id}
+
+	// Record call args
+	mmCreateUserNotFound.CreateUserNotFoundMock.mutex.Lock()
+	mmCreateUserNotFound.CreateUserNotFoundMock.callArgs = append(mmCreateUserNotFound.CreateUserNotFoundMock.callArgs, &mm_params)
+	mmCreateUserNotFound.CreateUserNotFoundMock.mutex.Unlock()
+
+	for _, e := range mmCreateUserNotFound.CreateUserNotFoundMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateUserNotFound.CreateUserNotFoundMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateUserNotFound.CreateUserNotFoundMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateUserNotFound.CreateUserNotFoundMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateUserNotFound.CreateUserNotFoundMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheInterfaceMockCreateUserNotFoundParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateUserNotFound.t.Errorf("CacheInterfaceMock.CreateUserNotFound got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUserNotFound.CreateUserNotFoundMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmCreateUserNotFound.t.Errorf("CacheInterfaceMock.CreateUserNotFound got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUserNotFound.CreateUserNotFoundMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateUserNotFound.t.Errorf("CacheInterfaceMock.CreateUserNotFound got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateUserNotFound.CreateUserNotFoundMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateUserNotFound.CreateUserNotFoundMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateUserNotFound.t.Fatal("No results are set for the CacheInterfaceMock.CreateUserNotFound")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateUserNotFound.funcCreateUserNotFound != nil {
+		return mmCreateUserNotFound.funcCreateUserNotFound(ctx, id)
+	}
+	mmCreateUserNotFound.t.Fatalf("Unexpected call to CacheInterfaceMock.CreateUserNotFound. %v %v", ctx, id)
+	return
+}
+
+// CreateUserNotFoundAfterCounter returns a count of finished CacheInterfaceMock.CreateUserNotFound invocations
+func (mmCreateUserNotFound *CacheInterfaceMock) CreateUserNotFoundAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUserNotFound.afterCreateUserNotFoundCounter)
+}
+
+// CreateUserNotFoundBeforeCounter returns a count of CacheInterfaceMock.CreateUserNotFound invocations
+func (mmCreateUserNotFound *CacheInterfaceMock) CreateUserNotFoundBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUserNotFound.beforeCreateUserNotFoundCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.CreateUserNotFound.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateUserNotFound *mCacheInterfaceMockCreateUserNotFound) Calls() []*CacheInterfaceMockCreateUserNotFoundParams {
+	mmCreateUserNotFound.mutex.RLock()
+
+	argCopy := make([]*CacheInterfaceMockCreateUserNotFoundParams, len(mmCreateUserNotFound.callArgs))
+	copy(argCopy, mmCreateUserNotFound.callArgs)
+
+	mmCreateUserNotFound.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateUserNotFoundDone returns true if the count of the CreateUserNotFound invocations corresponds
+// the number of defined expectations
+func (m *CacheInterfaceMock) MinimockCreateUserNotFoundDone() bool {
+	if m.CreateUserNotFoundMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateUserNotFoundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateUserNotFoundMock.invocationsDone()
+}
+
+// MinimockCreateUserNotFoundInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockCreateUserNotFoundInspect() {
+	for _, e := range m.CreateUserNotFoundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateUserNotFound at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateUserNotFoundCounter := mm_atomic.LoadUint64(&m.afterCreateUserNotFoundCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateUserNotFoundMock.defaultExpectation != nil && afterCreateUserNotFoundCounter < 1 {
+		if m.CreateUserNotFoundMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateUserNotFound at\n%s", m.CreateUserNotFoundMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheInterfaceMock.CreateUserNotFound at\n%s with params: %#v", m.CreateUserNotFoundMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserNotFoundMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateUserNotFound != nil && afterCreateUserNotFoundCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.CreateUserNotFound at\n%s", m.funcCreateUserNotFoundOrigin)
+	}
+
+	if !m.CreateUserNotFoundMock.invocationsDone() && afterCreateUserNotFoundCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.CreateUserNotFound at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateUserNotFoundMock.expectedInvocations), m.CreateUserNotFoundMock.expectedInvocationsOrigin, afterCreateUserNotFoundCounter)
+	}
+}
+
+type mCacheInterfaceMockDelete struct {
+	optional           bool
+	mock               *CacheInterfaceMock
+	defaultExpectation *CacheInterfaceMockDeleteExpectation
+	expectations       []*CacheInterfaceMockDeleteExpectation
+
+	callArgs []*CacheInterfaceMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheInterfaceMockDeleteExpectation specifies expectation struct of the CacheInterface.Delete
+type CacheInterfaceMockDeleteExpectation struct {
+	mock               *CacheInterfaceMock
+	params             *CacheInterfaceMockDeleteParams
+	paramPtrs          *CacheInterfaceMockDeleteParamPtrs
+	expectationOrigins CacheInterfaceMockDeleteExpectationOrigins
+	results            *CacheInterfaceMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheInterfaceMockDeleteParams contains parameters of the CacheInterface.Delete
+type CacheInterfaceMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// CacheInterfaceMockDeleteParamPtrs contains pointers to parameters of the CacheInterface.Delete
+type CacheInterfaceMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// CacheInterfaceMockDeleteResults contains results of the CacheInterface.Delete
+type CacheInterfaceMockDeleteResults struct {
+	err error
+}
+
+// CacheInterfaceMockDeleteOrigins contains origins of expectations of the CacheInterface.Delete
+type CacheInterfaceMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mCacheInterfaceMockDelete) Optional() *mCacheInterfaceMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for CacheInterface.Delete
+func (mmDelete *mCacheInterfaceMockDelete) Expect(ctx context.Context, id int64) *mCacheInterfaceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheInterfaceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &CacheInterfaceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("CacheInterfaceMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &CacheInterfaceMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.Delete
+func (mmDelete *mCacheInterfaceMockDelete) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheInterfaceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &CacheInterfaceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("CacheInterfaceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &CacheInterfaceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for CacheInterface.Delete
+func (mmDelete *mCacheInterfaceMockDelete) ExpectIdParam2(id int64) *mCacheInterfaceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheInterfaceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &CacheInterfaceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("CacheInterfaceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &CacheInterfaceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.Delete
+func (mmDelete *mCacheInterfaceMockDelete) Inspect(f func(ctx context.Context, id int64)) *mCacheInterfaceMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by CacheInterface.Delete
+func (mmDelete *mCacheInterfaceMockDelete) Return(err error) *CacheInterfaceMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheInterfaceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &CacheInterfaceMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &CacheInterfaceMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the CacheInterface.Delete method
+func (mmDelete *mCacheInterfaceMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *CacheInterfaceMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the CacheInterface.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the CacheInterface.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the CacheInterface.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mCacheInterfaceMockDelete) When(ctx context.Context, id int64) *CacheInterfaceMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheInterfaceMock.Delete mock is already set by Set")
+	}
+
+	expectation := &CacheInterfaceMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &CacheInterfaceMockDeleteParams{ctx, id},
+		expectationOrigins: CacheInterfaceMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CacheInterface.Delete return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockDeleteExpectation) Then(err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times CacheInterface.Delete should be invoked
+func (mmDelete *mCacheInterfaceMockDelete) Times(n uint64) *mCacheInterfaceMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of CacheInterfaceMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mCacheInterfaceMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.CacheInterface
+func (mmDelete *CacheInterfaceMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := CacheInterfaceMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheInterfaceMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("CacheInterfaceMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("CacheInterfaceMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("CacheInterfaceMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the CacheInterfaceMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to CacheInterfaceMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished CacheInterfaceMock.Delete invocations
+func (mmDelete *CacheInterfaceMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of CacheInterfaceMock.Delete invocations
+func (mmDelete *CacheInterfaceMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mCacheInterfaceMockDelete) Calls() []*CacheInterfaceMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*CacheInterfaceMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *CacheInterfaceMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheInterfaceMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheInterfaceMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mCacheInterfaceMockGet struct {
+	optional           bool
+	mock               *CacheInterfaceMock
+	defaultExpectation *CacheInterfaceMockGetExpectation
+	expectations       []*CacheInterfaceMockGetExpectation
+
+	callArgs []*CacheInterfaceMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheInterfaceMockGetExpectation specifies expectation struct of the CacheInterface.Get
+type CacheInterfaceMockGetExpectation struct {
+	mock               *CacheInterfaceMock
+	params             *CacheInterfaceMockGetParams
+	paramPtrs          *CacheInterfaceMockGetParamPtrs
+	expectationOrigins CacheInterfaceMockGetExpectationOrigins
+	results            *CacheInterfaceMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheInterfaceMockGetParams contains parameters of the CacheInterface.Get
+type CacheInterfaceMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// CacheInterfaceMockGetParamPtrs contains pointers to parameters of the CacheInterface.Get
+type CacheInterfaceMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// CacheInterfaceMockGetResults contains results of the CacheInterface.Get
+type CacheInterfaceMockGetResults struct {
+	up1 *model.User
+	err error
+}
+
+// CacheInterfaceMockGetOrigins contains origins of expectations of the CacheInterface.Get
+type CacheInterfaceMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mCacheInterfaceMockGet) Optional() *mCacheInterfaceMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for CacheInterface.Get
+func (mmGet *mCacheInterfaceMockGet) Expect(ctx context.Context, id int64) *mCacheInterfaceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CacheInterfaceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &CacheInterfaceMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.Get
+func (mmGet *mCacheInterfaceMockGet) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CacheInterfaceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &CacheInterfaceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for CacheInterface.Get
+func (mmGet *mCacheInterfaceMockGet) ExpectIdParam2(id int64) *mCacheInterfaceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CacheInterfaceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &CacheInterfaceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.Get
+func (mmGet *mCacheInterfaceMockGet) Inspect(f func(ctx context.Context, id int64)) *mCacheInterfaceMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by CacheInterface.Get
+func (mmGet *mCacheInterfaceMockGet) Return(up1 *model.User, err error) *CacheInterfaceMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CacheInterfaceMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &CacheInterfaceMockGetResults{up1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the CacheInterface.Get method
+func (mmGet *mCacheInterfaceMockGet) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *CacheInterfaceMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the CacheInterface.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the CacheInterface.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the CacheInterface.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mCacheInterfaceMockGet) When(ctx context.Context, id int64) *CacheInterfaceMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Set")
+	}
+
+	expectation := &CacheInterfaceMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &CacheInterfaceMockGetParams{ctx, id},
+		expectationOrigins: CacheInterfaceMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CacheInterface.Get return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockGetExpectation) Then(up1 *model.User, err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockGetResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times CacheInterface.Get should be invoked
+func (mmGet *mCacheInterfaceMockGet) Times(n uint64) *mCacheInterfaceMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of CacheInterfaceMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mCacheInterfaceMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.CacheInterface
+func (mmGet *CacheInterfaceMock) Get(ctx context.Context, id int64) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := CacheInterfaceMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheInterfaceMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("CacheInterfaceMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("CacheInterfaceMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("CacheInterfaceMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the CacheInterfaceMock.Get")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to CacheInterfaceMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished CacheInterfaceMock.Get invocations
+func (mmGet *CacheInterfaceMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of CacheInterfaceMock.Get invocations
+func (mmGet *CacheInterfaceMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mCacheInterfaceMockGet) Calls() []*CacheInterfaceMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*CacheInterfaceMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *CacheInterfaceMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheInterfaceMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheInterfaceMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mCacheInterfaceMockGetLoginBlock struct {
+	optional           bool
+	mock               *CacheInterfaceMock
+	defaultExpectation *CacheInterfaceMockGetLoginBlockExpectation
+	expectations       []*CacheInterfaceMockGetLoginBlockExpectation
+
+	callArgs []*CacheInterfaceMockGetLoginBlockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheInterfaceMockGetLoginBlockExpectation specifies expectation struct of the CacheInterface.GetLoginBlock
+type CacheInterfaceMockGetLoginBlockExpectation struct {
+	mock               *CacheInterfaceMock
+	params             *CacheInterfaceMockGetLoginBlockParams
+	paramPtrs          *CacheInterfaceMockGetLoginBlockParamPtrs
+	expectationOrigins CacheInterfaceMockGetLoginBlockExpectationOrigins
+	results            *CacheInterfaceMockGetLoginBlockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheInterfaceMockGetLoginBlockParams contains parameters of the CacheInterface.GetLoginBlock
+type CacheInterfaceMockGetLoginBlockParams struct {
+	ctx     context.Context
+	subject string
+}
+
+// CacheInterfaceMockGetLoginBlockParamPtrs contains pointers to parameters of the CacheInterface.GetLoginBlock
+type CacheInterfaceMockGetLoginBlockParamPtrs struct {
+	ctx     *context.Context
+	subject *string
+}
+
+// CacheInterfaceMockGetLoginBlockResults contains results of the CacheInterface.GetLoginBlock
+type CacheInterfaceMockGetLoginBlockResults struct {
+	d1  time.Duration
+	err error
+}
+
+// CacheInterfaceMockGetLoginBlockOrigins contains origins of expectations of the CacheInterface.GetLoginBlock
+type CacheInterfaceMockGetLoginBlockExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originSubject string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetLoginBlock *mCacheInterfaceMockGetLoginBlock) Optional() *mCacheInterfaceMockGetLoginBlock {
+	mmGetLoginBlock.optional = true
+	return mmGetLoginBlock
+}
+
+// Expect sets up expected params for CacheInterface.GetLoginBlock
+func (mmGetLoginBlock *mCacheInterfaceMockGetLoginBlock) Expect(ctx context.Context, subject string) *mCacheInterfaceMockGetLoginBlock {
+	if mmGetLoginBlock.mock.funcGetLoginBlock != nil {
+		mmGetLoginBlock.mock.t.Fatalf("CacheInterfaceMock.GetLoginBlock mock is already set by Set")
+	}
+
+	if mmGetLoginBlock.defaultExpectation == nil {
+		mmGetLoginBlock.defaultExpectation = &CacheInterfaceMockGetLoginBlockExpectation{}
+	}
+
+	if mmGetLoginBlock.defaultExpectation.paramPtrs != nil {
+		mmGetLoginBlock.mock.t.Fatalf("CacheInterfaceMock.GetLoginBlock mock is already set by ExpectParams functions")
+	}
+
+	mmGetLoginBlock.defaultExpectation.params = &CacheInterfaceMockGetLoginBlockParams{ctx, subject}
+	mmGetLoginBlock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetLoginBlock.expectations {
+		if minimock.Equal(e.params, mmGetLoginBlock.defaultExpectation.params) {
+			mmGetLoginBlock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLoginBlock.defaultExpectation.params)
+		}
+	}
+
+	return mmGetLoginBlock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.GetLoginBlock
+func (mmGetLoginBlock *mCacheInterfaceMockGetLoginBlock) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockGetLoginBlock {
+	if mmGetLoginBlock.mock.funcGetLoginBlock != nil {
+		mmGetLoginBlock.mock.t.Fatalf("CacheInterfaceMock.GetLoginBlock mock is already set by Set")
+	}
+
+	if mmGetLoginBlock.defaultExpectation == nil {
+		mmGetLoginBlock.defaultExpectation = &CacheInterfaceMockGetLoginBlockExpectation{}
+	}
+
+	if mmGetLoginBlock.defaultExpectation.params != nil {
+		mmGetLoginBlock.mock.t.Fatalf("CacheInterfaceMock.GetLoginBlock mock is already set by Expect")
+	}
+
+	if mmGetLoginBlock.defaultExpectation.paramPtrs == nil {
+		mmGetLoginBlock.defaultExpectation.paramPtrs = &CacheInterfaceMockGetLoginBlockParamPtrs{}
+	}
+	mmGetLoginBlock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetLoginBlock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetLoginBlock
+}
+
+// ExpectSubjectParam2 sets up expected param subject for CacheInterface.GetLoginBlock
+func (mmGetLoginBlock *mCacheInterfaceMockGetLoginBlock) ExpectSubjectParam2(subject string) *mCacheInterfaceMockGetLoginBlock {
+	if mmGetLoginBlock.mock.funcGetLoginBlock != nil {
+		mmGetLoginBlock.mock.t.Fatalf("CacheInterfaceMock.GetLoginBlock mock is already set by Set")
+	}
+
+	if mmGetLoginBlock.defaultExpectation == nil {
+		mmGetLoginBlock.defaultExpectation = &CacheInterfaceMockGetLoginBlockExpectation{}
+	}
+
+	if mmGetLoginBlock.defaultExpectation.params != nil {
+		mmGetLoginBlock.mock.t.Fatalf("CacheInterfaceMock.GetLoginBlock mock is already set by Expect")
+	}
+
+	if mmGetLoginBlock.defaultExpectation.paramPtrs == nil {
+		mmGetLoginBlock.defaultExpectation.paramPtrs = &CacheInterfaceMockGetLoginBlockParamPtrs{}
+	}
+	mmGetLoginBlock.defaultExpectation.paramPtrs.subject = &subject
+	mmGetLoginBlock.defaultExpectation.expectationOrigins.originSubject = minimock.CallerInfo(1)
+
+	return mmGetLoginBlock
+}
+
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.GetLoginBlock
+func (mmGetLoginBlock *mCacheInterfaceMockGetLoginBlock) Inspect(f func(ctx context.Context, subject string)) *mCacheInterfaceMockGetLoginBlock {
+	if mmGetLoginBlock.mock.inspectFuncGetLoginBlock != nil {
+		mmGetLoginBlock.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.GetLoginBlock")
+	}
+
+	mmGetLoginBlock.mock.inspectFuncGetLoginBlock = f
+
+	return mmGetLoginBlock
+}
+
+// Return sets up results that will be returned by CacheInterface.GetLoginBlock
+func (mmGetLoginBlock *mCacheInterfaceMockGetLoginBlock) Return(d1 time.Duration, err error) *CacheInterfaceMock {
+	if mmGetLoginBlock.mock.funcGetLoginBlock != nil {
+		mmGetLoginBlock.mock.t.Fatalf("CacheInterfaceMock.GetLoginBlock mock is already set by Set")
+	}
+
+	if mmGetLoginBlock.defaultExpectation == nil {
+		mmGetLoginBlock.defaultExpectation = &CacheInterfaceMockGetLoginBlockExpectation{mock: mmGetLoginBlock.mock}
+	}
+	mmGetLoginBlock.defaultExpectation.results = &CacheInterfaceMockGetLoginBlockResults{d1, err}
+	mmGetLoginBlock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetLoginBlock.mock
+}
+
+// Set uses given function f to mock the CacheInterface.GetLoginBlock method
+func (mmGetLoginBlock *mCacheInterfaceMockGetLoginBlock) Set(f func(ctx context.Context, subject string) (d1 time.Duration, err error)) *CacheInterfaceMock {
+	if mmGetLoginBlock.defaultExpectation != nil {
+		mmGetLoginBlock.mock.t.Fatalf("Default expectation is already set for the CacheInterface.GetLoginBlock method")
+	}
+
+	if len(mmGetLoginBlock.expectations) > 0 {
+		mmGetLoginBlock.mock.t.Fatalf("Some expectations are already set for the CacheInterface.GetLoginBlock method")
+	}
+
+	mmGetLoginBlock.mock.funcGetLoginBlock = f
+	mmGetLoginBlock.mock.funcGetLoginBlockOrigin = minimock.CallerInfo(1)
+	return mmGetLoginBlock.mock
+}
+
+// When sets expectation for the CacheInterface.GetLoginBlock which will trigger the result defined by the following
+// Then helper
+func (mmGetLoginBlock *mCacheInterfaceMockGetLoginBlock) When(ctx context.Context, subject string) *CacheInterfaceMockGetLoginBlockExpectation {
+	if mmGetLoginBlock.mock.funcGetLoginBlock != nil {
+		mmGetLoginBlock.mock.t.Fatalf("CacheInterfaceMock.GetLoginBlock mock is already set by Set")
+	}
+
+	expectation := &CacheInterfaceMockGetLoginBlockExpectation{
+		mock:               mmGetLoginBlock.mock,
+		params:             &CacheInterfaceMockGetLoginBlockParams{ctx, subject},
+		expectationOrigins: CacheInterfaceMockGetLoginBlockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetLoginBlock.expectations = append(mmGetLoginBlock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CacheInterface.GetLoginBlock return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockGetLoginBlockExpectation) Then(d1 time.Duration, err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockGetLoginBlockResults{d1, err}
+	return e.mock
+}
+
+// Times sets number of times CacheInterface.GetLoginBlock should be invoked
+func (mmGetLoginBlock *mCacheInterfaceMockGetLoginBlock) Times(n uint64) *mCacheInterfaceMockGetLoginBlock {
+	if n == 0 {
+		mmGetLoginBlock.mock.t.Fatalf("Times of CacheInterfaceMock.GetLoginBlock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLoginBlock.expectedInvocations, n)
+	mmGetLoginBlock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetLoginBlock
+}
+
+func (mmGetLoginBlock *mCacheInterfaceMockGetLoginBlock) invocationsDone() bool {
+	if len(mmGetLoginBlock.expectations) == 0 && mmGetLoginBlock.defaultExpectation == nil && mmGetLoginBlock.mock.funcGetLoginBlock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLoginBlock.mock.afterGetLoginBlockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLoginBlock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLoginBlock implements mm_repository.CacheInterface
+func (mmGetLoginBlock *CacheInterfaceMock) GetLoginBlock(ctx context.Context, subject string) (d1 time.Duration, err error) {
+	mm_atomic.AddUint64(&mmGetLoginBlock.beforeGetLoginBlockCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLoginBlock.afterGetLoginBlockCounter, 1)
+
+	mmGetLoginBlock.t.Helper()
+
+	if mmGetLoginBlock.inspectFuncGetLoginBlock != nil {
+		mmGetLoginBlock.inspectFuncGetLoginBlock(ctx, subject)
+	}
+
+	mm_params := CacheInterfaceMockGetLoginBlockParams{ctx, subject}
+
+	// Record call args
+	mmGetLoginBlock.GetLoginBlockMock.mutex.Lock()
+	mmGetLoginBlock.GetLoginBlockMock.callArgs = append(mmGetLoginBlock.GetLoginBlockMock.callArgs, &mm_params)
+	mmGetLoginBlock.GetLoginBlockMock.mutex.Unlock()
+
+	for _, e := range mmGetLoginBlock.GetLoginBlockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.d1, e.results.err
+		}
+	}
+
+	if mmGetLoginBlock.GetLoginBlockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLoginBlock.GetLoginBlockMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLoginBlock.GetLoginBlockMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLoginBlock.GetLoginBlockMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheInterfaceMockGetLoginBlockParams{ctx, subject}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetLoginBlock.t.Errorf("CacheInterfaceMock.GetLoginBlock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLoginBlock.GetLoginBlockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmGetLoginBlock.t.Errorf("CacheInterfaceMock.GetLoginBlock got unexpected parameter subject, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLoginBlock.GetLoginBlockMock.defaultExpectation.expectationOrigins.originSubject, *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLoginBlock.t.Errorf("CacheInterfaceMock.GetLoginBlock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetLoginBlock.GetLoginBlockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLoginBlock.GetLoginBlockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLoginBlock.t.Fatal("No results are set for the CacheInterfaceMock.GetLoginBlock")
+		}
+		return (*mm_results).d1, (*mm_results).err
+	}
+	if mmGetLoginBlock.funcGetLoginBlock != nil {
+		return mmGetLoginBlock.funcGetLoginBlock(ctx, subject)
+	}
+	mmGetLoginBlock.t.Fatalf("Unexpected call to CacheInterfaceMock.GetLoginBlock. %v %v", ctx, subject)
+	return
+}
+
+// GetLoginBlockAfterCounter returns a count of finished CacheInterfaceMock.GetLoginBlock invocations
+func (mmGetLoginBlock *CacheInterfaceMock) GetLoginBlockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLoginBlock.afterGetLoginBlockCounter)
+}
+
+// GetLoginBlockBeforeCounter returns a count of CacheInterfaceMock.GetLoginBlock invocations
+func (mmGetLoginBlock *CacheInterfaceMock) GetLoginBlockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLoginBlock.beforeGetLoginBlockCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.GetLoginBlock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLoginBlock *mCacheInterfaceMockGetLoginBlock) Calls() []*CacheInterfaceMockGetLoginBlockParams {
+	mmGetLoginBlock.mutex.RLock()
+
+	argCopy := make([]*CacheInterfaceMockGetLoginBlockParams, len(mmGetLoginBlock.callArgs))
+	copy(argCopy, mmGetLoginBlock.callArgs)
+
+	mmGetLoginBlock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLoginBlockDone returns true if the count of the GetLoginBlock invocations corresponds
+// the number of defined expectations
+func (m *CacheInterfaceMock) MinimockGetLoginBlockDone() bool {
+	if m.GetLoginBlockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLoginBlockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLoginBlockMock.invocationsDone()
+}
+
+// MinimockGetLoginBlockInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockGetLoginBlockInspect() {
+	for _, e := range m.GetLoginBlockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheInterfaceMock.GetLoginBlock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetLoginBlockCounter := mm_atomic.LoadUint64(&m.afterGetLoginBlockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLoginBlockMock.defaultExpectation != nil && afterGetLoginBlockCounter < 1 {
+		if m.GetLoginBlockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.GetLoginBlock at\n%s", m.GetLoginBlockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheInterfaceMock.GetLoginBlock at\n%s with params: %#v", m.GetLoginBlockMock.defaultExpectation.expectationOrigins.origin, *m.GetLoginBlockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLoginBlock != nil && afterGetLoginBlockCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.GetLoginBlock at\n%s", m.funcGetLoginBlockOrigin)
+	}
+
+	if !m.GetLoginBlockMock.invocationsDone() && afterGetLoginBlockCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.GetLoginBlock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLoginBlockMock.expectedInvocations), m.GetLoginBlockMock.expectedInvocationsOrigin, afterGetLoginBlockCounter)
+	}
+}
+
+type mCacheInterfaceMockGetRevokedFamily struct {
+	optional           bool
+	mock               *CacheInterfaceMock
+	defaultExpectation *CacheInterfaceMockGetRevokedFamilyExpectation
+	expectations       []*CacheInterfaceMockGetRevokedFamilyExpectation
+
+	callArgs []*CacheInterfaceMockGetRevokedFamilyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheInterfaceMockGetRevokedFamilyExpectation specifies expectation struct of the CacheInterface.GetRevokedFamily
+type CacheInterfaceMockGetRevokedFamilyExpectation struct {
+	mock               *CacheInterfaceMock
+	params             *CacheInterfaceMockGetRevokedFamilyParams
+	paramPtrs          *CacheInterfaceMockGetRevokedFamilyParamPtrs
+	expectationOrigins CacheInterfaceMockGetRevokedFamilyExpectationOrigins
+	results            *CacheInterfaceMockGetRevokedFamilyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheInterfaceMockGetRevokedFamilyParams contains parameters of the CacheInterface.GetRevokedFamily
+type CacheInterfaceMockGetRevokedFamilyParams struct {
+	ctx      context.Context
+	familyID string
+}
+
+// CacheInterfaceMockGetRevokedFamilyParamPtrs contains pointers to parameters of the CacheInterface.GetRevokedFamily
+type CacheInterfaceMockGetRevokedFamilyParamPtrs struct {
+	ctx      *context.Context
+	familyID *string
+}
+
+// CacheInterfaceMockGetRevokedFamilyResults contains results of the CacheInterface.GetRevokedFamily
+type CacheInterfaceMockGetRevokedFamilyResults struct {
+	b1  bool
+	err error
+}
+
+// CacheInterfaceMockGetRevokedFamilyOrigins contains origins of expectations of the CacheInterface.GetRevokedFamily
+type CacheInterfaceMockGetRevokedFamilyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originFamilyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRevokedFamily *mCacheInterfaceMockGetRevokedFamily) Optional() *mCacheInterfaceMockGetRevokedFamily {
+	mmGetRevokedFamily.optional = true
+	return mmGetRevokedFamily
 }
 
-// ExpectCtxParam1 sets up expected param ctx for CacheInterface.CreateRole
-func (mmCreateRole *mCacheInterfaceMockCreateRole) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockCreateRole {
-	if mmCreateRole.mock.funcCreateRole != nil {
-		mmCreateRole.mock.t.Fatalf("CacheInterfaceMock.CreateRole mock is already set by Set")
+// Expect sets up expected params for CacheInterface.GetRevokedFamily
+func (mmGetRevokedFamily *mCacheInterfaceMockGetRevokedFamily) Expect(ctx context.Context, familyID string) *mCacheInterfaceMockGetRevokedFamily {
+	if mmGetRevokedFamily.mock.funcGetRevokedFamily != nil {
+		mmGetRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.GetRevokedFamily mock is already set by Set")
 	}
 
-	if mmCreateRole.defaultExpectation == nil {
-		mmCreateRole.defaultExpectation = &CacheInterfaceMockCreateRoleExpectation{}
+	if mmGetRevokedFamily.defaultExpectation == nil {
+		mmGetRevokedFamily.defaultExpectation = &CacheInterfaceMockGetRevokedFamilyExpectation{}
 	}
 
-	if mmCreateRole.defaultExpectation.params != nil {
-		mmCreateRole.mock.t.Fatalf("CacheInterfaceMock.CreateRole mock is already set by Expect")
+	if mmGetRevokedFamily.defaultExpectation.paramPtrs != nil {
+		mmGetRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.GetRevokedFamily mock is already set by ExpectParams functions")
 	}
 
-	if mmCreateRole.defaultExpectation.paramPtrs == nil {
-		mmCreateRole.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRoleParamPtrs{}
+	mmGetRevokedFamily.defaultExpectation.params = &CacheInterfaceMockGetRevokedFamilyParams{ctx, familyID}
+	mmGetRevokedFamily.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRevokedFamily.expectations {
+		if minimock.Equal(e.params, mmGetRevokedFamily.defaultExpectation.params) {
+			mmGetRevokedFamily.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRevokedFamily.defaultExpectation.params)
+		}
 	}
-	mmCreateRole.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateRole
+	return mmGetRevokedFamily
 }
 
-// ExpectUsernameParam2 sets up expected param username for CacheInterface.CreateRole
-func (mmCreateRole *mCacheInterfaceMockCreateRole) ExpectUsernameParam2(username string) *mCacheInterfaceMockCreateRole {
-	if mmCreateRole.mock.funcCreateRole != nil {
-		mmCreateRole.mock.t.Fatalf("CacheInterfaceMock.CreateRole mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.GetRevokedFamily
+func (mmGetRevokedFamily *mCacheInterfaceMockGetRevokedFamily) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockGetRevokedFamily {
+	if mmGetRevokedFamily.mock.funcGetRevokedFamily != nil {
+		mmGetRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.GetRevokedFamily mock is already set by Set")
 	}
 
-	if mmCreateRole.defaultExpectation == nil {
-		mmCreateRole.defaultExpectation = &CacheInterfaceMockCreateRoleExpectation{}
+	if mmGetRevokedFamily.defaultExpectation == nil {
+		mmGetRevokedFamily.defaultExpectation = &CacheInterfaceMockGetRevokedFamilyExpectation{}
 	}
 
-	if mmCreateRole.defaultExpectation.params != nil {
-		mmCreateRole.mock.t.Fatalf("CacheInterfaceMock.CreateRole mock is already set by Expect")
+	if mmGetRevokedFamily.defaultExpectation.params != nil {
+		mmGetRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.GetRevokedFamily mock is already set by Expect")
 	}
 
-	if mmCreateRole.defaultExpectation.paramPtrs == nil {
-		mmCreateRole.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRoleParamPtrs{}
+	if mmGetRevokedFamily.defaultExpectation.paramPtrs == nil {
+		mmGetRevokedFamily.defaultExpectation.paramPtrs = &CacheInterfaceMockGetRevokedFamilyParamPtrs{}
 	}
-	mmCreateRole.defaultExpectation.paramPtrs.username = &username
-	mmCreateRole.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+	mmGetRevokedFamily.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRevokedFamily.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateRole
+	return mmGetRevokedFamily
 }
 
-// ExpectRoleParam3 sets up expected param role for CacheInterface.CreateRole
-func (mmCreateRole *mCacheInterfaceMockCreateRole) ExpectRoleParam3(role bool) *mCacheInterfaceMockCreateRole {
-	if mmCreateRole.mock.funcCreateRole != nil {
-		mmCreateRole.mock.t.Fatalf("CacheInterfaceMock.CreateRole mock is already set by Set")
+// ExpectFamilyIDParam2 sets up expected param familyID for CacheInterface.GetRevokedFamily
+func (mmGetRevokedFamily *mCacheInterfaceMockGetRevokedFamily) ExpectFamilyIDParam2(familyID string) *mCacheInterfaceMockGetRevokedFamily {
+	if mmGetRevokedFamily.mock.funcGetRevokedFamily != nil {
+		mmGetRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.GetRevokedFamily mock is already set by Set")
 	}
 
-	if mmCreateRole.defaultExpectation == nil {
-		mmCreateRole.defaultExpectation = &CacheInterfaceMockCreateRoleExpectation{}
+	if mmGetRevokedFamily.defaultExpectation == nil {
+		mmGetRevokedFamily.defaultExpectation = &CacheInterfaceMockGetRevokedFamilyExpectation{}
 	}
 
-	if mmCreateRole.defaultExpectation.params != nil {
-		mmCreateRole.mock.t.Fatalf("CacheInterfaceMock.CreateRole mock is already set by Expect")
+	if mmGetRevokedFamily.defaultExpectation.params != nil {
+		mmGetRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.GetRevokedFamily mock is already set by Expect")
 	}
 
-	if mmCreateRole.defaultExpectation.paramPtrs == nil {
-		mmCreateRole.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRoleParamPtrs{}
+	if mmGetRevokedFamily.defaultExpectation.paramPtrs == nil {
+		mmGetRevokedFamily.defaultExpectation.paramPtrs = &CacheInterfaceMockGetRevokedFamilyParamPtrs{}
 	}
-	mmCreateRole.defaultExpectation.paramPtrs.role = &role
-	mmCreateRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+	mmGetRevokedFamily.defaultExpectation.paramPtrs.familyID = &familyID
+	mmGetRevokedFamily.defaultExpectation.expectationOrigins.originFamilyID = minimock.CallerInfo(1)
 
-	return mmCreateRole
+	return mmGetRevokedFamily
 }
 
-// Inspect accepts an inspector function that has same arguments as the CacheInterface.CreateRole
-func (mmCreateRole *mCacheInterfaceMockCreateRole) Inspect(f func(ctx context.Context, username string, role bool)) *mCacheInterfaceMockCreateRole {
-	if mmCreateRole.mock.inspectFuncCreateRole != nil {
-		mmCreateRole.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.CreateRole")
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.GetRevokedFamily
+func (mmGetRevokedFamily *mCacheInterfaceMockGetRevokedFamily) Inspect(f func(ctx context.Context, familyID string)) *mCacheInterfaceMockGetRevokedFamily {
+	if mmGetRevokedFamily.mock.inspectFuncGetRevokedFamily != nil {
+		mmGetRevokedFamily.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.GetRevokedFamily")
 	}
 
-	mmCreateRole.mock.inspectFuncCreateRole = f
+	mmGetRevokedFamily.mock.inspectFuncGetRevokedFamily = f
 
-	return mmCreateRole
+	return mmGetRevokedFamily
 }
 
-// Return sets up results that will be returned by CacheInterface.CreateRole
-func (mmCreateRole *mCacheInterfaceMockCreateRole) Return(err error) *CacheInterfaceMock {
-	if mmCreateRole.mock.funcCreateRole != nil {
-		mmCreateRole.mock.t.Fatalf("CacheInterfaceMock.CreateRole mock is already set by Set")
+// Return sets up results that will be returned by CacheInterface.GetRevokedFamily
+func (mmGetRevokedFamily *mCacheInterfaceMockGetRevokedFamily) Return(b1 bool, err error) *CacheInterfaceMock {
+	if mmGetRevokedFamily.mock.funcGetRevokedFamily != nil {
+		mmGetRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.GetRevokedFamily mock is already set by Set")
 	}
 
-	if mmCreateRole.defaultExpectation == nil {
-		mmCreateRole.defaultExpectation = &CacheInterfaceMockCreateRoleExpectation{mock: mmCreateRole.mock}
+	if mmGetRevokedFamily.defaultExpectation == nil {
+		mmGetRevokedFamily.defaultExpectation = &CacheInterfaceMockGetRevokedFamilyExpectation{mock: mmGetRevokedFamily.mock}
 	}
-	mmCreateRole.defaultExpectation.results = &CacheInterfaceMockCreateRoleResults{err}
-	mmCreateRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateRole.mock
+	mmGetRevokedFamily.defaultExpectation.results = &CacheInterfaceMockGetRevokedFamilyResults{b1, err}
+	mmGetRevokedFamily.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRevokedFamily.mock
 }
 
-// Set uses given function f to mock the CacheInterface.CreateRole method
-func (mmCreateRole *mCacheInterfaceMockCreateRole) Set(f func(ctx context.Context, username string, role bool) (err error)) *CacheInterfaceMock {
-	if mmCreateRole.defaultExpectation != nil {
-		mmCreateRole.mock.t.Fatalf("Default expectation is already set for the CacheInterface.CreateRole method")
+// Set uses given function f to mock the CacheInterface.GetRevokedFamily method
+func (mmGetRevokedFamily *mCacheInterfaceMockGetRevokedFamily) Set(f func(ctx context.Context, familyID string) (b1 bool, err error)) *CacheInterfaceMock {
+	if mmGetRevokedFamily.defaultExpectation != nil {
+		mmGetRevokedFamily.mock.t.Fatalf("Default expectation is already set for the CacheInterface.GetRevokedFamily method")
 	}
 
-	if len(mmCreateRole.expectations) > 0 {
-		mmCreateRole.mock.t.Fatalf("Some expectations are already set for the CacheInterface.CreateRole method")
+	if len(mmGetRevokedFamily.expectations) > 0 {
+		mmGetRevokedFamily.mock.t.Fatalf("Some expectations are already set for the CacheInterface.GetRevokedFamily method")
 	}
 
-	mmCreateRole.mock.funcCreateRole = f
-	mmCreateRole.mock.funcCreateRoleOrigin = minimock.CallerInfo(1)
-	return mmCreateRole.mock
+	mmGetRevokedFamily.mock.funcGetRevokedFamily = f
+	mmGetRevokedFamily.mock.funcGetRevokedFamilyOrigin = minimock.CallerInfo(1)
+	return mmGetRevokedFamily.mock
 }
 
-// When sets expectation for the CacheInterface.CreateRole which will trigger the result defined by the following
+// When sets expectation for the CacheInterface.GetRevokedFamily which will trigger the result defined by the following
 // Then helper
-func (mmCreateRole *mCacheInterfaceMockCreateRole) When(ctx context.Context, username string, role bool) *CacheInterfaceMockCreateRoleExpectation {
-	if mmCreateRole.mock.funcCreateRole != nil {
-		mmCreateRole.mock.t.Fatalf("CacheInterfaceMock.CreateRole mock is already set by Set")
+func (mmGetRevokedFamily *mCacheInterfaceMockGetRevokedFamily) When(ctx context.Context, familyID string) *CacheInterfaceMockGetRevokedFamilyExpectation {
+	if mmGetRevokedFamily.mock.funcGetRevokedFamily != nil {
+		mmGetRevokedFamily.mock.t.Fatalf("CacheInterfaceMock.GetRevokedFamily mock is already set by Set")
 	}
 
-	expectation := &CacheInterfaceMockCreateRoleExpectation{
-		mock:               mmCreateRole.mock,
-		params:             &CacheInterfaceMockCreateRoleParams{ctx, username, role},
-		expectationOrigins: CacheInterfaceMockCreateRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CacheInterfaceMockGetRevokedFamilyExpectation{
+		mock:               mmGetRevokedFamily.mock,
+		params:             &CacheInterfaceMockGetRevokedFamilyParams{ctx, familyID},
+		expectationOrigins: CacheInterfaceMockGetRevokedFamilyExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateRole.expectations = append(mmCreateRole.expectations, expectation)
+	mmGetRevokedFamily.expectations = append(mmGetRevokedFamily.expectations, expectation)
 	return expectation
 }
 
-// Then sets up CacheInterface.CreateRole return parameters for the expectation previously defined by the When method
-func (e *CacheInterfaceMockCreateRoleExpectation) Then(err error) *CacheInterfaceMock {
-	e.results = &CacheInterfaceMockCreateRoleResults{err}
+// Then sets up CacheInterface.GetRevokedFamily return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockGetRevokedFamilyExpectation) Then(b1 bool, err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockGetRevokedFamilyResults{b1, err}
 	return e.mock
 }
 
-// Times sets number of times CacheInterface.CreateRole should be invoked
-func (mmCreateRole *mCacheInterfaceMockCreateRole) Times(n uint64) *mCacheInterfaceMockCreateRole {
+// Times sets number of times CacheInterface.GetRevokedFamily should be invoked
+func (mmGetRevokedFamily *mCacheInterfaceMockGetRevokedFamily) Times(n uint64) *mCacheInterfaceMockGetRevokedFamily {
 	if n == 0 {
-		mmCreateRole.mock.t.Fatalf("Times of CacheInterfaceMock.CreateRole mock can not be zero")
+		mmGetRevokedFamily.mock.t.Fatalf("Times of CacheInterfaceMock.GetRevokedFamily mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateRole.expectedInvocations, n)
-	mmCreateRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateRole
+	mm_atomic.StoreUint64(&mmGetRevokedFamily.expectedInvocations, n)
+	mmGetRevokedFamily.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRevokedFamily
 }
 
-func (mmCreateRole *mCacheInterfaceMockCreateRole) invocationsDone() bool {
-	if len(mmCreateRole.expectations) == 0 && mmCreateRole.defaultExpectation == nil && mmCreateRole.mock.funcCreateRole == nil {
+func (mmGetRevokedFamily *mCacheInterfaceMockGetRevokedFamily) invocationsDone() bool {
+	if len(mmGetRevokedFamily.expectations) == 0 && mmGetRevokedFamily.defaultExpectation == nil && mmGetRevokedFamily.mock.funcGetRevokedFamily == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateRole.mock.afterCreateRoleCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRole.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRevokedFamily.mock.afterGetRevokedFamilyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRevokedFamily.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateRole implements mm_repository.CacheInterface
-func (mmCreateRole *CacheInterfaceMock) CreateRole(ctx context.Context, username string, role bool) (err error) {
-	mm_atomic.AddUint64(&mmCreateRole.beforeCreateRoleCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateRole.afterCreateRoleCounter, 1)
+// GetRevokedFamily implements mm_repository.CacheInterface
+func (mmGetRevokedFamily *CacheInterfaceMock) GetRevokedFamily(ctx context.Context, familyID string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmGetRevokedFamily.beforeGetRevokedFamilyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRevokedFamily.afterGetRevokedFamilyCounter, 1)
 
-	mmCreateRole.t.Helper()
+	mmGetRevokedFamily.t.Helper()
 
-	if mmCreateRole.inspectFuncCreateRole != nil {
-		mmCreateRole.inspectFuncCreateRole(ctx, username, role)
+	if mmGetRevokedFamily.inspectFuncGetRevokedFamily != nil {
+		mmGetRevokedFamily.inspectFuncGetRevokedFamily(ctx, familyID)
 	}
 
-	mm_params := CacheInterfaceMockCreateRoleParams{ctx, username, role}
+	mm_params := CacheInterfaceMockGetRevokedFamilyParams{ctx, familyID}
 
 	// Record call args
-	mmCreateRole.CreateRoleMock.mutex.Lock()
-	mmCreateRole.CreateRoleMock.callArgs = append(mmCreateRole.CreateRoleMock.callArgs, &mm_params)
-	mmCreateRole.CreateRoleMock.mutex.Unlock()
+	mmGetRevokedFamily.GetRevokedFamilyMock.mutex.Lock()
+	mmGetRevokedFamily.GetRevokedFamilyMock.callArgs = append(mmGetRevokedFamily.GetRevokedFamilyMock.callArgs, &mm_params)
+	mmGetRevokedFamily.GetRevokedFamilyMock.mutex.Unlock()
 
-	for _, e := range mmCreateRole.CreateRoleMock.expectations {
+	for _, e := range mmGetRevokedFamily.GetRevokedFamilyMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.b1, e.results.err
 		}
 	}
 
-	if mmCreateRole.CreateRoleMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateRole.CreateRoleMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateRole.CreateRoleMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateRole.CreateRoleMock.defaultExpectation.paramPtrs
+	if mmGetRevokedFamily.GetRevokedFamilyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRevokedFamily.GetRevokedFamilyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRevokedFamily.GetRevokedFamilyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRevokedFamily.GetRevokedFamilyMock.defaultExpectation.paramPtrs
 
-		mm_got := CacheInterfaceMockCreateRoleParams{ctx, username, role}
+		mm_got := CacheInterfaceMockGetRevokedFamilyParams{ctx, familyID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateRole.t.Errorf("CacheInterfaceMock.CreateRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmCreateRole.t.Errorf("CacheInterfaceMock.CreateRole got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+				mmGetRevokedFamily.t.Errorf("CacheInterfaceMock.GetRevokedFamily got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRevokedFamily.GetRevokedFamilyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
-				mmCreateRole.t.Errorf("CacheInterfaceMock.CreateRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			if mm_want_ptrs.familyID != nil && !minimock.Equal(*mm_want_ptrs.familyID, mm_got.familyID) {
+				mmGetRevokedFamily.t.Errorf("CacheInterfaceMock.GetRevokedFamily got unexpected parameter familyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRevokedFamily.GetRevokedFamilyMock.defaultExpectation.expectationOrigins.originFamilyID, *mm_want_ptrs.familyID, mm_got.familyID, minimock.Diff(*mm_want_ptrs.familyID, mm_got.familyID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateRole.t.Errorf("CacheInterfaceMock.CreateRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetRevokedFamily.t.Errorf("CacheInterfaceMock.GetRevokedFamily got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRevokedFamily.GetRevokedFamilyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateRole.CreateRoleMock.defaultExpectation.results
+		mm_results := mmGetRevokedFamily.GetRevokedFamilyMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateRole.t.Fatal("No results are set for the CacheInterfaceMock.CreateRole")
+			mmGetRevokedFamily.t.Fatal("No results are set for the CacheInterfaceMock.GetRevokedFamily")
 		}
-		return (*mm_results).err
+		return (*mm_results).b1, (*mm_results).err
 	}
-	if mmCreateRole.funcCreateRole != nil {
-		return mmCreateRole.funcCreateRole(ctx, username, role)
+	if mmGetRevokedFamily.funcGetRevokedFamily != nil {
+		return mmGetRevokedFamily.funcGetRevokedFamily(ctx, familyID)
 	}
-	mmCreateRole.t.Fatalf("Unexpected call to CacheInterfaceMock.CreateRole. %v %v %v", ctx, username, role)
+	mmGetRevokedFamily.t.Fatalf("Unexpected call to CacheInterfaceMock.GetRevokedFamily. %v %v", ctx, familyID)
 	return
 }
 
-// CreateRoleAfterCounter returns a count of finished CacheInterfaceMock.CreateRole invocations
-func (mmCreateRole *CacheInterfaceMock) CreateRoleAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateRole.afterCreateRoleCounter)
+// GetRevokedFamilyAfterCounter returns a count of finished CacheInterfaceMock.GetRevokedFamily invocations
+func (mmGetRevokedFamily *CacheInterfaceMock) GetRevokedFamilyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRevokedFamily.afterGetRevokedFamilyCounter)
 }
 
-// CreateRoleBeforeCounter returns a count of CacheInterfaceMock.CreateRole invocations
-func (mmCreateRole *CacheInterfaceMock) CreateRoleBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateRole.beforeCreateRoleCounter)
+// GetRevokedFamilyBeforeCounter returns a count of CacheInterfaceMock.GetRevokedFamily invocations
+func (mmGetRevokedFamily *CacheInterfaceMock) GetRevokedFamilyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRevokedFamily.beforeGetRevokedFamilyCounter)
 }
 
-// Calls returns a list of arguments used in each call to CacheInterfaceMock.CreateRole.
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.GetRevokedFamily.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateRole *mCacheInterfaceMockCreateRole) Calls() []*CacheInterfaceMockCreateRoleParams {
-	mmCreateRole.mutex.RLock()
+func (mmGetRevokedFamily *mCacheInterfaceMockGetRevokedFamily) Calls() []*CacheInterfaceMockGetRevokedFamilyParams {
+	mmGetRevokedFamily.mutex.RLock()
 
-	argCopy := make([]*CacheInterfaceMockCreateRoleParams, len(mmCreateRole.callArgs))
-	copy(argCopy, mmCreateRole.callArgs)
+	argCopy := make([]*CacheInterfaceMockGetRevokedFamilyParams, len(mmGetRevokedFamily.callArgs))
+	copy(argCopy, mmGetRevokedFamily.callArgs)
 
-	mmCreateRole.mutex.RUnlock()
+	mmGetRevokedFamily.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateRoleDone returns true if the count of the CreateRole invocations corresponds
+// MinimockGetRevokedFamilyDone returns true if the count of the GetRevokedFamily invocations corresponds
 // the number of defined expectations
-func (m *CacheInterfaceMock) MinimockCreateRoleDone() bool {
-	if m.CreateRoleMock.optional {
+func (m *CacheInterfaceMock) MinimockGetRevokedFamilyDone() bool {
+	if m.GetRevokedFamilyMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateRoleMock.expectations {
+	for _, e := range m.GetRevokedFamilyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateRoleMock.invocationsDone()
+	return m.GetRevokedFamilyMock.invocationsDone()
 }
 
-// MinimockCreateRoleInspect logs each unmet expectation
-func (m *CacheInterfaceMock) MinimockCreateRoleInspect() {
-	for _, e := range m.CreateRoleMock.expectations {
+// MinimockGetRevokedFamilyInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockGetRevokedFamilyInspect() {
+	for _, e := range m.GetRevokedFamilyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CacheInterfaceMock.GetRevokedFamily at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateRoleCounter := mm_atomic.LoadUint64(&m.afterCreateRoleCounter)
+	afterGetRevokedFamilyCounter := mm_atomic.LoadUint64(&m.afterGetRevokedFamilyCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateRoleMock.defaultExpectation != nil && afterCreateRoleCounter < 1 {
-		if m.CreateRoleMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRole at\n%s", m.CreateRoleMock.defaultExpectation.returnOrigin)
+	if m.GetRevokedFamilyMock.defaultExpectation != nil && afterGetRevokedFamilyCounter < 1 {
+		if m.GetRevokedFamilyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.GetRevokedFamily at\n%s", m.GetRevokedFamilyMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRole at\n%s with params: %#v", m.CreateRoleMock.defaultExpectation.expectationOrigins.origin, *m.CreateRoleMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CacheInterfaceMock.GetRevokedFamily at\n%s with params: %#v", m.GetRevokedFamilyMock.defaultExpectation.expectationOrigins.origin, *m.GetRevokedFamilyMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateRole != nil && afterCreateRoleCounter < 1 {
-		m.t.Errorf("Expected call to CacheInterfaceMock.CreateRole at\n%s", m.funcCreateRoleOrigin)
+	if m.funcGetRevokedFamily != nil && afterGetRevokedFamilyCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.GetRevokedFamily at\n%s", m.funcGetRevokedFamilyOrigin)
 	}
 
-	if !m.CreateRoleMock.invocationsDone() && afterCreateRoleCounter > 0 {
-		m.t.Errorf("Expected %d calls to CacheInterfaceMock.CreateRole at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateRoleMock.expectedInvocations), m.CreateRoleMock.expectedInvocationsOrigin, afterCreateRoleCounter)
+	if !m.GetRevokedFamilyMock.invocationsDone() && afterGetRevokedFamilyCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.GetRevokedFamily at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRevokedFamilyMock.expectedInvocations), m.GetRevokedFamilyMock.expectedInvocationsOrigin, afterGetRevokedFamilyCounter)
 	}
 }
 
-type mCacheInterfaceMockCreateRoleEndpoints struct {
+type mCacheInterfaceMockGetRoles struct {
 	optional           bool
 	mock               *CacheInterfaceMock
-	defaultExpectation *CacheInterfaceMockCreateRoleEndpointsExpectation
-	expectations       []*CacheInterfaceMockCreateRoleEndpointsExpectation
+	defaultExpectation *CacheInterfaceMockGetRolesExpectation
+	expectations       []*CacheInterfaceMockGetRolesExpectation
 
-	callArgs []*CacheInterfaceMockCreateRoleEndpointsParams
+	callArgs []*CacheInterfaceMockGetRolesParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CacheInterfaceMockCreateRoleEndpointsExpectation specifies expectation struct of the CacheInterface.CreateRoleEndpoints
-type CacheInterfaceMockCreateRoleEndpointsExpectation struct {
+// CacheInterfaceMockGetRolesExpectation specifies expectation struct of the CacheInterface.GetRoles
+type CacheInterfaceMockGetRolesExpectation struct {
 	mock               *CacheInterfaceMock
-	params             *CacheInterfaceMockCreateRoleEndpointsParams
-	paramPtrs          *CacheInterfaceMockCreateRoleEndpointsParamPtrs
-	expectationOrigins CacheInterfaceMockCreateRoleEndpointsExpectationOrigins
-	results            *CacheInterfaceMockCreateRoleEndpointsResults
+	params             *CacheInterfaceMockGetRolesParams
+	paramPtrs          *CacheInterfaceMockGetRolesParamPtrs
+	expectationOrigins CacheInterfaceMockGetRolesExpectationOrigins
+	results            *CacheInterfaceMockGetRolesResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CacheInterfaceMockCreateRoleEndpointsParams contains parameters of the CacheInterface.CreateRoleEndpoints
-type CacheInterfaceMockCreateRoleEndpointsParams struct {
-	ctx       context.Context
-	isAdmin   bool
-	endpoints []string
+// CacheInterfaceMockGetRolesParams contains parameters of the CacheInterface.GetRoles
+type CacheInterfaceMockGetRolesParams struct {
+	ctx      context.Context
+	username string
 }
 
-// CacheInterfaceMockCreateRoleEndpointsParamPtrs contains pointers to parameters of the CacheInterface.CreateRoleEndpoints
-type CacheInterfaceMockCreateRoleEndpointsParamPtrs struct {
-	ctx       *context.Context
-	isAdmin   *bool
-	endpoints *[]string
+// CacheInterfaceMockGetRolesParamPtrs contains pointers to parameters of the CacheInterface.GetRoles
+type CacheInterfaceMockGetRolesParamPtrs struct {
+	ctx      *context.Context
+	username *string
 }
 
-// CacheInterfaceMockCreateRoleEndpointsResults contains results of the CacheInterface.CreateRoleEndpoints
-type CacheInterfaceMockCreateRoleEndpointsResults struct {
+// CacheInterfaceMockGetRolesResults contains results of the CacheInterface.GetRoles
+type CacheInterfaceMockGetRolesResults struct {
+	sa1 []string
 	err error
 }
 
-// CacheInterfaceMockCreateRoleEndpointsOrigins contains origins of expectations of the CacheInterface.CreateRoleEndpoints
-type CacheInterfaceMockCreateRoleEndpointsExpectationOrigins struct {
-	origin          string
-	originCtx       string
-	originIsAdmin   string
-	originEndpoints string
+// CacheInterfaceMockGetRolesOrigins contains origins of expectations of the CacheInterface.GetRoles
+type CacheInterfaceMockGetRolesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -895,370 +4096,345 @@ type CacheInterfaceMockCreateRoleEndpointsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) Optional() *mCacheInterfaceMockCreateRoleEndpoints {
-	mmCreateRoleEndpoints.optional = true
-	return mmCreateRoleEndpoints
+func (mmGetRoles *mCacheInterfaceMockGetRoles) Optional() *mCacheInterfaceMockGetRoles {
+	mmGetRoles.optional = true
+	return mmGetRoles
 }
 
-// Expect sets up expected params for CacheInterface.CreateRoleEndpoints
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) Expect(ctx context.Context, isAdmin bool, endpoints []string) *mCacheInterfaceMockCreateRoleEndpoints {
-	if mmCreateRoleEndpoints.mock.funcCreateRoleEndpoints != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.CreateRoleEndpoints mock is already set by Set")
+// Expect sets up expected params for CacheInterface.GetRoles
+func (mmGetRoles *mCacheInterfaceMockGetRoles) Expect(ctx context.Context, username string) *mCacheInterfaceMockGetRoles {
+	if mmGetRoles.mock.funcGetRoles != nil {
+		mmGetRoles.mock.t.Fatalf("CacheInterfaceMock.GetRoles mock is already set by Set")
 	}
 
-	if mmCreateRoleEndpoints.defaultExpectation == nil {
-		mmCreateRoleEndpoints.defaultExpectation = &CacheInterfaceMockCreateRoleEndpointsExpectation{}
+	if mmGetRoles.defaultExpectation == nil {
+		mmGetRoles.defaultExpectation = &CacheInterfaceMockGetRolesExpectation{}
 	}
 
-	if mmCreateRoleEndpoints.defaultExpectation.paramPtrs != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.CreateRoleEndpoints mock is already set by ExpectParams functions")
+	if mmGetRoles.defaultExpectation.paramPtrs != nil {
+		mmGetRoles.mock.t.Fatalf("CacheInterfaceMock.GetRoles mock is already set by ExpectParams functions")
 	}
 
-	mmCreateRoleEndpoints.defaultExpectation.params = &CacheInterfaceMockCreateRoleEndpointsParams{ctx, isAdmin, endpoints}
-	mmCreateRoleEndpoints.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateRoleEndpoints.expectations {
-		if minimock.Equal(e.params, mmCreateRoleEndpoints.defaultExpectation.params) {
-			mmCreateRoleEndpoints.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRoleEndpoints.defaultExpectation.params)
+	mmGetRoles.defaultExpectation.params = &CacheInterfaceMockGetRolesParams{ctx, username}
+	mmGetRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRoles.expectations {
+		if minimock.Equal(e.params, mmGetRoles.defaultExpectation.params) {
+			mmGetRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRoles.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateRoleEndpoints
-}
-
-// ExpectCtxParam1 sets up expected param ctx for CacheInterface.CreateRoleEndpoints
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockCreateRoleEndpoints {
-	if mmCreateRoleEndpoints.mock.funcCreateRoleEndpoints != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.CreateRoleEndpoints mock is already set by Set")
-	}
-
-	if mmCreateRoleEndpoints.defaultExpectation == nil {
-		mmCreateRoleEndpoints.defaultExpectation = &CacheInterfaceMockCreateRoleEndpointsExpectation{}
-	}
-
-	if mmCreateRoleEndpoints.defaultExpectation.params != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.CreateRoleEndpoints mock is already set by Expect")
-	}
-
-	if mmCreateRoleEndpoints.defaultExpectation.paramPtrs == nil {
-		mmCreateRoleEndpoints.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRoleEndpointsParamPtrs{}
-	}
-	mmCreateRoleEndpoints.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateRoleEndpoints.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmCreateRoleEndpoints
+	return mmGetRoles
 }
 
-// ExpectIsAdminParam2 sets up expected param isAdmin for CacheInterface.CreateRoleEndpoints
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) ExpectIsAdminParam2(isAdmin bool) *mCacheInterfaceMockCreateRoleEndpoints {
-	if mmCreateRoleEndpoints.mock.funcCreateRoleEndpoints != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.CreateRoleEndpoints mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.GetRoles
+func (mmGetRoles *mCacheInterfaceMockGetRoles) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockGetRoles {
+	if mmGetRoles.mock.funcGetRoles != nil {
+		mmGetRoles.mock.t.Fatalf("CacheInterfaceMock.GetRoles mock is already set by Set")
 	}
 
-	if mmCreateRoleEndpoints.defaultExpectation == nil {
-		mmCreateRoleEndpoints.defaultExpectation = &CacheInterfaceMockCreateRoleEndpointsExpectation{}
+	if mmGetRoles.defaultExpectation == nil {
+		mmGetRoles.defaultExpectation = &CacheInterfaceMockGetRolesExpectation{}
 	}
 
-	if mmCreateRoleEndpoints.defaultExpectation.params != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.CreateRoleEndpoints mock is already set by Expect")
+	if mmGetRoles.defaultExpectation.params != nil {
+		mmGetRoles.mock.t.Fatalf("CacheInterfaceMock.GetRoles mock is already set by Expect")
 	}
 
-	if mmCreateRoleEndpoints.defaultExpectation.paramPtrs == nil {
-		mmCreateRoleEndpoints.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRoleEndpointsParamPtrs{}
+	if mmGetRoles.defaultExpectation.paramPtrs == nil {
+		mmGetRoles.defaultExpectation.paramPtrs = &CacheInterfaceMockGetRolesParamPtrs{}
 	}
-	mmCreateRoleEndpoints.defaultExpectation.paramPtrs.isAdmin = &isAdmin
-	mmCreateRoleEndpoints.defaultExpectation.expectationOrigins.originIsAdmin = minimock.CallerInfo(1)
+	mmGetRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateRoleEndpoints
+	return mmGetRoles
 }
 
-// ExpectEndpointsParam3 sets up expected param endpoints for CacheInterface.CreateRoleEndpoints
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) ExpectEndpointsParam3(endpoints []string) *mCacheInterfaceMockCreateRoleEndpoints {
-	if mmCreateRoleEndpoints.mock.funcCreateRoleEndpoints != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.CreateRoleEndpoints mock is already set by Set")
+// ExpectUsernameParam2 sets up expected param username for CacheInterface.GetRoles
+func (mmGetRoles *mCacheInterfaceMockGetRoles) ExpectUsernameParam2(username string) *mCacheInterfaceMockGetRoles {
+	if mmGetRoles.mock.funcGetRoles != nil {
+		mmGetRoles.mock.t.Fatalf("CacheInterfaceMock.GetRoles mock is already set by Set")
 	}
 
-	if mmCreateRoleEndpoints.defaultExpectation == nil {
-		mmCreateRoleEndpoints.defaultExpectation = &CacheInterfaceMockCreateRoleEndpointsExpectation{}
+	if mmGetRoles.defaultExpectation == nil {
+		mmGetRoles.defaultExpectation = &CacheInterfaceMockGetRolesExpectation{}
 	}
 
-	if mmCreateRoleEndpoints.defaultExpectation.params != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.CreateRoleEndpoints mock is already set by Expect")
+	if mmGetRoles.defaultExpectation.params != nil {
+		mmGetRoles.mock.t.Fatalf("CacheInterfaceMock.GetRoles mock is already set by Expect")
 	}
 
-	if mmCreateRoleEndpoints.defaultExpectation.paramPtrs == nil {
-		mmCreateRoleEndpoints.defaultExpectation.paramPtrs = &CacheInterfaceMockCreateRoleEndpointsParamPtrs{}
+	if mmGetRoles.defaultExpectation.paramPtrs == nil {
+		mmGetRoles.defaultExpectation.paramPtrs = &CacheInterfaceMockGetRolesParamPtrs{}
 	}
-	mmCreateRoleEndpoints.defaultExpectation.paramPtrs.endpoints = &endpoints
-	mmCreateRoleEndpoints.defaultExpectation.expectationOrigins.originEndpoints = minimock.CallerInfo(1)
+	mmGetRoles.defaultExpectation.paramPtrs.username = &username
+	mmGetRoles.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
 
-	return mmCreateRoleEndpoints
+	return mmGetRoles
 }
 
-// Inspect accepts an inspector function that has same arguments as the CacheInterface.CreateRoleEndpoints
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) Inspect(f func(ctx context.Context, isAdmin bool, endpoints []string)) *mCacheInterfaceMockCreateRoleEndpoints {
-	if mmCreateRoleEndpoints.mock.inspectFuncCreateRoleEndpoints != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.CreateRoleEndpoints")
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.GetRoles
+func (mmGetRoles *mCacheInterfaceMockGetRoles) Inspect(f func(ctx context.Context, username string)) *mCacheInterfaceMockGetRoles {
+	if mmGetRoles.mock.inspectFuncGetRoles != nil {
+		mmGetRoles.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.GetRoles")
 	}
 
-	mmCreateRoleEndpoints.mock.inspectFuncCreateRoleEndpoints = f
+	mmGetRoles.mock.inspectFuncGetRoles = f
 
-	return mmCreateRoleEndpoints
+	return mmGetRoles
 }
 
-// Return sets up results that will be returned by CacheInterface.CreateRoleEndpoints
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) Return(err error) *CacheInterfaceMock {
-	if mmCreateRoleEndpoints.mock.funcCreateRoleEndpoints != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.CreateRoleEndpoints mock is already set by Set")
+// Return sets up results that will be returned by CacheInterface.GetRoles
+func (mmGetRoles *mCacheInterfaceMockGetRoles) Return(sa1 []string, err error) *CacheInterfaceMock {
+	if mmGetRoles.mock.funcGetRoles != nil {
+		mmGetRoles.mock.t.Fatalf("CacheInterfaceMock.GetRoles mock is already set by Set")
 	}
 
-	if mmCreateRoleEndpoints.defaultExpectation == nil {
-		mmCreateRoleEndpoints.defaultExpectation = &CacheInterfaceMockCreateRoleEndpointsExpectation{mock: mmCreateRoleEndpoints.mock}
+	if mmGetRoles.defaultExpectation == nil {
+		mmGetRoles.defaultExpectation = &CacheInterfaceMockGetRolesExpectation{mock: mmGetRoles.mock}
 	}
-	mmCreateRoleEndpoints.defaultExpectation.results = &CacheInterfaceMockCreateRoleEndpointsResults{err}
-	mmCreateRoleEndpoints.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateRoleEndpoints.mock
+	mmGetRoles.defaultExpectation.results = &CacheInterfaceMockGetRolesResults{sa1, err}
+	mmGetRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRoles.mock
 }
 
-// Set uses given function f to mock the CacheInterface.CreateRoleEndpoints method
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) Set(f func(ctx context.Context, isAdmin bool, endpoints []string) (err error)) *CacheInterfaceMock {
-	if mmCreateRoleEndpoints.defaultExpectation != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("Default expectation is already set for the CacheInterface.CreateRoleEndpoints method")
+// Set uses given function f to mock the CacheInterface.GetRoles method
+func (mmGetRoles *mCacheInterfaceMockGetRoles) Set(f func(ctx context.Context, username string) (sa1 []string, err error)) *CacheInterfaceMock {
+	if mmGetRoles.defaultExpectation != nil {
+		mmGetRoles.mock.t.Fatalf("Default expectation is already set for the CacheInterface.GetRoles method")
 	}
 
-	if len(mmCreateRoleEndpoints.expectations) > 0 {
-		mmCreateRoleEndpoints.mock.t.Fatalf("Some expectations are already set for the CacheInterface.CreateRoleEndpoints method")
+	if len(mmGetRoles.expectations) > 0 {
+		mmGetRoles.mock.t.Fatalf("Some expectations are already set for the CacheInterface.GetRoles method")
 	}
 
-	mmCreateRoleEndpoints.mock.funcCreateRoleEndpoints = f
-	mmCreateRoleEndpoints.mock.funcCreateRoleEndpointsOrigin = minimock.CallerInfo(1)
-	return mmCreateRoleEndpoints.mock
+	mmGetRoles.mock.funcGetRoles = f
+	mmGetRoles.mock.funcGetRolesOrigin = minimock.CallerInfo(1)
+	return mmGetRoles.mock
 }
 
-// When sets expectation for the CacheInterface.CreateRoleEndpoints which will trigger the result defined by the following
+// When sets expectation for the CacheInterface.GetRoles which will trigger the result defined by the following
 // Then helper
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) When(ctx context.Context, isAdmin bool, endpoints []string) *CacheInterfaceMockCreateRoleEndpointsExpectation {
-	if mmCreateRoleEndpoints.mock.funcCreateRoleEndpoints != nil {
-		mmCreateRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.CreateRoleEndpoints mock is already set by Set")
+func (mmGetRoles *mCacheInterfaceMockGetRoles) When(ctx context.Context, username string) *CacheInterfaceMockGetRolesExpectation {
+	if mmGetRoles.mock.funcGetRoles != nil {
+		mmGetRoles.mock.t.Fatalf("CacheInterfaceMock.GetRoles mock is already set by Set")
 	}
 
-	expectation := &CacheInterfaceMockCreateRoleEndpointsExpectation{
-		mock:               mmCreateRoleEndpoints.mock,
-		params:             &CacheInterfaceMockCreateRoleEndpointsParams{ctx, isAdmin, endpoints},
-		expectationOrigins: CacheInterfaceMockCreateRoleEndpointsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CacheInterfaceMockGetRolesExpectation{
+		mock:               mmGetRoles.mock,
+		params:             &CacheInterfaceMockGetRolesParams{ctx, username},
+		expectationOrigins: CacheInterfaceMockGetRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateRoleEndpoints.expectations = append(mmCreateRoleEndpoints.expectations, expectation)
+	mmGetRoles.expectations = append(mmGetRoles.expectations, expectation)
 	return expectation
 }
 
-// Then sets up CacheInterface.CreateRoleEndpoints return parameters for the expectation previously defined by the When method
-func (e *CacheInterfaceMockCreateRoleEndpointsExpectation) Then(err error) *CacheInterfaceMock {
-	e.results = &CacheInterfaceMockCreateRoleEndpointsResults{err}
+// Then sets up CacheInterface.GetRoles return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockGetRolesExpectation) Then(sa1 []string, err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockGetRolesResults{sa1, err}
 	return e.mock
 }
 
-// Times sets number of times CacheInterface.CreateRoleEndpoints should be invoked
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) Times(n uint64) *mCacheInterfaceMockCreateRoleEndpoints {
+// Times sets number of times CacheInterface.GetRoles should be invoked
+func (mmGetRoles *mCacheInterfaceMockGetRoles) Times(n uint64) *mCacheInterfaceMockGetRoles {
 	if n == 0 {
-		mmCreateRoleEndpoints.mock.t.Fatalf("Times of CacheInterfaceMock.CreateRoleEndpoints mock can not be zero")
+		mmGetRoles.mock.t.Fatalf("Times of CacheInterfaceMock.GetRoles mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateRoleEndpoints.expectedInvocations, n)
-	mmCreateRoleEndpoints.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateRoleEndpoints
+	mm_atomic.StoreUint64(&mmGetRoles.expectedInvocations, n)
+	mmGetRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRoles
 }
 
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) invocationsDone() bool {
-	if len(mmCreateRoleEndpoints.expectations) == 0 && mmCreateRoleEndpoints.defaultExpectation == nil && mmCreateRoleEndpoints.mock.funcCreateRoleEndpoints == nil {
+func (mmGetRoles *mCacheInterfaceMockGetRoles) invocationsDone() bool {
+	if len(mmGetRoles.expectations) == 0 && mmGetRoles.defaultExpectation == nil && mmGetRoles.mock.funcGetRoles == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateRoleEndpoints.mock.afterCreateRoleEndpointsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRoleEndpoints.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRoles.mock.afterGetRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRoles.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateRoleEndpoints implements mm_repository.CacheInterface
-func (mmCreateRoleEndpoints *CacheInterfaceMock) CreateRoleEndpoints(ctx context.Context, isAdmin bool, endpoints []string) (err error) {
-	mm_atomic.AddUint64(&mmCreateRoleEndpoints.beforeCreateRoleEndpointsCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateRoleEndpoints.afterCreateRoleEndpointsCounter, 1)
+// GetRoles implements mm_repository.CacheInterface
+func (mmGetRoles *CacheInterfaceMock) GetRoles(ctx context.Context, username string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmGetRoles.beforeGetRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRoles.afterGetRolesCounter, 1)
 
-	mmCreateRoleEndpoints.t.Helper()
+	mmGetRoles.t.Helper()
 
-	if mmCreateRoleEndpoints.inspectFuncCreateRoleEndpoints != nil {
-		mmCreateRoleEndpoints.inspectFuncCreateRoleEndpoints(ctx, isAdmin, endpoints)
+	if mmGetRoles.inspectFuncGetRoles != nil {
+		mmGetRoles.inspectFuncGetRoles(ctx, username)
 	}
 
-	mm_params := CacheInterfaceMockCreateRoleEndpointsParams{ctx, isAdmin, endpoints}
+	mm_params := CacheInterfaceMockGetRolesParams{ctx, username}
 
 	// Record call args
-	mmCreateRoleEndpoints.CreateRoleEndpointsMock.mutex.Lock()
-	mmCreateRoleEndpoints.CreateRoleEndpointsMock.callArgs = append(mmCreateRoleEndpoints.CreateRoleEndpointsMock.callArgs, &mm_params)
-	mmCreateRoleEndpoints.CreateRoleEndpointsMock.mutex.Unlock()
+	mmGetRoles.GetRolesMock.mutex.Lock()
+	mmGetRoles.GetRolesMock.callArgs = append(mmGetRoles.GetRolesMock.callArgs, &mm_params)
+	mmGetRoles.GetRolesMock.mutex.Unlock()
 
-	for _, e := range mmCreateRoleEndpoints.CreateRoleEndpointsMock.expectations {
+	for _, e := range mmGetRoles.GetRolesMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.sa1, e.results.err
 		}
 	}
 
-	if mmCreateRoleEndpoints.CreateRoleEndpointsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateRoleEndpoints.CreateRoleEndpointsMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateRoleEndpoints.CreateRoleEndpointsMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateRoleEndpoints.CreateRoleEndpointsMock.defaultExpectation.paramPtrs
+	if mmGetRoles.GetRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRoles.GetRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRoles.GetRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRoles.GetRolesMock.defaultExpectation.paramPtrs
 
-		mm_got := CacheInterfaceMockCreateRoleEndpointsParams{ctx, isAdmin, endpoints}
+		mm_got := CacheInterfaceMockGetRolesParams{ctx, username}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateRoleEndpoints.t.Errorf("CacheInterfaceMock.CreateRoleEndpoints got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateRoleEndpoints.CreateRoleEndpointsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.isAdmin != nil && !minimock.Equal(*mm_want_ptrs.isAdmin, mm_got.isAdmin) {
-				mmCreateRoleEndpoints.t.Errorf("CacheInterfaceMock.CreateRoleEndpoints got unexpected parameter isAdmin, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateRoleEndpoints.CreateRoleEndpointsMock.defaultExpectation.expectationOrigins.originIsAdmin, *mm_want_ptrs.isAdmin, mm_got.isAdmin, minimock.Diff(*mm_want_ptrs.isAdmin, mm_got.isAdmin))
+				mmGetRoles.t.Errorf("CacheInterfaceMock.GetRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRoles.GetRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.endpoints != nil && !minimock.Equal(*mm_want_ptrs.endpoints, mm_got.endpoints) {
-				mmCreateRoleEndpoints.t.Errorf("CacheInterfaceMock.CreateRoleEndpoints got unexpected parameter endpoints, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateRoleEndpoints.CreateRoleEndpointsMock.defaultExpectation.expectationOrigins.originEndpoints, *mm_want_ptrs.endpoints, mm_got.endpoints, minimock.Diff(*mm_want_ptrs.endpoints, mm_got.endpoints))
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetRoles.t.Errorf("CacheInterfaceMock.GetRoles got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRoles.GetRolesMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateRoleEndpoints.t.Errorf("CacheInterfaceMock.CreateRoleEndpoints got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateRoleEndpoints.CreateRoleEndpointsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetRoles.t.Errorf("CacheInterfaceMock.GetRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRoles.GetRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateRoleEndpoints.CreateRoleEndpointsMock.defaultExpectation.results
+		mm_results := mmGetRoles.GetRolesMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateRoleEndpoints.t.Fatal("No results are set for the CacheInterfaceMock.CreateRoleEndpoints")
+			mmGetRoles.t.Fatal("No results are set for the CacheInterfaceMock.GetRoles")
 		}
-		return (*mm_results).err
+		return (*mm_results).sa1, (*mm_results).err
 	}
-	if mmCreateRoleEndpoints.funcCreateRoleEndpoints != nil {
-		return mmCreateRoleEndpoints.funcCreateRoleEndpoints(ctx, isAdmin, endpoints)
+	if mmGetRoles.funcGetRoles != nil {
+		return mmGetRoles.funcGetRoles(ctx, username)
 	}
-	mmCreateRoleEndpoints.t.Fatalf("Unexpected call to CacheInterfaceMock.CreateRoleEndpoints. %v %v %v", ctx, isAdmin, endpoints)
+	mmGetRoles.t.Fatalf("Unexpected call to CacheInterfaceMock.GetRoles. %v %v", ctx, username)
 	return
 }
 
-// CreateRoleEndpointsAfterCounter returns a count of finished CacheInterfaceMock.CreateRoleEndpoints invocations
-func (mmCreateRoleEndpoints *CacheInterfaceMock) CreateRoleEndpointsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateRoleEndpoints.afterCreateRoleEndpointsCounter)
+// GetRolesAfterCounter returns a count of finished CacheInterfaceMock.GetRoles invocations
+func (mmGetRoles *CacheInterfaceMock) GetRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRoles.afterGetRolesCounter)
 }
 
-// CreateRoleEndpointsBeforeCounter returns a count of CacheInterfaceMock.CreateRoleEndpoints invocations
-func (mmCreateRoleEndpoints *CacheInterfaceMock) CreateRoleEndpointsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateRoleEndpoints.beforeCreateRoleEndpointsCounter)
+// GetRolesBeforeCounter returns a count of CacheInterfaceMock.GetRoles invocations
+func (mmGetRoles *CacheInterfaceMock) GetRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRoles.beforeGetRolesCounter)
 }
 
-// Calls returns a list of arguments used in each call to CacheInterfaceMock.CreateRoleEndpoints.
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.GetRoles.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateRoleEndpoints *mCacheInterfaceMockCreateRoleEndpoints) Calls() []*CacheInterfaceMockCreateRoleEndpointsParams {
-	mmCreateRoleEndpoints.mutex.RLock()
+func (mmGetRoles *mCacheInterfaceMockGetRoles) Calls() []*CacheInterfaceMockGetRolesParams {
+	mmGetRoles.mutex.RLock()
 
-	argCopy := make([]*CacheInterfaceMockCreateRoleEndpointsParams, len(mmCreateRoleEndpoints.callArgs))
-	copy(argCopy, mmCreateRoleEndpoints.callArgs)
+	argCopy := make([]*CacheInterfaceMockGetRolesParams, len(mmGetRoles.callArgs))
+	copy(argCopy, mmGetRoles.callArgs)
 
-	mmCreateRoleEndpoints.mutex.RUnlock()
+	mmGetRoles.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateRoleEndpointsDone returns true if the count of the CreateRoleEndpoints invocations corresponds
+// MinimockGetRolesDone returns true if the count of the GetRoles invocations corresponds
 // the number of defined expectations
-func (m *CacheInterfaceMock) MinimockCreateRoleEndpointsDone() bool {
-	if m.CreateRoleEndpointsMock.optional {
+func (m *CacheInterfaceMock) MinimockGetRolesDone() bool {
+	if m.GetRolesMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateRoleEndpointsMock.expectations {
+	for _, e := range m.GetRolesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateRoleEndpointsMock.invocationsDone()
+	return m.GetRolesMock.invocationsDone()
 }
 
-// MinimockCreateRoleEndpointsInspect logs each unmet expectation
-func (m *CacheInterfaceMock) MinimockCreateRoleEndpointsInspect() {
-	for _, e := range m.CreateRoleEndpointsMock.expectations {
+// MinimockGetRolesInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockGetRolesInspect() {
+	for _, e := range m.GetRolesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRoleEndpoints at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CacheInterfaceMock.GetRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateRoleEndpointsCounter := mm_atomic.LoadUint64(&m.afterCreateRoleEndpointsCounter)
+	afterGetRolesCounter := mm_atomic.LoadUint64(&m.afterGetRolesCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateRoleEndpointsMock.defaultExpectation != nil && afterCreateRoleEndpointsCounter < 1 {
-		if m.CreateRoleEndpointsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRoleEndpoints at\n%s", m.CreateRoleEndpointsMock.defaultExpectation.returnOrigin)
+	if m.GetRolesMock.defaultExpectation != nil && afterGetRolesCounter < 1 {
+		if m.GetRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.GetRoles at\n%s", m.GetRolesMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CacheInterfaceMock.CreateRoleEndpoints at\n%s with params: %#v", m.CreateRoleEndpointsMock.defaultExpectation.expectationOrigins.origin, *m.CreateRoleEndpointsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CacheInterfaceMock.GetRoles at\n%s with params: %#v", m.GetRolesMock.defaultExpectation.expectationOrigins.origin, *m.GetRolesMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateRoleEndpoints != nil && afterCreateRoleEndpointsCounter < 1 {
-		m.t.Errorf("Expected call to CacheInterfaceMock.CreateRoleEndpoints at\n%s", m.funcCreateRoleEndpointsOrigin)
+	if m.funcGetRoles != nil && afterGetRolesCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.GetRoles at\n%s", m.funcGetRolesOrigin)
 	}
 
-	if !m.CreateRoleEndpointsMock.invocationsDone() && afterCreateRoleEndpointsCounter > 0 {
-		m.t.Errorf("Expected %d calls to CacheInterfaceMock.CreateRoleEndpoints at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateRoleEndpointsMock.expectedInvocations), m.CreateRoleEndpointsMock.expectedInvocationsOrigin, afterCreateRoleEndpointsCounter)
+	if !m.GetRolesMock.invocationsDone() && afterGetRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.GetRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRolesMock.expectedInvocations), m.GetRolesMock.expectedInvocationsOrigin, afterGetRolesCounter)
 	}
 }
 
-type mCacheInterfaceMockGet struct {
+type mCacheInterfaceMockIncLoginFailures struct {
 	optional           bool
 	mock               *CacheInterfaceMock
-	defaultExpectation *CacheInterfaceMockGetExpectation
-	expectations       []*CacheInterfaceMockGetExpectation
+	defaultExpectation *CacheInterfaceMockIncLoginFailuresExpectation
+	expectations       []*CacheInterfaceMockIncLoginFailuresExpectation
 
-	callArgs []*CacheInterfaceMockGetParams
+	callArgs []*CacheInterfaceMockIncLoginFailuresParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CacheInterfaceMockGetExpectation specifies expectation struct of the CacheInterface.Get
-type CacheInterfaceMockGetExpectation struct {
+// CacheInterfaceMockIncLoginFailuresExpectation specifies expectation struct of the CacheInterface.IncLoginFailures
+type CacheInterfaceMockIncLoginFailuresExpectation struct {
 	mock               *CacheInterfaceMock
-	params             *CacheInterfaceMockGetParams
-	paramPtrs          *CacheInterfaceMockGetParamPtrs
-	expectationOrigins CacheInterfaceMockGetExpectationOrigins
-	results            *CacheInterfaceMockGetResults
+	params             *CacheInterfaceMockIncLoginFailuresParams
+	paramPtrs          *CacheInterfaceMockIncLoginFailuresParamPtrs
+	expectationOrigins CacheInterfaceMockIncLoginFailuresExpectationOrigins
+	results            *CacheInterfaceMockIncLoginFailuresResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CacheInterfaceMockGetParams contains parameters of the CacheInterface.Get
-type CacheInterfaceMockGetParams struct {
-	ctx context.Context
-	id  int64
+// CacheInterfaceMockIncLoginFailuresParams contains parameters of the CacheInterface.IncLoginFailures
+type CacheInterfaceMockIncLoginFailuresParams struct {
+	ctx     context.Context
+	subject string
+	window  time.Duration
 }
 
-// CacheInterfaceMockGetParamPtrs contains pointers to parameters of the CacheInterface.Get
-type CacheInterfaceMockGetParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+// CacheInterfaceMockIncLoginFailuresParamPtrs contains pointers to parameters of the CacheInterface.IncLoginFailures
+type CacheInterfaceMockIncLoginFailuresParamPtrs struct {
+	ctx     *context.Context
+	subject *string
+	window  *time.Duration
 }
 
-// CacheInterfaceMockGetResults contains results of the CacheInterface.Get
-type CacheInterfaceMockGetResults struct {
-	up1 *model.User
+// CacheInterfaceMockIncLoginFailuresResults contains results of the CacheInterface.IncLoginFailures
+type CacheInterfaceMockIncLoginFailuresResults struct {
+	i1  int64
 	err error
 }
 
-// CacheInterfaceMockGetOrigins contains origins of expectations of the CacheInterface.Get
-type CacheInterfaceMockGetExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// CacheInterfaceMockIncLoginFailuresOrigins contains origins of expectations of the CacheInterface.IncLoginFailures
+type CacheInterfaceMockIncLoginFailuresExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originSubject string
+	originWindow  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1266,342 +4442,369 @@ type CacheInterfaceMockGetExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGet *mCacheInterfaceMockGet) Optional() *mCacheInterfaceMockGet {
-	mmGet.optional = true
-	return mmGet
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) Optional() *mCacheInterfaceMockIncLoginFailures {
+	mmIncLoginFailures.optional = true
+	return mmIncLoginFailures
 }
 
-// Expect sets up expected params for CacheInterface.Get
-func (mmGet *mCacheInterfaceMockGet) Expect(ctx context.Context, id int64) *mCacheInterfaceMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Set")
+// Expect sets up expected params for CacheInterface.IncLoginFailures
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) Expect(ctx context.Context, subject string, window time.Duration) *mCacheInterfaceMockIncLoginFailures {
+	if mmIncLoginFailures.mock.funcIncLoginFailures != nil {
+		mmIncLoginFailures.mock.t.Fatalf("CacheInterfaceMock.IncLoginFailures mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &CacheInterfaceMockGetExpectation{}
+	if mmIncLoginFailures.defaultExpectation == nil {
+		mmIncLoginFailures.defaultExpectation = &CacheInterfaceMockIncLoginFailuresExpectation{}
 	}
 
-	if mmGet.defaultExpectation.paramPtrs != nil {
-		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by ExpectParams functions")
+	if mmIncLoginFailures.defaultExpectation.paramPtrs != nil {
+		mmIncLoginFailures.mock.t.Fatalf("CacheInterfaceMock.IncLoginFailures mock is already set by ExpectParams functions")
 	}
 
-	mmGet.defaultExpectation.params = &CacheInterfaceMockGetParams{ctx, id}
-	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGet.expectations {
-		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
-			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+	mmIncLoginFailures.defaultExpectation.params = &CacheInterfaceMockIncLoginFailuresParams{ctx, subject, window}
+	mmIncLoginFailures.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIncLoginFailures.expectations {
+		if minimock.Equal(e.params, mmIncLoginFailures.defaultExpectation.params) {
+			mmIncLoginFailures.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIncLoginFailures.defaultExpectation.params)
 		}
 	}
 
-	return mmGet
+	return mmIncLoginFailures
 }
 
-// ExpectCtxParam1 sets up expected param ctx for CacheInterface.Get
-func (mmGet *mCacheInterfaceMockGet) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.IncLoginFailures
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockIncLoginFailures {
+	if mmIncLoginFailures.mock.funcIncLoginFailures != nil {
+		mmIncLoginFailures.mock.t.Fatalf("CacheInterfaceMock.IncLoginFailures mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &CacheInterfaceMockGetExpectation{}
+	if mmIncLoginFailures.defaultExpectation == nil {
+		mmIncLoginFailures.defaultExpectation = &CacheInterfaceMockIncLoginFailuresExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Expect")
+	if mmIncLoginFailures.defaultExpectation.params != nil {
+		mmIncLoginFailures.mock.t.Fatalf("CacheInterfaceMock.IncLoginFailures mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &CacheInterfaceMockGetParamPtrs{}
+	if mmIncLoginFailures.defaultExpectation.paramPtrs == nil {
+		mmIncLoginFailures.defaultExpectation.paramPtrs = &CacheInterfaceMockIncLoginFailuresParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmIncLoginFailures.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIncLoginFailures.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmIncLoginFailures
 }
 
-// ExpectIdParam2 sets up expected param id for CacheInterface.Get
-func (mmGet *mCacheInterfaceMockGet) ExpectIdParam2(id int64) *mCacheInterfaceMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Set")
+// ExpectSubjectParam2 sets up expected param subject for CacheInterface.IncLoginFailures
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) ExpectSubjectParam2(subject string) *mCacheInterfaceMockIncLoginFailures {
+	if mmIncLoginFailures.mock.funcIncLoginFailures != nil {
+		mmIncLoginFailures.mock.t.Fatalf("CacheInterfaceMock.IncLoginFailures mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &CacheInterfaceMockGetExpectation{}
+	if mmIncLoginFailures.defaultExpectation == nil {
+		mmIncLoginFailures.defaultExpectation = &CacheInterfaceMockIncLoginFailuresExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Expect")
+	if mmIncLoginFailures.defaultExpectation.params != nil {
+		mmIncLoginFailures.mock.t.Fatalf("CacheInterfaceMock.IncLoginFailures mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &CacheInterfaceMockGetParamPtrs{}
+	if mmIncLoginFailures.defaultExpectation.paramPtrs == nil {
+		mmIncLoginFailures.defaultExpectation.paramPtrs = &CacheInterfaceMockIncLoginFailuresParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.id = &id
-	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmIncLoginFailures.defaultExpectation.paramPtrs.subject = &subject
+	mmIncLoginFailures.defaultExpectation.expectationOrigins.originSubject = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmIncLoginFailures
 }
 
-// Inspect accepts an inspector function that has same arguments as the CacheInterface.Get
-func (mmGet *mCacheInterfaceMockGet) Inspect(f func(ctx context.Context, id int64)) *mCacheInterfaceMockGet {
-	if mmGet.mock.inspectFuncGet != nil {
-		mmGet.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.Get")
+// ExpectWindowParam3 sets up expected param window for CacheInterface.IncLoginFailures
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) ExpectWindowParam3(window time.Duration) *mCacheInterfaceMockIncLoginFailures {
+	if mmIncLoginFailures.mock.funcIncLoginFailures != nil {
+		mmIncLoginFailures.mock.t.Fatalf("CacheInterfaceMock.IncLoginFailures mock is already set by Set")
 	}
 
-	mmGet.mock.inspectFuncGet = f
+	if mmIncLoginFailures.defaultExpectation == nil {
+		mmIncLoginFailures.defaultExpectation = &CacheInterfaceMockIncLoginFailuresExpectation{}
+	}
 
-	return mmGet
+	if mmIncLoginFailures.defaultExpectation.params != nil {
+		mmIncLoginFailures.mock.t.Fatalf("CacheInterfaceMock.IncLoginFailures mock is already set by Expect")
+	}
+
+	if mmIncLoginFailures.defaultExpectation.paramPtrs == nil {
+		mmIncLoginFailures.defaultExpectation.paramPtrs = &CacheInterfaceMockIncLoginFailuresParamPtrs{}
+	}
+	mmIncLoginFailures.defaultExpectation.paramPtrs.window = &window
+	mmIncLoginFailures.defaultExpectation.expectationOrigins.originWindow = minimock.CallerInfo(1)
+
+	return mmIncLoginFailures
 }
 
-// Return sets up results that will be returned by CacheInterface.Get
-func (mmGet *mCacheInterfaceMockGet) Return(up1 *model.User, err error) *CacheInterfaceMock {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.IncLoginFailures
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) Inspect(f func(ctx context.Context, subject string, window time.Duration)) *mCacheInterfaceMockIncLoginFailures {
+	if mmIncLoginFailures.mock.inspectFuncIncLoginFailures != nil {
+		mmIncLoginFailures.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.IncLoginFailures")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &CacheInterfaceMockGetExpectation{mock: mmGet.mock}
+	mmIncLoginFailures.mock.inspectFuncIncLoginFailures = f
+
+	return mmIncLoginFailures
+}
+
+// Return sets up results that will be returned by CacheInterface.IncLoginFailures
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) Return(i1 int64, err error) *CacheInterfaceMock {
+	if mmIncLoginFailures.mock.funcIncLoginFailures != nil {
+		mmIncLoginFailures.mock.t.Fatalf("CacheInterfaceMock.IncLoginFailures mock is already set by Set")
 	}
-	mmGet.defaultExpectation.results = &CacheInterfaceMockGetResults{up1, err}
-	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+
+	if mmIncLoginFailures.defaultExpectation == nil {
+		mmIncLoginFailures.defaultExpectation = &CacheInterfaceMockIncLoginFailuresExpectation{mock: mmIncLoginFailures.mock}
+	}
+	mmIncLoginFailures.defaultExpectation.results = &CacheInterfaceMockIncLoginFailuresResults{i1, err}
+	mmIncLoginFailures.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIncLoginFailures.mock
 }
 
-// Set uses given function f to mock the CacheInterface.Get method
-func (mmGet *mCacheInterfaceMockGet) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *CacheInterfaceMock {
-	if mmGet.defaultExpectation != nil {
-		mmGet.mock.t.Fatalf("Default expectation is already set for the CacheInterface.Get method")
+// Set uses given function f to mock the CacheInterface.IncLoginFailures method
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) Set(f func(ctx context.Context, subject string, window time.Duration) (i1 int64, err error)) *CacheInterfaceMock {
+	if mmIncLoginFailures.defaultExpectation != nil {
+		mmIncLoginFailures.mock.t.Fatalf("Default expectation is already set for the CacheInterface.IncLoginFailures method")
 	}
 
-	if len(mmGet.expectations) > 0 {
-		mmGet.mock.t.Fatalf("Some expectations are already set for the CacheInterface.Get method")
+	if len(mmIncLoginFailures.expectations) > 0 {
+		mmIncLoginFailures.mock.t.Fatalf("Some expectations are already set for the CacheInterface.IncLoginFailures method")
 	}
 
-	mmGet.mock.funcGet = f
-	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+	mmIncLoginFailures.mock.funcIncLoginFailures = f
+	mmIncLoginFailures.mock.funcIncLoginFailuresOrigin = minimock.CallerInfo(1)
+	return mmIncLoginFailures.mock
 }
 
-// When sets expectation for the CacheInterface.Get which will trigger the result defined by the following
+// When sets expectation for the CacheInterface.IncLoginFailures which will trigger the result defined by the following
 // Then helper
-func (mmGet *mCacheInterfaceMockGet) When(ctx context.Context, id int64) *CacheInterfaceMockGetExpectation {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("CacheInterfaceMock.Get mock is already set by Set")
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) When(ctx context.Context, subject string, window time.Duration) *CacheInterfaceMockIncLoginFailuresExpectation {
+	if mmIncLoginFailures.mock.funcIncLoginFailures != nil {
+		mmIncLoginFailures.mock.t.Fatalf("CacheInterfaceMock.IncLoginFailures mock is already set by Set")
 	}
 
-	expectation := &CacheInterfaceMockGetExpectation{
-		mock:               mmGet.mock,
-		params:             &CacheInterfaceMockGetParams{ctx, id},
-		expectationOrigins: CacheInterfaceMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CacheInterfaceMockIncLoginFailuresExpectation{
+		mock:               mmIncLoginFailures.mock,
+		params:             &CacheInterfaceMockIncLoginFailuresParams{ctx, subject, window},
+		expectationOrigins: CacheInterfaceMockIncLoginFailuresExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGet.expectations = append(mmGet.expectations, expectation)
+	mmIncLoginFailures.expectations = append(mmIncLoginFailures.expectations, expectation)
 	return expectation
 }
 
-// Then sets up CacheInterface.Get return parameters for the expectation previously defined by the When method
-func (e *CacheInterfaceMockGetExpectation) Then(up1 *model.User, err error) *CacheInterfaceMock {
-	e.results = &CacheInterfaceMockGetResults{up1, err}
+// Then sets up CacheInterface.IncLoginFailures return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockIncLoginFailuresExpectation) Then(i1 int64, err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockIncLoginFailuresResults{i1, err}
 	return e.mock
 }
 
-// Times sets number of times CacheInterface.Get should be invoked
-func (mmGet *mCacheInterfaceMockGet) Times(n uint64) *mCacheInterfaceMockGet {
+// Times sets number of times CacheInterface.IncLoginFailures should be invoked
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) Times(n uint64) *mCacheInterfaceMockIncLoginFailures {
 	if n == 0 {
-		mmGet.mock.t.Fatalf("Times of CacheInterfaceMock.Get mock can not be zero")
+		mmIncLoginFailures.mock.t.Fatalf("Times of CacheInterfaceMock.IncLoginFailures mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
-	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGet
+	mm_atomic.StoreUint64(&mmIncLoginFailures.expectedInvocations, n)
+	mmIncLoginFailures.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIncLoginFailures
 }
 
-func (mmGet *mCacheInterfaceMockGet) invocationsDone() bool {
-	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) invocationsDone() bool {
+	if len(mmIncLoginFailures.expectations) == 0 && mmIncLoginFailures.defaultExpectation == nil && mmIncLoginFailures.mock.funcIncLoginFailures == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmIncLoginFailures.mock.afterIncLoginFailuresCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIncLoginFailures.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Get implements mm_repository.CacheInterface
-func (mmGet *CacheInterfaceMock) Get(ctx context.Context, id int64) (up1 *model.User, err error) {
-	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
-	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+// IncLoginFailures implements mm_repository.CacheInterface
+func (mmIncLoginFailures *CacheInterfaceMock) IncLoginFailures(ctx context.Context, subject string, window time.Duration) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmIncLoginFailures.beforeIncLoginFailuresCounter, 1)
+	defer mm_atomic.AddUint64(&mmIncLoginFailures.afterIncLoginFailuresCounter, 1)
 
-	mmGet.t.Helper()
+	mmIncLoginFailures.t.Helper()
 
-	if mmGet.inspectFuncGet != nil {
-		mmGet.inspectFuncGet(ctx, id)
+	if mmIncLoginFailures.inspectFuncIncLoginFailures != nil {
+		mmIncLoginFailures.inspectFuncIncLoginFailures(ctx, subject, window)
 	}
 
-	mm_params := CacheInterfaceMockGetParams{ctx, id}
+	mm_params := CacheInterfaceMockIncLoginFailuresParams{ctx, subject, window}
 
 	// Record call args
-	mmGet.GetMock.mutex.Lock()
-	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
-	mmGet.GetMock.mutex.Unlock()
+	mmIncLoginFailures.IncLoginFailuresMock.mutex.Lock()
+	mmIncLoginFailures.IncLoginFailuresMock.callArgs = append(mmIncLoginFailures.IncLoginFailuresMock.callArgs, &mm_params)
+	mmIncLoginFailures.IncLoginFailuresMock.mutex.Unlock()
 
-	for _, e := range mmGet.GetMock.expectations {
+	for _, e := range mmIncLoginFailures.IncLoginFailuresMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
-	if mmGet.GetMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
-		mm_want := mmGet.GetMock.defaultExpectation.params
-		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+	if mmIncLoginFailures.IncLoginFailuresMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIncLoginFailures.IncLoginFailuresMock.defaultExpectation.Counter, 1)
+		mm_want := mmIncLoginFailures.IncLoginFailuresMock.defaultExpectation.params
+		mm_want_ptrs := mmIncLoginFailures.IncLoginFailuresMock.defaultExpectation.paramPtrs
 
-		mm_got := CacheInterfaceMockGetParams{ctx, id}
+		mm_got := CacheInterfaceMockIncLoginFailuresParams{ctx, subject, window}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGet.t.Errorf("CacheInterfaceMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmIncLoginFailures.t.Errorf("CacheInterfaceMock.IncLoginFailures got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncLoginFailures.IncLoginFailuresMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGet.t.Errorf("CacheInterfaceMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmIncLoginFailures.t.Errorf("CacheInterfaceMock.IncLoginFailures got unexpected parameter subject, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncLoginFailures.IncLoginFailuresMock.defaultExpectation.expectationOrigins.originSubject, *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+			if mm_want_ptrs.window != nil && !minimock.Equal(*mm_want_ptrs.window, mm_got.window) {
+				mmIncLoginFailures.t.Errorf("CacheInterfaceMock.IncLoginFailures got unexpected parameter window, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncLoginFailures.IncLoginFailuresMock.defaultExpectation.expectationOrigins.originWindow, *mm_want_ptrs.window, mm_got.window, minimock.Diff(*mm_want_ptrs.window, mm_got.window))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGet.t.Errorf("CacheInterfaceMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmIncLoginFailures.t.Errorf("CacheInterfaceMock.IncLoginFailures got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIncLoginFailures.IncLoginFailuresMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGet.GetMock.defaultExpectation.results
+		mm_results := mmIncLoginFailures.IncLoginFailuresMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGet.t.Fatal("No results are set for the CacheInterfaceMock.Get")
+			mmIncLoginFailures.t.Fatal("No results are set for the CacheInterfaceMock.IncLoginFailures")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmGet.funcGet != nil {
-		return mmGet.funcGet(ctx, id)
+	if mmIncLoginFailures.funcIncLoginFailures != nil {
+		return mmIncLoginFailures.funcIncLoginFailures(ctx, subject, window)
 	}
-	mmGet.t.Fatalf("Unexpected call to CacheInterfaceMock.Get. %v %v", ctx, id)
+	mmIncLoginFailures.t.Fatalf("Unexpected call to CacheInterfaceMock.IncLoginFailures. %v %v %v", ctx, subject, window)
 	return
 }
 
-// GetAfterCounter returns a count of finished CacheInterfaceMock.Get invocations
-func (mmGet *CacheInterfaceMock) GetAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+// IncLoginFailuresAfterCounter returns a count of finished CacheInterfaceMock.IncLoginFailures invocations
+func (mmIncLoginFailures *CacheInterfaceMock) IncLoginFailuresAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncLoginFailures.afterIncLoginFailuresCounter)
 }
 
-// GetBeforeCounter returns a count of CacheInterfaceMock.Get invocations
-func (mmGet *CacheInterfaceMock) GetBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+// IncLoginFailuresBeforeCounter returns a count of CacheInterfaceMock.IncLoginFailures invocations
+func (mmIncLoginFailures *CacheInterfaceMock) IncLoginFailuresBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncLoginFailures.beforeIncLoginFailuresCounter)
 }
 
-// Calls returns a list of arguments used in each call to CacheInterfaceMock.Get.
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.IncLoginFailures.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGet *mCacheInterfaceMockGet) Calls() []*CacheInterfaceMockGetParams {
-	mmGet.mutex.RLock()
+func (mmIncLoginFailures *mCacheInterfaceMockIncLoginFailures) Calls() []*CacheInterfaceMockIncLoginFailuresParams {
+	mmIncLoginFailures.mutex.RLock()
 
-	argCopy := make([]*CacheInterfaceMockGetParams, len(mmGet.callArgs))
-	copy(argCopy, mmGet.callArgs)
+	argCopy := make([]*CacheInterfaceMockIncLoginFailuresParams, len(mmIncLoginFailures.callArgs))
+	copy(argCopy, mmIncLoginFailures.callArgs)
 
-	mmGet.mutex.RUnlock()
+	mmIncLoginFailures.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetDone returns true if the count of the Get invocations corresponds
+// MinimockIncLoginFailuresDone returns true if the count of the IncLoginFailures invocations corresponds
 // the number of defined expectations
-func (m *CacheInterfaceMock) MinimockGetDone() bool {
-	if m.GetMock.optional {
+func (m *CacheInterfaceMock) MinimockIncLoginFailuresDone() bool {
+	if m.IncLoginFailuresMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetMock.expectations {
+	for _, e := range m.IncLoginFailuresMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetMock.invocationsDone()
+	return m.IncLoginFailuresMock.invocationsDone()
 }
 
-// MinimockGetInspect logs each unmet expectation
-func (m *CacheInterfaceMock) MinimockGetInspect() {
-	for _, e := range m.GetMock.expectations {
+// MinimockIncLoginFailuresInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockIncLoginFailuresInspect() {
+	for _, e := range m.IncLoginFailuresMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CacheInterfaceMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CacheInterfaceMock.IncLoginFailures at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	afterIncLoginFailuresCounter := mm_atomic.LoadUint64(&m.afterIncLoginFailuresCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
-		if m.GetMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CacheInterfaceMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+	if m.IncLoginFailuresMock.defaultExpectation != nil && afterIncLoginFailuresCounter < 1 {
+		if m.IncLoginFailuresMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.IncLoginFailures at\n%s", m.IncLoginFailuresMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CacheInterfaceMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CacheInterfaceMock.IncLoginFailures at\n%s with params: %#v", m.IncLoginFailuresMock.defaultExpectation.expectationOrigins.origin, *m.IncLoginFailuresMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGet != nil && afterGetCounter < 1 {
-		m.t.Errorf("Expected call to CacheInterfaceMock.Get at\n%s", m.funcGetOrigin)
+	if m.funcIncLoginFailures != nil && afterIncLoginFailuresCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.IncLoginFailures at\n%s", m.funcIncLoginFailuresOrigin)
 	}
 
-	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
-		m.t.Errorf("Expected %d calls to CacheInterfaceMock.Get at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	if !m.IncLoginFailuresMock.invocationsDone() && afterIncLoginFailuresCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.IncLoginFailures at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IncLoginFailuresMock.expectedInvocations), m.IncLoginFailuresMock.expectedInvocationsOrigin, afterIncLoginFailuresCounter)
 	}
 }
 
-type mCacheInterfaceMockGetRole struct {
+type mCacheInterfaceMockInvalidate struct {
 	optional           bool
 	mock               *CacheInterfaceMock
-	defaultExpectation *CacheInterfaceMockGetRoleExpectation
-	expectations       []*CacheInterfaceMockGetRoleExpectation
+	defaultExpectation *CacheInterfaceMockInvalidateExpectation
+	expectations       []*CacheInterfaceMockInvalidateExpectation
 
-	callArgs []*CacheInterfaceMockGetRoleParams
+	callArgs []*CacheInterfaceMockInvalidateParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CacheInterfaceMockGetRoleExpectation specifies expectation struct of the CacheInterface.GetRole
-type CacheInterfaceMockGetRoleExpectation struct {
+// CacheInterfaceMockInvalidateExpectation specifies expectation struct of the CacheInterface.Invalidate
+type CacheInterfaceMockInvalidateExpectation struct {
 	mock               *CacheInterfaceMock
-	params             *CacheInterfaceMockGetRoleParams
-	paramPtrs          *CacheInterfaceMockGetRoleParamPtrs
-	expectationOrigins CacheInterfaceMockGetRoleExpectationOrigins
-	results            *CacheInterfaceMockGetRoleResults
+	params             *CacheInterfaceMockInvalidateParams
+	paramPtrs          *CacheInterfaceMockInvalidateParamPtrs
+	expectationOrigins CacheInterfaceMockInvalidateExpectationOrigins
+	results            *CacheInterfaceMockInvalidateResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CacheInterfaceMockGetRoleParams contains parameters of the CacheInterface.GetRole
-type CacheInterfaceMockGetRoleParams struct {
-	ctx      context.Context
-	username string
+// CacheInterfaceMockInvalidateParams contains parameters of the CacheInterface.Invalidate
+type CacheInterfaceMockInvalidateParams struct {
+	ctx context.Context
+	inv model.CacheInvalidation
 }
 
-// CacheInterfaceMockGetRoleParamPtrs contains pointers to parameters of the CacheInterface.GetRole
-type CacheInterfaceMockGetRoleParamPtrs struct {
-	ctx      *context.Context
-	username *string
+// CacheInterfaceMockInvalidateParamPtrs contains pointers to parameters of the CacheInterface.Invalidate
+type CacheInterfaceMockInvalidateParamPtrs struct {
+	ctx *context.Context
+	inv *model.CacheInvalidation
 }
 
-// CacheInterfaceMockGetRoleResults contains results of the CacheInterface.GetRole
-type CacheInterfaceMockGetRoleResults struct {
-	bp1 *bool
+// CacheInterfaceMockInvalidateResults contains results of the CacheInterface.Invalidate
+type CacheInterfaceMockInvalidateResults struct {
 	err error
 }
 
-// CacheInterfaceMockGetRoleOrigins contains origins of expectations of the CacheInterface.GetRole
-type CacheInterfaceMockGetRoleExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originUsername string
+// CacheInterfaceMockInvalidateOrigins contains origins of expectations of the CacheInterface.Invalidate
+type CacheInterfaceMockInvalidateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originInv string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1609,342 +4812,341 @@ type CacheInterfaceMockGetRoleExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetRole *mCacheInterfaceMockGetRole) Optional() *mCacheInterfaceMockGetRole {
-	mmGetRole.optional = true
-	return mmGetRole
+func (mmInvalidate *mCacheInterfaceMockInvalidate) Optional() *mCacheInterfaceMockInvalidate {
+	mmInvalidate.optional = true
+	return mmInvalidate
 }
 
-// Expect sets up expected params for CacheInterface.GetRole
-func (mmGetRole *mCacheInterfaceMockGetRole) Expect(ctx context.Context, username string) *mCacheInterfaceMockGetRole {
-	if mmGetRole.mock.funcGetRole != nil {
-		mmGetRole.mock.t.Fatalf("CacheInterfaceMock.GetRole mock is already set by Set")
+// Expect sets up expected params for CacheInterface.Invalidate
+func (mmInvalidate *mCacheInterfaceMockInvalidate) Expect(ctx context.Context, inv model.CacheInvalidation) *mCacheInterfaceMockInvalidate {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("CacheInterfaceMock.Invalidate mock is already set by Set")
 	}
 
-	if mmGetRole.defaultExpectation == nil {
-		mmGetRole.defaultExpectation = &CacheInterfaceMockGetRoleExpectation{}
+	if mmInvalidate.defaultExpectation == nil {
+		mmInvalidate.defaultExpectation = &CacheInterfaceMockInvalidateExpectation{}
 	}
 
-	if mmGetRole.defaultExpectation.paramPtrs != nil {
-		mmGetRole.mock.t.Fatalf("CacheInterfaceMock.GetRole mock is already set by ExpectParams functions")
+	if mmInvalidate.defaultExpectation.paramPtrs != nil {
+		mmInvalidate.mock.t.Fatalf("CacheInterfaceMock.Invalidate mock is already set by ExpectParams functions")
 	}
 
-	mmGetRole.defaultExpectation.params = &CacheInterfaceMockGetRoleParams{ctx, username}
-	mmGetRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetRole.expectations {
-		if minimock.Equal(e.params, mmGetRole.defaultExpectation.params) {
-			mmGetRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRole.defaultExpectation.params)
+	mmInvalidate.defaultExpectation.params = &CacheInterfaceMockInvalidateParams{ctx, inv}
+	mmInvalidate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmInvalidate.expectations {
+		if minimock.Equal(e.params, mmInvalidate.defaultExpectation.params) {
+			mmInvalidate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInvalidate.defaultExpectation.params)
 		}
 	}
 
-	return mmGetRole
+	return mmInvalidate
 }
 
-// ExpectCtxParam1 sets up expected param ctx for CacheInterface.GetRole
-func (mmGetRole *mCacheInterfaceMockGetRole) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockGetRole {
-	if mmGetRole.mock.funcGetRole != nil {
-		mmGetRole.mock.t.Fatalf("CacheInterfaceMock.GetRole mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.Invalidate
+func (mmInvalidate *mCacheInterfaceMockInvalidate) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockInvalidate {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("CacheInterfaceMock.Invalidate mock is already set by Set")
 	}
 
-	if mmGetRole.defaultExpectation == nil {
-		mmGetRole.defaultExpectation = &CacheInterfaceMockGetRoleExpectation{}
+	if mmInvalidate.defaultExpectation == nil {
+		mmInvalidate.defaultExpectation = &CacheInterfaceMockInvalidateExpectation{}
 	}
 
-	if mmGetRole.defaultExpectation.params != nil {
-		mmGetRole.mock.t.Fatalf("CacheInterfaceMock.GetRole mock is already set by Expect")
+	if mmInvalidate.defaultExpectation.params != nil {
+		mmInvalidate.mock.t.Fatalf("CacheInterfaceMock.Invalidate mock is already set by Expect")
 	}
 
-	if mmGetRole.defaultExpectation.paramPtrs == nil {
-		mmGetRole.defaultExpectation.paramPtrs = &CacheInterfaceMockGetRoleParamPtrs{}
+	if mmInvalidate.defaultExpectation.paramPtrs == nil {
+		mmInvalidate.defaultExpectation.paramPtrs = &CacheInterfaceMockInvalidateParamPtrs{}
 	}
-	mmGetRole.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmInvalidate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmInvalidate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetRole
+	return mmInvalidate
 }
 
-// ExpectUsernameParam2 sets up expected param username for CacheInterface.GetRole
-func (mmGetRole *mCacheInterfaceMockGetRole) ExpectUsernameParam2(username string) *mCacheInterfaceMockGetRole {
-	if mmGetRole.mock.funcGetRole != nil {
-		mmGetRole.mock.t.Fatalf("CacheInterfaceMock.GetRole mock is already set by Set")
+// ExpectInvParam2 sets up expected param inv for CacheInterface.Invalidate
+func (mmInvalidate *mCacheInterfaceMockInvalidate) ExpectInvParam2(inv model.CacheInvalidation) *mCacheInterfaceMockInvalidate {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("CacheInterfaceMock.Invalidate mock is already set by Set")
 	}
 
-	if mmGetRole.defaultExpectation == nil {
-		mmGetRole.defaultExpectation = &CacheInterfaceMockGetRoleExpectation{}
+	if mmInvalidate.defaultExpectation == nil {
+		mmInvalidate.defaultExpectation = &CacheInterfaceMockInvalidateExpectation{}
 	}
 
-	if mmGetRole.defaultExpectation.params != nil {
-		mmGetRole.mock.t.Fatalf("CacheInterfaceMock.GetRole mock is already set by Expect")
+	if mmInvalidate.defaultExpectation.params != nil {
+		mmInvalidate.mock.t.Fatalf("CacheInterfaceMock.Invalidate mock is already set by Expect")
 	}
 
-	if mmGetRole.defaultExpectation.paramPtrs == nil {
-		mmGetRole.defaultExpectation.paramPtrs = &CacheInterfaceMockGetRoleParamPtrs{}
+	if mmInvalidate.defaultExpectation.paramPtrs == nil {
+		mmInvalidate.defaultExpectation.paramPtrs = &CacheInterfaceMockInvalidateParamPtrs{}
 	}
-	mmGetRole.defaultExpectation.paramPtrs.username = &username
-	mmGetRole.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+	mmInvalidate.defaultExpectation.paramPtrs.inv = &inv
+	mmInvalidate.defaultExpectation.expectationOrigins.originInv = minimock.CallerInfo(1)
 
-	return mmGetRole
+	return mmInvalidate
 }
 
-// Inspect accepts an inspector function that has same arguments as the CacheInterface.GetRole
-func (mmGetRole *mCacheInterfaceMockGetRole) Inspect(f func(ctx context.Context, username string)) *mCacheInterfaceMockGetRole {
-	if mmGetRole.mock.inspectFuncGetRole != nil {
-		mmGetRole.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.GetRole")
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.Invalidate
+func (mmInvalidate *mCacheInterfaceMockInvalidate) Inspect(f func(ctx context.Context, inv model.CacheInvalidation)) *mCacheInterfaceMockInvalidate {
+	if mmInvalidate.mock.inspectFuncInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.Invalidate")
 	}
 
-	mmGetRole.mock.inspectFuncGetRole = f
+	mmInvalidate.mock.inspectFuncInvalidate = f
 
-	return mmGetRole
+	return mmInvalidate
 }
 
-// Return sets up results that will be returned by CacheInterface.GetRole
-func (mmGetRole *mCacheInterfaceMockGetRole) Return(bp1 *bool, err error) *CacheInterfaceMock {
-	if mmGetRole.mock.funcGetRole != nil {
-		mmGetRole.mock.t.Fatalf("CacheInterfaceMock.GetRole mock is already set by Set")
+// Return sets up results that will be returned by CacheInterface.Invalidate
+func (mmInvalidate *mCacheInterfaceMockInvalidate) Return(err error) *CacheInterfaceMock {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("CacheInterfaceMock.Invalidate mock is already set by Set")
 	}
 
-	if mmGetRole.defaultExpectation == nil {
-		mmGetRole.defaultExpectation = &CacheInterfaceMockGetRoleExpectation{mock: mmGetRole.mock}
+	if mmInvalidate.defaultExpectation == nil {
+		mmInvalidate.defaultExpectation = &CacheInterfaceMockInvalidateExpectation{mock: mmInvalidate.mock}
 	}
-	mmGetRole.defaultExpectation.results = &CacheInterfaceMockGetRoleResults{bp1, err}
-	mmGetRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetRole.mock
+	mmInvalidate.defaultExpectation.results = &CacheInterfaceMockInvalidateResults{err}
+	mmInvalidate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmInvalidate.mock
 }
 
-// Set uses given function f to mock the CacheInterface.GetRole method
-func (mmGetRole *mCacheInterfaceMockGetRole) Set(f func(ctx context.Context, username string) (bp1 *bool, err error)) *CacheInterfaceMock {
-	if mmGetRole.defaultExpectation != nil {
-		mmGetRole.mock.t.Fatalf("Default expectation is already set for the CacheInterface.GetRole method")
+// Set uses given function f to mock the CacheInterface.Invalidate method
+func (mmInvalidate *mCacheInterfaceMockInvalidate) Set(f func(ctx context.Context, inv model.CacheInvalidation) (err error)) *CacheInterfaceMock {
+	if mmInvalidate.defaultExpectation != nil {
+		mmInvalidate.mock.t.Fatalf("Default expectation is already set for the CacheInterface.Invalidate method")
 	}
 
-	if len(mmGetRole.expectations) > 0 {
-		mmGetRole.mock.t.Fatalf("Some expectations are already set for the CacheInterface.GetRole method")
+	if len(mmInvalidate.expectations) > 0 {
+		mmInvalidate.mock.t.Fatalf("Some expectations are already set for the CacheInterface.Invalidate method")
 	}
 
-	mmGetRole.mock.funcGetRole = f
-	mmGetRole.mock.funcGetRoleOrigin = minimock.CallerInfo(1)
-	return mmGetRole.mock
+	mmInvalidate.mock.funcInvalidate = f
+	mmInvalidate.mock.funcInvalidateOrigin = minimock.CallerInfo(1)
+	return mmInvalidate.mock
 }
 
-// When sets expectation for the CacheInterface.GetRole which will trigger the result defined by the following
+// When sets expectation for the CacheInterface.Invalidate which will trigger the result defined by the following
 // Then helper
-func (mmGetRole *mCacheInterfaceMockGetRole) When(ctx context.Context, username string) *CacheInterfaceMockGetRoleExpectation {
-	if mmGetRole.mock.funcGetRole != nil {
-		mmGetRole.mock.t.Fatalf("CacheInterfaceMock.GetRole mock is already set by Set")
+func (mmInvalidate *mCacheInterfaceMockInvalidate) When(ctx context.Context, inv model.CacheInvalidation) *CacheInterfaceMockInvalidateExpectation {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("CacheInterfaceMock.Invalidate mock is already set by Set")
 	}
 
-	expectation := &CacheInterfaceMockGetRoleExpectation{
-		mock:               mmGetRole.mock,
-		params:             &CacheInterfaceMockGetRoleParams{ctx, username},
-		expectationOrigins: CacheInterfaceMockGetRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CacheInterfaceMockInvalidateExpectation{
+		mock:               mmInvalidate.mock,
+		params:             &CacheInterfaceMockInvalidateParams{ctx, inv},
+		expectationOrigins: CacheInterfaceMockInvalidateExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetRole.expectations = append(mmGetRole.expectations, expectation)
+	mmInvalidate.expectations = append(mmInvalidate.expectations, expectation)
 	return expectation
 }
 
-// Then sets up CacheInterface.GetRole return parameters for the expectation previously defined by the When method
-func (e *CacheInterfaceMockGetRoleExpectation) Then(bp1 *bool, err error) *CacheInterfaceMock {
-	e.results = &CacheInterfaceMockGetRoleResults{bp1, err}
+// Then sets up CacheInterface.Invalidate return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockInvalidateExpectation) Then(err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockInvalidateResults{err}
 	return e.mock
 }
 
-// Times sets number of times CacheInterface.GetRole should be invoked
-func (mmGetRole *mCacheInterfaceMockGetRole) Times(n uint64) *mCacheInterfaceMockGetRole {
+// Times sets number of times CacheInterface.Invalidate should be invoked
+func (mmInvalidate *mCacheInterfaceMockInvalidate) Times(n uint64) *mCacheInterfaceMockInvalidate {
 	if n == 0 {
-		mmGetRole.mock.t.Fatalf("Times of CacheInterfaceMock.GetRole mock can not be zero")
+		mmInvalidate.mock.t.Fatalf("Times of CacheInterfaceMock.Invalidate mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetRole.expectedInvocations, n)
-	mmGetRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetRole
+	mm_atomic.StoreUint64(&mmInvalidate.expectedInvocations, n)
+	mmInvalidate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmInvalidate
 }
 
-func (mmGetRole *mCacheInterfaceMockGetRole) invocationsDone() bool {
-	if len(mmGetRole.expectations) == 0 && mmGetRole.defaultExpectation == nil && mmGetRole.mock.funcGetRole == nil {
+func (mmInvalidate *mCacheInterfaceMockInvalidate) invocationsDone() bool {
+	if len(mmInvalidate.expectations) == 0 && mmInvalidate.defaultExpectation == nil && mmInvalidate.mock.funcInvalidate == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetRole.mock.afterGetRoleCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetRole.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmInvalidate.mock.afterInvalidateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmInvalidate.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetRole implements mm_repository.CacheInterface
-func (mmGetRole *CacheInterfaceMock) GetRole(ctx context.Context, username string) (bp1 *bool, err error) {
-	mm_atomic.AddUint64(&mmGetRole.beforeGetRoleCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetRole.afterGetRoleCounter, 1)
+// Invalidate implements mm_repository.CacheInterface
+func (mmInvalidate *CacheInterfaceMock) Invalidate(ctx context.Context, inv model.CacheInvalidation) (err error) {
+	mm_atomic.AddUint64(&mmInvalidate.beforeInvalidateCounter, 1)
+	defer mm_atomic.AddUint64(&mmInvalidate.afterInvalidateCounter, 1)
 
-	mmGetRole.t.Helper()
+	mmInvalidate.t.Helper()
 
-	if mmGetRole.inspectFuncGetRole != nil {
-		mmGetRole.inspectFuncGetRole(ctx, username)
+	if mmInvalidate.inspectFuncInvalidate != nil {
+		mmInvalidate.inspectFuncInvalidate(ctx, inv)
 	}
 
-	mm_params := CacheInterfaceMockGetRoleParams{ctx, username}
+	mm_params := CacheInterfaceMockInvalidateParams{ctx, inv}
 
 	// Record call args
-	mmGetRole.GetRoleMock.mutex.Lock()
-	mmGetRole.GetRoleMock.callArgs = append(mmGetRole.GetRoleMock.callArgs, &mm_params)
-	mmGetRole.GetRoleMock.mutex.Unlock()
+	mmInvalidate.InvalidateMock.mutex.Lock()
+	mmInvalidate.InvalidateMock.callArgs = append(mmInvalidate.InvalidateMock.callArgs, &mm_params)
+	mmInvalidate.InvalidateMock.mutex.Unlock()
 
-	for _, e := range mmGetRole.GetRoleMock.expectations {
+	for _, e := range mmInvalidate.InvalidateMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.bp1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetRole.GetRoleMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetRole.GetRoleMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetRole.GetRoleMock.defaultExpectation.params
-		mm_want_ptrs := mmGetRole.GetRoleMock.defaultExpectation.paramPtrs
+	if mmInvalidate.InvalidateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInvalidate.InvalidateMock.defaultExpectation.Counter, 1)
+		mm_want := mmInvalidate.InvalidateMock.defaultExpectation.params
+		mm_want_ptrs := mmInvalidate.InvalidateMock.defaultExpectation.paramPtrs
 
-		mm_got := CacheInterfaceMockGetRoleParams{ctx, username}
+		mm_got := CacheInterfaceMockInvalidateParams{ctx, inv}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetRole.t.Errorf("CacheInterfaceMock.GetRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetRole.GetRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmInvalidate.t.Errorf("CacheInterfaceMock.Invalidate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInvalidate.InvalidateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmGetRole.t.Errorf("CacheInterfaceMock.GetRole got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetRole.GetRoleMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			if mm_want_ptrs.inv != nil && !minimock.Equal(*mm_want_ptrs.inv, mm_got.inv) {
+				mmInvalidate.t.Errorf("CacheInterfaceMock.Invalidate got unexpected parameter inv, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInvalidate.InvalidateMock.defaultExpectation.expectationOrigins.originInv, *mm_want_ptrs.inv, mm_got.inv, minimock.Diff(*mm_want_ptrs.inv, mm_got.inv))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetRole.t.Errorf("CacheInterfaceMock.GetRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetRole.GetRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmInvalidate.t.Errorf("CacheInterfaceMock.Invalidate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmInvalidate.InvalidateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetRole.GetRoleMock.defaultExpectation.results
+		mm_results := mmInvalidate.InvalidateMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetRole.t.Fatal("No results are set for the CacheInterfaceMock.GetRole")
+			mmInvalidate.t.Fatal("No results are set for the CacheInterfaceMock.Invalidate")
 		}
-		return (*mm_results).bp1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetRole.funcGetRole != nil {
-		return mmGetRole.funcGetRole(ctx, username)
+	if mmInvalidate.funcInvalidate != nil {
+		return mmInvalidate.funcInvalidate(ctx, inv)
 	}
-	mmGetRole.t.Fatalf("Unexpected call to CacheInterfaceMock.GetRole. %v %v", ctx, username)
+	mmInvalidate.t.Fatalf("Unexpected call to CacheInterfaceMock.Invalidate. %v %v", ctx, inv)
 	return
 }
 
-// GetRoleAfterCounter returns a count of finished CacheInterfaceMock.GetRole invocations
-func (mmGetRole *CacheInterfaceMock) GetRoleAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetRole.afterGetRoleCounter)
+// InvalidateAfterCounter returns a count of finished CacheInterfaceMock.Invalidate invocations
+func (mmInvalidate *CacheInterfaceMock) InvalidateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInvalidate.afterInvalidateCounter)
 }
 
-// GetRoleBeforeCounter returns a count of CacheInterfaceMock.GetRole invocations
-func (mmGetRole *CacheInterfaceMock) GetRoleBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetRole.beforeGetRoleCounter)
+// InvalidateBeforeCounter returns a count of CacheInterfaceMock.Invalidate invocations
+func (mmInvalidate *CacheInterfaceMock) InvalidateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInvalidate.beforeInvalidateCounter)
 }
 
-// Calls returns a list of arguments used in each call to CacheInterfaceMock.GetRole.
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.Invalidate.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetRole *mCacheInterfaceMockGetRole) Calls() []*CacheInterfaceMockGetRoleParams {
-	mmGetRole.mutex.RLock()
+func (mmInvalidate *mCacheInterfaceMockInvalidate) Calls() []*CacheInterfaceMockInvalidateParams {
+	mmInvalidate.mutex.RLock()
 
-	argCopy := make([]*CacheInterfaceMockGetRoleParams, len(mmGetRole.callArgs))
-	copy(argCopy, mmGetRole.callArgs)
+	argCopy := make([]*CacheInterfaceMockInvalidateParams, len(mmInvalidate.callArgs))
+	copy(argCopy, mmInvalidate.callArgs)
 
-	mmGetRole.mutex.RUnlock()
+	mmInvalidate.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetRoleDone returns true if the count of the GetRole invocations corresponds
+// MinimockInvalidateDone returns true if the count of the Invalidate invocations corresponds
 // the number of defined expectations
-func (m *CacheInterfaceMock) MinimockGetRoleDone() bool {
-	if m.GetRoleMock.optional {
+func (m *CacheInterfaceMock) MinimockInvalidateDone() bool {
+	if m.InvalidateMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetRoleMock.expectations {
+	for _, e := range m.InvalidateMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetRoleMock.invocationsDone()
+	return m.InvalidateMock.invocationsDone()
 }
 
-// MinimockGetRoleInspect logs each unmet expectation
-func (m *CacheInterfaceMock) MinimockGetRoleInspect() {
-	for _, e := range m.GetRoleMock.expectations {
+// MinimockInvalidateInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockInvalidateInspect() {
+	for _, e := range m.InvalidateMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CacheInterfaceMock.GetRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CacheInterfaceMock.Invalidate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetRoleCounter := mm_atomic.LoadUint64(&m.afterGetRoleCounter)
+	afterInvalidateCounter := mm_atomic.LoadUint64(&m.afterInvalidateCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetRoleMock.defaultExpectation != nil && afterGetRoleCounter < 1 {
-		if m.GetRoleMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CacheInterfaceMock.GetRole at\n%s", m.GetRoleMock.defaultExpectation.returnOrigin)
+	if m.InvalidateMock.defaultExpectation != nil && afterInvalidateCounter < 1 {
+		if m.InvalidateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.Invalidate at\n%s", m.InvalidateMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CacheInterfaceMock.GetRole at\n%s with params: %#v", m.GetRoleMock.defaultExpectation.expectationOrigins.origin, *m.GetRoleMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CacheInterfaceMock.Invalidate at\n%s with params: %#v", m.InvalidateMock.defaultExpectation.expectationOrigins.origin, *m.InvalidateMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetRole != nil && afterGetRoleCounter < 1 {
-		m.t.Errorf("Expected call to CacheInterfaceMock.GetRole at\n%s", m.funcGetRoleOrigin)
+	if m.funcInvalidate != nil && afterInvalidateCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.Invalidate at\n%s", m.funcInvalidateOrigin)
 	}
 
-	if !m.GetRoleMock.invocationsDone() && afterGetRoleCounter > 0 {
-		m.t.Errorf("Expected %d calls to CacheInterfaceMock.GetRole at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetRoleMock.expectedInvocations), m.GetRoleMock.expectedInvocationsOrigin, afterGetRoleCounter)
+	if !m.InvalidateMock.invocationsDone() && afterInvalidateCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.Invalidate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.InvalidateMock.expectedInvocations), m.InvalidateMock.expectedInvocationsOrigin, afterInvalidateCounter)
 	}
 }
 
-type mCacheInterfaceMockGetRoleEndpoints struct {
+type mCacheInterfaceMockResetLoginFailures struct {
 	optional           bool
 	mock               *CacheInterfaceMock
-	defaultExpectation *CacheInterfaceMockGetRoleEndpointsExpectation
-	expectations       []*CacheInterfaceMockGetRoleEndpointsExpectation
+	defaultExpectation *CacheInterfaceMockResetLoginFailuresExpectation
+	expectations       []*CacheInterfaceMockResetLoginFailuresExpectation
 
-	callArgs []*CacheInterfaceMockGetRoleEndpointsParams
+	callArgs []*CacheInterfaceMockResetLoginFailuresParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CacheInterfaceMockGetRoleEndpointsExpectation specifies expectation struct of the CacheInterface.GetRoleEndpoints
-type CacheInterfaceMockGetRoleEndpointsExpectation struct {
+// CacheInterfaceMockResetLoginFailuresExpectation specifies expectation struct of the CacheInterface.ResetLoginFailures
+type CacheInterfaceMockResetLoginFailuresExpectation struct {
 	mock               *CacheInterfaceMock
-	params             *CacheInterfaceMockGetRoleEndpointsParams
-	paramPtrs          *CacheInterfaceMockGetRoleEndpointsParamPtrs
-	expectationOrigins CacheInterfaceMockGetRoleEndpointsExpectationOrigins
-	results            *CacheInterfaceMockGetRoleEndpointsResults
+	params             *CacheInterfaceMockResetLoginFailuresParams
+	paramPtrs          *CacheInterfaceMockResetLoginFailuresParamPtrs
+	expectationOrigins CacheInterfaceMockResetLoginFailuresExpectationOrigins
+	results            *CacheInterfaceMockResetLoginFailuresResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CacheInterfaceMockGetRoleEndpointsParams contains parameters of the CacheInterface.GetRoleEndpoints
-type CacheInterfaceMockGetRoleEndpointsParams struct {
+// CacheInterfaceMockResetLoginFailuresParams contains parameters of the CacheInterface.ResetLoginFailures
+type CacheInterfaceMockResetLoginFailuresParams struct {
 	ctx     context.Context
-	isAdmin bool
+	subject string
 }
 
-// CacheInterfaceMockGetRoleEndpointsParamPtrs contains pointers to parameters of the CacheInterface.GetRoleEndpoints
-type CacheInterfaceMockGetRoleEndpointsParamPtrs struct {
+// CacheInterfaceMockResetLoginFailuresParamPtrs contains pointers to parameters of the CacheInterface.ResetLoginFailures
+type CacheInterfaceMockResetLoginFailuresParamPtrs struct {
 	ctx     *context.Context
-	isAdmin *bool
+	subject *string
 }
 
-// CacheInterfaceMockGetRoleEndpointsResults contains results of the CacheInterface.GetRoleEndpoints
-type CacheInterfaceMockGetRoleEndpointsResults struct {
-	sa1 []string
+// CacheInterfaceMockResetLoginFailuresResults contains results of the CacheInterface.ResetLoginFailures
+type CacheInterfaceMockResetLoginFailuresResults struct {
 	err error
 }
 
-// CacheInterfaceMockGetRoleEndpointsOrigins contains origins of expectations of the CacheInterface.GetRoleEndpoints
-type CacheInterfaceMockGetRoleEndpointsExpectationOrigins struct {
+// CacheInterfaceMockResetLoginFailuresOrigins contains origins of expectations of the CacheInterface.ResetLoginFailures
+type CacheInterfaceMockResetLoginFailuresExpectationOrigins struct {
 	origin        string
 	originCtx     string
-	originIsAdmin string
+	originSubject string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1952,292 +5154,292 @@ type CacheInterfaceMockGetRoleEndpointsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetRoleEndpoints *mCacheInterfaceMockGetRoleEndpoints) Optional() *mCacheInterfaceMockGetRoleEndpoints {
-	mmGetRoleEndpoints.optional = true
-	return mmGetRoleEndpoints
+func (mmResetLoginFailures *mCacheInterfaceMockResetLoginFailures) Optional() *mCacheInterfaceMockResetLoginFailures {
+	mmResetLoginFailures.optional = true
+	return mmResetLoginFailures
 }
 
-// Expect sets up expected params for CacheInterface.GetRoleEndpoints
-func (mmGetRoleEndpoints *mCacheInterfaceMockGetRoleEndpoints) Expect(ctx context.Context, isAdmin bool) *mCacheInterfaceMockGetRoleEndpoints {
-	if mmGetRoleEndpoints.mock.funcGetRoleEndpoints != nil {
-		mmGetRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.GetRoleEndpoints mock is already set by Set")
+// Expect sets up expected params for CacheInterface.ResetLoginFailures
+func (mmResetLoginFailures *mCacheInterfaceMockResetLoginFailures) Expect(ctx context.Context, subject string) *mCacheInterfaceMockResetLoginFailures {
+	if mmResetLoginFailures.mock.funcResetLoginFailures != nil {
+		mmResetLoginFailures.mock.t.Fatalf("CacheInterfaceMock.ResetLoginFailures mock is already set by Set")
 	}
 
-	if mmGetRoleEndpoints.defaultExpectation == nil {
-		mmGetRoleEndpoints.defaultExpectation = &CacheInterfaceMockGetRoleEndpointsExpectation{}
+	if mmResetLoginFailures.defaultExpectation == nil {
+		mmResetLoginFailures.defaultExpectation = &CacheInterfaceMockResetLoginFailuresExpectation{}
 	}
 
-	if mmGetRoleEndpoints.defaultExpectation.paramPtrs != nil {
-		mmGetRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.GetRoleEndpoints mock is already set by ExpectParams functions")
+	if mmResetLoginFailures.defaultExpectation.paramPtrs != nil {
+		mmResetLoginFailures.mock.t.Fatalf("CacheInterfaceMock.ResetLoginFailures mock is already set by ExpectParams functions")
 	}
 
-	mmGetRoleEndpoints.defaultExpectation.params = &CacheInterfaceMockGetRoleEndpointsParams{ctx, isAdmin}
-	mmGetRoleEndpoints.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetRoleEndpoints.expectations {
-		if minimock.Equal(e.params, mmGetRoleEndpoints.defaultExpectation.params) {
-			mmGetRoleEndpoints.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRoleEndpoints.defaultExpectation.params)
+	mmResetLoginFailures.defaultExpectation.params = &CacheInterfaceMockResetLoginFailuresParams{ctx, subject}
+	mmResetLoginFailures.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmResetLoginFailures.expectations {
+		if minimock.Equal(e.params, mmResetLoginFailures.defaultExpectation.params) {
+			mmResetLoginFailures.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResetLoginFailures.defaultExpectation.params)
 		}
 	}
 
-	return mmGetRoleEndpoints
+	return mmResetLoginFailures
 }
 
-// ExpectCtxParam1 sets up expected param ctx for CacheInterface.GetRoleEndpoints
-func (mmGetRoleEndpoints *mCacheInterfaceMockGetRoleEndpoints) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockGetRoleEndpoints {
-	if mmGetRoleEndpoints.mock.funcGetRoleEndpoints != nil {
-		mmGetRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.GetRoleEndpoints mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for CacheInterface.ResetLoginFailures
+func (mmResetLoginFailures *mCacheInterfaceMockResetLoginFailures) ExpectCtxParam1(ctx context.Context) *mCacheInterfaceMockResetLoginFailures {
+	if mmResetLoginFailures.mock.funcResetLoginFailures != nil {
+		mmResetLoginFailures.mock.t.Fatalf("CacheInterfaceMock.ResetLoginFailures mock is already set by Set")
 	}
 
-	if mmGetRoleEndpoints.defaultExpectation == nil {
-		mmGetRoleEndpoints.defaultExpectation = &CacheInterfaceMockGetRoleEndpointsExpectation{}
+	if mmResetLoginFailures.defaultExpectation == nil {
+		mmResetLoginFailures.defaultExpectation = &CacheInterfaceMockResetLoginFailuresExpectation{}
 	}
 
-	if mmGetRoleEndpoints.defaultExpectation.params != nil {
-		mmGetRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.GetRoleEndpoints mock is already set by Expect")
+	if mmResetLoginFailures.defaultExpectation.params != nil {
+		mmResetLoginFailures.mock.t.Fatalf("CacheInterfaceMock.ResetLoginFailures mock is already set by Expect")
 	}
 
-	if mmGetRoleEndpoints.defaultExpectation.paramPtrs == nil {
-		mmGetRoleEndpoints.defaultExpectation.paramPtrs = &CacheInterfaceMockGetRoleEndpointsParamPtrs{}
+	if mmResetLoginFailures.defaultExpectation.paramPtrs == nil {
+		mmResetLoginFailures.defaultExpectation.paramPtrs = &CacheInterfaceMockResetLoginFailuresParamPtrs{}
 	}
-	mmGetRoleEndpoints.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetRoleEndpoints.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmResetLoginFailures.defaultExpectation.paramPtrs.ctx = &ctx
+	mmResetLoginFailures.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetRoleEndpoints
+	return mmResetLoginFailures
 }
 
-// ExpectIsAdminParam2 sets up expected param isAdmin for CacheInterface.GetRoleEndpoints
-func (mmGetRoleEndpoints *mCacheInterfaceMockGetRoleEndpoints) ExpectIsAdminParam2(isAdmin bool) *mCacheInterfaceMockGetRoleEndpoints {
-	if mmGetRoleEndpoints.mock.funcGetRoleEndpoints != nil {
-		mmGetRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.GetRoleEndpoints mock is already set by Set")
+// ExpectSubjectParam2 sets up expected param subject for CacheInterface.ResetLoginFailures
+func (mmResetLoginFailures *mCacheInterfaceMockResetLoginFailures) ExpectSubjectParam2(subject string) *mCacheInterfaceMockResetLoginFailures {
+	if mmResetLoginFailures.mock.funcResetLoginFailures != nil {
+		mmResetLoginFailures.mock.t.Fatalf("CacheInterfaceMock.ResetLoginFailures mock is already set by Set")
 	}
 
-	if mmGetRoleEndpoints.defaultExpectation == nil {
-		mmGetRoleEndpoints.defaultExpectation = &CacheInterfaceMockGetRoleEndpointsExpectation{}
+	if mmResetLoginFailures.defaultExpectation == nil {
+		mmResetLoginFailures.defaultExpectation = &CacheInterfaceMockResetLoginFailuresExpectation{}
 	}
 
-	if mmGetRoleEndpoints.defaultExpectation.params != nil {
-		mmGetRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.GetRoleEndpoints mock is already set by Expect")
+	if mmResetLoginFailures.defaultExpectation.params != nil {
+		mmResetLoginFailures.mock.t.Fatalf("CacheInterfaceMock.ResetLoginFailures mock is already set by Expect")
 	}
 
-	if mmGetRoleEndpoints.defaultExpectation.paramPtrs == nil {
-		mmGetRoleEndpoints.defaultExpectation.paramPtrs = &CacheInterfaceMockGetRoleEndpointsParamPtrs{}
+	if mmResetLoginFailures.defaultExpectation.paramPtrs == nil {
+		mmResetLoginFailures.defaultExpectation.paramPtrs = &CacheInterfaceMockResetLoginFailuresParamPtrs{}
 	}
-	mmGetRoleEndpoints.defaultExpectation.paramPtrs.isAdmin = &isAdmin
-	mmGetRoleEndpoints.defaultExpectation.expectationOrigins.originIsAdmin = minimock.CallerInfo(1)
+	mmResetLoginFailures.defaultExpectation.paramPtrs.subject = &subject
+	mmResetLoginFailures.defaultExpectation.expectationOrigins.originSubject = minimock.CallerInfo(1)
 
-	return mmGetRoleEndpoints
+	return mmResetLoginFailures
 }
 
-// Inspect accepts an inspector function that has same arguments as the CacheInterface.GetRoleEndpoints
-func (mmGetRoleEndpoints *mCacheInterfaceMockGetRoleEndpoints) Inspect(f func(ctx context.Context, isAdmin bool)) *mCacheInterfaceMockGetRoleEndpoints {
-	if mmGetRoleEndpoints.mock.inspectFuncGetRoleEndpoints != nil {
-		mmGetRoleEndpoints.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.GetRoleEndpoints")
+// Inspect accepts an inspector function that has same arguments as the CacheInterface.ResetLoginFailures
+func (mmResetLoginFailures *mCacheInterfaceMockResetLoginFailures) Inspect(f func(ctx context.Context, subject string)) *mCacheInterfaceMockResetLoginFailures {
+	if mmResetLoginFailures.mock.inspectFuncResetLoginFailures != nil {
+		mmResetLoginFailures.mock.t.Fatalf("Inspect function is already set for CacheInterfaceMock.ResetLoginFailures")
 	}
 
-	mmGetRoleEndpoints.mock.inspectFuncGetRoleEndpoints = f
+	mmResetLoginFailures.mock.inspectFuncResetLoginFailures = f
 
-	return mmGetRoleEndpoints
+	return mmResetLoginFailures
 }
 
-// Return sets up results that will be returned by CacheInterface.GetRoleEndpoints
-func (mmGetRoleEndpoints *mCacheInterfaceMockGetRoleEndpoints) Return(sa1 []string, err error) *CacheInterfaceMock {
-	if mmGetRoleEndpoints.mock.funcGetRoleEndpoints != nil {
-		mmGetRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.GetRoleEndpoints mock is already set by Set")
+// Return sets up results that will be returned by CacheInterface.ResetLoginFailures
+func (mmResetLoginFailures *mCacheInterfaceMockResetLoginFailures) Return(err error) *CacheInterfaceMock {
+	if mmResetLoginFailures.mock.funcResetLoginFailures != nil {
+		mmResetLoginFailures.mock.t.Fatalf("CacheInterfaceMock.ResetLoginFailures mock is already set by Set")
 	}
 
-	if mmGetRoleEndpoints.defaultExpectation == nil {
-		mmGetRoleEndpoints.defaultExpectation = &CacheInterfaceMockGetRoleEndpointsExpectation{mock: mmGetRoleEndpoints.mock}
+	if mmResetLoginFailures.defaultExpectation == nil {
+		mmResetLoginFailures.defaultExpectation = &CacheInterfaceMockResetLoginFailuresExpectation{mock: mmResetLoginFailures.mock}
 	}
-	mmGetRoleEndpoints.defaultExpectation.results = &CacheInterfaceMockGetRoleEndpointsResults{sa1, err}
-	mmGetRoleEndpoints.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetRoleEndpoints.mock
+	mmResetLoginFailures.defaultExpectation.results = &CacheInterfaceMockResetLoginFailuresResults{err}
+	mmResetLoginFailures.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmResetLoginFailures.mock
 }
 
-// Set uses given function f to mock the CacheInterface.GetRoleEndpoints method
-func (mmGetRoleEndpoints *mCacheInterfaceMockGetRoleEndpoints) Set(f func(ctx context.Context, isAdmin bool) (sa1 []string, err error)) *CacheInterfaceMock {
-	if mmGetRoleEndpoints.defaultExpectation != nil {
-		mmGetRoleEndpoints.mock.t.Fatalf("Default expectation is already set for the CacheInterface.GetRoleEndpoints method")
+// Set uses given function f to mock the CacheInterface.ResetLoginFailures method
+func (mmResetLoginFailures *mCacheInterfaceMockResetLoginFailures) Set(f func(ctx context.Context, subject string) (err error)) *CacheInterfaceMock {
+	if mmResetLoginFailures.defaultExpectation != nil {
+		mmResetLoginFailures.mock.t.Fatalf("Default expectation is already set for the CacheInterface.ResetLoginFailures method")
 	}
 
-	if len(mmGetRoleEndpoints.expectations) > 0 {
-		mmGetRoleEndpoints.mock.t.Fatalf("Some expectations are already set for the CacheInterface.GetRoleEndpoints method")
+	if len(mmResetLoginFailures.expectations) > 0 {
+		mmResetLoginFailures.mock.t.Fatalf("Some expectations are already set for the CacheInterface.ResetLoginFailures method")
 	}
 
-	mmGetRoleEndpoints.mock.funcGetRoleEndpoints = f
-	mmGetRoleEndpoints.mock.funcGetRoleEndpointsOrigin = minimock.CallerInfo(1)
-	return mmGetRoleEndpoints.mock
+	mmResetLoginFailures.mock.funcResetLoginFailures = f
+	mmResetLoginFailures.mock.funcResetLoginFailuresOrigin = minimock.CallerInfo(1)
+	return mmResetLoginFailures.mock
 }
 
-// When sets expectation for the CacheInterface.GetRoleEndpoints which will trigger the result defined by the following
+// When sets expectation for the CacheInterface.ResetLoginFailures which will trigger the result defined by the following
 // Then helper
-func (mmGetRoleEndpoints *mCacheInterfaceMockGetRoleEndpoints) When(ctx context.Context, isAdmin bool) *CacheInterfaceMockGetRoleEndpointsExpectation {
-	if mmGetRoleEndpoints.mock.funcGetRoleEndpoints != nil {
-		mmGetRoleEndpoints.mock.t.Fatalf("CacheInterfaceMock.GetRoleEndpoints mock is already set by Set")
+func (mmResetLoginFailures *mCacheInterfaceMockResetLoginFailures) When(ctx context.Context, subject string) *CacheInterfaceMockResetLoginFailuresExpectation {
+	if mmResetLoginFailures.mock.funcResetLoginFailures != nil {
+		mmResetLoginFailures.mock.t.Fatalf("CacheInterfaceMock.ResetLoginFailures mock is already set by Set")
 	}
 
-	expectation := &CacheInterfaceMockGetRoleEndpointsExpectation{
-		mock:               mmGetRoleEndpoints.mock,
-		params:             &CacheInterfaceMockGetRoleEndpointsParams{ctx, isAdmin},
-		expectationOrigins: CacheInterfaceMockGetRoleEndpointsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CacheInterfaceMockResetLoginFailuresExpectation{
+		mock:               mmResetLoginFailures.mock,
+		params:             &CacheInterfaceMockResetLoginFailuresParams{ctx, subject},
+		expectationOrigins: CacheInterfaceMockResetLoginFailuresExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetRoleEndpoints.expectations = append(mmGetRoleEndpoints.expectations, expectation)
+	mmResetLoginFailures.expectations = append(mmResetLoginFailures.expectations, expectation)
 	return expectation
 }
 
-// Then sets up CacheInterface.GetRoleEndpoints return parameters for the expectation previously defined by the When method
-func (e *CacheInterfaceMockGetRoleEndpointsExpectation) Then(sa1 []string, err error) *CacheInterfaceMock {
-	e.results = &CacheInterfaceMockGetRoleEndpointsResults{sa1, err}
+// Then sets up CacheInterface.ResetLoginFailures return parameters for the expectation previously defined by the When method
+func (e *CacheInterfaceMockResetLoginFailuresExpectation) Then(err error) *CacheInterfaceMock {
+	e.results = &CacheInterfaceMockResetLoginFailuresResults{err}
 	return e.mock
 }
 
-// Times sets number of times CacheInterface.GetRoleEndpoints should be invoked
-func (mmGetRoleEndpoints *mCacheInterfaceMockGetRoleEndpoints) Times(n uint64) *mCacheInterfaceMockGetRoleEndpoints {
+// Times sets number of times CacheInterface.ResetLoginFailures should be invoked
+func (mmResetLoginFailures *mCacheInterfaceMockResetLoginFailures) Times(n uint64) *mCacheInterfaceMockResetLoginFailures {
 	if n == 0 {
-		mmGetRoleEndpoints.mock.t.Fatalf("Times of CacheInterfaceMock.GetRoleEndpoints mock can not be zero")
+		mmResetLoginFailures.mock.t.Fatalf("Times of CacheInterfaceMock.ResetLoginFailures mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetRoleEndpoints.expectedInvocations, n)
-	mmGetRoleEndpoints.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetRoleEndpoints
+	mm_atomic.StoreUint64(&mmResetLoginFailures.expectedInvocations, n)
+	mmResetLoginFailures.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmResetLoginFailures
 }
 
-func (mmGetRoleEndpoints *mCacheInterfaceMockGetRoleEndpoints) invocationsDone() bool {
-	if len(mmGetRoleEndpoints.expectations) == 0 && mmGetRoleEndpoints.defaultExpectation == nil && mmGetRoleEndpoints.mock.funcGetRoleEndpoints == nil {
+func (mmResetLoginFailures *mCacheInterfaceMockResetLoginFailures) invocationsDone() bool {
+	if len(mmResetLoginFailures.expectations) == 0 && mmResetLoginFailures.defaultExpectation == nil && mmResetLoginFailures.mock.funcResetLoginFailures == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetRoleEndpoints.mock.afterGetRoleEndpointsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetRoleEndpoints.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmResetLoginFailures.mock.afterResetLoginFailuresCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResetLoginFailures.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetRoleEndpoints implements mm_repository.CacheInterface
-func (mmGetRoleEndpoints *CacheInterfaceMock) GetRoleEndpoints(ctx context.Context, isAdmin bool) (sa1 []string, err error) {
-	mm_atomic.AddUint64(&mmGetRoleEndpoints.beforeGetRoleEndpointsCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetRoleEndpoints.afterGetRoleEndpointsCounter, 1)
+// ResetLoginFailures implements mm_repository.CacheInterface
+func (mmResetLoginFailures *CacheInterfaceMock) ResetLoginFailures(ctx context.Context, subject string) (err error) {
+	mm_atomic.AddUint64(&mmResetLoginFailures.beforeResetLoginFailuresCounter, 1)
+	defer mm_atomic.AddUint64(&mmResetLoginFailures.afterResetLoginFailuresCounter, 1)
 
-	mmGetRoleEndpoints.t.Helper()
+	mmResetLoginFailures.t.Helper()
 
-	if mmGetRoleEndpoints.inspectFuncGetRoleEndpoints != nil {
-		mmGetRoleEndpoints.inspectFuncGetRoleEndpoints(ctx, isAdmin)
+	if mmResetLoginFailures.inspectFuncResetLoginFailures != nil {
+		mmResetLoginFailures.inspectFuncResetLoginFailures(ctx, subject)
 	}
 
-	mm_params := CacheInterfaceMockGetRoleEndpointsParams{ctx, isAdmin}
+	mm_params := CacheInterfaceMockResetLoginFailuresParams{ctx, subject}
 
 	// Record call args
-	mmGetRoleEndpoints.GetRoleEndpointsMock.mutex.Lock()
-	mmGetRoleEndpoints.GetRoleEndpointsMock.callArgs = append(mmGetRoleEndpoints.GetRoleEndpointsMock.callArgs, &mm_params)
-	mmGetRoleEndpoints.GetRoleEndpointsMock.mutex.Unlock()
+	mmResetLoginFailures.ResetLoginFailuresMock.mutex.Lock()
+	mmResetLoginFailures.ResetLoginFailuresMock.callArgs = append(mmResetLoginFailures.ResetLoginFailuresMock.callArgs, &mm_params)
+	mmResetLoginFailures.ResetLoginFailuresMock.mutex.Unlock()
 
-	for _, e := range mmGetRoleEndpoints.GetRoleEndpointsMock.expectations {
+	for _, e := range mmResetLoginFailures.ResetLoginFailuresMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.sa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.params
-		mm_want_ptrs := mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.paramPtrs
+	if mmResetLoginFailures.ResetLoginFailuresMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResetLoginFailures.ResetLoginFailuresMock.defaultExpectation.Counter, 1)
+		mm_want := mmResetLoginFailures.ResetLoginFailuresMock.defaultExpectation.params
+		mm_want_ptrs := mmResetLoginFailures.ResetLoginFailuresMock.defaultExpectation.paramPtrs
 
-		mm_got := CacheInterfaceMockGetRoleEndpointsParams{ctx, isAdmin}
+		mm_got := CacheInterfaceMockResetLoginFailuresParams{ctx, subject}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetRoleEndpoints.t.Errorf("CacheInterfaceMock.GetRoleEndpoints got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmResetLoginFailures.t.Errorf("CacheInterfaceMock.ResetLoginFailures got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetLoginFailures.ResetLoginFailuresMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.isAdmin != nil && !minimock.Equal(*mm_want_ptrs.isAdmin, mm_got.isAdmin) {
-				mmGetRoleEndpoints.t.Errorf("CacheInterfaceMock.GetRoleEndpoints got unexpected parameter isAdmin, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.expectationOrigins.originIsAdmin, *mm_want_ptrs.isAdmin, mm_got.isAdmin, minimock.Diff(*mm_want_ptrs.isAdmin, mm_got.isAdmin))
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmResetLoginFailures.t.Errorf("CacheInterfaceMock.ResetLoginFailures got unexpected parameter subject, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetLoginFailures.ResetLoginFailuresMock.defaultExpectation.expectationOrigins.originSubject, *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetRoleEndpoints.t.Errorf("CacheInterfaceMock.GetRoleEndpoints got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmResetLoginFailures.t.Errorf("CacheInterfaceMock.ResetLoginFailures got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmResetLoginFailures.ResetLoginFailuresMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetRoleEndpoints.GetRoleEndpointsMock.defaultExpectation.results
+		mm_results := mmResetLoginFailures.ResetLoginFailuresMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetRoleEndpoints.t.Fatal("No results are set for the CacheInterfaceMock.GetRoleEndpoints")
+			mmResetLoginFailures.t.Fatal("No results are set for the CacheInterfaceMock.ResetLoginFailures")
 		}
-		return (*mm_results).sa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetRoleEndpoints.funcGetRoleEndpoints != nil {
-		return mmGetRoleEndpoints.funcGetRoleEndpoints(ctx, isAdmin)
+	if mmResetLoginFailures.funcResetLoginFailures != nil {
+		return mmResetLoginFailures.funcResetLoginFailures(ctx, subject)
 	}
-	mmGetRoleEndpoints.t.Fatalf("Unexpected call to CacheInterfaceMock.GetRoleEndpoints. %v %v", ctx, isAdmin)
+	mmResetLoginFailures.t.Fatalf("Unexpected call to CacheInterfaceMock.ResetLoginFailures. %v %v", ctx, subject)
 	return
 }
 
-// GetRoleEndpointsAfterCounter returns a count of finished CacheInterfaceMock.GetRoleEndpoints invocations
-func (mmGetRoleEndpoints *CacheInterfaceMock) GetRoleEndpointsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetRoleEndpoints.afterGetRoleEndpointsCounter)
+// ResetLoginFailuresAfterCounter returns a count of finished CacheInterfaceMock.ResetLoginFailures invocations
+func (mmResetLoginFailures *CacheInterfaceMock) ResetLoginFailuresAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetLoginFailures.afterResetLoginFailuresCounter)
 }
 
-// GetRoleEndpointsBeforeCounter returns a count of CacheInterfaceMock.GetRoleEndpoints invocations
-func (mmGetRoleEndpoints *CacheInterfaceMock) GetRoleEndpointsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetRoleEndpoints.beforeGetRoleEndpointsCounter)
+// ResetLoginFailuresBeforeCounter returns a count of CacheInterfaceMock.ResetLoginFailures invocations
+func (mmResetLoginFailures *CacheInterfaceMock) ResetLoginFailuresBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetLoginFailures.beforeResetLoginFailuresCounter)
 }
 
-// Calls returns a list of arguments used in each call to CacheInterfaceMock.GetRoleEndpoints.
+// Calls returns a list of arguments used in each call to CacheInterfaceMock.ResetLoginFailures.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetRoleEndpoints *mCacheInterfaceMockGetRoleEndpoints) Calls() []*CacheInterfaceMockGetRoleEndpointsParams {
-	mmGetRoleEndpoints.mutex.RLock()
+func (mmResetLoginFailures *mCacheInterfaceMockResetLoginFailures) Calls() []*CacheInterfaceMockResetLoginFailuresParams {
+	mmResetLoginFailures.mutex.RLock()
 
-	argCopy := make([]*CacheInterfaceMockGetRoleEndpointsParams, len(mmGetRoleEndpoints.callArgs))
-	copy(argCopy, mmGetRoleEndpoints.callArgs)
+	argCopy := make([]*CacheInterfaceMockResetLoginFailuresParams, len(mmResetLoginFailures.callArgs))
+	copy(argCopy, mmResetLoginFailures.callArgs)
 
-	mmGetRoleEndpoints.mutex.RUnlock()
+	mmResetLoginFailures.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetRoleEndpointsDone returns true if the count of the GetRoleEndpoints invocations corresponds
+// MinimockResetLoginFailuresDone returns true if the count of the ResetLoginFailures invocations corresponds
 // the number of defined expectations
-func (m *CacheInterfaceMock) MinimockGetRoleEndpointsDone() bool {
-	if m.GetRoleEndpointsMock.optional {
+func (m *CacheInterfaceMock) MinimockResetLoginFailuresDone() bool {
+	if m.ResetLoginFailuresMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetRoleEndpointsMock.expectations {
+	for _, e := range m.ResetLoginFailuresMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetRoleEndpointsMock.invocationsDone()
+	return m.ResetLoginFailuresMock.invocationsDone()
 }
 
-// MinimockGetRoleEndpointsInspect logs each unmet expectation
-func (m *CacheInterfaceMock) MinimockGetRoleEndpointsInspect() {
-	for _, e := range m.GetRoleEndpointsMock.expectations {
+// MinimockResetLoginFailuresInspect logs each unmet expectation
+func (m *CacheInterfaceMock) MinimockResetLoginFailuresInspect() {
+	for _, e := range m.ResetLoginFailuresMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CacheInterfaceMock.GetRoleEndpoints at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CacheInterfaceMock.ResetLoginFailures at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetRoleEndpointsCounter := mm_atomic.LoadUint64(&m.afterGetRoleEndpointsCounter)
+	afterResetLoginFailuresCounter := mm_atomic.LoadUint64(&m.afterResetLoginFailuresCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetRoleEndpointsMock.defaultExpectation != nil && afterGetRoleEndpointsCounter < 1 {
-		if m.GetRoleEndpointsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CacheInterfaceMock.GetRoleEndpoints at\n%s", m.GetRoleEndpointsMock.defaultExpectation.returnOrigin)
+	if m.ResetLoginFailuresMock.defaultExpectation != nil && afterResetLoginFailuresCounter < 1 {
+		if m.ResetLoginFailuresMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheInterfaceMock.ResetLoginFailures at\n%s", m.ResetLoginFailuresMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CacheInterfaceMock.GetRoleEndpoints at\n%s with params: %#v", m.GetRoleEndpointsMock.defaultExpectation.expectationOrigins.origin, *m.GetRoleEndpointsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CacheInterfaceMock.ResetLoginFailures at\n%s with params: %#v", m.ResetLoginFailuresMock.defaultExpectation.expectationOrigins.origin, *m.ResetLoginFailuresMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetRoleEndpoints != nil && afterGetRoleEndpointsCounter < 1 {
-		m.t.Errorf("Expected call to CacheInterfaceMock.GetRoleEndpoints at\n%s", m.funcGetRoleEndpointsOrigin)
+	if m.funcResetLoginFailures != nil && afterResetLoginFailuresCounter < 1 {
+		m.t.Errorf("Expected call to CacheInterfaceMock.ResetLoginFailures at\n%s", m.funcResetLoginFailuresOrigin)
 	}
 
-	if !m.GetRoleEndpointsMock.invocationsDone() && afterGetRoleEndpointsCounter > 0 {
-		m.t.Errorf("Expected %d calls to CacheInterfaceMock.GetRoleEndpoints at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetRoleEndpointsMock.expectedInvocations), m.GetRoleEndpointsMock.expectedInvocationsOrigin, afterGetRoleEndpointsCounter)
+	if !m.ResetLoginFailuresMock.invocationsDone() && afterResetLoginFailuresCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheInterfaceMock.ResetLoginFailures at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResetLoginFailuresMock.expectedInvocations), m.ResetLoginFailuresMock.expectedInvocationsOrigin, afterResetLoginFailuresCounter)
 	}
 }
 
@@ -2245,17 +5447,35 @@ func (m *CacheInterfaceMock) MinimockGetRoleEndpointsInspect() {
 func (m *CacheInterfaceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockBlockLoginInspect()
+
 			m.MinimockCreateInspect()
 
-			m.MinimockCreateRoleInspect()
+			m.MinimockCreateActiveFamilyInspect()
 
-			m.MinimockCreateRoleEndpointsInspect()
+			m.MinimockCreateRevokedFamilyInspect()
+
+			m.MinimockCreateRolesInspect()
+
+			m.MinimockCreateRolesNotFoundInspect()
+
+			m.MinimockCreateUserNotFoundInspect()
+
+			m.MinimockDeleteInspect()
 
 			m.MinimockGetInspect()
 
-			m.MinimockGetRoleInspect()
+			m.MinimockGetLoginBlockInspect()
+
+			m.MinimockGetRevokedFamilyInspect()
+
+			m.MinimockGetRolesInspect()
+
+			m.MinimockIncLoginFailuresInspect()
+
+			m.MinimockInvalidateInspect()
 
-			m.MinimockGetRoleEndpointsInspect()
+			m.MinimockResetLoginFailuresInspect()
 		}
 	})
 }
@@ -2279,10 +5499,19 @@ func (m *CacheInterfaceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *CacheInterfaceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockBlockLoginDone() &&
 		m.MinimockCreateDone() &&
-		m.MinimockCreateRoleDone() &&
-		m.MinimockCreateRoleEndpointsDone() &&
+		m.MinimockCreateActiveFamilyDone() &&
+		m.MinimockCreateRevokedFamilyDone() &&
+		m.MinimockCreateRolesDone() &&
+		m.MinimockCreateRolesNotFoundDone() &&
+		m.MinimockCreateUserNotFoundDone() &&
+		m.MinimockDeleteDone() &&
 		m.MinimockGetDone() &&
-		m.MinimockGetRoleDone() &&
-		m.MinimockGetRoleEndpointsDone()
+		m.MinimockGetLoginBlockDone() &&
+		m.MinimockGetRevokedFamilyDone() &&
+		m.MinimockGetRolesDone() &&
+		m.MinimockIncLoginFailuresDone() &&
+		m.MinimockInvalidateDone() &&
+		m.MinimockResetLoginFailuresDone()
 }
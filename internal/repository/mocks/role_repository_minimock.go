@@ -0,0 +1,3585 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Ippolid/auth/internal/repository.RoleRepository -o role_repository_minimock.go -n RoleRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RoleRepositoryMock implements mm_repository.RoleRepository
+type RoleRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddPermission          func(ctx context.Context, perm model.RolePermission) (err error)
+	funcAddPermissionOrigin    string
+	inspectFuncAddPermission   func(ctx context.Context, perm model.RolePermission)
+	afterAddPermissionCounter  uint64
+	beforeAddPermissionCounter uint64
+	AddPermissionMock          mRoleRepositoryMockAddPermission
+
+	funcAssignRole          func(ctx context.Context, userRole model.UserRole) (err error)
+	funcAssignRoleOrigin    string
+	inspectFuncAssignRole   func(ctx context.Context, userRole model.UserRole)
+	afterAssignRoleCounter  uint64
+	beforeAssignRoleCounter uint64
+	AssignRoleMock          mRoleRepositoryMockAssignRole
+
+	funcCreateAudit          func(ctx context.Context, audit model.Audit) (err error)
+	funcCreateAuditOrigin    string
+	inspectFuncCreateAudit   func(ctx context.Context, audit model.Audit)
+	afterCreateAuditCounter  uint64
+	beforeCreateAuditCounter uint64
+	CreateAuditMock          mRoleRepositoryMockCreateAudit
+
+	funcCreateRole          func(ctx context.Context, name string) (i1 int64, err error)
+	funcCreateRoleOrigin    string
+	inspectFuncCreateRole   func(ctx context.Context, name string)
+	afterCreateRoleCounter  uint64
+	beforeCreateRoleCounter uint64
+	CreateRoleMock          mRoleRepositoryMockCreateRole
+
+	funcDeleteRole          func(ctx context.Context, name string) (ra1 []model.RoleHolder, err error)
+	funcDeleteRoleOrigin    string
+	inspectFuncDeleteRole   func(ctx context.Context, name string)
+	afterDeleteRoleCounter  uint64
+	beforeDeleteRoleCounter uint64
+	DeleteRoleMock          mRoleRepositoryMockDeleteRole
+
+	funcGetUserRoles          func(ctx context.Context, userID int64) (up1 *model.UserRoles, err error)
+	funcGetUserRolesOrigin    string
+	inspectFuncGetUserRoles   func(ctx context.Context, userID int64)
+	afterGetUserRolesCounter  uint64
+	beforeGetUserRolesCounter uint64
+	GetUserRolesMock          mRoleRepositoryMockGetUserRoles
+
+	funcListRoles          func(ctx context.Context) (ra1 []model.Role, err error)
+	funcListRolesOrigin    string
+	inspectFuncListRoles   func(ctx context.Context)
+	afterListRolesCounter  uint64
+	beforeListRolesCounter uint64
+	ListRolesMock          mRoleRepositoryMockListRoles
+
+	funcMakeLog          func(ctx context.Context, log model.Log) (err error)
+	funcMakeLogOrigin    string
+	inspectFuncMakeLog   func(ctx context.Context, log model.Log)
+	afterMakeLogCounter  uint64
+	beforeMakeLogCounter uint64
+	MakeLogMock          mRoleRepositoryMockMakeLog
+
+	funcRemovePermission          func(ctx context.Context, perm model.RolePermission) (err error)
+	funcRemovePermissionOrigin    string
+	inspectFuncRemovePermission   func(ctx context.Context, perm model.RolePermission)
+	afterRemovePermissionCounter  uint64
+	beforeRemovePermissionCounter uint64
+	RemovePermissionMock          mRoleRepositoryMockRemovePermission
+
+	funcUnassignRole          func(ctx context.Context, userRole model.UserRole) (err error)
+	funcUnassignRoleOrigin    string
+	inspectFuncUnassignRole   func(ctx context.Context, userRole model.UserRole)
+	afterUnassignRoleCounter  uint64
+	beforeUnassignRoleCounter uint64
+	UnassignRoleMock          mRoleRepositoryMockUnassignRole
+}
+
+// NewRoleRepositoryMock returns a mock for mm_repository.RoleRepository
+func NewRoleRepositoryMock(t minimock.Tester) *RoleRepositoryMock {
+	m := &RoleRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddPermissionMock = mRoleRepositoryMockAddPermission{mock: m}
+	m.AddPermissionMock.callArgs = []*RoleRepositoryMockAddPermissionParams{}
+
+	m.AssignRoleMock = mRoleRepositoryMockAssignRole{mock: m}
+	m.AssignRoleMock.callArgs = []*RoleRepositoryMockAssignRoleParams{}
+
+	m.CreateAuditMock = mRoleRepositoryMockCreateAudit{mock: m}
+	m.CreateAuditMock.callArgs = []*RoleRepositoryMockCreateAuditParams{}
+
+	m.CreateRoleMock = mRoleRepositoryMockCreateRole{mock: m}
+	m.CreateRoleMock.callArgs = []*RoleRepositoryMockCreateRoleParams{}
+
+	m.DeleteRoleMock = mRoleRepositoryMockDeleteRole{mock: m}
+	m.DeleteRoleMock.callArgs = []*RoleRepositoryMockDeleteRoleParams{}
+
+	m.GetUserRolesMock = mRoleRepositoryMockGetUserRoles{mock: m}
+	m.GetUserRolesMock.callArgs = []*RoleRepositoryMockGetUserRolesParams{}
+
+	m.ListRolesMock = mRoleRepositoryMockListRoles{mock: m}
+	m.ListRolesMock.callArgs = []*RoleRepositoryMockListRolesParams{}
+
+	m.MakeLogMock = mRoleRepositoryMockMakeLog{mock: m}
+	m.MakeLogMock.callArgs = []*RoleRepositoryMockMakeLogParams{}
+
+	m.RemovePermissionMock = mRoleRepositoryMockRemovePermission{mock: m}
+	m.RemovePermissionMock.callArgs = []*RoleRepositoryMockRemovePermissionParams{}
+
+	m.UnassignRoleMock = mRoleRepositoryMockUnassignRole{mock: m}
+	m.UnassignRoleMock.callArgs = []*RoleRepositoryMockUnassignRoleParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRoleRepositoryMockAddPermission struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockAddPermissionExpectation
+	expectations       []*RoleRepositoryMockAddPermissionExpectation
+
+	callArgs []*RoleRepositoryMockAddPermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockAddPermissionExpectation specifies expectation struct of the RoleRepository.AddPermission
+type RoleRepositoryMockAddPermissionExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockAddPermissionParams
+	paramPtrs          *RoleRepositoryMockAddPermissionParamPtrs
+	expectationOrigins RoleRepositoryMockAddPermissionExpectationOrigins
+	results            *RoleRepositoryMockAddPermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockAddPermissionParams contains parameters of the RoleRepository.AddPermission
+type RoleRepositoryMockAddPermissionParams struct {
+	ctx  context.Context
+	perm model.RolePermission
+}
+
+// RoleRepositoryMockAddPermissionParamPtrs contains pointers to parameters of the RoleRepository.AddPermission
+type RoleRepositoryMockAddPermissionParamPtrs struct {
+	ctx  *context.Context
+	perm *model.RolePermission
+}
+
+// RoleRepositoryMockAddPermissionResults contains results of the RoleRepository.AddPermission
+type RoleRepositoryMockAddPermissionResults struct {
+	err error
+}
+
+// RoleRepositoryMockAddPermissionOrigins contains origins of expectations of the RoleRepository.AddPermission
+type RoleRepositoryMockAddPermissionExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originPerm string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddPermission *mRoleRepositoryMockAddPermission) Optional() *mRoleRepositoryMockAddPermission {
+	mmAddPermission.optional = true
+	return mmAddPermission
+}
+
+// Expect sets up expected params for RoleRepository.AddPermission
+func (mmAddPermission *mRoleRepositoryMockAddPermission) Expect(ctx context.Context, perm model.RolePermission) *mRoleRepositoryMockAddPermission {
+	if mmAddPermission.mock.funcAddPermission != nil {
+		mmAddPermission.mock.t.Fatalf("RoleRepositoryMock.AddPermission mock is already set by Set")
+	}
+
+	if mmAddPermission.defaultExpectation == nil {
+		mmAddPermission.defaultExpectation = &RoleRepositoryMockAddPermissionExpectation{}
+	}
+
+	if mmAddPermission.defaultExpectation.paramPtrs != nil {
+		mmAddPermission.mock.t.Fatalf("RoleRepositoryMock.AddPermission mock is already set by ExpectParams functions")
+	}
+
+	mmAddPermission.defaultExpectation.params = &RoleRepositoryMockAddPermissionParams{ctx, perm}
+	mmAddPermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddPermission.expectations {
+		if minimock.Equal(e.params, mmAddPermission.defaultExpectation.params) {
+			mmAddPermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddPermission.defaultExpectation.params)
+		}
+	}
+
+	return mmAddPermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.AddPermission
+func (mmAddPermission *mRoleRepositoryMockAddPermission) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockAddPermission {
+	if mmAddPermission.mock.funcAddPermission != nil {
+		mmAddPermission.mock.t.Fatalf("RoleRepositoryMock.AddPermission mock is already set by Set")
+	}
+
+	if mmAddPermission.defaultExpectation == nil {
+		mmAddPermission.defaultExpectation = &RoleRepositoryMockAddPermissionExpectation{}
+	}
+
+	if mmAddPermission.defaultExpectation.params != nil {
+		mmAddPermission.mock.t.Fatalf("RoleRepositoryMock.AddPermission mock is already set by Expect")
+	}
+
+	if mmAddPermission.defaultExpectation.paramPtrs == nil {
+		mmAddPermission.defaultExpectation.paramPtrs = &RoleRepositoryMockAddPermissionParamPtrs{}
+	}
+	mmAddPermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddPermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddPermission
+}
+
+// ExpectPermParam2 sets up expected param perm for RoleRepository.AddPermission
+func (mmAddPermission *mRoleRepositoryMockAddPermission) ExpectPermParam2(perm model.RolePermission) *mRoleRepositoryMockAddPermission {
+	if mmAddPermission.mock.funcAddPermission != nil {
+		mmAddPermission.mock.t.Fatalf("RoleRepositoryMock.AddPermission mock is already set by Set")
+	}
+
+	if mmAddPermission.defaultExpectation == nil {
+		mmAddPermission.defaultExpectation = &RoleRepositoryMockAddPermissionExpectation{}
+	}
+
+	if mmAddPermission.defaultExpectation.params != nil {
+		mmAddPermission.mock.t.Fatalf("RoleRepositoryMock.AddPermission mock is already set by Expect")
+	}
+
+	if mmAddPermission.defaultExpectation.paramPtrs == nil {
+		mmAddPermission.defaultExpectation.paramPtrs = &RoleRepositoryMockAddPermissionParamPtrs{}
+	}
+	mmAddPermission.defaultExpectation.paramPtrs.perm = &perm
+	mmAddPermission.defaultExpectation.expectationOrigins.originPerm = minimock.CallerInfo(1)
+
+	return mmAddPermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.AddPermission
+func (mmAddPermission *mRoleRepositoryMockAddPermission) Inspect(f func(ctx context.Context, perm model.RolePermission)) *mRoleRepositoryMockAddPermission {
+	if mmAddPermission.mock.inspectFuncAddPermission != nil {
+		mmAddPermission.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.AddPermission")
+	}
+
+	mmAddPermission.mock.inspectFuncAddPermission = f
+
+	return mmAddPermission
+}
+
+// Return sets up results that will be returned by RoleRepository.AddPermission
+func (mmAddPermission *mRoleRepositoryMockAddPermission) Return(err error) *RoleRepositoryMock {
+	if mmAddPermission.mock.funcAddPermission != nil {
+		mmAddPermission.mock.t.Fatalf("RoleRepositoryMock.AddPermission mock is already set by Set")
+	}
+
+	if mmAddPermission.defaultExpectation == nil {
+		mmAddPermission.defaultExpectation = &RoleRepositoryMockAddPermissionExpectation{mock: mmAddPermission.mock}
+	}
+	mmAddPermission.defaultExpectation.results = &RoleRepositoryMockAddPermissionResults{err}
+	mmAddPermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddPermission.mock
+}
+
+// Set uses given function f to mock the RoleRepository.AddPermission method
+func (mmAddPermission *mRoleRepositoryMockAddPermission) Set(f func(ctx context.Context, perm model.RolePermission) (err error)) *RoleRepositoryMock {
+	if mmAddPermission.defaultExpectation != nil {
+		mmAddPermission.mock.t.Fatalf("Default expectation is already set for the RoleRepository.AddPermission method")
+	}
+
+	if len(mmAddPermission.expectations) > 0 {
+		mmAddPermission.mock.t.Fatalf("Some expectations are already set for the RoleRepository.AddPermission method")
+	}
+
+	mmAddPermission.mock.funcAddPermission = f
+	mmAddPermission.mock.funcAddPermissionOrigin = minimock.CallerInfo(1)
+	return mmAddPermission.mock
+}
+
+// When sets expectation for the RoleRepository.AddPermission which will trigger the result defined by the following
+// Then helper
+func (mmAddPermission *mRoleRepositoryMockAddPermission) When(ctx context.Context, perm model.RolePermission) *RoleRepositoryMockAddPermissionExpectation {
+	if mmAddPermission.mock.funcAddPermission != nil {
+		mmAddPermission.mock.t.Fatalf("RoleRepositoryMock.AddPermission mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockAddPermissionExpectation{
+		mock:               mmAddPermission.mock,
+		params:             &RoleRepositoryMockAddPermissionParams{ctx, perm},
+		expectationOrigins: RoleRepositoryMockAddPermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddPermission.expectations = append(mmAddPermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.AddPermission return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockAddPermissionExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockAddPermissionResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.AddPermission should be invoked
+func (mmAddPermission *mRoleRepositoryMockAddPermission) Times(n uint64) *mRoleRepositoryMockAddPermission {
+	if n == 0 {
+		mmAddPermission.mock.t.Fatalf("Times of RoleRepositoryMock.AddPermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddPermission.expectedInvocations, n)
+	mmAddPermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddPermission
+}
+
+func (mmAddPermission *mRoleRepositoryMockAddPermission) invocationsDone() bool {
+	if len(mmAddPermission.expectations) == 0 && mmAddPermission.defaultExpectation == nil && mmAddPermission.mock.funcAddPermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddPermission.mock.afterAddPermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddPermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddPermission implements mm_repository.RoleRepository
+func (mmAddPermission *RoleRepositoryMock) AddPermission(ctx context.Context, perm model.RolePermission) (err error) {
+	mm_atomic.AddUint64(&mmAddPermission.beforeAddPermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddPermission.afterAddPermissionCounter, 1)
+
+	mmAddPermission.t.Helper()
+
+	if mmAddPermission.inspectFuncAddPermission != nil {
+		mmAddPermission.inspectFuncAddPermission(ctx, perm)
+	}
+
+	mm_params := RoleRepositoryMockAddPermissionParams{ctx, perm}
+
+	// Record call args
+	mmAddPermission.AddPermissionMock.mutex.Lock()
+	mmAddPermission.AddPermissionMock.callArgs = append(mmAddPermission.AddPermissionMock.callArgs, &mm_params)
+	mmAddPermission.AddPermissionMock.mutex.Unlock()
+
+	for _, e := range mmAddPermission.AddPermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddPermission.AddPermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddPermission.AddPermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddPermission.AddPermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddPermission.AddPermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockAddPermissionParams{ctx, perm}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddPermission.t.Errorf("RoleRepositoryMock.AddPermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddPermission.AddPermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.perm != nil && !minimock.Equal(*mm_want_ptrs.perm, mm_got.perm) {
+				mmAddPermission.t.Errorf("RoleRepositoryMock.AddPermission got unexpected parameter perm, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddPermission.AddPermissionMock.defaultExpectation.expectationOrigins.originPerm, *mm_want_ptrs.perm, mm_got.perm, minimock.Diff(*mm_want_ptrs.perm, mm_got.perm))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddPermission.t.Errorf("RoleRepositoryMock.AddPermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddPermission.AddPermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddPermission.AddPermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddPermission.t.Fatal("No results are set for the RoleRepositoryMock.AddPermission")
+		}
+		return (*mm_results).err
+	}
+	if mmAddPermission.funcAddPermission != nil {
+		return mmAddPermission.funcAddPermission(ctx, perm)
+	}
+	mmAddPermission.t.Fatalf("Unexpected call to RoleRepositoryMock.AddPermission. %v %v", ctx, perm)
+	return
+}
+
+// AddPermissionAfterCounter returns a count of finished RoleRepositoryMock.AddPermission invocations
+func (mmAddPermission *RoleRepositoryMock) AddPermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddPermission.afterAddPermissionCounter)
+}
+
+// AddPermissionBeforeCounter returns a count of RoleRepositoryMock.AddPermission invocations
+func (mmAddPermission *RoleRepositoryMock) AddPermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddPermission.beforeAddPermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.AddPermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddPermission *mRoleRepositoryMockAddPermission) Calls() []*RoleRepositoryMockAddPermissionParams {
+	mmAddPermission.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockAddPermissionParams, len(mmAddPermission.callArgs))
+	copy(argCopy, mmAddPermission.callArgs)
+
+	mmAddPermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddPermissionDone returns true if the count of the AddPermission invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockAddPermissionDone() bool {
+	if m.AddPermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddPermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddPermissionMock.invocationsDone()
+}
+
+// MinimockAddPermissionInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockAddPermissionInspect() {
+	for _, e := range m.AddPermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.AddPermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddPermissionCounter := mm_atomic.LoadUint64(&m.afterAddPermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddPermissionMock.defaultExpectation != nil && afterAddPermissionCounter < 1 {
+		if m.AddPermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.AddPermission at\n%s", m.AddPermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.AddPermission at\n%s with params: %#v", m.AddPermissionMock.defaultExpectation.expectationOrigins.origin, *m.AddPermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddPermission != nil && afterAddPermissionCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.AddPermission at\n%s", m.funcAddPermissionOrigin)
+	}
+
+	if !m.AddPermissionMock.invocationsDone() && afterAddPermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.AddPermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddPermissionMock.expectedInvocations), m.AddPermissionMock.expectedInvocationsOrigin, afterAddPermissionCounter)
+	}
+}
+
+type mRoleRepositoryMockAssignRole struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockAssignRoleExpectation
+	expectations       []*RoleRepositoryMockAssignRoleExpectation
+
+	callArgs []*RoleRepositoryMockAssignRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockAssignRoleExpectation specifies expectation struct of the RoleRepository.AssignRole
+type RoleRepositoryMockAssignRoleExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockAssignRoleParams
+	paramPtrs          *RoleRepositoryMockAssignRoleParamPtrs
+	expectationOrigins RoleRepositoryMockAssignRoleExpectationOrigins
+	results            *RoleRepositoryMockAssignRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockAssignRoleParams contains parameters of the RoleRepository.AssignRole
+type RoleRepositoryMockAssignRoleParams struct {
+	ctx      context.Context
+	userRole model.UserRole
+}
+
+// RoleRepositoryMockAssignRoleParamPtrs contains pointers to parameters of the RoleRepository.AssignRole
+type RoleRepositoryMockAssignRoleParamPtrs struct {
+	ctx      *context.Context
+	userRole *model.UserRole
+}
+
+// RoleRepositoryMockAssignRoleResults contains results of the RoleRepository.AssignRole
+type RoleRepositoryMockAssignRoleResults struct {
+	err error
+}
+
+// RoleRepositoryMockAssignRoleOrigins contains origins of expectations of the RoleRepository.AssignRole
+type RoleRepositoryMockAssignRoleExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAssignRole *mRoleRepositoryMockAssignRole) Optional() *mRoleRepositoryMockAssignRole {
+	mmAssignRole.optional = true
+	return mmAssignRole
+}
+
+// Expect sets up expected params for RoleRepository.AssignRole
+func (mmAssignRole *mRoleRepositoryMockAssignRole) Expect(ctx context.Context, userRole model.UserRole) *mRoleRepositoryMockAssignRole {
+	if mmAssignRole.mock.funcAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("RoleRepositoryMock.AssignRole mock is already set by Set")
+	}
+
+	if mmAssignRole.defaultExpectation == nil {
+		mmAssignRole.defaultExpectation = &RoleRepositoryMockAssignRoleExpectation{}
+	}
+
+	if mmAssignRole.defaultExpectation.paramPtrs != nil {
+		mmAssignRole.mock.t.Fatalf("RoleRepositoryMock.AssignRole mock is already set by ExpectParams functions")
+	}
+
+	mmAssignRole.defaultExpectation.params = &RoleRepositoryMockAssignRoleParams{ctx, userRole}
+	mmAssignRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAssignRole.expectations {
+		if minimock.Equal(e.params, mmAssignRole.defaultExpectation.params) {
+			mmAssignRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAssignRole.defaultExpectation.params)
+		}
+	}
+
+	return mmAssignRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.AssignRole
+func (mmAssignRole *mRoleRepositoryMockAssignRole) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockAssignRole {
+	if mmAssignRole.mock.funcAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("RoleRepositoryMock.AssignRole mock is already set by Set")
+	}
+
+	if mmAssignRole.defaultExpectation == nil {
+		mmAssignRole.defaultExpectation = &RoleRepositoryMockAssignRoleExpectation{}
+	}
+
+	if mmAssignRole.defaultExpectation.params != nil {
+		mmAssignRole.mock.t.Fatalf("RoleRepositoryMock.AssignRole mock is already set by Expect")
+	}
+
+	if mmAssignRole.defaultExpectation.paramPtrs == nil {
+		mmAssignRole.defaultExpectation.paramPtrs = &RoleRepositoryMockAssignRoleParamPtrs{}
+	}
+	mmAssignRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAssignRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAssignRole
+}
+
+// ExpectUserRoleParam2 sets up expected param userRole for RoleRepository.AssignRole
+func (mmAssignRole *mRoleRepositoryMockAssignRole) ExpectUserRoleParam2(userRole model.UserRole) *mRoleRepositoryMockAssignRole {
+	if mmAssignRole.mock.funcAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("RoleRepositoryMock.AssignRole mock is already set by Set")
+	}
+
+	if mmAssignRole.defaultExpectation == nil {
+		mmAssignRole.defaultExpectation = &RoleRepositoryMockAssignRoleExpectation{}
+	}
+
+	if mmAssignRole.defaultExpectation.params != nil {
+		mmAssignRole.mock.t.Fatalf("RoleRepositoryMock.AssignRole mock is already set by Expect")
+	}
+
+	if mmAssignRole.defaultExpectation.paramPtrs == nil {
+		mmAssignRole.defaultExpectation.paramPtrs = &RoleRepositoryMockAssignRoleParamPtrs{}
+	}
+	mmAssignRole.defaultExpectation.paramPtrs.userRole = &userRole
+	mmAssignRole.defaultExpectation.expectationOrigins.originUserRole = minimock.CallerInfo(1)
+
+	return mmAssignRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.AssignRole
+func (mmAssignRole *mRoleRepositoryMockAssignRole) Inspect(f func(ctx context.Context, userRole model.UserRole)) *mRoleRepositoryMockAssignRole {
+	if mmAssignRole.mock.inspectFuncAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.AssignRole")
+	}
+
+	mmAssignRole.mock.inspectFuncAssignRole = f
+
+	return mmAssignRole
+}
+
+// Return sets up results that will be returned by RoleRepository.AssignRole
+func (mmAssignRole *mRoleRepositoryMockAssignRole) Return(err error) *RoleRepositoryMock {
+	if mmAssignRole.mock.funcAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("RoleRepositoryMock.AssignRole mock is already set by Set")
+	}
+
+	if mmAssignRole.defaultExpectation == nil {
+		mmAssignRole.defaultExpectation = &RoleRepositoryMockAssignRoleExpectation{mock: mmAssignRole.mock}
+	}
+	mmAssignRole.defaultExpectation.results = &RoleRepositoryMockAssignRoleResults{err}
+	mmAssignRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAssignRole.mock
+}
+
+// Set uses given function f to mock the RoleRepository.AssignRole method
+func (mmAssignRole *mRoleRepositoryMockAssignRole) Set(f func(ctx context.Context, userRole model.UserRole) (err error)) *RoleRepositoryMock {
+	if mmAssignRole.defaultExpectation != nil {
+		mmAssignRole.mock.t.Fatalf("Default expectation is already set for the RoleRepository.AssignRole method")
+	}
+
+	if len(mmAssignRole.expectations) > 0 {
+		mmAssignRole.mock.t.Fatalf("Some expectations are already set for the RoleRepository.AssignRole method")
+	}
+
+	mmAssignRole.mock.funcAssignRole = f
+	mmAssignRole.mock.funcAssignRoleOrigin = minimock.CallerInfo(1)
+	return mmAssignRole.mock
+}
+
+// When sets expectation for the RoleRepository.AssignRole which will trigger the result defined by the following
+// Then helper
+func (mmAssignRole *mRoleRepositoryMockAssignRole) When(ctx context.Context, userRole model.UserRole) *RoleRepositoryMockAssignRoleExpectation {
+	if mmAssignRole.mock.funcAssignRole != nil {
+		mmAssignRole.mock.t.Fatalf("RoleRepositoryMock.AssignRole mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockAssignRoleExpectation{
+		mock:               mmAssignRole.mock,
+		params:             &RoleRepositoryMockAssignRoleParams{ctx, userRole},
+		expectationOrigins: RoleRepositoryMockAssignRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAssignRole.expectations = append(mmAssignRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.AssignRole return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockAssignRoleExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockAssignRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.AssignRole should be invoked
+func (mmAssignRole *mRoleRepositoryMockAssignRole) Times(n uint64) *mRoleRepositoryMockAssignRole {
+	if n == 0 {
+		mmAssignRole.mock.t.Fatalf("Times of RoleRepositoryMock.AssignRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAssignRole.expectedInvocations, n)
+	mmAssignRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAssignRole
+}
+
+func (mmAssignRole *mRoleRepositoryMockAssignRole) invocationsDone() bool {
+	if len(mmAssignRole.expectations) == 0 && mmAssignRole.defaultExpectation == nil && mmAssignRole.mock.funcAssignRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAssignRole.mock.afterAssignRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAssignRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AssignRole implements mm_repository.RoleRepository
+func (mmAssignRole *RoleRepositoryMock) AssignRole(ctx context.Context, userRole model.UserRole) (err error) {
+	mm_atomic.AddUint64(&mmAssignRole.beforeAssignRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmAssignRole.afterAssignRoleCounter, 1)
+
+	mmAssignRole.t.Helper()
+
+	if mmAssignRole.inspectFuncAssignRole != nil {
+		mmAssignRole.inspectFuncAssignRole(ctx, userRole)
+	}
+
+	mm_params := RoleRepositoryMockAssignRoleParams{ctx, userRole}
+
+	// Record call args
+	mmAssignRole.AssignRoleMock.mutex.Lock()
+	mmAssignRole.AssignRoleMock.callArgs = append(mmAssignRole.AssignRoleMock.callArgs, &mm_params)
+	mmAssignRole.AssignRoleMock.mutex.Unlock()
+
+	for _, e := range mmAssignRole.AssignRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAssignRole.AssignRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAssignRole.AssignRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmAssignRole.AssignRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmAssignRole.AssignRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockAssignRoleParams{ctx, userRole}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAssignRole.t.Errorf("RoleRepositoryMock.AssignRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAssignRole.AssignRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userRole != nil && !minimock.Equal(*mm_want_ptrs.userRole, mm_got.userRole) {
+				mmAssignRole.t.Errorf("RoleRepositoryMock.AssignRole got unexpected parameter userRole, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAssignRole.AssignRoleMock.defaultExpectation.expectationOrigins.originUserRole, *mm_want_ptrs.userRole, mm_got.userRole, minimock.Diff(*mm_want_ptrs.userRole, mm_got.userRole))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAssignRole.t.Errorf("RoleRepositoryMock.AssignRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAssignRole.AssignRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAssignRole.AssignRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAssignRole.t.Fatal("No results are set for the RoleRepositoryMock.AssignRole")
+		}
+		return (*mm_results).err
+	}
+	if mmAssignRole.funcAssignRole != nil {
+		return mmAssignRole.funcAssignRole(ctx, userRole)
+	}
+	mmAssignRole.t.Fatalf("Unexpected call to RoleRepositoryMock.AssignRole. %v %v", ctx, userRole)
+	return
+}
+
+// AssignRoleAfterCounter returns a count of finished RoleRepositoryMock.AssignRole invocations
+func (mmAssignRole *RoleRepositoryMock) AssignRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignRole.afterAssignRoleCounter)
+}
+
+// AssignRoleBeforeCounter returns a count of RoleRepositoryMock.AssignRole invocations
+func (mmAssignRole *RoleRepositoryMock) AssignRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignRole.beforeAssignRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.AssignRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAssignRole *mRoleRepositoryMockAssignRole) Calls() []*RoleRepositoryMockAssignRoleParams {
+	mmAssignRole.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockAssignRoleParams, len(mmAssignRole.callArgs))
+	copy(argCopy, mmAssignRole.callArgs)
+
+	mmAssignRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAssignRoleDone returns true if the count of the AssignRole invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockAssignRoleDone() bool {
+	if m.AssignRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AssignRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AssignRoleMock.invocationsDone()
+}
+
+// MinimockAssignRoleInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockAssignRoleInspect() {
+	for _, e := range m.AssignRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.AssignRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAssignRoleCounter := mm_atomic.LoadUint64(&m.afterAssignRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AssignRoleMock.defaultExpectation != nil && afterAssignRoleCounter < 1 {
+		if m.AssignRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.AssignRole at\n%s", m.AssignRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.AssignRole at\n%s with params: %#v", m.AssignRoleMock.defaultExpectation.expectationOrigins.origin, *m.AssignRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAssignRole != nil && afterAssignRoleCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.AssignRole at\n%s", m.funcAssignRoleOrigin)
+	}
+
+	if !m.AssignRoleMock.invocationsDone() && afterAssignRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.AssignRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AssignRoleMock.expectedInvocations), m.AssignRoleMock.expectedInvocationsOrigin, afterAssignRoleCounter)
+	}
+}
+
+type mRoleRepositoryMockCreateAudit struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockCreateAuditExpectation
+	expectations       []*RoleRepositoryMockCreateAuditExpectation
+
+	callArgs []*RoleRepositoryMockCreateAuditParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockCreateAuditExpectation specifies expectation struct of the RoleRepository.CreateAudit
+type RoleRepositoryMockCreateAuditExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockCreateAuditParams
+	paramPtrs          *RoleRepositoryMockCreateAuditParamPtrs
+	expectationOrigins RoleRepositoryMockCreateAuditExpectationOrigins
+	results            *RoleRepositoryMockCreateAuditResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockCreateAuditParams contains parameters of the RoleRepository.CreateAudit
+type RoleRepositoryMockCreateAuditParams struct {
+	ctx   context.Context
+	audit model.Audit
+}
+
+// RoleRepositoryMockCreateAuditParamPtrs contains pointers to parameters of the RoleRepository.CreateAudit
+type RoleRepositoryMockCreateAuditParamPtrs struct {
+	ctx   *context.Context
+	audit *model.Audit
+}
+
+// RoleRepositoryMockCreateAuditResults contains results of the RoleRepository.CreateAudit
+type RoleRepositoryMockCreateAuditResults struct {
+	err error
+}
+
+// RoleRepositoryMockCreateAuditOrigins contains origins of expectations of the RoleRepository.CreateAudit
+type RoleRepositoryMockCreateAuditExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originAudit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAudit *mRoleRepositoryMockCreateAudit) Optional() *mRoleRepositoryMockCreateAudit {
+	mmCreateAudit.optional = true
+	return mmCreateAudit
+}
+
+// Expect sets up expected params for RoleRepository.CreateAudit
+func (mmCreateAudit *mRoleRepositoryMockCreateAudit) Expect(ctx context.Context, audit model.Audit) *mRoleRepositoryMockCreateAudit {
+	if mmCreateAudit.mock.funcCreateAudit != nil {
+		mmCreateAudit.mock.t.Fatalf("RoleRepositoryMock.CreateAudit mock is already set by Set")
+	}
+
+	if mmCreateAudit.defaultExpectation == nil {
+		mmCreateAudit.defaultExpectation = &RoleRepositoryMockCreateAuditExpectation{}
+	}
+
+	if mmCreateAudit.defaultExpectation.paramPtrs != nil {
+		mmCreateAudit.mock.t.Fatalf("RoleRepositoryMock.CreateAudit mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAudit.defaultExpectation.params = &RoleRepositoryMockCreateAuditParams{ctx, audit}
+	mmCreateAudit.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAudit.expectations {
+		if minimock.Equal(e.params, mmCreateAudit.defaultExpectation.params) {
+			mmCreateAudit.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAudit.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAudit
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.CreateAudit
+func (mmCreateAudit *mRoleRepositoryMockCreateAudit) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockCreateAudit {
+	if mmCreateAudit.mock.funcCreateAudit != nil {
+		mmCreateAudit.mock.t.Fatalf("RoleRepositoryMock.CreateAudit mock is already set by Set")
+	}
+
+	if mmCreateAudit.defaultExpectation == nil {
+		mmCreateAudit.defaultExpectation = &RoleRepositoryMockCreateAuditExpectation{}
+	}
+
+	if mmCreateAudit.defaultExpectation.params != nil {
+		mmCreateAudit.mock.t.Fatalf("RoleRepositoryMock.CreateAudit mock is already set by Expect")
+	}
+
+	if mmCreateAudit.defaultExpectation.paramPtrs == nil {
+		mmCreateAudit.defaultExpectation.paramPtrs = &RoleRepositoryMockCreateAuditParamPtrs{}
+	}
+	mmCreateAudit.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAudit.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateAudit
+}
+
+// ExpectAuditParam2 sets up expected param audit for RoleRepository.CreateAudit
+func (mmCreateAudit *mRoleRepositoryMockCreateAudit) ExpectAuditParam2(audit model.Audit) *mRoleRepositoryMockCreateAudit {
+	if mmCreateAudit.mock.funcCreateAudit != nil {
+		mmCreateAudit.mock.t.Fatalf("RoleRepositoryMock.CreateAudit mock is already set by Set")
+	}
+
+	if mmCreateAudit.defaultExpectation == nil {
+		mmCreateAudit.defaultExpectation = &RoleRepositoryMockCreateAuditExpectation{}
+	}
+
+	if mmCreateAudit.defaultExpectation.params != nil {
+		mmCreateAudit.mock.t.Fatalf("RoleRepositoryMock.CreateAudit mock is already set by Expect")
+	}
+
+	if mmCreateAudit.defaultExpectation.paramPtrs == nil {
+		mmCreateAudit.defaultExpectation.paramPtrs = &RoleRepositoryMockCreateAuditParamPtrs{}
+	}
+	mmCreateAudit.defaultExpectation.paramPtrs.audit = &audit
+	mmCreateAudit.defaultExpectation.expectationOrigins.originAudit = minimock.CallerInfo(1)
+
+	return mmCreateAudit
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.CreateAudit
+func (mmCreateAudit *mRoleRepositoryMockCreateAudit) Inspect(f func(ctx context.Context, audit model.Audit)) *mRoleRepositoryMockCreateAudit {
+	if mmCreateAudit.mock.inspectFuncCreateAudit != nil {
+		mmCreateAudit.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.CreateAudit")
+	}
+
+	mmCreateAudit.mock.inspectFuncCreateAudit = f
+
+	return mmCreateAudit
+}
+
+// Return sets up results that will be returned by RoleRepository.CreateAudit
+func (mmCreateAudit *mRoleRepositoryMockCreateAudit) Return(err error) *RoleRepositoryMock {
+	if mmCreateAudit.mock.funcCreateAudit != nil {
+		mmCreateAudit.mock.t.Fatalf("RoleRepositoryMock.CreateAudit mock is already set by Set")
+	}
+
+	if mmCreateAudit.defaultExpectation == nil {
+		mmCreateAudit.defaultExpectation = &RoleRepositoryMockCreateAuditExpectation{mock: mmCreateAudit.mock}
+	}
+	mmCreateAudit.defaultExpectation.results = &RoleRepositoryMockCreateAuditResults{err}
+	mmCreateAudit.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAudit.mock
+}
+
+// Set uses given function f to mock the RoleRepository.CreateAudit method
+func (mmCreateAudit *mRoleRepositoryMockCreateAudit) Set(f func(ctx context.Context, audit model.Audit) (err error)) *RoleRepositoryMock {
+	if mmCreateAudit.defaultExpectation != nil {
+		mmCreateAudit.mock.t.Fatalf("Default expectation is already set for the RoleRepository.CreateAudit method")
+	}
+
+	if len(mmCreateAudit.expectations) > 0 {
+		mmCreateAudit.mock.t.Fatalf("Some expectations are already set for the RoleRepository.CreateAudit method")
+	}
+
+	mmCreateAudit.mock.funcCreateAudit = f
+	mmCreateAudit.mock.funcCreateAuditOrigin = minimock.CallerInfo(1)
+	return mmCreateAudit.mock
+}
+
+// When sets expectation for the RoleRepository.CreateAudit which will trigger the result defined by the following
+// Then helper
+func (mmCreateAudit *mRoleRepositoryMockCreateAudit) When(ctx context.Context, audit model.Audit) *RoleRepositoryMockCreateAuditExpectation {
+	if mmCreateAudit.mock.funcCreateAudit != nil {
+		mmCreateAudit.mock.t.Fatalf("RoleRepositoryMock.CreateAudit mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockCreateAuditExpectation{
+		mock:               mmCreateAudit.mock,
+		params:             &RoleRepositoryMockCreateAuditParams{ctx, audit},
+		expectationOrigins: RoleRepositoryMockCreateAuditExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAudit.expectations = append(mmCreateAudit.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.CreateAudit return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockCreateAuditExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockCreateAuditResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.CreateAudit should be invoked
+func (mmCreateAudit *mRoleRepositoryMockCreateAudit) Times(n uint64) *mRoleRepositoryMockCreateAudit {
+	if n == 0 {
+		mmCreateAudit.mock.t.Fatalf("Times of RoleRepositoryMock.CreateAudit mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAudit.expectedInvocations, n)
+	mmCreateAudit.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAudit
+}
+
+func (mmCreateAudit *mRoleRepositoryMockCreateAudit) invocationsDone() bool {
+	if len(mmCreateAudit.expectations) == 0 && mmCreateAudit.defaultExpectation == nil && mmCreateAudit.mock.funcCreateAudit == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAudit.mock.afterCreateAuditCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAudit.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAudit implements mm_repository.RoleRepository
+func (mmCreateAudit *RoleRepositoryMock) CreateAudit(ctx context.Context, audit model.Audit) (err error) {
+	mm_atomic.AddUint64(&mmCreateAudit.beforeCreateAuditCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAudit.afterCreateAuditCounter, 1)
+
+	mmCreateAudit.t.Helper()
+
+	if mmCreateAudit.inspectFuncCreateAudit != nil {
+		mmCreateAudit.inspectFuncCreateAudit(ctx, audit)
+	}
+
+	mm_params := RoleRepositoryMockCreateAuditParams{ctx, audit}
+
+	// Record call args
+	mmCreateAudit.CreateAuditMock.mutex.Lock()
+	mmCreateAudit.CreateAuditMock.callArgs = append(mmCreateAudit.CreateAuditMock.callArgs, &mm_params)
+	mmCreateAudit.CreateAuditMock.mutex.Unlock()
+
+	for _, e := range mmCreateAudit.CreateAuditMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateAudit.CreateAuditMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAudit.CreateAuditMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAudit.CreateAuditMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAudit.CreateAuditMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockCreateAuditParams{ctx, audit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAudit.t.Errorf("RoleRepositoryMock.CreateAudit got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAudit.CreateAuditMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.audit != nil && !minimock.Equal(*mm_want_ptrs.audit, mm_got.audit) {
+				mmCreateAudit.t.Errorf("RoleRepositoryMock.CreateAudit got unexpected parameter audit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAudit.CreateAuditMock.defaultExpectation.expectationOrigins.originAudit, *mm_want_ptrs.audit, mm_got.audit, minimock.Diff(*mm_want_ptrs.audit, mm_got.audit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAudit.t.Errorf("RoleRepositoryMock.CreateAudit got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAudit.CreateAuditMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAudit.CreateAuditMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAudit.t.Fatal("No results are set for the RoleRepositoryMock.CreateAudit")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateAudit.funcCreateAudit != nil {
+		return mmCreateAudit.funcCreateAudit(ctx, audit)
+	}
+	mmCreateAudit.t.Fatalf("Unexpected call to RoleRepositoryMock.CreateAudit. %v %v", ctx, audit)
+	return
+}
+
+// CreateAuditAfterCounter returns a count of finished RoleRepositoryMock.CreateAudit invocations
+func (mmCreateAudit *RoleRepositoryMock) CreateAuditAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAudit.afterCreateAuditCounter)
+}
+
+// CreateAuditBeforeCounter returns a count of RoleRepositoryMock.CreateAudit invocations
+func (mmCreateAudit *RoleRepositoryMock) CreateAuditBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAudit.beforeCreateAuditCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.CreateAudit.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAudit *mRoleRepositoryMockCreateAudit) Calls() []*RoleRepositoryMockCreateAuditParams {
+	mmCreateAudit.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockCreateAuditParams, len(mmCreateAudit.callArgs))
+	copy(argCopy, mmCreateAudit.callArgs)
+
+	mmCreateAudit.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAuditDone returns true if the count of the CreateAudit invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockCreateAuditDone() bool {
+	if m.CreateAuditMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAuditMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAuditMock.invocationsDone()
+}
+
+// MinimockCreateAuditInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockCreateAuditInspect() {
+	for _, e := range m.CreateAuditMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.CreateAudit at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateAuditCounter := mm_atomic.LoadUint64(&m.afterCreateAuditCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAuditMock.defaultExpectation != nil && afterCreateAuditCounter < 1 {
+		if m.CreateAuditMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.CreateAudit at\n%s", m.CreateAuditMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.CreateAudit at\n%s with params: %#v", m.CreateAuditMock.defaultExpectation.expectationOrigins.origin, *m.CreateAuditMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAudit != nil && afterCreateAuditCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.CreateAudit at\n%s", m.funcCreateAuditOrigin)
+	}
+
+	if !m.CreateAuditMock.invocationsDone() && afterCreateAuditCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.CreateAudit at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAuditMock.expectedInvocations), m.CreateAuditMock.expectedInvocationsOrigin, afterCreateAuditCounter)
+	}
+}
+
+type mRoleRepositoryMockCreateRole struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockCreateRoleExpectation
+	expectations       []*RoleRepositoryMockCreateRoleExpectation
+
+	callArgs []*RoleRepositoryMockCreateRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockCreateRoleExpectation specifies expectation struct of the RoleRepository.CreateRole
+type RoleRepositoryMockCreateRoleExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockCreateRoleParams
+	paramPtrs          *RoleRepositoryMockCreateRoleParamPtrs
+	expectationOrigins RoleRepositoryMockCreateRoleExpectationOrigins
+	results            *RoleRepositoryMockCreateRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockCreateRoleParams contains parameters of the RoleRepository.CreateRole
+type RoleRepositoryMockCreateRoleParams struct {
+	ctx  context.Context
+	name string
+}
+
+// RoleRepositoryMockCreateRoleParamPtrs contains pointers to parameters of the RoleRepository.CreateRole
+type RoleRepositoryMockCreateRoleParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// RoleRepositoryMockCreateRoleResults contains results of the RoleRepository.CreateRole
+type RoleRepositoryMockCreateRoleResults struct {
+	i1  int64
+	err error
+}
+
+// RoleRepositoryMockCreateRoleOrigins contains origins of expectations of the RoleRepository.CreateRole
+type RoleRepositoryMockCreateRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Optional() *mRoleRepositoryMockCreateRole {
+	mmCreateRole.optional = true
+	return mmCreateRole
+}
+
+// Expect sets up expected params for RoleRepository.CreateRole
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Expect(ctx context.Context, name string) *mRoleRepositoryMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &RoleRepositoryMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRole.defaultExpectation.params = &RoleRepositoryMockCreateRoleParams{ctx, name}
+	mmCreateRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRole.expectations {
+		if minimock.Equal(e.params, mmCreateRole.defaultExpectation.params) {
+			mmCreateRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRole.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.CreateRole
+func (mmCreateRole *mRoleRepositoryMockCreateRole) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &RoleRepositoryMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.params != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Expect")
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs == nil {
+		mmCreateRole.defaultExpectation.paramPtrs = &RoleRepositoryMockCreateRoleParamPtrs{}
+	}
+	mmCreateRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRole
+}
+
+// ExpectNameParam2 sets up expected param name for RoleRepository.CreateRole
+func (mmCreateRole *mRoleRepositoryMockCreateRole) ExpectNameParam2(name string) *mRoleRepositoryMockCreateRole {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &RoleRepositoryMockCreateRoleExpectation{}
+	}
+
+	if mmCreateRole.defaultExpectation.params != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Expect")
+	}
+
+	if mmCreateRole.defaultExpectation.paramPtrs == nil {
+		mmCreateRole.defaultExpectation.paramPtrs = &RoleRepositoryMockCreateRoleParamPtrs{}
+	}
+	mmCreateRole.defaultExpectation.paramPtrs.name = &name
+	mmCreateRole.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmCreateRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.CreateRole
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Inspect(f func(ctx context.Context, name string)) *mRoleRepositoryMockCreateRole {
+	if mmCreateRole.mock.inspectFuncCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.CreateRole")
+	}
+
+	mmCreateRole.mock.inspectFuncCreateRole = f
+
+	return mmCreateRole
+}
+
+// Return sets up results that will be returned by RoleRepository.CreateRole
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Return(i1 int64, err error) *RoleRepositoryMock {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Set")
+	}
+
+	if mmCreateRole.defaultExpectation == nil {
+		mmCreateRole.defaultExpectation = &RoleRepositoryMockCreateRoleExpectation{mock: mmCreateRole.mock}
+	}
+	mmCreateRole.defaultExpectation.results = &RoleRepositoryMockCreateRoleResults{i1, err}
+	mmCreateRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRole.mock
+}
+
+// Set uses given function f to mock the RoleRepository.CreateRole method
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Set(f func(ctx context.Context, name string) (i1 int64, err error)) *RoleRepositoryMock {
+	if mmCreateRole.defaultExpectation != nil {
+		mmCreateRole.mock.t.Fatalf("Default expectation is already set for the RoleRepository.CreateRole method")
+	}
+
+	if len(mmCreateRole.expectations) > 0 {
+		mmCreateRole.mock.t.Fatalf("Some expectations are already set for the RoleRepository.CreateRole method")
+	}
+
+	mmCreateRole.mock.funcCreateRole = f
+	mmCreateRole.mock.funcCreateRoleOrigin = minimock.CallerInfo(1)
+	return mmCreateRole.mock
+}
+
+// When sets expectation for the RoleRepository.CreateRole which will trigger the result defined by the following
+// Then helper
+func (mmCreateRole *mRoleRepositoryMockCreateRole) When(ctx context.Context, name string) *RoleRepositoryMockCreateRoleExpectation {
+	if mmCreateRole.mock.funcCreateRole != nil {
+		mmCreateRole.mock.t.Fatalf("RoleRepositoryMock.CreateRole mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockCreateRoleExpectation{
+		mock:               mmCreateRole.mock,
+		params:             &RoleRepositoryMockCreateRoleParams{ctx, name},
+		expectationOrigins: RoleRepositoryMockCreateRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRole.expectations = append(mmCreateRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.CreateRole return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockCreateRoleExpectation) Then(i1 int64, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockCreateRoleResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.CreateRole should be invoked
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Times(n uint64) *mRoleRepositoryMockCreateRole {
+	if n == 0 {
+		mmCreateRole.mock.t.Fatalf("Times of RoleRepositoryMock.CreateRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRole.expectedInvocations, n)
+	mmCreateRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRole
+}
+
+func (mmCreateRole *mRoleRepositoryMockCreateRole) invocationsDone() bool {
+	if len(mmCreateRole.expectations) == 0 && mmCreateRole.defaultExpectation == nil && mmCreateRole.mock.funcCreateRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRole.mock.afterCreateRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRole implements mm_repository.RoleRepository
+func (mmCreateRole *RoleRepositoryMock) CreateRole(ctx context.Context, name string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateRole.beforeCreateRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRole.afterCreateRoleCounter, 1)
+
+	mmCreateRole.t.Helper()
+
+	if mmCreateRole.inspectFuncCreateRole != nil {
+		mmCreateRole.inspectFuncCreateRole(ctx, name)
+	}
+
+	mm_params := RoleRepositoryMockCreateRoleParams{ctx, name}
+
+	// Record call args
+	mmCreateRole.CreateRoleMock.mutex.Lock()
+	mmCreateRole.CreateRoleMock.callArgs = append(mmCreateRole.CreateRoleMock.callArgs, &mm_params)
+	mmCreateRole.CreateRoleMock.mutex.Unlock()
+
+	for _, e := range mmCreateRole.CreateRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateRole.CreateRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRole.CreateRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRole.CreateRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRole.CreateRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockCreateRoleParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRole.t.Errorf("RoleRepositoryMock.CreateRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmCreateRole.t.Errorf("RoleRepositoryMock.CreateRole got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRole.t.Errorf("RoleRepositoryMock.CreateRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRole.CreateRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRole.CreateRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRole.t.Fatal("No results are set for the RoleRepositoryMock.CreateRole")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateRole.funcCreateRole != nil {
+		return mmCreateRole.funcCreateRole(ctx, name)
+	}
+	mmCreateRole.t.Fatalf("Unexpected call to RoleRepositoryMock.CreateRole. %v %v", ctx, name)
+	return
+}
+
+// CreateRoleAfterCounter returns a count of finished RoleRepositoryMock.CreateRole invocations
+func (mmCreateRole *RoleRepositoryMock) CreateRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRole.afterCreateRoleCounter)
+}
+
+// CreateRoleBeforeCounter returns a count of RoleRepositoryMock.CreateRole invocations
+func (mmCreateRole *RoleRepositoryMock) CreateRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRole.beforeCreateRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.CreateRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRole *mRoleRepositoryMockCreateRole) Calls() []*RoleRepositoryMockCreateRoleParams {
+	mmCreateRole.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockCreateRoleParams, len(mmCreateRole.callArgs))
+	copy(argCopy, mmCreateRole.callArgs)
+
+	mmCreateRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRoleDone returns true if the count of the CreateRole invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockCreateRoleDone() bool {
+	if m.CreateRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRoleMock.invocationsDone()
+}
+
+// MinimockCreateRoleInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockCreateRoleInspect() {
+	for _, e := range m.CreateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.CreateRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRoleCounter := mm_atomic.LoadUint64(&m.afterCreateRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRoleMock.defaultExpectation != nil && afterCreateRoleCounter < 1 {
+		if m.CreateRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.CreateRole at\n%s", m.CreateRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.CreateRole at\n%s with params: %#v", m.CreateRoleMock.defaultExpectation.expectationOrigins.origin, *m.CreateRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRole != nil && afterCreateRoleCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.CreateRole at\n%s", m.funcCreateRoleOrigin)
+	}
+
+	if !m.CreateRoleMock.invocationsDone() && afterCreateRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.CreateRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRoleMock.expectedInvocations), m.CreateRoleMock.expectedInvocationsOrigin, afterCreateRoleCounter)
+	}
+}
+
+type mRoleRepositoryMockDeleteRole struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockDeleteRoleExpectation
+	expectations       []*RoleRepositoryMockDeleteRoleExpectation
+
+	callArgs []*RoleRepositoryMockDeleteRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockDeleteRoleExpectation specifies expectation struct of the RoleRepository.DeleteRole
+type RoleRepositoryMockDeleteRoleExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockDeleteRoleParams
+	paramPtrs          *RoleRepositoryMockDeleteRoleParamPtrs
+	expectationOrigins RoleRepositoryMockDeleteRoleExpectationOrigins
+	results            *RoleRepositoryMockDeleteRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockDeleteRoleParams contains parameters of the RoleRepository.DeleteRole
+type RoleRepositoryMockDeleteRoleParams struct {
+	ctx  context.Context
+	name string
+}
+
+// RoleRepositoryMockDeleteRoleParamPtrs contains pointers to parameters of the RoleRepository.DeleteRole
+type RoleRepositoryMockDeleteRoleParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// RoleRepositoryMockDeleteRoleResults contains results of the RoleRepository.DeleteRole
+type RoleRepositoryMockDeleteRoleResults struct {
+	ra1 []model.RoleHolder
+	err error
+}
+
+// RoleRepositoryMockDeleteRoleOrigins contains origins of expectations of the RoleRepository.DeleteRole
+type RoleRepositoryMockDeleteRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Optional() *mRoleRepositoryMockDeleteRole {
+	mmDeleteRole.optional = true
+	return mmDeleteRole
+}
+
+// Expect sets up expected params for RoleRepository.DeleteRole
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Expect(ctx context.Context, name string) *mRoleRepositoryMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &RoleRepositoryMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRole.defaultExpectation.params = &RoleRepositoryMockDeleteRoleParams{ctx, name}
+	mmDeleteRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRole.expectations {
+		if minimock.Equal(e.params, mmDeleteRole.defaultExpectation.params) {
+			mmDeleteRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRole.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.DeleteRole
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &RoleRepositoryMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.params != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Expect")
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteRole.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteRoleParamPtrs{}
+	}
+	mmDeleteRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRole
+}
+
+// ExpectNameParam2 sets up expected param name for RoleRepository.DeleteRole
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) ExpectNameParam2(name string) *mRoleRepositoryMockDeleteRole {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &RoleRepositoryMockDeleteRoleExpectation{}
+	}
+
+	if mmDeleteRole.defaultExpectation.params != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Expect")
+	}
+
+	if mmDeleteRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteRole.defaultExpectation.paramPtrs = &RoleRepositoryMockDeleteRoleParamPtrs{}
+	}
+	mmDeleteRole.defaultExpectation.paramPtrs.name = &name
+	mmDeleteRole.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeleteRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.DeleteRole
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Inspect(f func(ctx context.Context, name string)) *mRoleRepositoryMockDeleteRole {
+	if mmDeleteRole.mock.inspectFuncDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.DeleteRole")
+	}
+
+	mmDeleteRole.mock.inspectFuncDeleteRole = f
+
+	return mmDeleteRole
+}
+
+// Return sets up results that will be returned by RoleRepository.DeleteRole
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Return(ra1 []model.RoleHolder, err error) *RoleRepositoryMock {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Set")
+	}
+
+	if mmDeleteRole.defaultExpectation == nil {
+		mmDeleteRole.defaultExpectation = &RoleRepositoryMockDeleteRoleExpectation{mock: mmDeleteRole.mock}
+	}
+	mmDeleteRole.defaultExpectation.results = &RoleRepositoryMockDeleteRoleResults{ra1, err}
+	mmDeleteRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRole.mock
+}
+
+// Set uses given function f to mock the RoleRepository.DeleteRole method
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Set(f func(ctx context.Context, name string) (ra1 []model.RoleHolder, err error)) *RoleRepositoryMock {
+	if mmDeleteRole.defaultExpectation != nil {
+		mmDeleteRole.mock.t.Fatalf("Default expectation is already set for the RoleRepository.DeleteRole method")
+	}
+
+	if len(mmDeleteRole.expectations) > 0 {
+		mmDeleteRole.mock.t.Fatalf("Some expectations are already set for the RoleRepository.DeleteRole method")
+	}
+
+	mmDeleteRole.mock.funcDeleteRole = f
+	mmDeleteRole.mock.funcDeleteRoleOrigin = minimock.CallerInfo(1)
+	return mmDeleteRole.mock
+}
+
+// When sets expectation for the RoleRepository.DeleteRole which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) When(ctx context.Context, name string) *RoleRepositoryMockDeleteRoleExpectation {
+	if mmDeleteRole.mock.funcDeleteRole != nil {
+		mmDeleteRole.mock.t.Fatalf("RoleRepositoryMock.DeleteRole mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockDeleteRoleExpectation{
+		mock:               mmDeleteRole.mock,
+		params:             &RoleRepositoryMockDeleteRoleParams{ctx, name},
+		expectationOrigins: RoleRepositoryMockDeleteRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRole.expectations = append(mmDeleteRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.DeleteRole return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockDeleteRoleExpectation) Then(ra1 []model.RoleHolder, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockDeleteRoleResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.DeleteRole should be invoked
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Times(n uint64) *mRoleRepositoryMockDeleteRole {
+	if n == 0 {
+		mmDeleteRole.mock.t.Fatalf("Times of RoleRepositoryMock.DeleteRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRole.expectedInvocations, n)
+	mmDeleteRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRole
+}
+
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) invocationsDone() bool {
+	if len(mmDeleteRole.expectations) == 0 && mmDeleteRole.defaultExpectation == nil && mmDeleteRole.mock.funcDeleteRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRole.mock.afterDeleteRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRole implements mm_repository.RoleRepository
+func (mmDeleteRole *RoleRepositoryMock) DeleteRole(ctx context.Context, name string) (ra1 []model.RoleHolder, err error) {
+	mm_atomic.AddUint64(&mmDeleteRole.beforeDeleteRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRole.afterDeleteRoleCounter, 1)
+
+	mmDeleteRole.t.Helper()
+
+	if mmDeleteRole.inspectFuncDeleteRole != nil {
+		mmDeleteRole.inspectFuncDeleteRole(ctx, name)
+	}
+
+	mm_params := RoleRepositoryMockDeleteRoleParams{ctx, name}
+
+	// Record call args
+	mmDeleteRole.DeleteRoleMock.mutex.Lock()
+	mmDeleteRole.DeleteRoleMock.callArgs = append(mmDeleteRole.DeleteRoleMock.callArgs, &mm_params)
+	mmDeleteRole.DeleteRoleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRole.DeleteRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmDeleteRole.DeleteRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRole.DeleteRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRole.DeleteRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRole.DeleteRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockDeleteRoleParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRole.t.Errorf("RoleRepositoryMock.DeleteRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRole.DeleteRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeleteRole.t.Errorf("RoleRepositoryMock.DeleteRole got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRole.DeleteRoleMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRole.t.Errorf("RoleRepositoryMock.DeleteRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRole.DeleteRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRole.DeleteRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRole.t.Fatal("No results are set for the RoleRepositoryMock.DeleteRole")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmDeleteRole.funcDeleteRole != nil {
+		return mmDeleteRole.funcDeleteRole(ctx, name)
+	}
+	mmDeleteRole.t.Fatalf("Unexpected call to RoleRepositoryMock.DeleteRole. %v %v", ctx, name)
+	return
+}
+
+// DeleteRoleAfterCounter returns a count of finished RoleRepositoryMock.DeleteRole invocations
+func (mmDeleteRole *RoleRepositoryMock) DeleteRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRole.afterDeleteRoleCounter)
+}
+
+// DeleteRoleBeforeCounter returns a count of RoleRepositoryMock.DeleteRole invocations
+func (mmDeleteRole *RoleRepositoryMock) DeleteRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRole.beforeDeleteRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.DeleteRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRole *mRoleRepositoryMockDeleteRole) Calls() []*RoleRepositoryMockDeleteRoleParams {
+	mmDeleteRole.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockDeleteRoleParams, len(mmDeleteRole.callArgs))
+	copy(argCopy, mmDeleteRole.callArgs)
+
+	mmDeleteRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRoleDone returns true if the count of the DeleteRole invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockDeleteRoleDone() bool {
+	if m.DeleteRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRoleMock.invocationsDone()
+}
+
+// MinimockDeleteRoleInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockDeleteRoleInspect() {
+	for _, e := range m.DeleteRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRoleMock.defaultExpectation != nil && afterDeleteRoleCounter < 1 {
+		if m.DeleteRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRole at\n%s", m.DeleteRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRole at\n%s with params: %#v", m.DeleteRoleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRole != nil && afterDeleteRoleCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.DeleteRole at\n%s", m.funcDeleteRoleOrigin)
+	}
+
+	if !m.DeleteRoleMock.invocationsDone() && afterDeleteRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.DeleteRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRoleMock.expectedInvocations), m.DeleteRoleMock.expectedInvocationsOrigin, afterDeleteRoleCounter)
+	}
+}
+
+type mRoleRepositoryMockGetUserRoles struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockGetUserRolesExpectation
+	expectations       []*RoleRepositoryMockGetUserRolesExpectation
+
+	callArgs []*RoleRepositoryMockGetUserRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockGetUserRolesExpectation specifies expectation struct of the RoleRepository.GetUserRoles
+type RoleRepositoryMockGetUserRolesExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockGetUserRolesParams
+	paramPtrs          *RoleRepositoryMockGetUserRolesParamPtrs
+	expectationOrigins RoleRepositoryMockGetUserRolesExpectationOrigins
+	results            *RoleRepositoryMockGetUserRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockGetUserRolesParams contains parameters of the RoleRepository.GetUserRoles
+type RoleRepositoryMockGetUserRolesParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// RoleRepositoryMockGetUserRolesParamPtrs contains pointers to parameters of the RoleRepository.GetUserRoles
+type RoleRepositoryMockGetUserRolesParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// RoleRepositoryMockGetUserRolesResults contains results of the RoleRepository.GetUserRoles
+type RoleRepositoryMockGetUserRolesResults struct {
+	up1 *model.UserRoles
+	err error
+}
+
+// RoleRepositoryMockGetUserRolesOrigins contains origins of expectations of the RoleRepository.GetUserRoles
+type RoleRepositoryMockGetUserRolesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserRoles *mRoleRepositoryMockGetUserRoles) Optional() *mRoleRepositoryMockGetUserRoles {
+	mmGetUserRoles.optional = true
+	return mmGetUserRoles
+}
+
+// Expect sets up expected params for RoleRepository.GetUserRoles
+func (mmGetUserRoles *mRoleRepositoryMockGetUserRoles) Expect(ctx context.Context, userID int64) *mRoleRepositoryMockGetUserRoles {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("RoleRepositoryMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &RoleRepositoryMockGetUserRolesExpectation{}
+	}
+
+	if mmGetUserRoles.defaultExpectation.paramPtrs != nil {
+		mmGetUserRoles.mock.t.Fatalf("RoleRepositoryMock.GetUserRoles mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserRoles.defaultExpectation.params = &RoleRepositoryMockGetUserRolesParams{ctx, userID}
+	mmGetUserRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserRoles.expectations {
+		if minimock.Equal(e.params, mmGetUserRoles.defaultExpectation.params) {
+			mmGetUserRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.GetUserRoles
+func (mmGetUserRoles *mRoleRepositoryMockGetUserRoles) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockGetUserRoles {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("RoleRepositoryMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &RoleRepositoryMockGetUserRolesExpectation{}
+	}
+
+	if mmGetUserRoles.defaultExpectation.params != nil {
+		mmGetUserRoles.mock.t.Fatalf("RoleRepositoryMock.GetUserRoles mock is already set by Expect")
+	}
+
+	if mmGetUserRoles.defaultExpectation.paramPtrs == nil {
+		mmGetUserRoles.defaultExpectation.paramPtrs = &RoleRepositoryMockGetUserRolesParamPtrs{}
+	}
+	mmGetUserRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserRoles
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RoleRepository.GetUserRoles
+func (mmGetUserRoles *mRoleRepositoryMockGetUserRoles) ExpectUserIDParam2(userID int64) *mRoleRepositoryMockGetUserRoles {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("RoleRepositoryMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &RoleRepositoryMockGetUserRolesExpectation{}
+	}
+
+	if mmGetUserRoles.defaultExpectation.params != nil {
+		mmGetUserRoles.mock.t.Fatalf("RoleRepositoryMock.GetUserRoles mock is already set by Expect")
+	}
+
+	if mmGetUserRoles.defaultExpectation.paramPtrs == nil {
+		mmGetUserRoles.defaultExpectation.paramPtrs = &RoleRepositoryMockGetUserRolesParamPtrs{}
+	}
+	mmGetUserRoles.defaultExpectation.paramPtrs.userID = &userID
+	mmGetUserRoles.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetUserRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.GetUserRoles
+func (mmGetUserRoles *mRoleRepositoryMockGetUserRoles) Inspect(f func(ctx context.Context, userID int64)) *mRoleRepositoryMockGetUserRoles {
+	if mmGetUserRoles.mock.inspectFuncGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.GetUserRoles")
+	}
+
+	mmGetUserRoles.mock.inspectFuncGetUserRoles = f
+
+	return mmGetUserRoles
+}
+
+// Return sets up results that will be returned by RoleRepository.GetUserRoles
+func (mmGetUserRoles *mRoleRepositoryMockGetUserRoles) Return(up1 *model.UserRoles, err error) *RoleRepositoryMock {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("RoleRepositoryMock.GetUserRoles mock is already set by Set")
+	}
+
+	if mmGetUserRoles.defaultExpectation == nil {
+		mmGetUserRoles.defaultExpectation = &RoleRepositoryMockGetUserRolesExpectation{mock: mmGetUserRoles.mock}
+	}
+	mmGetUserRoles.defaultExpectation.results = &RoleRepositoryMockGetUserRolesResults{up1, err}
+	mmGetUserRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserRoles.mock
+}
+
+// Set uses given function f to mock the RoleRepository.GetUserRoles method
+func (mmGetUserRoles *mRoleRepositoryMockGetUserRoles) Set(f func(ctx context.Context, userID int64) (up1 *model.UserRoles, err error)) *RoleRepositoryMock {
+	if mmGetUserRoles.defaultExpectation != nil {
+		mmGetUserRoles.mock.t.Fatalf("Default expectation is already set for the RoleRepository.GetUserRoles method")
+	}
+
+	if len(mmGetUserRoles.expectations) > 0 {
+		mmGetUserRoles.mock.t.Fatalf("Some expectations are already set for the RoleRepository.GetUserRoles method")
+	}
+
+	mmGetUserRoles.mock.funcGetUserRoles = f
+	mmGetUserRoles.mock.funcGetUserRolesOrigin = minimock.CallerInfo(1)
+	return mmGetUserRoles.mock
+}
+
+// When sets expectation for the RoleRepository.GetUserRoles which will trigger the result defined by the following
+// Then helper
+func (mmGetUserRoles *mRoleRepositoryMockGetUserRoles) When(ctx context.Context, userID int64) *RoleRepositoryMockGetUserRolesExpectation {
+	if mmGetUserRoles.mock.funcGetUserRoles != nil {
+		mmGetUserRoles.mock.t.Fatalf("RoleRepositoryMock.GetUserRoles mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockGetUserRolesExpectation{
+		mock:               mmGetUserRoles.mock,
+		params:             &RoleRepositoryMockGetUserRolesParams{ctx, userID},
+		expectationOrigins: RoleRepositoryMockGetUserRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserRoles.expectations = append(mmGetUserRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.GetUserRoles return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockGetUserRolesExpectation) Then(up1 *model.UserRoles, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockGetUserRolesResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.GetUserRoles should be invoked
+func (mmGetUserRoles *mRoleRepositoryMockGetUserRoles) Times(n uint64) *mRoleRepositoryMockGetUserRoles {
+	if n == 0 {
+		mmGetUserRoles.mock.t.Fatalf("Times of RoleRepositoryMock.GetUserRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserRoles.expectedInvocations, n)
+	mmGetUserRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserRoles
+}
+
+func (mmGetUserRoles *mRoleRepositoryMockGetUserRoles) invocationsDone() bool {
+	if len(mmGetUserRoles.expectations) == 0 && mmGetUserRoles.defaultExpectation == nil && mmGetUserRoles.mock.funcGetUserRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserRoles.mock.afterGetUserRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserRoles implements mm_repository.RoleRepository
+func (mmGetUserRoles *RoleRepositoryMock) GetUserRoles(ctx context.Context, userID int64) (up1 *model.UserRoles, err error) {
+	mm_atomic.AddUint64(&mmGetUserRoles.beforeGetUserRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserRoles.afterGetUserRolesCounter, 1)
+
+	mmGetUserRoles.t.Helper()
+
+	if mmGetUserRoles.inspectFuncGetUserRoles != nil {
+		mmGetUserRoles.inspectFuncGetUserRoles(ctx, userID)
+	}
+
+	mm_params := RoleRepositoryMockGetUserRolesParams{ctx, userID}
+
+	// Record call args
+	mmGetUserRoles.GetUserRolesMock.mutex.Lock()
+	mmGetUserRoles.GetUserRolesMock.callArgs = append(mmGetUserRoles.GetUserRolesMock.callArgs, &mm_params)
+	mmGetUserRoles.GetUserRolesMock.mutex.Unlock()
+
+	for _, e := range mmGetUserRoles.GetUserRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUserRoles.GetUserRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserRoles.GetUserRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserRoles.GetUserRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserRoles.GetUserRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockGetUserRolesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserRoles.t.Errorf("RoleRepositoryMock.GetUserRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetUserRoles.t.Errorf("RoleRepositoryMock.GetUserRoles got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserRoles.t.Errorf("RoleRepositoryMock.GetUserRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserRoles.GetUserRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserRoles.t.Fatal("No results are set for the RoleRepositoryMock.GetUserRoles")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUserRoles.funcGetUserRoles != nil {
+		return mmGetUserRoles.funcGetUserRoles(ctx, userID)
+	}
+	mmGetUserRoles.t.Fatalf("Unexpected call to RoleRepositoryMock.GetUserRoles. %v %v", ctx, userID)
+	return
+}
+
+// GetUserRolesAfterCounter returns a count of finished RoleRepositoryMock.GetUserRoles invocations
+func (mmGetUserRoles *RoleRepositoryMock) GetUserRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserRoles.afterGetUserRolesCounter)
+}
+
+// GetUserRolesBeforeCounter returns a count of RoleRepositoryMock.GetUserRoles invocations
+func (mmGetUserRoles *RoleRepositoryMock) GetUserRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserRoles.beforeGetUserRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.GetUserRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserRoles *mRoleRepositoryMockGetUserRoles) Calls() []*RoleRepositoryMockGetUserRolesParams {
+	mmGetUserRoles.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockGetUserRolesParams, len(mmGetUserRoles.callArgs))
+	copy(argCopy, mmGetUserRoles.callArgs)
+
+	mmGetUserRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserRolesDone returns true if the count of the GetUserRoles invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockGetUserRolesDone() bool {
+	if m.GetUserRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserRolesMock.invocationsDone()
+}
+
+// MinimockGetUserRolesInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockGetUserRolesInspect() {
+	for _, e := range m.GetUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.GetUserRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserRolesCounter := mm_atomic.LoadUint64(&m.afterGetUserRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserRolesMock.defaultExpectation != nil && afterGetUserRolesCounter < 1 {
+		if m.GetUserRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.GetUserRoles at\n%s", m.GetUserRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.GetUserRoles at\n%s with params: %#v", m.GetUserRolesMock.defaultExpectation.expectationOrigins.origin, *m.GetUserRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserRoles != nil && afterGetUserRolesCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.GetUserRoles at\n%s", m.funcGetUserRolesOrigin)
+	}
+
+	if !m.GetUserRolesMock.invocationsDone() && afterGetUserRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.GetUserRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserRolesMock.expectedInvocations), m.GetUserRolesMock.expectedInvocationsOrigin, afterGetUserRolesCounter)
+	}
+}
+
+type mRoleRepositoryMockListRoles struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockListRolesExpectation
+	expectations       []*RoleRepositoryMockListRolesExpectation
+
+	callArgs []*RoleRepositoryMockListRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockListRolesExpectation specifies expectation struct of the RoleRepository.ListRoles
+type RoleRepositoryMockListRolesExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockListRolesParams
+	paramPtrs          *RoleRepositoryMockListRolesParamPtrs
+	expectationOrigins RoleRepositoryMockListRolesExpectationOrigins
+	results            *RoleRepositoryMockListRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockListRolesParams contains parameters of the RoleRepository.ListRoles
+type RoleRepositoryMockListRolesParams struct {
+	ctx context.Context
+}
+
+// RoleRepositoryMockListRolesParamPtrs contains pointers to parameters of the RoleRepository.ListRoles
+type RoleRepositoryMockListRolesParamPtrs struct {
+	ctx *context.Context
+}
+
+// RoleRepositoryMockListRolesResults contains results of the RoleRepository.ListRoles
+type RoleRepositoryMockListRolesResults struct {
+	ra1 []model.Role
+	err error
+}
+
+// RoleRepositoryMockListRolesOrigins contains origins of expectations of the RoleRepository.ListRoles
+type RoleRepositoryMockListRolesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRoles *mRoleRepositoryMockListRoles) Optional() *mRoleRepositoryMockListRoles {
+	mmListRoles.optional = true
+	return mmListRoles
+}
+
+// Expect sets up expected params for RoleRepository.ListRoles
+func (mmListRoles *mRoleRepositoryMockListRoles) Expect(ctx context.Context) *mRoleRepositoryMockListRoles {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &RoleRepositoryMockListRolesExpectation{}
+	}
+
+	if mmListRoles.defaultExpectation.paramPtrs != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListRoles.defaultExpectation.params = &RoleRepositoryMockListRolesParams{ctx}
+	mmListRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRoles.expectations {
+		if minimock.Equal(e.params, mmListRoles.defaultExpectation.params) {
+			mmListRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.ListRoles
+func (mmListRoles *mRoleRepositoryMockListRoles) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockListRoles {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &RoleRepositoryMockListRolesExpectation{}
+	}
+
+	if mmListRoles.defaultExpectation.params != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by Expect")
+	}
+
+	if mmListRoles.defaultExpectation.paramPtrs == nil {
+		mmListRoles.defaultExpectation.paramPtrs = &RoleRepositoryMockListRolesParamPtrs{}
+	}
+	mmListRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.ListRoles
+func (mmListRoles *mRoleRepositoryMockListRoles) Inspect(f func(ctx context.Context)) *mRoleRepositoryMockListRoles {
+	if mmListRoles.mock.inspectFuncListRoles != nil {
+		mmListRoles.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.ListRoles")
+	}
+
+	mmListRoles.mock.inspectFuncListRoles = f
+
+	return mmListRoles
+}
+
+// Return sets up results that will be returned by RoleRepository.ListRoles
+func (mmListRoles *mRoleRepositoryMockListRoles) Return(ra1 []model.Role, err error) *RoleRepositoryMock {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &RoleRepositoryMockListRolesExpectation{mock: mmListRoles.mock}
+	}
+	mmListRoles.defaultExpectation.results = &RoleRepositoryMockListRolesResults{ra1, err}
+	mmListRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRoles.mock
+}
+
+// Set uses given function f to mock the RoleRepository.ListRoles method
+func (mmListRoles *mRoleRepositoryMockListRoles) Set(f func(ctx context.Context) (ra1 []model.Role, err error)) *RoleRepositoryMock {
+	if mmListRoles.defaultExpectation != nil {
+		mmListRoles.mock.t.Fatalf("Default expectation is already set for the RoleRepository.ListRoles method")
+	}
+
+	if len(mmListRoles.expectations) > 0 {
+		mmListRoles.mock.t.Fatalf("Some expectations are already set for the RoleRepository.ListRoles method")
+	}
+
+	mmListRoles.mock.funcListRoles = f
+	mmListRoles.mock.funcListRolesOrigin = minimock.CallerInfo(1)
+	return mmListRoles.mock
+}
+
+// When sets expectation for the RoleRepository.ListRoles which will trigger the result defined by the following
+// Then helper
+func (mmListRoles *mRoleRepositoryMockListRoles) When(ctx context.Context) *RoleRepositoryMockListRolesExpectation {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleRepositoryMock.ListRoles mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockListRolesExpectation{
+		mock:               mmListRoles.mock,
+		params:             &RoleRepositoryMockListRolesParams{ctx},
+		expectationOrigins: RoleRepositoryMockListRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRoles.expectations = append(mmListRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.ListRoles return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockListRolesExpectation) Then(ra1 []model.Role, err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockListRolesResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.ListRoles should be invoked
+func (mmListRoles *mRoleRepositoryMockListRoles) Times(n uint64) *mRoleRepositoryMockListRoles {
+	if n == 0 {
+		mmListRoles.mock.t.Fatalf("Times of RoleRepositoryMock.ListRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRoles.expectedInvocations, n)
+	mmListRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRoles
+}
+
+func (mmListRoles *mRoleRepositoryMockListRoles) invocationsDone() bool {
+	if len(mmListRoles.expectations) == 0 && mmListRoles.defaultExpectation == nil && mmListRoles.mock.funcListRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRoles.mock.afterListRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRoles implements mm_repository.RoleRepository
+func (mmListRoles *RoleRepositoryMock) ListRoles(ctx context.Context) (ra1 []model.Role, err error) {
+	mm_atomic.AddUint64(&mmListRoles.beforeListRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRoles.afterListRolesCounter, 1)
+
+	mmListRoles.t.Helper()
+
+	if mmListRoles.inspectFuncListRoles != nil {
+		mmListRoles.inspectFuncListRoles(ctx)
+	}
+
+	mm_params := RoleRepositoryMockListRolesParams{ctx}
+
+	// Record call args
+	mmListRoles.ListRolesMock.mutex.Lock()
+	mmListRoles.ListRolesMock.callArgs = append(mmListRoles.ListRolesMock.callArgs, &mm_params)
+	mmListRoles.ListRolesMock.mutex.Unlock()
+
+	for _, e := range mmListRoles.ListRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmListRoles.ListRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRoles.ListRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRoles.ListRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListRoles.ListRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockListRolesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRoles.t.Errorf("RoleRepositoryMock.ListRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRoles.ListRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRoles.t.Errorf("RoleRepositoryMock.ListRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRoles.ListRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRoles.ListRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRoles.t.Fatal("No results are set for the RoleRepositoryMock.ListRoles")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmListRoles.funcListRoles != nil {
+		return mmListRoles.funcListRoles(ctx)
+	}
+	mmListRoles.t.Fatalf("Unexpected call to RoleRepositoryMock.ListRoles. %v", ctx)
+	return
+}
+
+// ListRolesAfterCounter returns a count of finished RoleRepositoryMock.ListRoles invocations
+func (mmListRoles *RoleRepositoryMock) ListRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoles.afterListRolesCounter)
+}
+
+// ListRolesBeforeCounter returns a count of RoleRepositoryMock.ListRoles invocations
+func (mmListRoles *RoleRepositoryMock) ListRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoles.beforeListRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.ListRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRoles *mRoleRepositoryMockListRoles) Calls() []*RoleRepositoryMockListRolesParams {
+	mmListRoles.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockListRolesParams, len(mmListRoles.callArgs))
+	copy(argCopy, mmListRoles.callArgs)
+
+	mmListRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRolesDone returns true if the count of the ListRoles invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockListRolesDone() bool {
+	if m.ListRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRolesMock.invocationsDone()
+}
+
+// MinimockListRolesInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockListRolesInspect() {
+	for _, e := range m.ListRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRolesCounter := mm_atomic.LoadUint64(&m.afterListRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRolesMock.defaultExpectation != nil && afterListRolesCounter < 1 {
+		if m.ListRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListRoles at\n%s", m.ListRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.ListRoles at\n%s with params: %#v", m.ListRolesMock.defaultExpectation.expectationOrigins.origin, *m.ListRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRoles != nil && afterListRolesCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.ListRoles at\n%s", m.funcListRolesOrigin)
+	}
+
+	if !m.ListRolesMock.invocationsDone() && afterListRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.ListRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRolesMock.expectedInvocations), m.ListRolesMock.expectedInvocationsOrigin, afterListRolesCounter)
+	}
+}
+
+type mRoleRepositoryMockMakeLog struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockMakeLogExpectation
+	expectations       []*RoleRepositoryMockMakeLogExpectation
+
+	callArgs []*RoleRepositoryMockMakeLogParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockMakeLogExpectation specifies expectation struct of the RoleRepository.MakeLog
+type RoleRepositoryMockMakeLogExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockMakeLogParams
+	paramPtrs          *RoleRepositoryMockMakeLogParamPtrs
+	expectationOrigins RoleRepositoryMockMakeLogExpectationOrigins
+	results            *RoleRepositoryMockMakeLogResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockMakeLogParams contains parameters of the RoleRepository.MakeLog
+type RoleRepositoryMockMakeLogParams struct {
+	ctx context.Context
+	log model.Log
+}
+
+// RoleRepositoryMockMakeLogParamPtrs contains pointers to parameters of the RoleRepository.MakeLog
+type RoleRepositoryMockMakeLogParamPtrs struct {
+	ctx *context.Context
+	log *model.Log
+}
+
+// RoleRepositoryMockMakeLogResults contains results of the RoleRepository.MakeLog
+type RoleRepositoryMockMakeLogResults struct {
+	err error
+}
+
+// RoleRepositoryMockMakeLogOrigins contains origins of expectations of the RoleRepository.MakeLog
+type RoleRepositoryMockMakeLogExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originLog string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMakeLog *mRoleRepositoryMockMakeLog) Optional() *mRoleRepositoryMockMakeLog {
+	mmMakeLog.optional = true
+	return mmMakeLog
+}
+
+// Expect sets up expected params for RoleRepository.MakeLog
+func (mmMakeLog *mRoleRepositoryMockMakeLog) Expect(ctx context.Context, log model.Log) *mRoleRepositoryMockMakeLog {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("RoleRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &RoleRepositoryMockMakeLogExpectation{}
+	}
+
+	if mmMakeLog.defaultExpectation.paramPtrs != nil {
+		mmMakeLog.mock.t.Fatalf("RoleRepositoryMock.MakeLog mock is already set by ExpectParams functions")
+	}
+
+	mmMakeLog.defaultExpectation.params = &RoleRepositoryMockMakeLogParams{ctx, log}
+	mmMakeLog.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMakeLog.expectations {
+		if minimock.Equal(e.params, mmMakeLog.defaultExpectation.params) {
+			mmMakeLog.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMakeLog.defaultExpectation.params)
+		}
+	}
+
+	return mmMakeLog
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.MakeLog
+func (mmMakeLog *mRoleRepositoryMockMakeLog) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockMakeLog {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("RoleRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &RoleRepositoryMockMakeLogExpectation{}
+	}
+
+	if mmMakeLog.defaultExpectation.params != nil {
+		mmMakeLog.mock.t.Fatalf("RoleRepositoryMock.MakeLog mock is already set by Expect")
+	}
+
+	if mmMakeLog.defaultExpectation.paramPtrs == nil {
+		mmMakeLog.defaultExpectation.paramPtrs = &RoleRepositoryMockMakeLogParamPtrs{}
+	}
+	mmMakeLog.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMakeLog.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMakeLog
+}
+
+// ExpectLogParam2 sets up expected param log for RoleRepository.MakeLog
+func (mmMakeLog *mRoleRepositoryMockMakeLog) ExpectLogParam2(log model.Log) *mRoleRepositoryMockMakeLog {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("RoleRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &RoleRepositoryMockMakeLogExpectation{}
+	}
+
+	if mmMakeLog.defaultExpectation.params != nil {
+		mmMakeLog.mock.t.Fatalf("RoleRepositoryMock.MakeLog mock is already set by Expect")
+	}
+
+	if mmMakeLog.defaultExpectation.paramPtrs == nil {
+		mmMakeLog.defaultExpectation.paramPtrs = &RoleRepositoryMockMakeLogParamPtrs{}
+	}
+	mmMakeLog.defaultExpectation.paramPtrs.log = &log
+	mmMakeLog.defaultExpectation.expectationOrigins.originLog = minimock.CallerInfo(1)
+
+	return mmMakeLog
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.MakeLog
+func (mmMakeLog *mRoleRepositoryMockMakeLog) Inspect(f func(ctx context.Context, log model.Log)) *mRoleRepositoryMockMakeLog {
+	if mmMakeLog.mock.inspectFuncMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.MakeLog")
+	}
+
+	mmMakeLog.mock.inspectFuncMakeLog = f
+
+	return mmMakeLog
+}
+
+// Return sets up results that will be returned by RoleRepository.MakeLog
+func (mmMakeLog *mRoleRepositoryMockMakeLog) Return(err error) *RoleRepositoryMock {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("RoleRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &RoleRepositoryMockMakeLogExpectation{mock: mmMakeLog.mock}
+	}
+	mmMakeLog.defaultExpectation.results = &RoleRepositoryMockMakeLogResults{err}
+	mmMakeLog.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMakeLog.mock
+}
+
+// Set uses given function f to mock the RoleRepository.MakeLog method
+func (mmMakeLog *mRoleRepositoryMockMakeLog) Set(f func(ctx context.Context, log model.Log) (err error)) *RoleRepositoryMock {
+	if mmMakeLog.defaultExpectation != nil {
+		mmMakeLog.mock.t.Fatalf("Default expectation is already set for the RoleRepository.MakeLog method")
+	}
+
+	if len(mmMakeLog.expectations) > 0 {
+		mmMakeLog.mock.t.Fatalf("Some expectations are already set for the RoleRepository.MakeLog method")
+	}
+
+	mmMakeLog.mock.funcMakeLog = f
+	mmMakeLog.mock.funcMakeLogOrigin = minimock.CallerInfo(1)
+	return mmMakeLog.mock
+}
+
+// When sets expectation for the RoleRepository.MakeLog which will trigger the result defined by the following
+// Then helper
+func (mmMakeLog *mRoleRepositoryMockMakeLog) When(ctx context.Context, log model.Log) *RoleRepositoryMockMakeLogExpectation {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("RoleRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockMakeLogExpectation{
+		mock:               mmMakeLog.mock,
+		params:             &RoleRepositoryMockMakeLogParams{ctx, log},
+		expectationOrigins: RoleRepositoryMockMakeLogExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMakeLog.expectations = append(mmMakeLog.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.MakeLog return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockMakeLogExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockMakeLogResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.MakeLog should be invoked
+func (mmMakeLog *mRoleRepositoryMockMakeLog) Times(n uint64) *mRoleRepositoryMockMakeLog {
+	if n == 0 {
+		mmMakeLog.mock.t.Fatalf("Times of RoleRepositoryMock.MakeLog mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMakeLog.expectedInvocations, n)
+	mmMakeLog.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMakeLog
+}
+
+func (mmMakeLog *mRoleRepositoryMockMakeLog) invocationsDone() bool {
+	if len(mmMakeLog.expectations) == 0 && mmMakeLog.defaultExpectation == nil && mmMakeLog.mock.funcMakeLog == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMakeLog.mock.afterMakeLogCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMakeLog.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MakeLog implements mm_repository.RoleRepository
+func (mmMakeLog *RoleRepositoryMock) MakeLog(ctx context.Context, log model.Log) (err error) {
+	mm_atomic.AddUint64(&mmMakeLog.beforeMakeLogCounter, 1)
+	defer mm_atomic.AddUint64(&mmMakeLog.afterMakeLogCounter, 1)
+
+	mmMakeLog.t.Helper()
+
+	if mmMakeLog.inspectFuncMakeLog != nil {
+		mmMakeLog.inspectFuncMakeLog(ctx, log)
+	}
+
+	mm_params := RoleRepositoryMockMakeLogParams{ctx, log}
+
+	// Record call args
+	mmMakeLog.MakeLogMock.mutex.Lock()
+	mmMakeLog.MakeLogMock.callArgs = append(mmMakeLog.MakeLogMock.callArgs, &mm_params)
+	mmMakeLog.MakeLogMock.mutex.Unlock()
+
+	for _, e := range mmMakeLog.MakeLogMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMakeLog.MakeLogMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMakeLog.MakeLogMock.defaultExpectation.Counter, 1)
+		mm_want := mmMakeLog.MakeLogMock.defaultExpectation.params
+		mm_want_ptrs := mmMakeLog.MakeLogMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockMakeLogParams{ctx, log}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMakeLog.t.Errorf("RoleRepositoryMock.MakeLog got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.log != nil && !minimock.Equal(*mm_want_ptrs.log, mm_got.log) {
+				mmMakeLog.t.Errorf("RoleRepositoryMock.MakeLog got unexpected parameter log, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.originLog, *mm_want_ptrs.log, mm_got.log, minimock.Diff(*mm_want_ptrs.log, mm_got.log))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMakeLog.t.Errorf("RoleRepositoryMock.MakeLog got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMakeLog.MakeLogMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMakeLog.t.Fatal("No results are set for the RoleRepositoryMock.MakeLog")
+		}
+		return (*mm_results).err
+	}
+	if mmMakeLog.funcMakeLog != nil {
+		return mmMakeLog.funcMakeLog(ctx, log)
+	}
+	mmMakeLog.t.Fatalf("Unexpected call to RoleRepositoryMock.MakeLog. %v %v", ctx, log)
+	return
+}
+
+// MakeLogAfterCounter returns a count of finished RoleRepositoryMock.MakeLog invocations
+func (mmMakeLog *RoleRepositoryMock) MakeLogAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMakeLog.afterMakeLogCounter)
+}
+
+// MakeLogBeforeCounter returns a count of RoleRepositoryMock.MakeLog invocations
+func (mmMakeLog *RoleRepositoryMock) MakeLogBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMakeLog.beforeMakeLogCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.MakeLog.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMakeLog *mRoleRepositoryMockMakeLog) Calls() []*RoleRepositoryMockMakeLogParams {
+	mmMakeLog.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockMakeLogParams, len(mmMakeLog.callArgs))
+	copy(argCopy, mmMakeLog.callArgs)
+
+	mmMakeLog.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMakeLogDone returns true if the count of the MakeLog invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockMakeLogDone() bool {
+	if m.MakeLogMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MakeLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MakeLogMock.invocationsDone()
+}
+
+// MinimockMakeLogInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockMakeLogInspect() {
+	for _, e := range m.MakeLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.MakeLog at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMakeLogCounter := mm_atomic.LoadUint64(&m.afterMakeLogCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MakeLogMock.defaultExpectation != nil && afterMakeLogCounter < 1 {
+		if m.MakeLogMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.MakeLog at\n%s", m.MakeLogMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.MakeLog at\n%s with params: %#v", m.MakeLogMock.defaultExpectation.expectationOrigins.origin, *m.MakeLogMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMakeLog != nil && afterMakeLogCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.MakeLog at\n%s", m.funcMakeLogOrigin)
+	}
+
+	if !m.MakeLogMock.invocationsDone() && afterMakeLogCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.MakeLog at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MakeLogMock.expectedInvocations), m.MakeLogMock.expectedInvocationsOrigin, afterMakeLogCounter)
+	}
+}
+
+type mRoleRepositoryMockRemovePermission struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockRemovePermissionExpectation
+	expectations       []*RoleRepositoryMockRemovePermissionExpectation
+
+	callArgs []*RoleRepositoryMockRemovePermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockRemovePermissionExpectation specifies expectation struct of the RoleRepository.RemovePermission
+type RoleRepositoryMockRemovePermissionExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockRemovePermissionParams
+	paramPtrs          *RoleRepositoryMockRemovePermissionParamPtrs
+	expectationOrigins RoleRepositoryMockRemovePermissionExpectationOrigins
+	results            *RoleRepositoryMockRemovePermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockRemovePermissionParams contains parameters of the RoleRepository.RemovePermission
+type RoleRepositoryMockRemovePermissionParams struct {
+	ctx  context.Context
+	perm model.RolePermission
+}
+
+// RoleRepositoryMockRemovePermissionParamPtrs contains pointers to parameters of the RoleRepository.RemovePermission
+type RoleRepositoryMockRemovePermissionParamPtrs struct {
+	ctx  *context.Context
+	perm *model.RolePermission
+}
+
+// RoleRepositoryMockRemovePermissionResults contains results of the RoleRepository.RemovePermission
+type RoleRepositoryMockRemovePermissionResults struct {
+	err error
+}
+
+// RoleRepositoryMockRemovePermissionOrigins contains origins of expectations of the RoleRepository.RemovePermission
+type RoleRepositoryMockRemovePermissionExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originPerm string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemovePermission *mRoleRepositoryMockRemovePermission) Optional() *mRoleRepositoryMockRemovePermission {
+	mmRemovePermission.optional = true
+	return mmRemovePermission
+}
+
+// Expect sets up expected params for RoleRepository.RemovePermission
+func (mmRemovePermission *mRoleRepositoryMockRemovePermission) Expect(ctx context.Context, perm model.RolePermission) *mRoleRepositoryMockRemovePermission {
+	if mmRemovePermission.mock.funcRemovePermission != nil {
+		mmRemovePermission.mock.t.Fatalf("RoleRepositoryMock.RemovePermission mock is already set by Set")
+	}
+
+	if mmRemovePermission.defaultExpectation == nil {
+		mmRemovePermission.defaultExpectation = &RoleRepositoryMockRemovePermissionExpectation{}
+	}
+
+	if mmRemovePermission.defaultExpectation.paramPtrs != nil {
+		mmRemovePermission.mock.t.Fatalf("RoleRepositoryMock.RemovePermission mock is already set by ExpectParams functions")
+	}
+
+	mmRemovePermission.defaultExpectation.params = &RoleRepositoryMockRemovePermissionParams{ctx, perm}
+	mmRemovePermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemovePermission.expectations {
+		if minimock.Equal(e.params, mmRemovePermission.defaultExpectation.params) {
+			mmRemovePermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemovePermission.defaultExpectation.params)
+		}
+	}
+
+	return mmRemovePermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.RemovePermission
+func (mmRemovePermission *mRoleRepositoryMockRemovePermission) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockRemovePermission {
+	if mmRemovePermission.mock.funcRemovePermission != nil {
+		mmRemovePermission.mock.t.Fatalf("RoleRepositoryMock.RemovePermission mock is already set by Set")
+	}
+
+	if mmRemovePermission.defaultExpectation == nil {
+		mmRemovePermission.defaultExpectation = &RoleRepositoryMockRemovePermissionExpectation{}
+	}
+
+	if mmRemovePermission.defaultExpectation.params != nil {
+		mmRemovePermission.mock.t.Fatalf("RoleRepositoryMock.RemovePermission mock is already set by Expect")
+	}
+
+	if mmRemovePermission.defaultExpectation.paramPtrs == nil {
+		mmRemovePermission.defaultExpectation.paramPtrs = &RoleRepositoryMockRemovePermissionParamPtrs{}
+	}
+	mmRemovePermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemovePermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemovePermission
+}
+
+// ExpectPermParam2 sets up expected param perm for RoleRepository.RemovePermission
+func (mmRemovePermission *mRoleRepositoryMockRemovePermission) ExpectPermParam2(perm model.RolePermission) *mRoleRepositoryMockRemovePermission {
+	if mmRemovePermission.mock.funcRemovePermission != nil {
+		mmRemovePermission.mock.t.Fatalf("RoleRepositoryMock.RemovePermission mock is already set by Set")
+	}
+
+	if mmRemovePermission.defaultExpectation == nil {
+		mmRemovePermission.defaultExpectation = &RoleRepositoryMockRemovePermissionExpectation{}
+	}
+
+	if mmRemovePermission.defaultExpectation.params != nil {
+		mmRemovePermission.mock.t.Fatalf("RoleRepositoryMock.RemovePermission mock is already set by Expect")
+	}
+
+	if mmRemovePermission.defaultExpectation.paramPtrs == nil {
+		mmRemovePermission.defaultExpectation.paramPtrs = &RoleRepositoryMockRemovePermissionParamPtrs{}
+	}
+	mmRemovePermission.defaultExpectation.paramPtrs.perm = &perm
+	mmRemovePermission.defaultExpectation.expectationOrigins.originPerm = minimock.CallerInfo(1)
+
+	return mmRemovePermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.RemovePermission
+func (mmRemovePermission *mRoleRepositoryMockRemovePermission) Inspect(f func(ctx context.Context, perm model.RolePermission)) *mRoleRepositoryMockRemovePermission {
+	if mmRemovePermission.mock.inspectFuncRemovePermission != nil {
+		mmRemovePermission.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.RemovePermission")
+	}
+
+	mmRemovePermission.mock.inspectFuncRemovePermission = f
+
+	return mmRemovePermission
+}
+
+// Return sets up results that will be returned by RoleRepository.RemovePermission
+func (mmRemovePermission *mRoleRepositoryMockRemovePermission) Return(err error) *RoleRepositoryMock {
+	if mmRemovePermission.mock.funcRemovePermission != nil {
+		mmRemovePermission.mock.t.Fatalf("RoleRepositoryMock.RemovePermission mock is already set by Set")
+	}
+
+	if mmRemovePermission.defaultExpectation == nil {
+		mmRemovePermission.defaultExpectation = &RoleRepositoryMockRemovePermissionExpectation{mock: mmRemovePermission.mock}
+	}
+	mmRemovePermission.defaultExpectation.results = &RoleRepositoryMockRemovePermissionResults{err}
+	mmRemovePermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemovePermission.mock
+}
+
+// Set uses given function f to mock the RoleRepository.RemovePermission method
+func (mmRemovePermission *mRoleRepositoryMockRemovePermission) Set(f func(ctx context.Context, perm model.RolePermission) (err error)) *RoleRepositoryMock {
+	if mmRemovePermission.defaultExpectation != nil {
+		mmRemovePermission.mock.t.Fatalf("Default expectation is already set for the RoleRepository.RemovePermission method")
+	}
+
+	if len(mmRemovePermission.expectations) > 0 {
+		mmRemovePermission.mock.t.Fatalf("Some expectations are already set for the RoleRepository.RemovePermission method")
+	}
+
+	mmRemovePermission.mock.funcRemovePermission = f
+	mmRemovePermission.mock.funcRemovePermissionOrigin = minimock.CallerInfo(1)
+	return mmRemovePermission.mock
+}
+
+// When sets expectation for the RoleRepository.RemovePermission which will trigger the result defined by the following
+// Then helper
+func (mmRemovePermission *mRoleRepositoryMockRemovePermission) When(ctx context.Context, perm model.RolePermission) *RoleRepositoryMockRemovePermissionExpectation {
+	if mmRemovePermission.mock.funcRemovePermission != nil {
+		mmRemovePermission.mock.t.Fatalf("RoleRepositoryMock.RemovePermission mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockRemovePermissionExpectation{
+		mock:               mmRemovePermission.mock,
+		params:             &RoleRepositoryMockRemovePermissionParams{ctx, perm},
+		expectationOrigins: RoleRepositoryMockRemovePermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemovePermission.expectations = append(mmRemovePermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.RemovePermission return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockRemovePermissionExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockRemovePermissionResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.RemovePermission should be invoked
+func (mmRemovePermission *mRoleRepositoryMockRemovePermission) Times(n uint64) *mRoleRepositoryMockRemovePermission {
+	if n == 0 {
+		mmRemovePermission.mock.t.Fatalf("Times of RoleRepositoryMock.RemovePermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemovePermission.expectedInvocations, n)
+	mmRemovePermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemovePermission
+}
+
+func (mmRemovePermission *mRoleRepositoryMockRemovePermission) invocationsDone() bool {
+	if len(mmRemovePermission.expectations) == 0 && mmRemovePermission.defaultExpectation == nil && mmRemovePermission.mock.funcRemovePermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemovePermission.mock.afterRemovePermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemovePermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemovePermission implements mm_repository.RoleRepository
+func (mmRemovePermission *RoleRepositoryMock) RemovePermission(ctx context.Context, perm model.RolePermission) (err error) {
+	mm_atomic.AddUint64(&mmRemovePermission.beforeRemovePermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemovePermission.afterRemovePermissionCounter, 1)
+
+	mmRemovePermission.t.Helper()
+
+	if mmRemovePermission.inspectFuncRemovePermission != nil {
+		mmRemovePermission.inspectFuncRemovePermission(ctx, perm)
+	}
+
+	mm_params := RoleRepositoryMockRemovePermissionParams{ctx, perm}
+
+	// Record call args
+	mmRemovePermission.RemovePermissionMock.mutex.Lock()
+	mmRemovePermission.RemovePermissionMock.callArgs = append(mmRemovePermission.RemovePermissionMock.callArgs, &mm_params)
+	mmRemovePermission.RemovePermissionMock.mutex.Unlock()
+
+	for _, e := range mmRemovePermission.RemovePermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemovePermission.RemovePermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemovePermission.RemovePermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemovePermission.RemovePermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmRemovePermission.RemovePermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockRemovePermissionParams{ctx, perm}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemovePermission.t.Errorf("RoleRepositoryMock.RemovePermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePermission.RemovePermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.perm != nil && !minimock.Equal(*mm_want_ptrs.perm, mm_got.perm) {
+				mmRemovePermission.t.Errorf("RoleRepositoryMock.RemovePermission got unexpected parameter perm, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePermission.RemovePermissionMock.defaultExpectation.expectationOrigins.originPerm, *mm_want_ptrs.perm, mm_got.perm, minimock.Diff(*mm_want_ptrs.perm, mm_got.perm))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemovePermission.t.Errorf("RoleRepositoryMock.RemovePermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemovePermission.RemovePermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemovePermission.RemovePermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemovePermission.t.Fatal("No results are set for the RoleRepositoryMock.RemovePermission")
+		}
+		return (*mm_results).err
+	}
+	if mmRemovePermission.funcRemovePermission != nil {
+		return mmRemovePermission.funcRemovePermission(ctx, perm)
+	}
+	mmRemovePermission.t.Fatalf("Unexpected call to RoleRepositoryMock.RemovePermission. %v %v", ctx, perm)
+	return
+}
+
+// RemovePermissionAfterCounter returns a count of finished RoleRepositoryMock.RemovePermission invocations
+func (mmRemovePermission *RoleRepositoryMock) RemovePermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePermission.afterRemovePermissionCounter)
+}
+
+// RemovePermissionBeforeCounter returns a count of RoleRepositoryMock.RemovePermission invocations
+func (mmRemovePermission *RoleRepositoryMock) RemovePermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePermission.beforeRemovePermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.RemovePermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemovePermission *mRoleRepositoryMockRemovePermission) Calls() []*RoleRepositoryMockRemovePermissionParams {
+	mmRemovePermission.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockRemovePermissionParams, len(mmRemovePermission.callArgs))
+	copy(argCopy, mmRemovePermission.callArgs)
+
+	mmRemovePermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemovePermissionDone returns true if the count of the RemovePermission invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockRemovePermissionDone() bool {
+	if m.RemovePermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemovePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemovePermissionMock.invocationsDone()
+}
+
+// MinimockRemovePermissionInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockRemovePermissionInspect() {
+	for _, e := range m.RemovePermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.RemovePermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemovePermissionCounter := mm_atomic.LoadUint64(&m.afterRemovePermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemovePermissionMock.defaultExpectation != nil && afterRemovePermissionCounter < 1 {
+		if m.RemovePermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.RemovePermission at\n%s", m.RemovePermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.RemovePermission at\n%s with params: %#v", m.RemovePermissionMock.defaultExpectation.expectationOrigins.origin, *m.RemovePermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemovePermission != nil && afterRemovePermissionCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.RemovePermission at\n%s", m.funcRemovePermissionOrigin)
+	}
+
+	if !m.RemovePermissionMock.invocationsDone() && afterRemovePermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.RemovePermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemovePermissionMock.expectedInvocations), m.RemovePermissionMock.expectedInvocationsOrigin, afterRemovePermissionCounter)
+	}
+}
+
+type mRoleRepositoryMockUnassignRole struct {
+	optional           bool
+	mock               *RoleRepositoryMock
+	defaultExpectation *RoleRepositoryMockUnassignRoleExpectation
+	expectations       []*RoleRepositoryMockUnassignRoleExpectation
+
+	callArgs []*RoleRepositoryMockUnassignRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleRepositoryMockUnassignRoleExpectation specifies expectation struct of the RoleRepository.UnassignRole
+type RoleRepositoryMockUnassignRoleExpectation struct {
+	mock               *RoleRepositoryMock
+	params             *RoleRepositoryMockUnassignRoleParams
+	paramPtrs          *RoleRepositoryMockUnassignRoleParamPtrs
+	expectationOrigins RoleRepositoryMockUnassignRoleExpectationOrigins
+	results            *RoleRepositoryMockUnassignRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleRepositoryMockUnassignRoleParams contains parameters of the RoleRepository.UnassignRole
+type RoleRepositoryMockUnassignRoleParams struct {
+	ctx      context.Context
+	userRole model.UserRole
+}
+
+// RoleRepositoryMockUnassignRoleParamPtrs contains pointers to parameters of the RoleRepository.UnassignRole
+type RoleRepositoryMockUnassignRoleParamPtrs struct {
+	ctx      *context.Context
+	userRole *model.UserRole
+}
+
+// RoleRepositoryMockUnassignRoleResults contains results of the RoleRepository.UnassignRole
+type RoleRepositoryMockUnassignRoleResults struct {
+	err error
+}
+
+// RoleRepositoryMockUnassignRoleOrigins contains origins of expectations of the RoleRepository.UnassignRole
+type RoleRepositoryMockUnassignRoleExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnassignRole *mRoleRepositoryMockUnassignRole) Optional() *mRoleRepositoryMockUnassignRole {
+	mmUnassignRole.optional = true
+	return mmUnassignRole
+}
+
+// Expect sets up expected params for RoleRepository.UnassignRole
+func (mmUnassignRole *mRoleRepositoryMockUnassignRole) Expect(ctx context.Context, userRole model.UserRole) *mRoleRepositoryMockUnassignRole {
+	if mmUnassignRole.mock.funcUnassignRole != nil {
+		mmUnassignRole.mock.t.Fatalf("RoleRepositoryMock.UnassignRole mock is already set by Set")
+	}
+
+	if mmUnassignRole.defaultExpectation == nil {
+		mmUnassignRole.defaultExpectation = &RoleRepositoryMockUnassignRoleExpectation{}
+	}
+
+	if mmUnassignRole.defaultExpectation.paramPtrs != nil {
+		mmUnassignRole.mock.t.Fatalf("RoleRepositoryMock.UnassignRole mock is already set by ExpectParams functions")
+	}
+
+	mmUnassignRole.defaultExpectation.params = &RoleRepositoryMockUnassignRoleParams{ctx, userRole}
+	mmUnassignRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUnassignRole.expectations {
+		if minimock.Equal(e.params, mmUnassignRole.defaultExpectation.params) {
+			mmUnassignRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnassignRole.defaultExpectation.params)
+		}
+	}
+
+	return mmUnassignRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleRepository.UnassignRole
+func (mmUnassignRole *mRoleRepositoryMockUnassignRole) ExpectCtxParam1(ctx context.Context) *mRoleRepositoryMockUnassignRole {
+	if mmUnassignRole.mock.funcUnassignRole != nil {
+		mmUnassignRole.mock.t.Fatalf("RoleRepositoryMock.UnassignRole mock is already set by Set")
+	}
+
+	if mmUnassignRole.defaultExpectation == nil {
+		mmUnassignRole.defaultExpectation = &RoleRepositoryMockUnassignRoleExpectation{}
+	}
+
+	if mmUnassignRole.defaultExpectation.params != nil {
+		mmUnassignRole.mock.t.Fatalf("RoleRepositoryMock.UnassignRole mock is already set by Expect")
+	}
+
+	if mmUnassignRole.defaultExpectation.paramPtrs == nil {
+		mmUnassignRole.defaultExpectation.paramPtrs = &RoleRepositoryMockUnassignRoleParamPtrs{}
+	}
+	mmUnassignRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUnassignRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUnassignRole
+}
+
+// ExpectUserRoleParam2 sets up expected param userRole for RoleRepository.UnassignRole
+func (mmUnassignRole *mRoleRepositoryMockUnassignRole) ExpectUserRoleParam2(userRole model.UserRole) *mRoleRepositoryMockUnassignRole {
+	if mmUnassignRole.mock.funcUnassignRole != nil {
+		mmUnassignRole.mock.t.Fatalf("RoleRepositoryMock.UnassignRole mock is already set by Set")
+	}
+
+	if mmUnassignRole.defaultExpectation == nil {
+		mmUnassignRole.defaultExpectation = &RoleRepositoryMockUnassignRoleExpectation{}
+	}
+
+	if mmUnassignRole.defaultExpectation.params != nil {
+		mmUnassignRole.mock.t.Fatalf("RoleRepositoryMock.UnassignRole mock is already set by Expect")
+	}
+
+	if mmUnassignRole.defaultExpectation.paramPtrs == nil {
+		mmUnassignRole.defaultExpectation.paramPtrs = &RoleRepositoryMockUnassignRoleParamPtrs{}
+	}
+	mmUnassignRole.defaultExpectation.paramPtrs.userRole = &userRole
+	mmUnassignRole.defaultExpectation.expectationOrigins.originUserRole = minimock.CallerInfo(1)
+
+	return mmUnassignRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleRepository.UnassignRole
+func (mmUnassignRole *mRoleRepositoryMockUnassignRole) Inspect(f func(ctx context.Context, userRole model.UserRole)) *mRoleRepositoryMockUnassignRole {
+	if mmUnassignRole.mock.inspectFuncUnassignRole != nil {
+		mmUnassignRole.mock.t.Fatalf("Inspect function is already set for RoleRepositoryMock.UnassignRole")
+	}
+
+	mmUnassignRole.mock.inspectFuncUnassignRole = f
+
+	return mmUnassignRole
+}
+
+// Return sets up results that will be returned by RoleRepository.UnassignRole
+func (mmUnassignRole *mRoleRepositoryMockUnassignRole) Return(err error) *RoleRepositoryMock {
+	if mmUnassignRole.mock.funcUnassignRole != nil {
+		mmUnassignRole.mock.t.Fatalf("RoleRepositoryMock.UnassignRole mock is already set by Set")
+	}
+
+	if mmUnassignRole.defaultExpectation == nil {
+		mmUnassignRole.defaultExpectation = &RoleRepositoryMockUnassignRoleExpectation{mock: mmUnassignRole.mock}
+	}
+	mmUnassignRole.defaultExpectation.results = &RoleRepositoryMockUnassignRoleResults{err}
+	mmUnassignRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUnassignRole.mock
+}
+
+// Set uses given function f to mock the RoleRepository.UnassignRole method
+func (mmUnassignRole *mRoleRepositoryMockUnassignRole) Set(f func(ctx context.Context, userRole model.UserRole) (err error)) *RoleRepositoryMock {
+	if mmUnassignRole.defaultExpectation != nil {
+		mmUnassignRole.mock.t.Fatalf("Default expectation is already set for the RoleRepository.UnassignRole method")
+	}
+
+	if len(mmUnassignRole.expectations) > 0 {
+		mmUnassignRole.mock.t.Fatalf("Some expectations are already set for the RoleRepository.UnassignRole method")
+	}
+
+	mmUnassignRole.mock.funcUnassignRole = f
+	mmUnassignRole.mock.funcUnassignRoleOrigin = minimock.CallerInfo(1)
+	return mmUnassignRole.mock
+}
+
+// When sets expectation for the RoleRepository.UnassignRole which will trigger the result defined by the following
+// Then helper
+func (mmUnassignRole *mRoleRepositoryMockUnassignRole) When(ctx context.Context, userRole model.UserRole) *RoleRepositoryMockUnassignRoleExpectation {
+	if mmUnassignRole.mock.funcUnassignRole != nil {
+		mmUnassignRole.mock.t.Fatalf("RoleRepositoryMock.UnassignRole mock is already set by Set")
+	}
+
+	expectation := &RoleRepositoryMockUnassignRoleExpectation{
+		mock:               mmUnassignRole.mock,
+		params:             &RoleRepositoryMockUnassignRoleParams{ctx, userRole},
+		expectationOrigins: RoleRepositoryMockUnassignRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUnassignRole.expectations = append(mmUnassignRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleRepository.UnassignRole return parameters for the expectation previously defined by the When method
+func (e *RoleRepositoryMockUnassignRoleExpectation) Then(err error) *RoleRepositoryMock {
+	e.results = &RoleRepositoryMockUnassignRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleRepository.UnassignRole should be invoked
+func (mmUnassignRole *mRoleRepositoryMockUnassignRole) Times(n uint64) *mRoleRepositoryMockUnassignRole {
+	if n == 0 {
+		mmUnassignRole.mock.t.Fatalf("Times of RoleRepositoryMock.UnassignRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUnassignRole.expectedInvocations, n)
+	mmUnassignRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUnassignRole
+}
+
+func (mmUnassignRole *mRoleRepositoryMockUnassignRole) invocationsDone() bool {
+	if len(mmUnassignRole.expectations) == 0 && mmUnassignRole.defaultExpectation == nil && mmUnassignRole.mock.funcUnassignRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUnassignRole.mock.afterUnassignRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnassignRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UnassignRole implements mm_repository.RoleRepository
+func (mmUnassignRole *RoleRepositoryMock) UnassignRole(ctx context.Context, userRole model.UserRole) (err error) {
+	mm_atomic.AddUint64(&mmUnassignRole.beforeUnassignRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnassignRole.afterUnassignRoleCounter, 1)
+
+	mmUnassignRole.t.Helper()
+
+	if mmUnassignRole.inspectFuncUnassignRole != nil {
+		mmUnassignRole.inspectFuncUnassignRole(ctx, userRole)
+	}
+
+	mm_params := RoleRepositoryMockUnassignRoleParams{ctx, userRole}
+
+	// Record call args
+	mmUnassignRole.UnassignRoleMock.mutex.Lock()
+	mmUnassignRole.UnassignRoleMock.callArgs = append(mmUnassignRole.UnassignRoleMock.callArgs, &mm_params)
+	mmUnassignRole.UnassignRoleMock.mutex.Unlock()
+
+	for _, e := range mmUnassignRole.UnassignRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUnassignRole.UnassignRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnassignRole.UnassignRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnassignRole.UnassignRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmUnassignRole.UnassignRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleRepositoryMockUnassignRoleParams{ctx, userRole}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUnassignRole.t.Errorf("RoleRepositoryMock.UnassignRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnassignRole.UnassignRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userRole != nil && !minimock.Equal(*mm_want_ptrs.userRole, mm_got.userRole) {
+				mmUnassignRole.t.Errorf("RoleRepositoryMock.UnassignRole got unexpected parameter userRole, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnassignRole.UnassignRoleMock.defaultExpectation.expectationOrigins.originUserRole, *mm_want_ptrs.userRole, mm_got.userRole, minimock.Diff(*mm_want_ptrs.userRole, mm_got.userRole))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUnassignRole.t.Errorf("RoleRepositoryMock.UnassignRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUnassignRole.UnassignRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUnassignRole.UnassignRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUnassignRole.t.Fatal("No results are set for the RoleRepositoryMock.UnassignRole")
+		}
+		return (*mm_results).err
+	}
+	if mmUnassignRole.funcUnassignRole != nil {
+		return mmUnassignRole.funcUnassignRole(ctx, userRole)
+	}
+	mmUnassignRole.t.Fatalf("Unexpected call to RoleRepositoryMock.UnassignRole. %v %v", ctx, userRole)
+	return
+}
+
+// UnassignRoleAfterCounter returns a count of finished RoleRepositoryMock.UnassignRole invocations
+func (mmUnassignRole *RoleRepositoryMock) UnassignRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnassignRole.afterUnassignRoleCounter)
+}
+
+// UnassignRoleBeforeCounter returns a count of RoleRepositoryMock.UnassignRole invocations
+func (mmUnassignRole *RoleRepositoryMock) UnassignRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnassignRole.beforeUnassignRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleRepositoryMock.UnassignRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUnassignRole *mRoleRepositoryMockUnassignRole) Calls() []*RoleRepositoryMockUnassignRoleParams {
+	mmUnassignRole.mutex.RLock()
+
+	argCopy := make([]*RoleRepositoryMockUnassignRoleParams, len(mmUnassignRole.callArgs))
+	copy(argCopy, mmUnassignRole.callArgs)
+
+	mmUnassignRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUnassignRoleDone returns true if the count of the UnassignRole invocations corresponds
+// the number of defined expectations
+func (m *RoleRepositoryMock) MinimockUnassignRoleDone() bool {
+	if m.UnassignRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UnassignRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UnassignRoleMock.invocationsDone()
+}
+
+// MinimockUnassignRoleInspect logs each unmet expectation
+func (m *RoleRepositoryMock) MinimockUnassignRoleInspect() {
+	for _, e := range m.UnassignRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleRepositoryMock.UnassignRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUnassignRoleCounter := mm_atomic.LoadUint64(&m.afterUnassignRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UnassignRoleMock.defaultExpectation != nil && afterUnassignRoleCounter < 1 {
+		if m.UnassignRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleRepositoryMock.UnassignRole at\n%s", m.UnassignRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleRepositoryMock.UnassignRole at\n%s with params: %#v", m.UnassignRoleMock.defaultExpectation.expectationOrigins.origin, *m.UnassignRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUnassignRole != nil && afterUnassignRoleCounter < 1 {
+		m.t.Errorf("Expected call to RoleRepositoryMock.UnassignRole at\n%s", m.funcUnassignRoleOrigin)
+	}
+
+	if !m.UnassignRoleMock.invocationsDone() && afterUnassignRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleRepositoryMock.UnassignRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UnassignRoleMock.expectedInvocations), m.UnassignRoleMock.expectedInvocationsOrigin, afterUnassignRoleCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RoleRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddPermissionInspect()
+
+			m.MinimockAssignRoleInspect()
+
+			m.MinimockCreateAuditInspect()
+
+			m.MinimockCreateRoleInspect()
+
+			m.MinimockDeleteRoleInspect()
+
+			m.MinimockGetUserRolesInspect()
+
+			m.MinimockListRolesInspect()
+
+			m.MinimockMakeLogInspect()
+
+			m.MinimockRemovePermissionInspect()
+
+			m.MinimockUnassignRoleInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RoleRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RoleRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddPermissionDone() &&
+		m.MinimockAssignRoleDone() &&
+		m.MinimockCreateAuditDone() &&
+		m.MinimockCreateRoleDone() &&
+		m.MinimockDeleteRoleDone() &&
+		m.MinimockGetUserRolesDone() &&
+		m.MinimockListRolesDone() &&
+		m.MinimockMakeLogDone() &&
+		m.MinimockRemovePermissionDone() &&
+		m.MinimockUnassignRoleDone()
+}
@@ -19,6 +19,20 @@ type UserRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCreateEmailVerificationToken          func(ctx context.Context, token model.EmailVerificationToken) (err error)
+	funcCreateEmailVerificationTokenOrigin    string
+	inspectFuncCreateEmailVerificationToken   func(ctx context.Context, token model.EmailVerificationToken)
+	afterCreateEmailVerificationTokenCounter  uint64
+	beforeCreateEmailVerificationTokenCounter uint64
+	CreateEmailVerificationTokenMock          mUserRepositoryMockCreateEmailVerificationToken
+
+	funcCreatePasswordResetToken          func(ctx context.Context, token model.PasswordResetToken) (err error)
+	funcCreatePasswordResetTokenOrigin    string
+	inspectFuncCreatePasswordResetToken   func(ctx context.Context, token model.PasswordResetToken)
+	afterCreatePasswordResetTokenCounter  uint64
+	beforeCreatePasswordResetTokenCounter uint64
+	CreatePasswordResetTokenMock          mUserRepositoryMockCreatePasswordResetToken
+
 	funcCreateUser          func(ctx context.Context, user model.User) (i1 int64, err error)
 	funcCreateUserOrigin    string
 	inspectFuncCreateUser   func(ctx context.Context, user model.User)
@@ -40,6 +54,13 @@ type UserRepositoryMock struct {
 	beforeGetUserCounter uint64
 	GetUserMock          mUserRepositoryMockGetUser
 
+	funcGetUserByEmail          func(ctx context.Context, email string) (up1 *model.User, err error)
+	funcGetUserByEmailOrigin    string
+	inspectFuncGetUserByEmail   func(ctx context.Context, email string)
+	afterGetUserByEmailCounter  uint64
+	beforeGetUserByEmailCounter uint64
+	GetUserByEmailMock          mUserRepositoryMockGetUserByEmail
+
 	funcMakeLog          func(ctx context.Context, log model.Log) (err error)
 	funcMakeLogOrigin    string
 	inspectFuncMakeLog   func(ctx context.Context, log model.Log)
@@ -47,12 +68,40 @@ type UserRepositoryMock struct {
 	beforeMakeLogCounter uint64
 	MakeLogMock          mUserRepositoryMockMakeLog
 
+	funcSetEmailVerified          func(ctx context.Context, id int64, verified bool) (err error)
+	funcSetEmailVerifiedOrigin    string
+	inspectFuncSetEmailVerified   func(ctx context.Context, id int64, verified bool)
+	afterSetEmailVerifiedCounter  uint64
+	beforeSetEmailVerifiedCounter uint64
+	SetEmailVerifiedMock          mUserRepositoryMockSetEmailVerified
+
+	funcUpdatePassword          func(ctx context.Context, id int64, password string) (err error)
+	funcUpdatePasswordOrigin    string
+	inspectFuncUpdatePassword   func(ctx context.Context, id int64, password string)
+	afterUpdatePasswordCounter  uint64
+	beforeUpdatePasswordCounter uint64
+	UpdatePasswordMock          mUserRepositoryMockUpdatePassword
+
 	funcUpdateUser          func(ctx context.Context, id int64, info model.UserInfo) (err error)
 	funcUpdateUserOrigin    string
 	inspectFuncUpdateUser   func(ctx context.Context, id int64, info model.UserInfo)
 	afterUpdateUserCounter  uint64
 	beforeUpdateUserCounter uint64
 	UpdateUserMock          mUserRepositoryMockUpdateUser
+
+	funcUseEmailVerificationToken          func(ctx context.Context, tokenHash string) (i1 int64, err error)
+	funcUseEmailVerificationTokenOrigin    string
+	inspectFuncUseEmailVerificationToken   func(ctx context.Context, tokenHash string)
+	afterUseEmailVerificationTokenCounter  uint64
+	beforeUseEmailVerificationTokenCounter uint64
+	UseEmailVerificationTokenMock          mUserRepositoryMockUseEmailVerificationToken
+
+	funcUsePasswordResetToken          func(ctx context.Context, tokenHash string) (i1 int64, err error)
+	funcUsePasswordResetTokenOrigin    string
+	inspectFuncUsePasswordResetToken   func(ctx context.Context, tokenHash string)
+	afterUsePasswordResetTokenCounter  uint64
+	beforeUsePasswordResetTokenCounter uint64
+	UsePasswordResetTokenMock          mUserRepositoryMockUsePasswordResetToken
 }
 
 // NewUserRepositoryMock returns a mock for mm_repository.UserRepository
@@ -63,6 +112,12 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CreateEmailVerificationTokenMock = mUserRepositoryMockCreateEmailVerificationToken{mock: m}
+	m.CreateEmailVerificationTokenMock.callArgs = []*UserRepositoryMockCreateEmailVerificationTokenParams{}
+
+	m.CreatePasswordResetTokenMock = mUserRepositoryMockCreatePasswordResetToken{mock: m}
+	m.CreatePasswordResetTokenMock.callArgs = []*UserRepositoryMockCreatePasswordResetTokenParams{}
+
 	m.CreateUserMock = mUserRepositoryMockCreateUser{mock: m}
 	m.CreateUserMock.callArgs = []*UserRepositoryMockCreateUserParams{}
 
@@ -72,64 +127,78 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 	m.GetUserMock = mUserRepositoryMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*UserRepositoryMockGetUserParams{}
 
+	m.GetUserByEmailMock = mUserRepositoryMockGetUserByEmail{mock: m}
+	m.GetUserByEmailMock.callArgs = []*UserRepositoryMockGetUserByEmailParams{}
+
 	m.MakeLogMock = mUserRepositoryMockMakeLog{mock: m}
 	m.MakeLogMock.callArgs = []*UserRepositoryMockMakeLogParams{}
 
+	m.SetEmailVerifiedMock = mUserRepositoryMockSetEmailVerified{mock: m}
+	m.SetEmailVerifiedMock.callArgs = []*UserRepositoryMockSetEmailVerifiedParams{}
+
+	m.UpdatePasswordMock = mUserRepositoryMockUpdatePassword{mock: m}
+	m.UpdatePasswordMock.callArgs = []*UserRepositoryMockUpdatePasswordParams{}
+
 	m.UpdateUserMock = mUserRepositoryMockUpdateUser{mock: m}
 	m.UpdateUserMock.callArgs = []*UserRepositoryMockUpdateUserParams{}
 
+	m.UseEmailVerificationTokenMock = mUserRepositoryMockUseEmailVerificationToken{mock: m}
+	m.UseEmailVerificationTokenMock.callArgs = []*UserRepositoryMockUseEmailVerificationTokenParams{}
+
+	m.UsePasswordResetTokenMock = mUserRepositoryMockUsePasswordResetToken{mock: m}
+	m.UsePasswordResetTokenMock.callArgs = []*UserRepositoryMockUsePasswordResetTokenParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mUserRepositoryMockCreateUser struct {
+type mUserRepositoryMockCreateEmailVerificationToken struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockCreateUserExpectation
-	expectations       []*UserRepositoryMockCreateUserExpectation
+	defaultExpectation *UserRepositoryMockCreateEmailVerificationTokenExpectation
+	expectations       []*UserRepositoryMockCreateEmailVerificationTokenExpectation
 
-	callArgs []*UserRepositoryMockCreateUserParams
+	callArgs []*UserRepositoryMockCreateEmailVerificationTokenParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockCreateUserExpectation specifies expectation struct of the UserRepository.CreateUser
-type UserRepositoryMockCreateUserExpectation struct {
+// UserRepositoryMockCreateEmailVerificationTokenExpectation specifies expectation struct of the UserRepository.CreateEmailVerificationToken
+type UserRepositoryMockCreateEmailVerificationTokenExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockCreateUserParams
-	paramPtrs          *UserRepositoryMockCreateUserParamPtrs
-	expectationOrigins UserRepositoryMockCreateUserExpectationOrigins
-	results            *UserRepositoryMockCreateUserResults
+	params             *UserRepositoryMockCreateEmailVerificationTokenParams
+	paramPtrs          *UserRepositoryMockCreateEmailVerificationTokenParamPtrs
+	expectationOrigins UserRepositoryMockCreateEmailVerificationTokenExpectationOrigins
+	results            *UserRepositoryMockCreateEmailVerificationTokenResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockCreateUserParams contains parameters of the UserRepository.CreateUser
-type UserRepositoryMockCreateUserParams struct {
-	ctx  context.Context
-	user model.User
+// UserRepositoryMockCreateEmailVerificationTokenParams contains parameters of the UserRepository.CreateEmailVerificationToken
+type UserRepositoryMockCreateEmailVerificationTokenParams struct {
+	ctx   context.Context
+	token model.EmailVerificationToken
 }
 
-// UserRepositoryMockCreateUserParamPtrs contains pointers to parameters of the UserRepository.CreateUser
-type UserRepositoryMockCreateUserParamPtrs struct {
-	ctx  *context.Context
-	user *model.User
+// UserRepositoryMockCreateEmailVerificationTokenParamPtrs contains pointers to parameters of the UserRepository.CreateEmailVerificationToken
+type UserRepositoryMockCreateEmailVerificationTokenParamPtrs struct {
+	ctx   *context.Context
+	token *model.EmailVerificationToken
 }
 
-// UserRepositoryMockCreateUserResults contains results of the UserRepository.CreateUser
-type UserRepositoryMockCreateUserResults struct {
-	i1  int64
+// UserRepositoryMockCreateEmailVerificationTokenResults contains results of the UserRepository.CreateEmailVerificationToken
+type UserRepositoryMockCreateEmailVerificationTokenResults struct {
 	err error
 }
 
-// UserRepositoryMockCreateUserOrigins contains origins of expectations of the UserRepository.CreateUser
-type UserRepositoryMockCreateUserExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originUser string
+// UserRepositoryMockCreateEmailVerificationTokenOrigins contains origins of expectations of the UserRepository.CreateEmailVerificationToken
+type UserRepositoryMockCreateEmailVerificationTokenExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -137,341 +206,341 @@ type UserRepositoryMockCreateUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateUser *mUserRepositoryMockCreateUser) Optional() *mUserRepositoryMockCreateUser {
-	mmCreateUser.optional = true
-	return mmCreateUser
+func (mmCreateEmailVerificationToken *mUserRepositoryMockCreateEmailVerificationToken) Optional() *mUserRepositoryMockCreateEmailVerificationToken {
+	mmCreateEmailVerificationToken.optional = true
+	return mmCreateEmailVerificationToken
 }
 
-// Expect sets up expected params for UserRepository.CreateUser
-func (mmCreateUser *mUserRepositoryMockCreateUser) Expect(ctx context.Context, user model.User) *mUserRepositoryMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+// Expect sets up expected params for UserRepository.CreateEmailVerificationToken
+func (mmCreateEmailVerificationToken *mUserRepositoryMockCreateEmailVerificationToken) Expect(ctx context.Context, token model.EmailVerificationToken) *mUserRepositoryMockCreateEmailVerificationToken {
+	if mmCreateEmailVerificationToken.mock.funcCreateEmailVerificationToken != nil {
+		mmCreateEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.CreateEmailVerificationToken mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
+	if mmCreateEmailVerificationToken.defaultExpectation == nil {
+		mmCreateEmailVerificationToken.defaultExpectation = &UserRepositoryMockCreateEmailVerificationTokenExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by ExpectParams functions")
+	if mmCreateEmailVerificationToken.defaultExpectation.paramPtrs != nil {
+		mmCreateEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.CreateEmailVerificationToken mock is already set by ExpectParams functions")
 	}
 
-	mmCreateUser.defaultExpectation.params = &UserRepositoryMockCreateUserParams{ctx, user}
-	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateUser.expectations {
-		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
-			mmCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUser.defaultExpectation.params)
+	mmCreateEmailVerificationToken.defaultExpectation.params = &UserRepositoryMockCreateEmailVerificationTokenParams{ctx, token}
+	mmCreateEmailVerificationToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateEmailVerificationToken.expectations {
+		if minimock.Equal(e.params, mmCreateEmailVerificationToken.defaultExpectation.params) {
+			mmCreateEmailVerificationToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateEmailVerificationToken.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateUser
+	return mmCreateEmailVerificationToken
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.CreateUser
-func (mmCreateUser *mUserRepositoryMockCreateUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.CreateEmailVerificationToken
+func (mmCreateEmailVerificationToken *mUserRepositoryMockCreateEmailVerificationToken) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCreateEmailVerificationToken {
+	if mmCreateEmailVerificationToken.mock.funcCreateEmailVerificationToken != nil {
+		mmCreateEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.CreateEmailVerificationToken mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
+	if mmCreateEmailVerificationToken.defaultExpectation == nil {
+		mmCreateEmailVerificationToken.defaultExpectation = &UserRepositoryMockCreateEmailVerificationTokenExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.params != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Expect")
+	if mmCreateEmailVerificationToken.defaultExpectation.params != nil {
+		mmCreateEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.CreateEmailVerificationToken mock is already set by Expect")
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs == nil {
-		mmCreateUser.defaultExpectation.paramPtrs = &UserRepositoryMockCreateUserParamPtrs{}
+	if mmCreateEmailVerificationToken.defaultExpectation.paramPtrs == nil {
+		mmCreateEmailVerificationToken.defaultExpectation.paramPtrs = &UserRepositoryMockCreateEmailVerificationTokenParamPtrs{}
 	}
-	mmCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreateEmailVerificationToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateEmailVerificationToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateUser
+	return mmCreateEmailVerificationToken
 }
 
-// ExpectUserParam2 sets up expected param user for UserRepository.CreateUser
-func (mmCreateUser *mUserRepositoryMockCreateUser) ExpectUserParam2(user model.User) *mUserRepositoryMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+// ExpectTokenParam2 sets up expected param token for UserRepository.CreateEmailVerificationToken
+func (mmCreateEmailVerificationToken *mUserRepositoryMockCreateEmailVerificationToken) ExpectTokenParam2(token model.EmailVerificationToken) *mUserRepositoryMockCreateEmailVerificationToken {
+	if mmCreateEmailVerificationToken.mock.funcCreateEmailVerificationToken != nil {
+		mmCreateEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.CreateEmailVerificationToken mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
+	if mmCreateEmailVerificationToken.defaultExpectation == nil {
+		mmCreateEmailVerificationToken.defaultExpectation = &UserRepositoryMockCreateEmailVerificationTokenExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.params != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Expect")
+	if mmCreateEmailVerificationToken.defaultExpectation.params != nil {
+		mmCreateEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.CreateEmailVerificationToken mock is already set by Expect")
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs == nil {
-		mmCreateUser.defaultExpectation.paramPtrs = &UserRepositoryMockCreateUserParamPtrs{}
+	if mmCreateEmailVerificationToken.defaultExpectation.paramPtrs == nil {
+		mmCreateEmailVerificationToken.defaultExpectation.paramPtrs = &UserRepositoryMockCreateEmailVerificationTokenParamPtrs{}
 	}
-	mmCreateUser.defaultExpectation.paramPtrs.user = &user
-	mmCreateUser.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+	mmCreateEmailVerificationToken.defaultExpectation.paramPtrs.token = &token
+	mmCreateEmailVerificationToken.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
 
-	return mmCreateUser
+	return mmCreateEmailVerificationToken
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.CreateUser
-func (mmCreateUser *mUserRepositoryMockCreateUser) Inspect(f func(ctx context.Context, user model.User)) *mUserRepositoryMockCreateUser {
-	if mmCreateUser.mock.inspectFuncCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.CreateUser")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.CreateEmailVerificationToken
+func (mmCreateEmailVerificationToken *mUserRepositoryMockCreateEmailVerificationToken) Inspect(f func(ctx context.Context, token model.EmailVerificationToken)) *mUserRepositoryMockCreateEmailVerificationToken {
+	if mmCreateEmailVerificationToken.mock.inspectFuncCreateEmailVerificationToken != nil {
+		mmCreateEmailVerificationToken.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.CreateEmailVerificationToken")
 	}
 
-	mmCreateUser.mock.inspectFuncCreateUser = f
+	mmCreateEmailVerificationToken.mock.inspectFuncCreateEmailVerificationToken = f
 
-	return mmCreateUser
+	return mmCreateEmailVerificationToken
 }
 
-// Return sets up results that will be returned by UserRepository.CreateUser
-func (mmCreateUser *mUserRepositoryMockCreateUser) Return(i1 int64, err error) *UserRepositoryMock {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+// Return sets up results that will be returned by UserRepository.CreateEmailVerificationToken
+func (mmCreateEmailVerificationToken *mUserRepositoryMockCreateEmailVerificationToken) Return(err error) *UserRepositoryMock {
+	if mmCreateEmailVerificationToken.mock.funcCreateEmailVerificationToken != nil {
+		mmCreateEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.CreateEmailVerificationToken mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{mock: mmCreateUser.mock}
+	if mmCreateEmailVerificationToken.defaultExpectation == nil {
+		mmCreateEmailVerificationToken.defaultExpectation = &UserRepositoryMockCreateEmailVerificationTokenExpectation{mock: mmCreateEmailVerificationToken.mock}
 	}
-	mmCreateUser.defaultExpectation.results = &UserRepositoryMockCreateUserResults{i1, err}
-	mmCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateUser.mock
+	mmCreateEmailVerificationToken.defaultExpectation.results = &UserRepositoryMockCreateEmailVerificationTokenResults{err}
+	mmCreateEmailVerificationToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateEmailVerificationToken.mock
 }
 
-// Set uses given function f to mock the UserRepository.CreateUser method
-func (mmCreateUser *mUserRepositoryMockCreateUser) Set(f func(ctx context.Context, user model.User) (i1 int64, err error)) *UserRepositoryMock {
-	if mmCreateUser.defaultExpectation != nil {
-		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.CreateUser method")
+// Set uses given function f to mock the UserRepository.CreateEmailVerificationToken method
+func (mmCreateEmailVerificationToken *mUserRepositoryMockCreateEmailVerificationToken) Set(f func(ctx context.Context, token model.EmailVerificationToken) (err error)) *UserRepositoryMock {
+	if mmCreateEmailVerificationToken.defaultExpectation != nil {
+		mmCreateEmailVerificationToken.mock.t.Fatalf("Default expectation is already set for the UserRepository.CreateEmailVerificationToken method")
 	}
 
-	if len(mmCreateUser.expectations) > 0 {
-		mmCreateUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.CreateUser method")
+	if len(mmCreateEmailVerificationToken.expectations) > 0 {
+		mmCreateEmailVerificationToken.mock.t.Fatalf("Some expectations are already set for the UserRepository.CreateEmailVerificationToken method")
 	}
 
-	mmCreateUser.mock.funcCreateUser = f
-	mmCreateUser.mock.funcCreateUserOrigin = minimock.CallerInfo(1)
-	return mmCreateUser.mock
+	mmCreateEmailVerificationToken.mock.funcCreateEmailVerificationToken = f
+	mmCreateEmailVerificationToken.mock.funcCreateEmailVerificationTokenOrigin = minimock.CallerInfo(1)
+	return mmCreateEmailVerificationToken.mock
 }
 
-// When sets expectation for the UserRepository.CreateUser which will trigger the result defined by the following
+// When sets expectation for the UserRepository.CreateEmailVerificationToken which will trigger the result defined by the following
 // Then helper
-func (mmCreateUser *mUserRepositoryMockCreateUser) When(ctx context.Context, user model.User) *UserRepositoryMockCreateUserExpectation {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+func (mmCreateEmailVerificationToken *mUserRepositoryMockCreateEmailVerificationToken) When(ctx context.Context, token model.EmailVerificationToken) *UserRepositoryMockCreateEmailVerificationTokenExpectation {
+	if mmCreateEmailVerificationToken.mock.funcCreateEmailVerificationToken != nil {
+		mmCreateEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.CreateEmailVerificationToken mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockCreateUserExpectation{
-		mock:               mmCreateUser.mock,
-		params:             &UserRepositoryMockCreateUserParams{ctx, user},
-		expectationOrigins: UserRepositoryMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockCreateEmailVerificationTokenExpectation{
+		mock:               mmCreateEmailVerificationToken.mock,
+		params:             &UserRepositoryMockCreateEmailVerificationTokenParams{ctx, token},
+		expectationOrigins: UserRepositoryMockCreateEmailVerificationTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
+	mmCreateEmailVerificationToken.expectations = append(mmCreateEmailVerificationToken.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.CreateUser return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockCreateUserExpectation) Then(i1 int64, err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockCreateUserResults{i1, err}
+// Then sets up UserRepository.CreateEmailVerificationToken return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCreateEmailVerificationTokenExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCreateEmailVerificationTokenResults{err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.CreateUser should be invoked
-func (mmCreateUser *mUserRepositoryMockCreateUser) Times(n uint64) *mUserRepositoryMockCreateUser {
+// Times sets number of times UserRepository.CreateEmailVerificationToken should be invoked
+func (mmCreateEmailVerificationToken *mUserRepositoryMockCreateEmailVerificationToken) Times(n uint64) *mUserRepositoryMockCreateEmailVerificationToken {
 	if n == 0 {
-		mmCreateUser.mock.t.Fatalf("Times of UserRepositoryMock.CreateUser mock can not be zero")
+		mmCreateEmailVerificationToken.mock.t.Fatalf("Times of UserRepositoryMock.CreateEmailVerificationToken mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateUser.expectedInvocations, n)
-	mmCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateUser
+	mm_atomic.StoreUint64(&mmCreateEmailVerificationToken.expectedInvocations, n)
+	mmCreateEmailVerificationToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateEmailVerificationToken
 }
 
-func (mmCreateUser *mUserRepositoryMockCreateUser) invocationsDone() bool {
-	if len(mmCreateUser.expectations) == 0 && mmCreateUser.defaultExpectation == nil && mmCreateUser.mock.funcCreateUser == nil {
+func (mmCreateEmailVerificationToken *mUserRepositoryMockCreateEmailVerificationToken) invocationsDone() bool {
+	if len(mmCreateEmailVerificationToken.expectations) == 0 && mmCreateEmailVerificationToken.defaultExpectation == nil && mmCreateEmailVerificationToken.mock.funcCreateEmailVerificationToken == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateUser.mock.afterCreateUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateEmailVerificationToken.mock.afterCreateEmailVerificationTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateEmailVerificationToken.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateUser implements mm_repository.UserRepository
-func (mmCreateUser *UserRepositoryMock) CreateUser(ctx context.Context, user model.User) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
+// CreateEmailVerificationToken implements mm_repository.UserRepository
+func (mmCreateEmailVerificationToken *UserRepositoryMock) CreateEmailVerificationToken(ctx context.Context, token model.EmailVerificationToken) (err error) {
+	mm_atomic.AddUint64(&mmCreateEmailVerificationToken.beforeCreateEmailVerificationTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateEmailVerificationToken.afterCreateEmailVerificationTokenCounter, 1)
 
-	mmCreateUser.t.Helper()
+	mmCreateEmailVerificationToken.t.Helper()
 
-	if mmCreateUser.inspectFuncCreateUser != nil {
-		mmCreateUser.inspectFuncCreateUser(ctx, user)
+	if mmCreateEmailVerificationToken.inspectFuncCreateEmailVerificationToken != nil {
+		mmCreateEmailVerificationToken.inspectFuncCreateEmailVerificationToken(ctx, token)
 	}
 
-	mm_params := UserRepositoryMockCreateUserParams{ctx, user}
+	mm_params := UserRepositoryMockCreateEmailVerificationTokenParams{ctx, token}
 
 	// Record call args
-	mmCreateUser.CreateUserMock.mutex.Lock()
-	mmCreateUser.CreateUserMock.callArgs = append(mmCreateUser.CreateUserMock.callArgs, &mm_params)
-	mmCreateUser.CreateUserMock.mutex.Unlock()
+	mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.mutex.Lock()
+	mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.callArgs = append(mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.callArgs, &mm_params)
+	mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.mutex.Unlock()
 
-	for _, e := range mmCreateUser.CreateUserMock.expectations {
+	for _, e := range mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreateUser.CreateUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateUser.CreateUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
+	if mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockCreateUserParams{ctx, user}
+		mm_got := UserRepositoryMockCreateEmailVerificationTokenParams{ctx, token}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCreateEmailVerificationToken.t.Errorf("UserRepositoryMock.CreateEmailVerificationToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
-				mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmCreateEmailVerificationToken.t.Errorf("UserRepositoryMock.CreateEmailVerificationToken got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreateEmailVerificationToken.t.Errorf("UserRepositoryMock.CreateEmailVerificationToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateUser.CreateUserMock.defaultExpectation.results
+		mm_results := mmCreateEmailVerificationToken.CreateEmailVerificationTokenMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateUser.t.Fatal("No results are set for the UserRepositoryMock.CreateUser")
+			mmCreateEmailVerificationToken.t.Fatal("No results are set for the UserRepositoryMock.CreateEmailVerificationToken")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreateUser.funcCreateUser != nil {
-		return mmCreateUser.funcCreateUser(ctx, user)
+	if mmCreateEmailVerificationToken.funcCreateEmailVerificationToken != nil {
+		return mmCreateEmailVerificationToken.funcCreateEmailVerificationToken(ctx, token)
 	}
-	mmCreateUser.t.Fatalf("Unexpected call to UserRepositoryMock.CreateUser. %v %v", ctx, user)
+	mmCreateEmailVerificationToken.t.Fatalf("Unexpected call to UserRepositoryMock.CreateEmailVerificationToken. %v %v", ctx, token)
 	return
 }
 
-// CreateUserAfterCounter returns a count of finished UserRepositoryMock.CreateUser invocations
-func (mmCreateUser *UserRepositoryMock) CreateUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateUser.afterCreateUserCounter)
+// CreateEmailVerificationTokenAfterCounter returns a count of finished UserRepositoryMock.CreateEmailVerificationToken invocations
+func (mmCreateEmailVerificationToken *UserRepositoryMock) CreateEmailVerificationTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateEmailVerificationToken.afterCreateEmailVerificationTokenCounter)
 }
 
-// CreateUserBeforeCounter returns a count of UserRepositoryMock.CreateUser invocations
-func (mmCreateUser *UserRepositoryMock) CreateUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateUser.beforeCreateUserCounter)
+// CreateEmailVerificationTokenBeforeCounter returns a count of UserRepositoryMock.CreateEmailVerificationToken invocations
+func (mmCreateEmailVerificationToken *UserRepositoryMock) CreateEmailVerificationTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateEmailVerificationToken.beforeCreateEmailVerificationTokenCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.CreateUser.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.CreateEmailVerificationToken.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateUser *mUserRepositoryMockCreateUser) Calls() []*UserRepositoryMockCreateUserParams {
-	mmCreateUser.mutex.RLock()
+func (mmCreateEmailVerificationToken *mUserRepositoryMockCreateEmailVerificationToken) Calls() []*UserRepositoryMockCreateEmailVerificationTokenParams {
+	mmCreateEmailVerificationToken.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockCreateUserParams, len(mmCreateUser.callArgs))
-	copy(argCopy, mmCreateUser.callArgs)
+	argCopy := make([]*UserRepositoryMockCreateEmailVerificationTokenParams, len(mmCreateEmailVerificationToken.callArgs))
+	copy(argCopy, mmCreateEmailVerificationToken.callArgs)
 
-	mmCreateUser.mutex.RUnlock()
+	mmCreateEmailVerificationToken.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateUserDone returns true if the count of the CreateUser invocations corresponds
+// MinimockCreateEmailVerificationTokenDone returns true if the count of the CreateEmailVerificationToken invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockCreateUserDone() bool {
-	if m.CreateUserMock.optional {
+func (m *UserRepositoryMock) MinimockCreateEmailVerificationTokenDone() bool {
+	if m.CreateEmailVerificationTokenMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateUserMock.expectations {
+	for _, e := range m.CreateEmailVerificationTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateUserMock.invocationsDone()
+	return m.CreateEmailVerificationTokenMock.invocationsDone()
 }
 
-// MinimockCreateUserInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockCreateUserInspect() {
-	for _, e := range m.CreateUserMock.expectations {
+// MinimockCreateEmailVerificationTokenInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCreateEmailVerificationTokenInspect() {
+	for _, e := range m.CreateEmailVerificationTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateEmailVerificationToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateUserCounter := mm_atomic.LoadUint64(&m.afterCreateUserCounter)
+	afterCreateEmailVerificationTokenCounter := mm_atomic.LoadUint64(&m.afterCreateEmailVerificationTokenCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateUserMock.defaultExpectation != nil && afterCreateUserCounter < 1 {
-		if m.CreateUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s", m.CreateUserMock.defaultExpectation.returnOrigin)
+	if m.CreateEmailVerificationTokenMock.defaultExpectation != nil && afterCreateEmailVerificationTokenCounter < 1 {
+		if m.CreateEmailVerificationTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateEmailVerificationToken at\n%s", m.CreateEmailVerificationTokenMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s with params: %#v", m.CreateUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateEmailVerificationToken at\n%s with params: %#v", m.CreateEmailVerificationTokenMock.defaultExpectation.expectationOrigins.origin, *m.CreateEmailVerificationTokenMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateUser != nil && afterCreateUserCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s", m.funcCreateUserOrigin)
+	if m.funcCreateEmailVerificationToken != nil && afterCreateEmailVerificationTokenCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.CreateEmailVerificationToken at\n%s", m.funcCreateEmailVerificationTokenOrigin)
 	}
 
-	if !m.CreateUserMock.invocationsDone() && afterCreateUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.CreateUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateUserMock.expectedInvocations), m.CreateUserMock.expectedInvocationsOrigin, afterCreateUserCounter)
+	if !m.CreateEmailVerificationTokenMock.invocationsDone() && afterCreateEmailVerificationTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.CreateEmailVerificationToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateEmailVerificationTokenMock.expectedInvocations), m.CreateEmailVerificationTokenMock.expectedInvocationsOrigin, afterCreateEmailVerificationTokenCounter)
 	}
 }
 
-type mUserRepositoryMockDeleteUser struct {
+type mUserRepositoryMockCreatePasswordResetToken struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockDeleteUserExpectation
-	expectations       []*UserRepositoryMockDeleteUserExpectation
+	defaultExpectation *UserRepositoryMockCreatePasswordResetTokenExpectation
+	expectations       []*UserRepositoryMockCreatePasswordResetTokenExpectation
 
-	callArgs []*UserRepositoryMockDeleteUserParams
+	callArgs []*UserRepositoryMockCreatePasswordResetTokenParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockDeleteUserExpectation specifies expectation struct of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserExpectation struct {
+// UserRepositoryMockCreatePasswordResetTokenExpectation specifies expectation struct of the UserRepository.CreatePasswordResetToken
+type UserRepositoryMockCreatePasswordResetTokenExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockDeleteUserParams
-	paramPtrs          *UserRepositoryMockDeleteUserParamPtrs
-	expectationOrigins UserRepositoryMockDeleteUserExpectationOrigins
-	results            *UserRepositoryMockDeleteUserResults
+	params             *UserRepositoryMockCreatePasswordResetTokenParams
+	paramPtrs          *UserRepositoryMockCreatePasswordResetTokenParamPtrs
+	expectationOrigins UserRepositoryMockCreatePasswordResetTokenExpectationOrigins
+	results            *UserRepositoryMockCreatePasswordResetTokenResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockDeleteUserParams contains parameters of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserParams struct {
-	ctx context.Context
-	id  int64
+// UserRepositoryMockCreatePasswordResetTokenParams contains parameters of the UserRepository.CreatePasswordResetToken
+type UserRepositoryMockCreatePasswordResetTokenParams struct {
+	ctx   context.Context
+	token model.PasswordResetToken
 }
 
-// UserRepositoryMockDeleteUserParamPtrs contains pointers to parameters of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+// UserRepositoryMockCreatePasswordResetTokenParamPtrs contains pointers to parameters of the UserRepository.CreatePasswordResetToken
+type UserRepositoryMockCreatePasswordResetTokenParamPtrs struct {
+	ctx   *context.Context
+	token *model.PasswordResetToken
 }
 
-// UserRepositoryMockDeleteUserResults contains results of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserResults struct {
+// UserRepositoryMockCreatePasswordResetTokenResults contains results of the UserRepository.CreatePasswordResetToken
+type UserRepositoryMockCreatePasswordResetTokenResults struct {
 	err error
 }
 
-// UserRepositoryMockDeleteUserOrigins contains origins of expectations of the UserRepository.DeleteUser
-type UserRepositoryMockDeleteUserExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// UserRepositoryMockCreatePasswordResetTokenOrigins contains origins of expectations of the UserRepository.CreatePasswordResetToken
+type UserRepositoryMockCreatePasswordResetTokenExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -479,342 +548,342 @@ type UserRepositoryMockDeleteUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Optional() *mUserRepositoryMockDeleteUser {
-	mmDeleteUser.optional = true
-	return mmDeleteUser
+func (mmCreatePasswordResetToken *mUserRepositoryMockCreatePasswordResetToken) Optional() *mUserRepositoryMockCreatePasswordResetToken {
+	mmCreatePasswordResetToken.optional = true
+	return mmCreatePasswordResetToken
 }
 
-// Expect sets up expected params for UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Expect(ctx context.Context, id int64) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// Expect sets up expected params for UserRepository.CreatePasswordResetToken
+func (mmCreatePasswordResetToken *mUserRepositoryMockCreatePasswordResetToken) Expect(ctx context.Context, token model.PasswordResetToken) *mUserRepositoryMockCreatePasswordResetToken {
+	if mmCreatePasswordResetToken.mock.funcCreatePasswordResetToken != nil {
+		mmCreatePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.CreatePasswordResetToken mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	if mmCreatePasswordResetToken.defaultExpectation == nil {
+		mmCreatePasswordResetToken.defaultExpectation = &UserRepositoryMockCreatePasswordResetTokenExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by ExpectParams functions")
+	if mmCreatePasswordResetToken.defaultExpectation.paramPtrs != nil {
+		mmCreatePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.CreatePasswordResetToken mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteUser.defaultExpectation.params = &UserRepositoryMockDeleteUserParams{ctx, id}
-	mmDeleteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteUser.expectations {
-		if minimock.Equal(e.params, mmDeleteUser.defaultExpectation.params) {
-			mmDeleteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUser.defaultExpectation.params)
+	mmCreatePasswordResetToken.defaultExpectation.params = &UserRepositoryMockCreatePasswordResetTokenParams{ctx, token}
+	mmCreatePasswordResetToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePasswordResetToken.expectations {
+		if minimock.Equal(e.params, mmCreatePasswordResetToken.defaultExpectation.params) {
+			mmCreatePasswordResetToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePasswordResetToken.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteUser
+	return mmCreatePasswordResetToken
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.CreatePasswordResetToken
+func (mmCreatePasswordResetToken *mUserRepositoryMockCreatePasswordResetToken) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCreatePasswordResetToken {
+	if mmCreatePasswordResetToken.mock.funcCreatePasswordResetToken != nil {
+		mmCreatePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.CreatePasswordResetToken mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	if mmCreatePasswordResetToken.defaultExpectation == nil {
+		mmCreatePasswordResetToken.defaultExpectation = &UserRepositoryMockCreatePasswordResetTokenExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.params != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	if mmCreatePasswordResetToken.defaultExpectation.params != nil {
+		mmCreatePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.CreatePasswordResetToken mock is already set by Expect")
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	if mmCreatePasswordResetToken.defaultExpectation.paramPtrs == nil {
+		mmCreatePasswordResetToken.defaultExpectation.paramPtrs = &UserRepositoryMockCreatePasswordResetTokenParamPtrs{}
 	}
-	mmDeleteUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreatePasswordResetToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePasswordResetToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteUser
+	return mmCreatePasswordResetToken
 }
 
-// ExpectIdParam2 sets up expected param id for UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectIdParam2(id int64) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// ExpectTokenParam2 sets up expected param token for UserRepository.CreatePasswordResetToken
+func (mmCreatePasswordResetToken *mUserRepositoryMockCreatePasswordResetToken) ExpectTokenParam2(token model.PasswordResetToken) *mUserRepositoryMockCreatePasswordResetToken {
+	if mmCreatePasswordResetToken.mock.funcCreatePasswordResetToken != nil {
+		mmCreatePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.CreatePasswordResetToken mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	if mmCreatePasswordResetToken.defaultExpectation == nil {
+		mmCreatePasswordResetToken.defaultExpectation = &UserRepositoryMockCreatePasswordResetTokenExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.params != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	if mmCreatePasswordResetToken.defaultExpectation.params != nil {
+		mmCreatePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.CreatePasswordResetToken mock is already set by Expect")
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	if mmCreatePasswordResetToken.defaultExpectation.paramPtrs == nil {
+		mmCreatePasswordResetToken.defaultExpectation.paramPtrs = &UserRepositoryMockCreatePasswordResetTokenParamPtrs{}
 	}
-	mmDeleteUser.defaultExpectation.paramPtrs.id = &id
-	mmDeleteUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmCreatePasswordResetToken.defaultExpectation.paramPtrs.token = &token
+	mmCreatePasswordResetToken.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
 
-	return mmDeleteUser
+	return mmCreatePasswordResetToken
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockDeleteUser {
-	if mmDeleteUser.mock.inspectFuncDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.DeleteUser")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.CreatePasswordResetToken
+func (mmCreatePasswordResetToken *mUserRepositoryMockCreatePasswordResetToken) Inspect(f func(ctx context.Context, token model.PasswordResetToken)) *mUserRepositoryMockCreatePasswordResetToken {
+	if mmCreatePasswordResetToken.mock.inspectFuncCreatePasswordResetToken != nil {
+		mmCreatePasswordResetToken.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.CreatePasswordResetToken")
 	}
 
-	mmDeleteUser.mock.inspectFuncDeleteUser = f
+	mmCreatePasswordResetToken.mock.inspectFuncCreatePasswordResetToken = f
 
-	return mmDeleteUser
+	return mmCreatePasswordResetToken
 }
 
-// Return sets up results that will be returned by UserRepository.DeleteUser
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Return(err error) *UserRepositoryMock {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+// Return sets up results that will be returned by UserRepository.CreatePasswordResetToken
+func (mmCreatePasswordResetToken *mUserRepositoryMockCreatePasswordResetToken) Return(err error) *UserRepositoryMock {
+	if mmCreatePasswordResetToken.mock.funcCreatePasswordResetToken != nil {
+		mmCreatePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.CreatePasswordResetToken mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{mock: mmDeleteUser.mock}
+	if mmCreatePasswordResetToken.defaultExpectation == nil {
+		mmCreatePasswordResetToken.defaultExpectation = &UserRepositoryMockCreatePasswordResetTokenExpectation{mock: mmCreatePasswordResetToken.mock}
 	}
-	mmDeleteUser.defaultExpectation.results = &UserRepositoryMockDeleteUserResults{err}
-	mmDeleteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser.mock
+	mmCreatePasswordResetToken.defaultExpectation.results = &UserRepositoryMockCreatePasswordResetTokenResults{err}
+	mmCreatePasswordResetToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePasswordResetToken.mock
 }
 
-// Set uses given function f to mock the UserRepository.DeleteUser method
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Set(f func(ctx context.Context, id int64) (err error)) *UserRepositoryMock {
-	if mmDeleteUser.defaultExpectation != nil {
-		mmDeleteUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.DeleteUser method")
+// Set uses given function f to mock the UserRepository.CreatePasswordResetToken method
+func (mmCreatePasswordResetToken *mUserRepositoryMockCreatePasswordResetToken) Set(f func(ctx context.Context, token model.PasswordResetToken) (err error)) *UserRepositoryMock {
+	if mmCreatePasswordResetToken.defaultExpectation != nil {
+		mmCreatePasswordResetToken.mock.t.Fatalf("Default expectation is already set for the UserRepository.CreatePasswordResetToken method")
 	}
 
-	if len(mmDeleteUser.expectations) > 0 {
-		mmDeleteUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.DeleteUser method")
+	if len(mmCreatePasswordResetToken.expectations) > 0 {
+		mmCreatePasswordResetToken.mock.t.Fatalf("Some expectations are already set for the UserRepository.CreatePasswordResetToken method")
 	}
 
-	mmDeleteUser.mock.funcDeleteUser = f
-	mmDeleteUser.mock.funcDeleteUserOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser.mock
+	mmCreatePasswordResetToken.mock.funcCreatePasswordResetToken = f
+	mmCreatePasswordResetToken.mock.funcCreatePasswordResetTokenOrigin = minimock.CallerInfo(1)
+	return mmCreatePasswordResetToken.mock
 }
 
-// When sets expectation for the UserRepository.DeleteUser which will trigger the result defined by the following
+// When sets expectation for the UserRepository.CreatePasswordResetToken which will trigger the result defined by the following
 // Then helper
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) When(ctx context.Context, id int64) *UserRepositoryMockDeleteUserExpectation {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+func (mmCreatePasswordResetToken *mUserRepositoryMockCreatePasswordResetToken) When(ctx context.Context, token model.PasswordResetToken) *UserRepositoryMockCreatePasswordResetTokenExpectation {
+	if mmCreatePasswordResetToken.mock.funcCreatePasswordResetToken != nil {
+		mmCreatePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.CreatePasswordResetToken mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockDeleteUserExpectation{
-		mock:               mmDeleteUser.mock,
-		params:             &UserRepositoryMockDeleteUserParams{ctx, id},
-		expectationOrigins: UserRepositoryMockDeleteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockCreatePasswordResetTokenExpectation{
+		mock:               mmCreatePasswordResetToken.mock,
+		params:             &UserRepositoryMockCreatePasswordResetTokenParams{ctx, token},
+		expectationOrigins: UserRepositoryMockCreatePasswordResetTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteUser.expectations = append(mmDeleteUser.expectations, expectation)
+	mmCreatePasswordResetToken.expectations = append(mmCreatePasswordResetToken.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.DeleteUser return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockDeleteUserExpectation) Then(err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockDeleteUserResults{err}
+// Then sets up UserRepository.CreatePasswordResetToken return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCreatePasswordResetTokenExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCreatePasswordResetTokenResults{err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.DeleteUser should be invoked
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Times(n uint64) *mUserRepositoryMockDeleteUser {
+// Times sets number of times UserRepository.CreatePasswordResetToken should be invoked
+func (mmCreatePasswordResetToken *mUserRepositoryMockCreatePasswordResetToken) Times(n uint64) *mUserRepositoryMockCreatePasswordResetToken {
 	if n == 0 {
-		mmDeleteUser.mock.t.Fatalf("Times of UserRepositoryMock.DeleteUser mock can not be zero")
+		mmCreatePasswordResetToken.mock.t.Fatalf("Times of UserRepositoryMock.CreatePasswordResetToken mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteUser.expectedInvocations, n)
-	mmDeleteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser
+	mm_atomic.StoreUint64(&mmCreatePasswordResetToken.expectedInvocations, n)
+	mmCreatePasswordResetToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePasswordResetToken
 }
 
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) invocationsDone() bool {
-	if len(mmDeleteUser.expectations) == 0 && mmDeleteUser.defaultExpectation == nil && mmDeleteUser.mock.funcDeleteUser == nil {
+func (mmCreatePasswordResetToken *mUserRepositoryMockCreatePasswordResetToken) invocationsDone() bool {
+	if len(mmCreatePasswordResetToken.expectations) == 0 && mmCreatePasswordResetToken.defaultExpectation == nil && mmCreatePasswordResetToken.mock.funcCreatePasswordResetToken == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUser.mock.afterDeleteUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePasswordResetToken.mock.afterCreatePasswordResetTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePasswordResetToken.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteUser implements mm_repository.UserRepository
-func (mmDeleteUser *UserRepositoryMock) DeleteUser(ctx context.Context, id int64) (err error) {
-	mm_atomic.AddUint64(&mmDeleteUser.beforeDeleteUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteUser.afterDeleteUserCounter, 1)
+// CreatePasswordResetToken implements mm_repository.UserRepository
+func (mmCreatePasswordResetToken *UserRepositoryMock) CreatePasswordResetToken(ctx context.Context, token model.PasswordResetToken) (err error) {
+	mm_atomic.AddUint64(&mmCreatePasswordResetToken.beforeCreatePasswordResetTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePasswordResetToken.afterCreatePasswordResetTokenCounter, 1)
 
-	mmDeleteUser.t.Helper()
+	mmCreatePasswordResetToken.t.Helper()
 
-	if mmDeleteUser.inspectFuncDeleteUser != nil {
-		mmDeleteUser.inspectFuncDeleteUser(ctx, id)
+	if mmCreatePasswordResetToken.inspectFuncCreatePasswordResetToken != nil {
+		mmCreatePasswordResetToken.inspectFuncCreatePasswordResetToken(ctx, token)
 	}
 
-	mm_params := UserRepositoryMockDeleteUserParams{ctx, id}
+	mm_params := UserRepositoryMockCreatePasswordResetTokenParams{ctx, token}
 
 	// Record call args
-	mmDeleteUser.DeleteUserMock.mutex.Lock()
-	mmDeleteUser.DeleteUserMock.callArgs = append(mmDeleteUser.DeleteUserMock.callArgs, &mm_params)
-	mmDeleteUser.DeleteUserMock.mutex.Unlock()
+	mmCreatePasswordResetToken.CreatePasswordResetTokenMock.mutex.Lock()
+	mmCreatePasswordResetToken.CreatePasswordResetTokenMock.callArgs = append(mmCreatePasswordResetToken.CreatePasswordResetTokenMock.callArgs, &mm_params)
+	mmCreatePasswordResetToken.CreatePasswordResetTokenMock.mutex.Unlock()
 
-	for _, e := range mmDeleteUser.DeleteUserMock.expectations {
+	for _, e := range mmCreatePasswordResetToken.CreatePasswordResetTokenMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteUser.DeleteUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteUser.DeleteUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteUser.DeleteUserMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteUser.DeleteUserMock.defaultExpectation.paramPtrs
+	if mmCreatePasswordResetToken.CreatePasswordResetTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePasswordResetToken.CreatePasswordResetTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePasswordResetToken.CreatePasswordResetTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePasswordResetToken.CreatePasswordResetTokenMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockDeleteUserParams{ctx, id}
+		mm_got := UserRepositoryMockCreatePasswordResetTokenParams{ctx, token}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCreatePasswordResetToken.t.Errorf("UserRepositoryMock.CreatePasswordResetToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePasswordResetToken.CreatePasswordResetTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmCreatePasswordResetToken.t.Errorf("UserRepositoryMock.CreatePasswordResetToken got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePasswordResetToken.CreatePasswordResetTokenMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreatePasswordResetToken.t.Errorf("UserRepositoryMock.CreatePasswordResetToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePasswordResetToken.CreatePasswordResetTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteUser.DeleteUserMock.defaultExpectation.results
+		mm_results := mmCreatePasswordResetToken.CreatePasswordResetTokenMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteUser.t.Fatal("No results are set for the UserRepositoryMock.DeleteUser")
+			mmCreatePasswordResetToken.t.Fatal("No results are set for the UserRepositoryMock.CreatePasswordResetToken")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteUser.funcDeleteUser != nil {
-		return mmDeleteUser.funcDeleteUser(ctx, id)
+	if mmCreatePasswordResetToken.funcCreatePasswordResetToken != nil {
+		return mmCreatePasswordResetToken.funcCreatePasswordResetToken(ctx, token)
 	}
-	mmDeleteUser.t.Fatalf("Unexpected call to UserRepositoryMock.DeleteUser. %v %v", ctx, id)
+	mmCreatePasswordResetToken.t.Fatalf("Unexpected call to UserRepositoryMock.CreatePasswordResetToken. %v %v", ctx, token)
 	return
 }
 
-// DeleteUserAfterCounter returns a count of finished UserRepositoryMock.DeleteUser invocations
-func (mmDeleteUser *UserRepositoryMock) DeleteUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUser.afterDeleteUserCounter)
+// CreatePasswordResetTokenAfterCounter returns a count of finished UserRepositoryMock.CreatePasswordResetToken invocations
+func (mmCreatePasswordResetToken *UserRepositoryMock) CreatePasswordResetTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePasswordResetToken.afterCreatePasswordResetTokenCounter)
 }
 
-// DeleteUserBeforeCounter returns a count of UserRepositoryMock.DeleteUser invocations
-func (mmDeleteUser *UserRepositoryMock) DeleteUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUser.beforeDeleteUserCounter)
+// CreatePasswordResetTokenBeforeCounter returns a count of UserRepositoryMock.CreatePasswordResetToken invocations
+func (mmCreatePasswordResetToken *UserRepositoryMock) CreatePasswordResetTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePasswordResetToken.beforeCreatePasswordResetTokenCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.DeleteUser.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.CreatePasswordResetToken.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteUser *mUserRepositoryMockDeleteUser) Calls() []*UserRepositoryMockDeleteUserParams {
-	mmDeleteUser.mutex.RLock()
+func (mmCreatePasswordResetToken *mUserRepositoryMockCreatePasswordResetToken) Calls() []*UserRepositoryMockCreatePasswordResetTokenParams {
+	mmCreatePasswordResetToken.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockDeleteUserParams, len(mmDeleteUser.callArgs))
-	copy(argCopy, mmDeleteUser.callArgs)
+	argCopy := make([]*UserRepositoryMockCreatePasswordResetTokenParams, len(mmCreatePasswordResetToken.callArgs))
+	copy(argCopy, mmCreatePasswordResetToken.callArgs)
 
-	mmDeleteUser.mutex.RUnlock()
+	mmCreatePasswordResetToken.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteUserDone returns true if the count of the DeleteUser invocations corresponds
+// MinimockCreatePasswordResetTokenDone returns true if the count of the CreatePasswordResetToken invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockDeleteUserDone() bool {
-	if m.DeleteUserMock.optional {
+func (m *UserRepositoryMock) MinimockCreatePasswordResetTokenDone() bool {
+	if m.CreatePasswordResetTokenMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteUserMock.expectations {
+	for _, e := range m.CreatePasswordResetTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteUserMock.invocationsDone()
+	return m.CreatePasswordResetTokenMock.invocationsDone()
 }
 
-// MinimockDeleteUserInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockDeleteUserInspect() {
-	for _, e := range m.DeleteUserMock.expectations {
+// MinimockCreatePasswordResetTokenInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCreatePasswordResetTokenInspect() {
+	for _, e := range m.CreatePasswordResetTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.CreatePasswordResetToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteUserCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCounter)
+	afterCreatePasswordResetTokenCounter := mm_atomic.LoadUint64(&m.afterCreatePasswordResetTokenCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteUserMock.defaultExpectation != nil && afterDeleteUserCounter < 1 {
-		if m.DeleteUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.DeleteUserMock.defaultExpectation.returnOrigin)
+	if m.CreatePasswordResetTokenMock.defaultExpectation != nil && afterCreatePasswordResetTokenCounter < 1 {
+		if m.CreatePasswordResetTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreatePasswordResetToken at\n%s", m.CreatePasswordResetTokenMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", m.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.CreatePasswordResetToken at\n%s with params: %#v", m.CreatePasswordResetTokenMock.defaultExpectation.expectationOrigins.origin, *m.CreatePasswordResetTokenMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteUser != nil && afterDeleteUserCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.funcDeleteUserOrigin)
+	if m.funcCreatePasswordResetToken != nil && afterCreatePasswordResetTokenCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.CreatePasswordResetToken at\n%s", m.funcCreatePasswordResetTokenOrigin)
 	}
 
-	if !m.DeleteUserMock.invocationsDone() && afterDeleteUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.DeleteUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteUserMock.expectedInvocations), m.DeleteUserMock.expectedInvocationsOrigin, afterDeleteUserCounter)
+	if !m.CreatePasswordResetTokenMock.invocationsDone() && afterCreatePasswordResetTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.CreatePasswordResetToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePasswordResetTokenMock.expectedInvocations), m.CreatePasswordResetTokenMock.expectedInvocationsOrigin, afterCreatePasswordResetTokenCounter)
 	}
 }
 
-type mUserRepositoryMockGetUser struct {
+type mUserRepositoryMockCreateUser struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockGetUserExpectation
-	expectations       []*UserRepositoryMockGetUserExpectation
+	defaultExpectation *UserRepositoryMockCreateUserExpectation
+	expectations       []*UserRepositoryMockCreateUserExpectation
 
-	callArgs []*UserRepositoryMockGetUserParams
+	callArgs []*UserRepositoryMockCreateUserParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockGetUserExpectation specifies expectation struct of the UserRepository.GetUser
-type UserRepositoryMockGetUserExpectation struct {
+// UserRepositoryMockCreateUserExpectation specifies expectation struct of the UserRepository.CreateUser
+type UserRepositoryMockCreateUserExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockGetUserParams
-	paramPtrs          *UserRepositoryMockGetUserParamPtrs
-	expectationOrigins UserRepositoryMockGetUserExpectationOrigins
-	results            *UserRepositoryMockGetUserResults
+	params             *UserRepositoryMockCreateUserParams
+	paramPtrs          *UserRepositoryMockCreateUserParamPtrs
+	expectationOrigins UserRepositoryMockCreateUserExpectationOrigins
+	results            *UserRepositoryMockCreateUserResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockGetUserParams contains parameters of the UserRepository.GetUser
-type UserRepositoryMockGetUserParams struct {
-	ctx context.Context
-	id  int64
+// UserRepositoryMockCreateUserParams contains parameters of the UserRepository.CreateUser
+type UserRepositoryMockCreateUserParams struct {
+	ctx  context.Context
+	user model.User
 }
 
-// UserRepositoryMockGetUserParamPtrs contains pointers to parameters of the UserRepository.GetUser
-type UserRepositoryMockGetUserParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+// UserRepositoryMockCreateUserParamPtrs contains pointers to parameters of the UserRepository.CreateUser
+type UserRepositoryMockCreateUserParamPtrs struct {
+	ctx  *context.Context
+	user *model.User
 }
 
-// UserRepositoryMockGetUserResults contains results of the UserRepository.GetUser
-type UserRepositoryMockGetUserResults struct {
-	up1 *model.User
+// UserRepositoryMockCreateUserResults contains results of the UserRepository.CreateUser
+type UserRepositoryMockCreateUserResults struct {
+	i1  int64
 	err error
 }
 
-// UserRepositoryMockGetUserOrigins contains origins of expectations of the UserRepository.GetUser
-type UserRepositoryMockGetUserExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// UserRepositoryMockCreateUserOrigins contains origins of expectations of the UserRepository.CreateUser
+type UserRepositoryMockCreateUserExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUser string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -822,341 +891,2831 @@ type UserRepositoryMockGetUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUser *mUserRepositoryMockGetUser) Optional() *mUserRepositoryMockGetUser {
-	mmGetUser.optional = true
-	return mmGetUser
+func (mmCreateUser *mUserRepositoryMockCreateUser) Optional() *mUserRepositoryMockCreateUser {
+	mmCreateUser.optional = true
+	return mmCreateUser
 }
 
-// Expect sets up expected params for UserRepository.GetUser
-func (mmGetUser *mUserRepositoryMockGetUser) Expect(ctx context.Context, id int64) *mUserRepositoryMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+// Expect sets up expected params for UserRepository.CreateUser
+func (mmCreateUser *mUserRepositoryMockCreateUser) Expect(ctx context.Context, user model.User) *mUserRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by ExpectParams functions")
+	if mmCreateUser.defaultExpectation.paramPtrs != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by ExpectParams functions")
 	}
 
-	mmGetUser.defaultExpectation.params = &UserRepositoryMockGetUserParams{ctx, id}
-	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUser.expectations {
-		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
-			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+	mmCreateUser.defaultExpectation.params = &UserRepositoryMockCreateUserParams{ctx, user}
+	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateUser.expectations {
+		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
+			mmCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUser.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUser
+	return mmCreateUser
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUser
-func (mmGetUser *mUserRepositoryMockGetUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.CreateUser
+func (mmCreateUser *mUserRepositoryMockCreateUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Expect")
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Expect")
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserParamPtrs{}
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UserRepositoryMockCreateUserParamPtrs{}
 	}
-	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUser
+	return mmCreateUser
 }
 
-// ExpectIdParam2 sets up expected param id for UserRepository.GetUser
-func (mmGetUser *mUserRepositoryMockGetUser) ExpectIdParam2(id int64) *mUserRepositoryMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+// ExpectUserParam2 sets up expected param user for UserRepository.CreateUser
+func (mmCreateUser *mUserRepositoryMockCreateUser) ExpectUserParam2(user model.User) *mUserRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Expect")
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Expect")
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserParamPtrs{}
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UserRepositoryMockCreateUserParamPtrs{}
 	}
-	mmGetUser.defaultExpectation.paramPtrs.id = &id
-	mmGetUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmCreateUser.defaultExpectation.paramPtrs.user = &user
+	mmCreateUser.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
 
-	return mmGetUser
+	return mmCreateUser
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUser
-func (mmGetUser *mUserRepositoryMockGetUser) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockGetUser {
-	if mmGetUser.mock.inspectFuncGetUser != nil {
-		mmGetUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUser")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.CreateUser
+func (mmCreateUser *mUserRepositoryMockCreateUser) Inspect(f func(ctx context.Context, user model.User)) *mUserRepositoryMockCreateUser {
+	if mmCreateUser.mock.inspectFuncCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.CreateUser")
 	}
 
-	mmGetUser.mock.inspectFuncGetUser = f
+	mmCreateUser.mock.inspectFuncCreateUser = f
+
+	return mmCreateUser
+}
+
+// Return sets up results that will be returned by UserRepository.CreateUser
+func (mmCreateUser *mUserRepositoryMockCreateUser) Return(i1 int64, err error) *UserRepositoryMock {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserRepositoryMockCreateUserExpectation{mock: mmCreateUser.mock}
+	}
+	mmCreateUser.defaultExpectation.results = &UserRepositoryMockCreateUserResults{i1, err}
+	mmCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// Set uses given function f to mock the UserRepository.CreateUser method
+func (mmCreateUser *mUserRepositoryMockCreateUser) Set(f func(ctx context.Context, user model.User) (i1 int64, err error)) *UserRepositoryMock {
+	if mmCreateUser.defaultExpectation != nil {
+		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.CreateUser method")
+	}
+
+	if len(mmCreateUser.expectations) > 0 {
+		mmCreateUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.CreateUser method")
+	}
+
+	mmCreateUser.mock.funcCreateUser = f
+	mmCreateUser.mock.funcCreateUserOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// When sets expectation for the UserRepository.CreateUser which will trigger the result defined by the following
+// Then helper
+func (mmCreateUser *mUserRepositoryMockCreateUser) When(ctx context.Context, user model.User) *UserRepositoryMockCreateUserExpectation {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockCreateUserExpectation{
+		mock:               mmCreateUser.mock,
+		params:             &UserRepositoryMockCreateUserParams{ctx, user},
+		expectationOrigins: UserRepositoryMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.CreateUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCreateUserExpectation) Then(i1 int64, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCreateUserResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.CreateUser should be invoked
+func (mmCreateUser *mUserRepositoryMockCreateUser) Times(n uint64) *mUserRepositoryMockCreateUser {
+	if n == 0 {
+		mmCreateUser.mock.t.Fatalf("Times of UserRepositoryMock.CreateUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateUser.expectedInvocations, n)
+	mmCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateUser
+}
+
+func (mmCreateUser *mUserRepositoryMockCreateUser) invocationsDone() bool {
+	if len(mmCreateUser.expectations) == 0 && mmCreateUser.defaultExpectation == nil && mmCreateUser.mock.funcCreateUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateUser.mock.afterCreateUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateUser implements mm_repository.UserRepository
+func (mmCreateUser *UserRepositoryMock) CreateUser(ctx context.Context, user model.User) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
+
+	mmCreateUser.t.Helper()
+
+	if mmCreateUser.inspectFuncCreateUser != nil {
+		mmCreateUser.inspectFuncCreateUser(ctx, user)
+	}
+
+	mm_params := UserRepositoryMockCreateUserParams{ctx, user}
+
+	// Record call args
+	mmCreateUser.CreateUserMock.mutex.Lock()
+	mmCreateUser.CreateUserMock.callArgs = append(mmCreateUser.CreateUserMock.callArgs, &mm_params)
+	mmCreateUser.CreateUserMock.mutex.Unlock()
+
+	for _, e := range mmCreateUser.CreateUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateUser.CreateUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateUser.CreateUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockCreateUserParams{ctx, user}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateUser.t.Errorf("UserRepositoryMock.CreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateUser.CreateUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateUser.t.Fatal("No results are set for the UserRepositoryMock.CreateUser")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateUser.funcCreateUser != nil {
+		return mmCreateUser.funcCreateUser(ctx, user)
+	}
+	mmCreateUser.t.Fatalf("Unexpected call to UserRepositoryMock.CreateUser. %v %v", ctx, user)
+	return
+}
+
+// CreateUserAfterCounter returns a count of finished UserRepositoryMock.CreateUser invocations
+func (mmCreateUser *UserRepositoryMock) CreateUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.afterCreateUserCounter)
+}
+
+// CreateUserBeforeCounter returns a count of UserRepositoryMock.CreateUser invocations
+func (mmCreateUser *UserRepositoryMock) CreateUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.beforeCreateUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.CreateUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateUser *mUserRepositoryMockCreateUser) Calls() []*UserRepositoryMockCreateUserParams {
+	mmCreateUser.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockCreateUserParams, len(mmCreateUser.callArgs))
+	copy(argCopy, mmCreateUser.callArgs)
+
+	mmCreateUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateUserDone returns true if the count of the CreateUser invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockCreateUserDone() bool {
+	if m.CreateUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateUserMock.invocationsDone()
+}
+
+// MinimockCreateUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCreateUserInspect() {
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateUserCounter := mm_atomic.LoadUint64(&m.afterCreateUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateUserMock.defaultExpectation != nil && afterCreateUserCounter < 1 {
+		if m.CreateUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s", m.CreateUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s with params: %#v", m.CreateUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateUser != nil && afterCreateUserCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.CreateUser at\n%s", m.funcCreateUserOrigin)
+	}
+
+	if !m.CreateUserMock.invocationsDone() && afterCreateUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.CreateUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateUserMock.expectedInvocations), m.CreateUserMock.expectedInvocationsOrigin, afterCreateUserCounter)
+	}
+}
+
+type mUserRepositoryMockDeleteUser struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockDeleteUserExpectation
+	expectations       []*UserRepositoryMockDeleteUserExpectation
+
+	callArgs []*UserRepositoryMockDeleteUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockDeleteUserExpectation specifies expectation struct of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockDeleteUserParams
+	paramPtrs          *UserRepositoryMockDeleteUserParamPtrs
+	expectationOrigins UserRepositoryMockDeleteUserExpectationOrigins
+	results            *UserRepositoryMockDeleteUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockDeleteUserParams contains parameters of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserRepositoryMockDeleteUserParamPtrs contains pointers to parameters of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserRepositoryMockDeleteUserResults contains results of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserResults struct {
+	err error
+}
+
+// UserRepositoryMockDeleteUserOrigins contains origins of expectations of the UserRepository.DeleteUser
+type UserRepositoryMockDeleteUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Optional() *mUserRepositoryMockDeleteUser {
+	mmDeleteUser.optional = true
+	return mmDeleteUser
+}
+
+// Expect sets up expected params for UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Expect(ctx context.Context, id int64) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUser.defaultExpectation.params = &UserRepositoryMockDeleteUserParams{ctx, id}
+	mmDeleteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUser.expectations {
+		if minimock.Equal(e.params, mmDeleteUser.defaultExpectation.params) {
+			mmDeleteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) ExpectIdParam2(id int64) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &UserRepositoryMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.id = &id
+	mmDeleteUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockDeleteUser {
+	if mmDeleteUser.mock.inspectFuncDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.DeleteUser")
+	}
+
+	mmDeleteUser.mock.inspectFuncDeleteUser = f
+
+	return mmDeleteUser
+}
+
+// Return sets up results that will be returned by UserRepository.DeleteUser
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Return(err error) *UserRepositoryMock {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserRepositoryMockDeleteUserExpectation{mock: mmDeleteUser.mock}
+	}
+	mmDeleteUser.defaultExpectation.results = &UserRepositoryMockDeleteUserResults{err}
+	mmDeleteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// Set uses given function f to mock the UserRepository.DeleteUser method
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Set(f func(ctx context.Context, id int64) (err error)) *UserRepositoryMock {
+	if mmDeleteUser.defaultExpectation != nil {
+		mmDeleteUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.DeleteUser method")
+	}
+
+	if len(mmDeleteUser.expectations) > 0 {
+		mmDeleteUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.DeleteUser method")
+	}
+
+	mmDeleteUser.mock.funcDeleteUser = f
+	mmDeleteUser.mock.funcDeleteUserOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// When sets expectation for the UserRepository.DeleteUser which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) When(ctx context.Context, id int64) *UserRepositoryMockDeleteUserExpectation {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserRepositoryMock.DeleteUser mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockDeleteUserExpectation{
+		mock:               mmDeleteUser.mock,
+		params:             &UserRepositoryMockDeleteUserParams{ctx, id},
+		expectationOrigins: UserRepositoryMockDeleteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUser.expectations = append(mmDeleteUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.DeleteUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockDeleteUserExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockDeleteUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.DeleteUser should be invoked
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Times(n uint64) *mUserRepositoryMockDeleteUser {
+	if n == 0 {
+		mmDeleteUser.mock.t.Fatalf("Times of UserRepositoryMock.DeleteUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUser.expectedInvocations, n)
+	mmDeleteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser
+}
+
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) invocationsDone() bool {
+	if len(mmDeleteUser.expectations) == 0 && mmDeleteUser.defaultExpectation == nil && mmDeleteUser.mock.funcDeleteUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUser.mock.afterDeleteUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUser implements mm_repository.UserRepository
+func (mmDeleteUser *UserRepositoryMock) DeleteUser(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUser.beforeDeleteUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUser.afterDeleteUserCounter, 1)
+
+	mmDeleteUser.t.Helper()
+
+	if mmDeleteUser.inspectFuncDeleteUser != nil {
+		mmDeleteUser.inspectFuncDeleteUser(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockDeleteUserParams{ctx, id}
+
+	// Record call args
+	mmDeleteUser.DeleteUserMock.mutex.Lock()
+	mmDeleteUser.DeleteUserMock.callArgs = append(mmDeleteUser.DeleteUserMock.callArgs, &mm_params)
+	mmDeleteUser.DeleteUserMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUser.DeleteUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUser.DeleteUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUser.DeleteUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUser.DeleteUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUser.DeleteUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockDeleteUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUser.t.Errorf("UserRepositoryMock.DeleteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUser.DeleteUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUser.t.Fatal("No results are set for the UserRepositoryMock.DeleteUser")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUser.funcDeleteUser != nil {
+		return mmDeleteUser.funcDeleteUser(ctx, id)
+	}
+	mmDeleteUser.t.Fatalf("Unexpected call to UserRepositoryMock.DeleteUser. %v %v", ctx, id)
+	return
+}
+
+// DeleteUserAfterCounter returns a count of finished UserRepositoryMock.DeleteUser invocations
+func (mmDeleteUser *UserRepositoryMock) DeleteUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.afterDeleteUserCounter)
+}
+
+// DeleteUserBeforeCounter returns a count of UserRepositoryMock.DeleteUser invocations
+func (mmDeleteUser *UserRepositoryMock) DeleteUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.beforeDeleteUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.DeleteUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUser *mUserRepositoryMockDeleteUser) Calls() []*UserRepositoryMockDeleteUserParams {
+	mmDeleteUser.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockDeleteUserParams, len(mmDeleteUser.callArgs))
+	copy(argCopy, mmDeleteUser.callArgs)
+
+	mmDeleteUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserDone returns true if the count of the DeleteUser invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockDeleteUserDone() bool {
+	if m.DeleteUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserMock.invocationsDone()
+}
+
+// MinimockDeleteUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockDeleteUserInspect() {
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserMock.defaultExpectation != nil && afterDeleteUserCounter < 1 {
+		if m.DeleteUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.DeleteUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s with params: %#v", m.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUser != nil && afterDeleteUserCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.DeleteUser at\n%s", m.funcDeleteUserOrigin)
+	}
+
+	if !m.DeleteUserMock.invocationsDone() && afterDeleteUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.DeleteUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserMock.expectedInvocations), m.DeleteUserMock.expectedInvocationsOrigin, afterDeleteUserCounter)
+	}
+}
+
+type mUserRepositoryMockGetUser struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetUserExpectation
+	expectations       []*UserRepositoryMockGetUserExpectation
+
+	callArgs []*UserRepositoryMockGetUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockGetUserExpectation specifies expectation struct of the UserRepository.GetUser
+type UserRepositoryMockGetUserExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockGetUserParams
+	paramPtrs          *UserRepositoryMockGetUserParamPtrs
+	expectationOrigins UserRepositoryMockGetUserExpectationOrigins
+	results            *UserRepositoryMockGetUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockGetUserParams contains parameters of the UserRepository.GetUser
+type UserRepositoryMockGetUserParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserRepositoryMockGetUserParamPtrs contains pointers to parameters of the UserRepository.GetUser
+type UserRepositoryMockGetUserParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserRepositoryMockGetUserResults contains results of the UserRepository.GetUser
+type UserRepositoryMockGetUserResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserRepositoryMockGetUserOrigins contains origins of expectations of the UserRepository.GetUser
+type UserRepositoryMockGetUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUser *mUserRepositoryMockGetUser) Optional() *mUserRepositoryMockGetUser {
+	mmGetUser.optional = true
+	return mmGetUser
+}
+
+// Expect sets up expected params for UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) Expect(ctx context.Context, id int64) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by ExpectParams functions")
+	}
+
+	mmGetUser.defaultExpectation.params = &UserRepositoryMockGetUserParams{ctx, id}
+	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUser.expectations {
+		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
+			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) ExpectIdParam2(id int64) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.id = &id
+	mmGetUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.inspectFuncGetUser != nil {
+		mmGetUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUser")
+	}
+
+	mmGetUser.mock.inspectFuncGetUser = f
+
+	return mmGetUser
+}
+
+// Return sets up results that will be returned by UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{mock: mmGetUser.mock}
+	}
+	mmGetUser.defaultExpectation.results = &UserRepositoryMockGetUserResults{up1, err}
+	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetUser method
+func (mmGetUser *mUserRepositoryMockGetUser) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmGetUser.defaultExpectation != nil {
+		mmGetUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUser method")
+	}
+
+	if len(mmGetUser.expectations) > 0 {
+		mmGetUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUser method")
+	}
+
+	mmGetUser.mock.funcGetUser = f
+	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// When sets expectation for the UserRepository.GetUser which will trigger the result defined by the following
+// Then helper
+func (mmGetUser *mUserRepositoryMockGetUser) When(ctx context.Context, id int64) *UserRepositoryMockGetUserExpectation {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetUserExpectation{
+		mock:               mmGetUser.mock,
+		params:             &UserRepositoryMockGetUserParams{ctx, id},
+		expectationOrigins: UserRepositoryMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetUserExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetUserResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetUser should be invoked
+func (mmGetUser *mUserRepositoryMockGetUser) Times(n uint64) *mUserRepositoryMockGetUser {
+	if n == 0 {
+		mmGetUser.mock.t.Fatalf("Times of UserRepositoryMock.GetUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
+	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUser
+}
+
+func (mmGetUser *mUserRepositoryMockGetUser) invocationsDone() bool {
+	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUser implements mm_repository.UserRepository
+func (mmGetUser *UserRepositoryMock) GetUser(ctx context.Context, id int64) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+
+	mmGetUser.t.Helper()
+
+	if mmGetUser.inspectFuncGetUser != nil {
+		mmGetUser.inspectFuncGetUser(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockGetUserParams{ctx, id}
+
+	// Record call args
+	mmGetUser.GetUserMock.mutex.Lock()
+	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
+	mmGetUser.GetUserMock.mutex.Unlock()
+
+	for _, e := range mmGetUser.GetUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUser.GetUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUser.t.Fatal("No results are set for the UserRepositoryMock.GetUser")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUser.funcGetUser != nil {
+		return mmGetUser.funcGetUser(ctx, id)
+	}
+	mmGetUser.t.Fatalf("Unexpected call to UserRepositoryMock.GetUser. %v %v", ctx, id)
+	return
+}
+
+// GetUserAfterCounter returns a count of finished UserRepositoryMock.GetUser invocations
+func (mmGetUser *UserRepositoryMock) GetUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+}
+
+// GetUserBeforeCounter returns a count of UserRepositoryMock.GetUser invocations
+func (mmGetUser *UserRepositoryMock) GetUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUser *mUserRepositoryMockGetUser) Calls() []*UserRepositoryMockGetUserParams {
+	mmGetUser.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetUserParams, len(mmGetUser.callArgs))
+	copy(argCopy, mmGetUser.callArgs)
+
+	mmGetUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetUserDone() bool {
+	if m.GetUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserMock.invocationsDone()
+}
+
+// MinimockGetUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetUserInspect() {
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
+		if m.GetUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUser != nil && afterGetUserCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s", m.funcGetUserOrigin)
+	}
+
+	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	}
+}
+
+type mUserRepositoryMockGetUserByEmail struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetUserByEmailExpectation
+	expectations       []*UserRepositoryMockGetUserByEmailExpectation
+
+	callArgs []*UserRepositoryMockGetUserByEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockGetUserByEmailExpectation specifies expectation struct of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockGetUserByEmailParams
+	paramPtrs          *UserRepositoryMockGetUserByEmailParamPtrs
+	expectationOrigins UserRepositoryMockGetUserByEmailExpectationOrigins
+	results            *UserRepositoryMockGetUserByEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockGetUserByEmailParams contains parameters of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UserRepositoryMockGetUserByEmailParamPtrs contains pointers to parameters of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// UserRepositoryMockGetUserByEmailResults contains results of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserRepositoryMockGetUserByEmailOrigins contains origins of expectations of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Optional() *mUserRepositoryMockGetUserByEmail {
+	mmGetUserByEmail.optional = true
+	return mmGetUserByEmail
+}
+
+// Expect sets up expected params for UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Expect(ctx context.Context, email string) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserByEmail.defaultExpectation.params = &UserRepositoryMockGetUserByEmailParams{ctx, email}
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserByEmail.expectations {
+		if minimock.Equal(e.params, mmGetUserByEmail.defaultExpectation.params) {
+			mmGetUserByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserByEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserByEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.params != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Expect")
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserByEmailParamPtrs{}
+	}
+	mmGetUserByEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserByEmail
+}
+
+// ExpectEmailParam2 sets up expected param email for UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) ExpectEmailParam2(email string) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.params != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Expect")
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserByEmailParamPtrs{}
+	}
+	mmGetUserByEmail.defaultExpectation.paramPtrs.email = &email
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmGetUserByEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Inspect(f func(ctx context.Context, email string)) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.inspectFuncGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUserByEmail")
+	}
+
+	mmGetUserByEmail.mock.inspectFuncGetUserByEmail = f
+
+	return mmGetUserByEmail
+}
+
+// Return sets up results that will be returned by UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Return(up1 *model.User, err error) *UserRepositoryMock {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{mock: mmGetUserByEmail.mock}
+	}
+	mmGetUserByEmail.defaultExpectation.results = &UserRepositoryMockGetUserByEmailResults{up1, err}
+	mmGetUserByEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetUserByEmail method
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Set(f func(ctx context.Context, email string) (up1 *model.User, err error)) *UserRepositoryMock {
+	if mmGetUserByEmail.defaultExpectation != nil {
+		mmGetUserByEmail.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUserByEmail method")
+	}
+
+	if len(mmGetUserByEmail.expectations) > 0 {
+		mmGetUserByEmail.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUserByEmail method")
+	}
+
+	mmGetUserByEmail.mock.funcGetUserByEmail = f
+	mmGetUserByEmail.mock.funcGetUserByEmailOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail.mock
+}
+
+// When sets expectation for the UserRepository.GetUserByEmail which will trigger the result defined by the following
+// Then helper
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) When(ctx context.Context, email string) *UserRepositoryMockGetUserByEmailExpectation {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetUserByEmailExpectation{
+		mock:               mmGetUserByEmail.mock,
+		params:             &UserRepositoryMockGetUserByEmailParams{ctx, email},
+		expectationOrigins: UserRepositoryMockGetUserByEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserByEmail.expectations = append(mmGetUserByEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetUserByEmail return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetUserByEmailExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetUserByEmailResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetUserByEmail should be invoked
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Times(n uint64) *mUserRepositoryMockGetUserByEmail {
+	if n == 0 {
+		mmGetUserByEmail.mock.t.Fatalf("Times of UserRepositoryMock.GetUserByEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserByEmail.expectedInvocations, n)
+	mmGetUserByEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail
+}
+
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) invocationsDone() bool {
+	if len(mmGetUserByEmail.expectations) == 0 && mmGetUserByEmail.defaultExpectation == nil && mmGetUserByEmail.mock.funcGetUserByEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.mock.afterGetUserByEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserByEmail implements mm_repository.UserRepository
+func (mmGetUserByEmail *UserRepositoryMock) GetUserByEmail(ctx context.Context, email string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserByEmail.afterGetUserByEmailCounter, 1)
+
+	mmGetUserByEmail.t.Helper()
+
+	if mmGetUserByEmail.inspectFuncGetUserByEmail != nil {
+		mmGetUserByEmail.inspectFuncGetUserByEmail(ctx, email)
+	}
+
+	mm_params := UserRepositoryMockGetUserByEmailParams{ctx, email}
+
+	// Record call args
+	mmGetUserByEmail.GetUserByEmailMock.mutex.Lock()
+	mmGetUserByEmail.GetUserByEmailMock.callArgs = append(mmGetUserByEmail.GetUserByEmailMock.callArgs, &mm_params)
+	mmGetUserByEmail.GetUserByEmailMock.mutex.Unlock()
+
+	for _, e := range mmGetUserByEmail.GetUserByEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUserByEmail.GetUserByEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetUserByEmailParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserByEmail.t.Errorf("UserRepositoryMock.GetUserByEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmGetUserByEmail.t.Errorf("UserRepositoryMock.GetUserByEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserByEmail.t.Errorf("UserRepositoryMock.GetUserByEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserByEmail.t.Fatal("No results are set for the UserRepositoryMock.GetUserByEmail")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUserByEmail.funcGetUserByEmail != nil {
+		return mmGetUserByEmail.funcGetUserByEmail(ctx, email)
+	}
+	mmGetUserByEmail.t.Fatalf("Unexpected call to UserRepositoryMock.GetUserByEmail. %v %v", ctx, email)
+	return
+}
+
+// GetUserByEmailAfterCounter returns a count of finished UserRepositoryMock.GetUserByEmail invocations
+func (mmGetUserByEmail *UserRepositoryMock) GetUserByEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByEmail.afterGetUserByEmailCounter)
+}
+
+// GetUserByEmailBeforeCounter returns a count of UserRepositoryMock.GetUserByEmail invocations
+func (mmGetUserByEmail *UserRepositoryMock) GetUserByEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUserByEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Calls() []*UserRepositoryMockGetUserByEmailParams {
+	mmGetUserByEmail.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetUserByEmailParams, len(mmGetUserByEmail.callArgs))
+	copy(argCopy, mmGetUserByEmail.callArgs)
+
+	mmGetUserByEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserByEmailDone returns true if the count of the GetUserByEmail invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetUserByEmailDone() bool {
+	if m.GetUserByEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserByEmailMock.invocationsDone()
+}
+
+// MinimockGetUserByEmailInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetUserByEmailInspect() {
+	for _, e := range m.GetUserByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserByEmailCounter := mm_atomic.LoadUint64(&m.afterGetUserByEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserByEmailMock.defaultExpectation != nil && afterGetUserByEmailCounter < 1 {
+		if m.GetUserByEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByEmail at\n%s", m.GetUserByEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByEmail at\n%s with params: %#v", m.GetUserByEmailMock.defaultExpectation.expectationOrigins.origin, *m.GetUserByEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserByEmail != nil && afterGetUserByEmailCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetUserByEmail at\n%s", m.funcGetUserByEmailOrigin)
+	}
+
+	if !m.GetUserByEmailMock.invocationsDone() && afterGetUserByEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUserByEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserByEmailMock.expectedInvocations), m.GetUserByEmailMock.expectedInvocationsOrigin, afterGetUserByEmailCounter)
+	}
+}
+
+type mUserRepositoryMockMakeLog struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockMakeLogExpectation
+	expectations       []*UserRepositoryMockMakeLogExpectation
+
+	callArgs []*UserRepositoryMockMakeLogParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockMakeLogExpectation specifies expectation struct of the UserRepository.MakeLog
+type UserRepositoryMockMakeLogExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockMakeLogParams
+	paramPtrs          *UserRepositoryMockMakeLogParamPtrs
+	expectationOrigins UserRepositoryMockMakeLogExpectationOrigins
+	results            *UserRepositoryMockMakeLogResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockMakeLogParams contains parameters of the UserRepository.MakeLog
+type UserRepositoryMockMakeLogParams struct {
+	ctx context.Context
+	log model.Log
+}
+
+// UserRepositoryMockMakeLogParamPtrs contains pointers to parameters of the UserRepository.MakeLog
+type UserRepositoryMockMakeLogParamPtrs struct {
+	ctx *context.Context
+	log *model.Log
+}
+
+// UserRepositoryMockMakeLogResults contains results of the UserRepository.MakeLog
+type UserRepositoryMockMakeLogResults struct {
+	err error
+}
+
+// UserRepositoryMockMakeLogOrigins contains origins of expectations of the UserRepository.MakeLog
+type UserRepositoryMockMakeLogExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originLog string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMakeLog *mUserRepositoryMockMakeLog) Optional() *mUserRepositoryMockMakeLog {
+	mmMakeLog.optional = true
+	return mmMakeLog
+}
+
+// Expect sets up expected params for UserRepository.MakeLog
+func (mmMakeLog *mUserRepositoryMockMakeLog) Expect(ctx context.Context, log model.Log) *mUserRepositoryMockMakeLog {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &UserRepositoryMockMakeLogExpectation{}
+	}
+
+	if mmMakeLog.defaultExpectation.paramPtrs != nil {
+		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by ExpectParams functions")
+	}
+
+	mmMakeLog.defaultExpectation.params = &UserRepositoryMockMakeLogParams{ctx, log}
+	mmMakeLog.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMakeLog.expectations {
+		if minimock.Equal(e.params, mmMakeLog.defaultExpectation.params) {
+			mmMakeLog.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMakeLog.defaultExpectation.params)
+		}
+	}
+
+	return mmMakeLog
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.MakeLog
+func (mmMakeLog *mUserRepositoryMockMakeLog) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockMakeLog {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &UserRepositoryMockMakeLogExpectation{}
+	}
+
+	if mmMakeLog.defaultExpectation.params != nil {
+		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Expect")
+	}
+
+	if mmMakeLog.defaultExpectation.paramPtrs == nil {
+		mmMakeLog.defaultExpectation.paramPtrs = &UserRepositoryMockMakeLogParamPtrs{}
+	}
+	mmMakeLog.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMakeLog.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMakeLog
+}
+
+// ExpectLogParam2 sets up expected param log for UserRepository.MakeLog
+func (mmMakeLog *mUserRepositoryMockMakeLog) ExpectLogParam2(log model.Log) *mUserRepositoryMockMakeLog {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &UserRepositoryMockMakeLogExpectation{}
+	}
+
+	if mmMakeLog.defaultExpectation.params != nil {
+		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Expect")
+	}
+
+	if mmMakeLog.defaultExpectation.paramPtrs == nil {
+		mmMakeLog.defaultExpectation.paramPtrs = &UserRepositoryMockMakeLogParamPtrs{}
+	}
+	mmMakeLog.defaultExpectation.paramPtrs.log = &log
+	mmMakeLog.defaultExpectation.expectationOrigins.originLog = minimock.CallerInfo(1)
+
+	return mmMakeLog
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.MakeLog
+func (mmMakeLog *mUserRepositoryMockMakeLog) Inspect(f func(ctx context.Context, log model.Log)) *mUserRepositoryMockMakeLog {
+	if mmMakeLog.mock.inspectFuncMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.MakeLog")
+	}
+
+	mmMakeLog.mock.inspectFuncMakeLog = f
+
+	return mmMakeLog
+}
+
+// Return sets up results that will be returned by UserRepository.MakeLog
+func (mmMakeLog *mUserRepositoryMockMakeLog) Return(err error) *UserRepositoryMock {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	if mmMakeLog.defaultExpectation == nil {
+		mmMakeLog.defaultExpectation = &UserRepositoryMockMakeLogExpectation{mock: mmMakeLog.mock}
+	}
+	mmMakeLog.defaultExpectation.results = &UserRepositoryMockMakeLogResults{err}
+	mmMakeLog.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMakeLog.mock
+}
+
+// Set uses given function f to mock the UserRepository.MakeLog method
+func (mmMakeLog *mUserRepositoryMockMakeLog) Set(f func(ctx context.Context, log model.Log) (err error)) *UserRepositoryMock {
+	if mmMakeLog.defaultExpectation != nil {
+		mmMakeLog.mock.t.Fatalf("Default expectation is already set for the UserRepository.MakeLog method")
+	}
+
+	if len(mmMakeLog.expectations) > 0 {
+		mmMakeLog.mock.t.Fatalf("Some expectations are already set for the UserRepository.MakeLog method")
+	}
+
+	mmMakeLog.mock.funcMakeLog = f
+	mmMakeLog.mock.funcMakeLogOrigin = minimock.CallerInfo(1)
+	return mmMakeLog.mock
+}
+
+// When sets expectation for the UserRepository.MakeLog which will trigger the result defined by the following
+// Then helper
+func (mmMakeLog *mUserRepositoryMockMakeLog) When(ctx context.Context, log model.Log) *UserRepositoryMockMakeLogExpectation {
+	if mmMakeLog.mock.funcMakeLog != nil {
+		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockMakeLogExpectation{
+		mock:               mmMakeLog.mock,
+		params:             &UserRepositoryMockMakeLogParams{ctx, log},
+		expectationOrigins: UserRepositoryMockMakeLogExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMakeLog.expectations = append(mmMakeLog.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.MakeLog return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockMakeLogExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockMakeLogResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.MakeLog should be invoked
+func (mmMakeLog *mUserRepositoryMockMakeLog) Times(n uint64) *mUserRepositoryMockMakeLog {
+	if n == 0 {
+		mmMakeLog.mock.t.Fatalf("Times of UserRepositoryMock.MakeLog mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMakeLog.expectedInvocations, n)
+	mmMakeLog.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMakeLog
+}
+
+func (mmMakeLog *mUserRepositoryMockMakeLog) invocationsDone() bool {
+	if len(mmMakeLog.expectations) == 0 && mmMakeLog.defaultExpectation == nil && mmMakeLog.mock.funcMakeLog == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMakeLog.mock.afterMakeLogCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMakeLog.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MakeLog implements mm_repository.UserRepository
+func (mmMakeLog *UserRepositoryMock) MakeLog(ctx context.Context, log model.Log) (err error) {
+	mm_atomic.AddUint64(&mmMakeLog.beforeMakeLogCounter, 1)
+	defer mm_atomic.AddUint64(&mmMakeLog.afterMakeLogCounter, 1)
+
+	mmMakeLog.t.Helper()
+
+	if mmMakeLog.inspectFuncMakeLog != nil {
+		mmMakeLog.inspectFuncMakeLog(ctx, log)
+	}
+
+	mm_params := UserRepositoryMockMakeLogParams{ctx, log}
+
+	// Record call args
+	mmMakeLog.MakeLogMock.mutex.Lock()
+	mmMakeLog.MakeLogMock.callArgs = append(mmMakeLog.MakeLogMock.callArgs, &mm_params)
+	mmMakeLog.MakeLogMock.mutex.Unlock()
+
+	for _, e := range mmMakeLog.MakeLogMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMakeLog.MakeLogMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMakeLog.MakeLogMock.defaultExpectation.Counter, 1)
+		mm_want := mmMakeLog.MakeLogMock.defaultExpectation.params
+		mm_want_ptrs := mmMakeLog.MakeLogMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockMakeLogParams{ctx, log}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMakeLog.t.Errorf("UserRepositoryMock.MakeLog got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.log != nil && !minimock.Equal(*mm_want_ptrs.log, mm_got.log) {
+				mmMakeLog.t.Errorf("UserRepositoryMock.MakeLog got unexpected parameter log, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.originLog, *mm_want_ptrs.log, mm_got.log, minimock.Diff(*mm_want_ptrs.log, mm_got.log))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMakeLog.t.Errorf("UserRepositoryMock.MakeLog got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMakeLog.MakeLogMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMakeLog.t.Fatal("No results are set for the UserRepositoryMock.MakeLog")
+		}
+		return (*mm_results).err
+	}
+	if mmMakeLog.funcMakeLog != nil {
+		return mmMakeLog.funcMakeLog(ctx, log)
+	}
+	mmMakeLog.t.Fatalf("Unexpected call to UserRepositoryMock.MakeLog. %v %v", ctx, log)
+	return
+}
+
+// MakeLogAfterCounter returns a count of finished UserRepositoryMock.MakeLog invocations
+func (mmMakeLog *UserRepositoryMock) MakeLogAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMakeLog.afterMakeLogCounter)
+}
+
+// MakeLogBeforeCounter returns a count of UserRepositoryMock.MakeLog invocations
+func (mmMakeLog *UserRepositoryMock) MakeLogBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMakeLog.beforeMakeLogCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.MakeLog.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMakeLog *mUserRepositoryMockMakeLog) Calls() []*UserRepositoryMockMakeLogParams {
+	mmMakeLog.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockMakeLogParams, len(mmMakeLog.callArgs))
+	copy(argCopy, mmMakeLog.callArgs)
+
+	mmMakeLog.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMakeLogDone returns true if the count of the MakeLog invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockMakeLogDone() bool {
+	if m.MakeLogMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MakeLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MakeLogMock.invocationsDone()
+}
+
+// MinimockMakeLogInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockMakeLogInspect() {
+	for _, e := range m.MakeLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.MakeLog at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMakeLogCounter := mm_atomic.LoadUint64(&m.afterMakeLogCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MakeLogMock.defaultExpectation != nil && afterMakeLogCounter < 1 {
+		if m.MakeLogMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.MakeLog at\n%s", m.MakeLogMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.MakeLog at\n%s with params: %#v", m.MakeLogMock.defaultExpectation.expectationOrigins.origin, *m.MakeLogMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMakeLog != nil && afterMakeLogCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.MakeLog at\n%s", m.funcMakeLogOrigin)
+	}
+
+	if !m.MakeLogMock.invocationsDone() && afterMakeLogCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.MakeLog at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MakeLogMock.expectedInvocations), m.MakeLogMock.expectedInvocationsOrigin, afterMakeLogCounter)
+	}
+}
+
+type mUserRepositoryMockSetEmailVerified struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockSetEmailVerifiedExpectation
+	expectations       []*UserRepositoryMockSetEmailVerifiedExpectation
+
+	callArgs []*UserRepositoryMockSetEmailVerifiedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockSetEmailVerifiedExpectation specifies expectation struct of the UserRepository.SetEmailVerified
+type UserRepositoryMockSetEmailVerifiedExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockSetEmailVerifiedParams
+	paramPtrs          *UserRepositoryMockSetEmailVerifiedParamPtrs
+	expectationOrigins UserRepositoryMockSetEmailVerifiedExpectationOrigins
+	results            *UserRepositoryMockSetEmailVerifiedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockSetEmailVerifiedParams contains parameters of the UserRepository.SetEmailVerified
+type UserRepositoryMockSetEmailVerifiedParams struct {
+	ctx      context.Context
+	id       int64
+	verified bool
+}
+
+// UserRepositoryMockSetEmailVerifiedParamPtrs contains pointers to parameters of the UserRepository.SetEmailVerified
+type UserRepositoryMockSetEmailVerifiedParamPtrs struct {
+	ctx      *context.Context
+	id       *int64
+	verified *bool
+}
+
+// UserRepositoryMockSetEmailVerifiedResults contains results of the UserRepository.SetEmailVerified
+type UserRepositoryMockSetEmailVerifiedResults struct {
+	err error
+}
+
+// UserRepositoryMockSetEmailVerifiedOrigins contains origins of expectations of the UserRepository.SetEmailVerified
+type UserRepositoryMockSetEmailVerifiedExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originVerified string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Optional() *mUserRepositoryMockSetEmailVerified {
+	mmSetEmailVerified.optional = true
+	return mmSetEmailVerified
+}
+
+// Expect sets up expected params for UserRepository.SetEmailVerified
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Expect(ctx context.Context, id int64, verified bool) *mUserRepositoryMockSetEmailVerified {
+	if mmSetEmailVerified.mock.funcSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Set")
+	}
+
+	if mmSetEmailVerified.defaultExpectation == nil {
+		mmSetEmailVerified.defaultExpectation = &UserRepositoryMockSetEmailVerifiedExpectation{}
+	}
+
+	if mmSetEmailVerified.defaultExpectation.paramPtrs != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by ExpectParams functions")
+	}
+
+	mmSetEmailVerified.defaultExpectation.params = &UserRepositoryMockSetEmailVerifiedParams{ctx, id, verified}
+	mmSetEmailVerified.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetEmailVerified.expectations {
+		if minimock.Equal(e.params, mmSetEmailVerified.defaultExpectation.params) {
+			mmSetEmailVerified.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetEmailVerified.defaultExpectation.params)
+		}
+	}
+
+	return mmSetEmailVerified
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.SetEmailVerified
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockSetEmailVerified {
+	if mmSetEmailVerified.mock.funcSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Set")
+	}
+
+	if mmSetEmailVerified.defaultExpectation == nil {
+		mmSetEmailVerified.defaultExpectation = &UserRepositoryMockSetEmailVerifiedExpectation{}
+	}
+
+	if mmSetEmailVerified.defaultExpectation.params != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Expect")
+	}
+
+	if mmSetEmailVerified.defaultExpectation.paramPtrs == nil {
+		mmSetEmailVerified.defaultExpectation.paramPtrs = &UserRepositoryMockSetEmailVerifiedParamPtrs{}
+	}
+	mmSetEmailVerified.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetEmailVerified.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetEmailVerified
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.SetEmailVerified
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) ExpectIdParam2(id int64) *mUserRepositoryMockSetEmailVerified {
+	if mmSetEmailVerified.mock.funcSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Set")
+	}
+
+	if mmSetEmailVerified.defaultExpectation == nil {
+		mmSetEmailVerified.defaultExpectation = &UserRepositoryMockSetEmailVerifiedExpectation{}
+	}
+
+	if mmSetEmailVerified.defaultExpectation.params != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Expect")
+	}
+
+	if mmSetEmailVerified.defaultExpectation.paramPtrs == nil {
+		mmSetEmailVerified.defaultExpectation.paramPtrs = &UserRepositoryMockSetEmailVerifiedParamPtrs{}
+	}
+	mmSetEmailVerified.defaultExpectation.paramPtrs.id = &id
+	mmSetEmailVerified.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmSetEmailVerified
+}
+
+// ExpectVerifiedParam3 sets up expected param verified for UserRepository.SetEmailVerified
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) ExpectVerifiedParam3(verified bool) *mUserRepositoryMockSetEmailVerified {
+	if mmSetEmailVerified.mock.funcSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Set")
+	}
+
+	if mmSetEmailVerified.defaultExpectation == nil {
+		mmSetEmailVerified.defaultExpectation = &UserRepositoryMockSetEmailVerifiedExpectation{}
+	}
+
+	if mmSetEmailVerified.defaultExpectation.params != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Expect")
+	}
+
+	if mmSetEmailVerified.defaultExpectation.paramPtrs == nil {
+		mmSetEmailVerified.defaultExpectation.paramPtrs = &UserRepositoryMockSetEmailVerifiedParamPtrs{}
+	}
+	mmSetEmailVerified.defaultExpectation.paramPtrs.verified = &verified
+	mmSetEmailVerified.defaultExpectation.expectationOrigins.originVerified = minimock.CallerInfo(1)
+
+	return mmSetEmailVerified
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.SetEmailVerified
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Inspect(f func(ctx context.Context, id int64, verified bool)) *mUserRepositoryMockSetEmailVerified {
+	if mmSetEmailVerified.mock.inspectFuncSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.SetEmailVerified")
+	}
+
+	mmSetEmailVerified.mock.inspectFuncSetEmailVerified = f
+
+	return mmSetEmailVerified
+}
+
+// Return sets up results that will be returned by UserRepository.SetEmailVerified
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Return(err error) *UserRepositoryMock {
+	if mmSetEmailVerified.mock.funcSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Set")
+	}
+
+	if mmSetEmailVerified.defaultExpectation == nil {
+		mmSetEmailVerified.defaultExpectation = &UserRepositoryMockSetEmailVerifiedExpectation{mock: mmSetEmailVerified.mock}
+	}
+	mmSetEmailVerified.defaultExpectation.results = &UserRepositoryMockSetEmailVerifiedResults{err}
+	mmSetEmailVerified.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetEmailVerified.mock
+}
+
+// Set uses given function f to mock the UserRepository.SetEmailVerified method
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Set(f func(ctx context.Context, id int64, verified bool) (err error)) *UserRepositoryMock {
+	if mmSetEmailVerified.defaultExpectation != nil {
+		mmSetEmailVerified.mock.t.Fatalf("Default expectation is already set for the UserRepository.SetEmailVerified method")
+	}
+
+	if len(mmSetEmailVerified.expectations) > 0 {
+		mmSetEmailVerified.mock.t.Fatalf("Some expectations are already set for the UserRepository.SetEmailVerified method")
+	}
+
+	mmSetEmailVerified.mock.funcSetEmailVerified = f
+	mmSetEmailVerified.mock.funcSetEmailVerifiedOrigin = minimock.CallerInfo(1)
+	return mmSetEmailVerified.mock
+}
+
+// When sets expectation for the UserRepository.SetEmailVerified which will trigger the result defined by the following
+// Then helper
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) When(ctx context.Context, id int64, verified bool) *UserRepositoryMockSetEmailVerifiedExpectation {
+	if mmSetEmailVerified.mock.funcSetEmailVerified != nil {
+		mmSetEmailVerified.mock.t.Fatalf("UserRepositoryMock.SetEmailVerified mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockSetEmailVerifiedExpectation{
+		mock:               mmSetEmailVerified.mock,
+		params:             &UserRepositoryMockSetEmailVerifiedParams{ctx, id, verified},
+		expectationOrigins: UserRepositoryMockSetEmailVerifiedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetEmailVerified.expectations = append(mmSetEmailVerified.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.SetEmailVerified return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockSetEmailVerifiedExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockSetEmailVerifiedResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.SetEmailVerified should be invoked
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Times(n uint64) *mUserRepositoryMockSetEmailVerified {
+	if n == 0 {
+		mmSetEmailVerified.mock.t.Fatalf("Times of UserRepositoryMock.SetEmailVerified mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetEmailVerified.expectedInvocations, n)
+	mmSetEmailVerified.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetEmailVerified
+}
+
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) invocationsDone() bool {
+	if len(mmSetEmailVerified.expectations) == 0 && mmSetEmailVerified.defaultExpectation == nil && mmSetEmailVerified.mock.funcSetEmailVerified == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetEmailVerified.mock.afterSetEmailVerifiedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetEmailVerified.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetEmailVerified implements mm_repository.UserRepository
+func (mmSetEmailVerified *UserRepositoryMock) SetEmailVerified(ctx context.Context, id int64, verified bool) (err error) {
+	mm_atomic.AddUint64(&mmSetEmailVerified.beforeSetEmailVerifiedCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetEmailVerified.afterSetEmailVerifiedCounter, 1)
+
+	mmSetEmailVerified.t.Helper()
+
+	if mmSetEmailVerified.inspectFuncSetEmailVerified != nil {
+		mmSetEmailVerified.inspectFuncSetEmailVerified(ctx, id, verified)
+	}
+
+	mm_params := UserRepositoryMockSetEmailVerifiedParams{ctx, id, verified}
+
+	// Record call args
+	mmSetEmailVerified.SetEmailVerifiedMock.mutex.Lock()
+	mmSetEmailVerified.SetEmailVerifiedMock.callArgs = append(mmSetEmailVerified.SetEmailVerifiedMock.callArgs, &mm_params)
+	mmSetEmailVerified.SetEmailVerifiedMock.mutex.Unlock()
+
+	for _, e := range mmSetEmailVerified.SetEmailVerifiedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.params
+		mm_want_ptrs := mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockSetEmailVerifiedParams{ctx, id, verified}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetEmailVerified.t.Errorf("UserRepositoryMock.SetEmailVerified got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSetEmailVerified.t.Errorf("UserRepositoryMock.SetEmailVerified got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.verified != nil && !minimock.Equal(*mm_want_ptrs.verified, mm_got.verified) {
+				mmSetEmailVerified.t.Errorf("UserRepositoryMock.SetEmailVerified got unexpected parameter verified, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.expectationOrigins.originVerified, *mm_want_ptrs.verified, mm_got.verified, minimock.Diff(*mm_want_ptrs.verified, mm_got.verified))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetEmailVerified.t.Errorf("UserRepositoryMock.SetEmailVerified got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetEmailVerified.SetEmailVerifiedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetEmailVerified.t.Fatal("No results are set for the UserRepositoryMock.SetEmailVerified")
+		}
+		return (*mm_results).err
+	}
+	if mmSetEmailVerified.funcSetEmailVerified != nil {
+		return mmSetEmailVerified.funcSetEmailVerified(ctx, id, verified)
+	}
+	mmSetEmailVerified.t.Fatalf("Unexpected call to UserRepositoryMock.SetEmailVerified. %v %v %v", ctx, id, verified)
+	return
+}
+
+// SetEmailVerifiedAfterCounter returns a count of finished UserRepositoryMock.SetEmailVerified invocations
+func (mmSetEmailVerified *UserRepositoryMock) SetEmailVerifiedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetEmailVerified.afterSetEmailVerifiedCounter)
+}
+
+// SetEmailVerifiedBeforeCounter returns a count of UserRepositoryMock.SetEmailVerified invocations
+func (mmSetEmailVerified *UserRepositoryMock) SetEmailVerifiedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetEmailVerified.beforeSetEmailVerifiedCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.SetEmailVerified.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetEmailVerified *mUserRepositoryMockSetEmailVerified) Calls() []*UserRepositoryMockSetEmailVerifiedParams {
+	mmSetEmailVerified.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockSetEmailVerifiedParams, len(mmSetEmailVerified.callArgs))
+	copy(argCopy, mmSetEmailVerified.callArgs)
+
+	mmSetEmailVerified.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetEmailVerifiedDone returns true if the count of the SetEmailVerified invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockSetEmailVerifiedDone() bool {
+	if m.SetEmailVerifiedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetEmailVerifiedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetEmailVerifiedMock.invocationsDone()
+}
+
+// MinimockSetEmailVerifiedInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockSetEmailVerifiedInspect() {
+	for _, e := range m.SetEmailVerifiedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetEmailVerified at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetEmailVerifiedCounter := mm_atomic.LoadUint64(&m.afterSetEmailVerifiedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetEmailVerifiedMock.defaultExpectation != nil && afterSetEmailVerifiedCounter < 1 {
+		if m.SetEmailVerifiedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetEmailVerified at\n%s", m.SetEmailVerifiedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.SetEmailVerified at\n%s with params: %#v", m.SetEmailVerifiedMock.defaultExpectation.expectationOrigins.origin, *m.SetEmailVerifiedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetEmailVerified != nil && afterSetEmailVerifiedCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.SetEmailVerified at\n%s", m.funcSetEmailVerifiedOrigin)
+	}
+
+	if !m.SetEmailVerifiedMock.invocationsDone() && afterSetEmailVerifiedCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.SetEmailVerified at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetEmailVerifiedMock.expectedInvocations), m.SetEmailVerifiedMock.expectedInvocationsOrigin, afterSetEmailVerifiedCounter)
+	}
+}
+
+type mUserRepositoryMockUpdatePassword struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdatePasswordExpectation
+	expectations       []*UserRepositoryMockUpdatePasswordExpectation
+
+	callArgs []*UserRepositoryMockUpdatePasswordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockUpdatePasswordExpectation specifies expectation struct of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockUpdatePasswordParams
+	paramPtrs          *UserRepositoryMockUpdatePasswordParamPtrs
+	expectationOrigins UserRepositoryMockUpdatePasswordExpectationOrigins
+	results            *UserRepositoryMockUpdatePasswordResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockUpdatePasswordParams contains parameters of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordParams struct {
+	ctx      context.Context
+	id       int64
+	password string
+}
+
+// UserRepositoryMockUpdatePasswordParamPtrs contains pointers to parameters of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordParamPtrs struct {
+	ctx      *context.Context
+	id       *int64
+	password *string
+}
+
+// UserRepositoryMockUpdatePasswordResults contains results of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordResults struct {
+	err error
+}
+
+// UserRepositoryMockUpdatePasswordOrigins contains origins of expectations of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Optional() *mUserRepositoryMockUpdatePassword {
+	mmUpdatePassword.optional = true
+	return mmUpdatePassword
+}
+
+// Expect sets up expected params for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Expect(ctx context.Context, id int64, password string) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by ExpectParams functions")
+	}
+
+	mmUpdatePassword.defaultExpectation.params = &UserRepositoryMockUpdatePasswordParams{ctx, id, password}
+	mmUpdatePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePassword.expectations {
+		if minimock.Equal(e.params, mmUpdatePassword.defaultExpectation.params) {
+			mmUpdatePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePassword.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdatePassword
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectIdParam2(id int64) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.id = &id
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
+}
+
+// ExpectPasswordParam3 sets up expected param password for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectPasswordParam3(password string) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.password = &password
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Inspect(f func(ctx context.Context, id int64, password string)) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.inspectFuncUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdatePassword")
+	}
+
+	mmUpdatePassword.mock.inspectFuncUpdatePassword = f
+
+	return mmUpdatePassword
+}
+
+// Return sets up results that will be returned by UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Return(err error) *UserRepositoryMock {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{mock: mmUpdatePassword.mock}
+	}
+	mmUpdatePassword.defaultExpectation.results = &UserRepositoryMockUpdatePasswordResults{err}
+	mmUpdatePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword.mock
+}
+
+// Set uses given function f to mock the UserRepository.UpdatePassword method
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Set(f func(ctx context.Context, id int64, password string) (err error)) *UserRepositoryMock {
+	if mmUpdatePassword.defaultExpectation != nil {
+		mmUpdatePassword.mock.t.Fatalf("Default expectation is already set for the UserRepository.UpdatePassword method")
+	}
+
+	if len(mmUpdatePassword.expectations) > 0 {
+		mmUpdatePassword.mock.t.Fatalf("Some expectations are already set for the UserRepository.UpdatePassword method")
+	}
+
+	mmUpdatePassword.mock.funcUpdatePassword = f
+	mmUpdatePassword.mock.funcUpdatePasswordOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword.mock
+}
+
+// When sets expectation for the UserRepository.UpdatePassword which will trigger the result defined by the following
+// Then helper
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) When(ctx context.Context, id int64, password string) *UserRepositoryMockUpdatePasswordExpectation {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockUpdatePasswordExpectation{
+		mock:               mmUpdatePassword.mock,
+		params:             &UserRepositoryMockUpdatePasswordParams{ctx, id, password},
+		expectationOrigins: UserRepositoryMockUpdatePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdatePassword.expectations = append(mmUpdatePassword.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.UpdatePassword return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdatePasswordExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdatePasswordResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.UpdatePassword should be invoked
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Times(n uint64) *mUserRepositoryMockUpdatePassword {
+	if n == 0 {
+		mmUpdatePassword.mock.t.Fatalf("Times of UserRepositoryMock.UpdatePassword mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdatePassword.expectedInvocations, n)
+	mmUpdatePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword
+}
+
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) invocationsDone() bool {
+	if len(mmUpdatePassword.expectations) == 0 && mmUpdatePassword.defaultExpectation == nil && mmUpdatePassword.mock.funcUpdatePassword == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePassword.mock.afterUpdatePasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePassword.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdatePassword implements mm_repository.UserRepository
+func (mmUpdatePassword *UserRepositoryMock) UpdatePassword(ctx context.Context, id int64, password string) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePassword.beforeUpdatePasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePassword.afterUpdatePasswordCounter, 1)
+
+	mmUpdatePassword.t.Helper()
+
+	if mmUpdatePassword.inspectFuncUpdatePassword != nil {
+		mmUpdatePassword.inspectFuncUpdatePassword(ctx, id, password)
+	}
+
+	mm_params := UserRepositoryMockUpdatePasswordParams{ctx, id, password}
+
+	// Record call args
+	mmUpdatePassword.UpdatePasswordMock.mutex.Lock()
+	mmUpdatePassword.UpdatePasswordMock.callArgs = append(mmUpdatePassword.UpdatePasswordMock.callArgs, &mm_params)
+	mmUpdatePassword.UpdatePasswordMock.mutex.Unlock()
+
+	for _, e := range mmUpdatePassword.UpdatePasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdatePassword.UpdatePasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePassword.UpdatePasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockUpdatePasswordParams{ctx, id, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdatePassword.t.Fatal("No results are set for the UserRepositoryMock.UpdatePassword")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdatePassword.funcUpdatePassword != nil {
+		return mmUpdatePassword.funcUpdatePassword(ctx, id, password)
+	}
+	mmUpdatePassword.t.Fatalf("Unexpected call to UserRepositoryMock.UpdatePassword. %v %v %v", ctx, id, password)
+	return
+}
+
+// UpdatePasswordAfterCounter returns a count of finished UserRepositoryMock.UpdatePassword invocations
+func (mmUpdatePassword *UserRepositoryMock) UpdatePasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePassword.afterUpdatePasswordCounter)
+}
+
+// UpdatePasswordBeforeCounter returns a count of UserRepositoryMock.UpdatePassword invocations
+func (mmUpdatePassword *UserRepositoryMock) UpdatePasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePassword.beforeUpdatePasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UpdatePassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Calls() []*UserRepositoryMockUpdatePasswordParams {
+	mmUpdatePassword.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockUpdatePasswordParams, len(mmUpdatePassword.callArgs))
+	copy(argCopy, mmUpdatePassword.callArgs)
+
+	mmUpdatePassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdatePasswordDone returns true if the count of the UpdatePassword invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockUpdatePasswordDone() bool {
+	if m.UpdatePasswordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdatePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdatePasswordMock.invocationsDone()
+}
+
+// MinimockUpdatePasswordInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdatePasswordInspect() {
+	for _, e := range m.UpdatePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdatePasswordCounter := mm_atomic.LoadUint64(&m.afterUpdatePasswordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePasswordMock.defaultExpectation != nil && afterUpdatePasswordCounter < 1 {
+		if m.UpdatePasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s", m.UpdatePasswordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s with params: %#v", m.UpdatePasswordMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePassword != nil && afterUpdatePasswordCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s", m.funcUpdatePasswordOrigin)
+	}
+
+	if !m.UpdatePasswordMock.invocationsDone() && afterUpdatePasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UpdatePassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePasswordMock.expectedInvocations), m.UpdatePasswordMock.expectedInvocationsOrigin, afterUpdatePasswordCounter)
+	}
+}
+
+type mUserRepositoryMockUpdateUser struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdateUserExpectation
+	expectations       []*UserRepositoryMockUpdateUserExpectation
 
-	return mmGetUser
+	callArgs []*UserRepositoryMockUpdateUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
 }
 
-// Return sets up results that will be returned by UserRepository.GetUser
-func (mmGetUser *mUserRepositoryMockGetUser) Return(up1 *model.User, err error) *UserRepositoryMock {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+// UserRepositoryMockUpdateUserExpectation specifies expectation struct of the UserRepository.UpdateUser
+type UserRepositoryMockUpdateUserExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockUpdateUserParams
+	paramPtrs          *UserRepositoryMockUpdateUserParamPtrs
+	expectationOrigins UserRepositoryMockUpdateUserExpectationOrigins
+	results            *UserRepositoryMockUpdateUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockUpdateUserParams contains parameters of the UserRepository.UpdateUser
+type UserRepositoryMockUpdateUserParams struct {
+	ctx  context.Context
+	id   int64
+	info model.UserInfo
+}
+
+// UserRepositoryMockUpdateUserParamPtrs contains pointers to parameters of the UserRepository.UpdateUser
+type UserRepositoryMockUpdateUserParamPtrs struct {
+	ctx  *context.Context
+	id   *int64
+	info *model.UserInfo
+}
+
+// UserRepositoryMockUpdateUserResults contains results of the UserRepository.UpdateUser
+type UserRepositoryMockUpdateUserResults struct {
+	err error
+}
+
+// UserRepositoryMockUpdateUserOrigins contains origins of expectations of the UserRepository.UpdateUser
+type UserRepositoryMockUpdateUserExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originId   string
+	originInfo string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) Optional() *mUserRepositoryMockUpdateUser {
+	mmUpdateUser.optional = true
+	return mmUpdateUser
+}
+
+// Expect sets up expected params for UserRepository.UpdateUser
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) Expect(ctx context.Context, id int64, info model.UserInfo) *mUserRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{mock: mmGetUser.mock}
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
 	}
-	mmGetUser.defaultExpectation.results = &UserRepositoryMockGetUserResults{up1, err}
-	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+
+	if mmUpdateUser.defaultExpectation.paramPtrs != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateUser.defaultExpectation.params = &UserRepositoryMockUpdateUserParams{ctx, id, info}
+	mmUpdateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateUser.expectations {
+		if minimock.Equal(e.params, mmUpdateUser.defaultExpectation.params) {
+			mmUpdateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateUser.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateUser
 }
 
-// Set uses given function f to mock the UserRepository.GetUser method
-func (mmGetUser *mUserRepositoryMockGetUser) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *UserRepositoryMock {
-	if mmGetUser.defaultExpectation != nil {
-		mmGetUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUser method")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdateUser
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
 	}
 
-	if len(mmGetUser.expectations) > 0 {
-		mmGetUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUser method")
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
 	}
 
-	mmGetUser.mock.funcGetUser = f
-	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+	if mmUpdateUser.defaultExpectation.params != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Expect")
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
+		mmUpdateUser.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserParamPtrs{}
+	}
+	mmUpdateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateUser
 }
 
-// When sets expectation for the UserRepository.GetUser which will trigger the result defined by the following
+// ExpectIdParam2 sets up expected param id for UserRepository.UpdateUser
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) ExpectIdParam2(id int64) *mUserRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
+	}
+
+	if mmUpdateUser.defaultExpectation.params != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Expect")
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
+		mmUpdateUser.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserParamPtrs{}
+	}
+	mmUpdateUser.defaultExpectation.paramPtrs.id = &id
+	mmUpdateUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdateUser
+}
+
+// ExpectInfoParam3 sets up expected param info for UserRepository.UpdateUser
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) ExpectInfoParam3(info model.UserInfo) *mUserRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
+	}
+
+	if mmUpdateUser.defaultExpectation.params != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Expect")
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
+		mmUpdateUser.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserParamPtrs{}
+	}
+	mmUpdateUser.defaultExpectation.paramPtrs.info = &info
+	mmUpdateUser.defaultExpectation.expectationOrigins.originInfo = minimock.CallerInfo(1)
+
+	return mmUpdateUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdateUser
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) Inspect(f func(ctx context.Context, id int64, info model.UserInfo)) *mUserRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.inspectFuncUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdateUser")
+	}
+
+	mmUpdateUser.mock.inspectFuncUpdateUser = f
+
+	return mmUpdateUser
+}
+
+// Return sets up results that will be returned by UserRepository.UpdateUser
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) Return(err error) *UserRepositoryMock {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{mock: mmUpdateUser.mock}
+	}
+	mmUpdateUser.defaultExpectation.results = &UserRepositoryMockUpdateUserResults{err}
+	mmUpdateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateUser.mock
+}
+
+// Set uses given function f to mock the UserRepository.UpdateUser method
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) Set(f func(ctx context.Context, id int64, info model.UserInfo) (err error)) *UserRepositoryMock {
+	if mmUpdateUser.defaultExpectation != nil {
+		mmUpdateUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.UpdateUser method")
+	}
+
+	if len(mmUpdateUser.expectations) > 0 {
+		mmUpdateUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.UpdateUser method")
+	}
+
+	mmUpdateUser.mock.funcUpdateUser = f
+	mmUpdateUser.mock.funcUpdateUserOrigin = minimock.CallerInfo(1)
+	return mmUpdateUser.mock
+}
+
+// When sets expectation for the UserRepository.UpdateUser which will trigger the result defined by the following
 // Then helper
-func (mmGetUser *mUserRepositoryMockGetUser) When(ctx context.Context, id int64) *UserRepositoryMockGetUserExpectation {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) When(ctx context.Context, id int64, info model.UserInfo) *UserRepositoryMockUpdateUserExpectation {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockGetUserExpectation{
-		mock:               mmGetUser.mock,
-		params:             &UserRepositoryMockGetUserParams{ctx, id},
-		expectationOrigins: UserRepositoryMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockUpdateUserExpectation{
+		mock:               mmUpdateUser.mock,
+		params:             &UserRepositoryMockUpdateUserParams{ctx, id, info},
+		expectationOrigins: UserRepositoryMockUpdateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	mmUpdateUser.expectations = append(mmUpdateUser.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.GetUser return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockGetUserExpectation) Then(up1 *model.User, err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockGetUserResults{up1, err}
+// Then sets up UserRepository.UpdateUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdateUserExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdateUserResults{err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.GetUser should be invoked
-func (mmGetUser *mUserRepositoryMockGetUser) Times(n uint64) *mUserRepositoryMockGetUser {
+// Times sets number of times UserRepository.UpdateUser should be invoked
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) Times(n uint64) *mUserRepositoryMockUpdateUser {
 	if n == 0 {
-		mmGetUser.mock.t.Fatalf("Times of UserRepositoryMock.GetUser mock can not be zero")
+		mmUpdateUser.mock.t.Fatalf("Times of UserRepositoryMock.UpdateUser mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
-	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUser
+	mm_atomic.StoreUint64(&mmUpdateUser.expectedInvocations, n)
+	mmUpdateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateUser
 }
 
-func (mmGetUser *mUserRepositoryMockGetUser) invocationsDone() bool {
-	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) invocationsDone() bool {
+	if len(mmUpdateUser.expectations) == 0 && mmUpdateUser.defaultExpectation == nil && mmUpdateUser.mock.funcUpdateUser == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateUser.mock.afterUpdateUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateUser.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUser implements mm_repository.UserRepository
-func (mmGetUser *UserRepositoryMock) GetUser(ctx context.Context, id int64) (up1 *model.User, err error) {
-	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+// UpdateUser implements mm_repository.UserRepository
+func (mmUpdateUser *UserRepositoryMock) UpdateUser(ctx context.Context, id int64, info model.UserInfo) (err error) {
+	mm_atomic.AddUint64(&mmUpdateUser.beforeUpdateUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateUser.afterUpdateUserCounter, 1)
 
-	mmGetUser.t.Helper()
+	mmUpdateUser.t.Helper()
 
-	if mmGetUser.inspectFuncGetUser != nil {
-		mmGetUser.inspectFuncGetUser(ctx, id)
+	if mmUpdateUser.inspectFuncUpdateUser != nil {
+		mmUpdateUser.inspectFuncUpdateUser(ctx, id, info)
 	}
 
-	mm_params := UserRepositoryMockGetUserParams{ctx, id}
+	mm_params := UserRepositoryMockUpdateUserParams{ctx, id, info}
 
 	// Record call args
-	mmGetUser.GetUserMock.mutex.Lock()
-	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
-	mmGetUser.GetUserMock.mutex.Unlock()
+	mmUpdateUser.UpdateUserMock.mutex.Lock()
+	mmUpdateUser.UpdateUserMock.callArgs = append(mmUpdateUser.UpdateUserMock.callArgs, &mm_params)
+	mmUpdateUser.UpdateUserMock.mutex.Unlock()
 
-	for _, e := range mmGetUser.GetUserMock.expectations {
+	for _, e := range mmUpdateUser.UpdateUserMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetUser.GetUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+	if mmUpdateUser.UpdateUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateUser.UpdateUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateUser.UpdateUserMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateUser.UpdateUserMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockGetUserParams{ctx, id}
+		mm_got := UserRepositoryMockUpdateUserParams{ctx, id, info}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateUser.t.Errorf("UserRepositoryMock.UpdateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateUser.UpdateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmUpdateUser.t.Errorf("UserRepositoryMock.UpdateUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateUser.UpdateUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.info != nil && !minimock.Equal(*mm_want_ptrs.info, mm_got.info) {
+				mmUpdateUser.t.Errorf("UserRepositoryMock.UpdateUser got unexpected parameter info, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateUser.UpdateUserMock.defaultExpectation.expectationOrigins.originInfo, *mm_want_ptrs.info, mm_got.info, minimock.Diff(*mm_want_ptrs.info, mm_got.info))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateUser.t.Errorf("UserRepositoryMock.UpdateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateUser.UpdateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		mm_results := mmUpdateUser.UpdateUserMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUser.t.Fatal("No results are set for the UserRepositoryMock.GetUser")
+			mmUpdateUser.t.Fatal("No results are set for the UserRepositoryMock.UpdateUser")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetUser.funcGetUser != nil {
-		return mmGetUser.funcGetUser(ctx, id)
+	if mmUpdateUser.funcUpdateUser != nil {
+		return mmUpdateUser.funcUpdateUser(ctx, id, info)
 	}
-	mmGetUser.t.Fatalf("Unexpected call to UserRepositoryMock.GetUser. %v %v", ctx, id)
+	mmUpdateUser.t.Fatalf("Unexpected call to UserRepositoryMock.UpdateUser. %v %v %v", ctx, id, info)
 	return
 }
 
-// GetUserAfterCounter returns a count of finished UserRepositoryMock.GetUser invocations
-func (mmGetUser *UserRepositoryMock) GetUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+// UpdateUserAfterCounter returns a count of finished UserRepositoryMock.UpdateUser invocations
+func (mmUpdateUser *UserRepositoryMock) UpdateUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateUser.afterUpdateUserCounter)
 }
 
-// GetUserBeforeCounter returns a count of UserRepositoryMock.GetUser invocations
-func (mmGetUser *UserRepositoryMock) GetUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+// UpdateUserBeforeCounter returns a count of UserRepositoryMock.UpdateUser invocations
+func (mmUpdateUser *UserRepositoryMock) UpdateUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateUser.beforeUpdateUserCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUser.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UpdateUser.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUser *mUserRepositoryMockGetUser) Calls() []*UserRepositoryMockGetUserParams {
-	mmGetUser.mutex.RLock()
+func (mmUpdateUser *mUserRepositoryMockUpdateUser) Calls() []*UserRepositoryMockUpdateUserParams {
+	mmUpdateUser.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockGetUserParams, len(mmGetUser.callArgs))
-	copy(argCopy, mmGetUser.callArgs)
+	argCopy := make([]*UserRepositoryMockUpdateUserParams, len(mmUpdateUser.callArgs))
+	copy(argCopy, mmUpdateUser.callArgs)
 
-	mmGetUser.mutex.RUnlock()
+	mmUpdateUser.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// MinimockUpdateUserDone returns true if the count of the UpdateUser invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockGetUserDone() bool {
-	if m.GetUserMock.optional {
+func (m *UserRepositoryMock) MinimockUpdateUserDone() bool {
+	if m.UpdateUserMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserMock.expectations {
+	for _, e := range m.UpdateUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserMock.invocationsDone()
+	return m.UpdateUserMock.invocationsDone()
 }
 
-// MinimockGetUserInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockGetUserInspect() {
-	for _, e := range m.GetUserMock.expectations {
+// MinimockUpdateUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdateUserInspect() {
+	for _, e := range m.UpdateUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	afterUpdateUserCounter := mm_atomic.LoadUint64(&m.afterUpdateUserCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
-		if m.GetUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+	if m.UpdateUserMock.defaultExpectation != nil && afterUpdateUserCounter < 1 {
+		if m.UpdateUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateUser at\n%s", m.UpdateUserMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateUser at\n%s with params: %#v", m.UpdateUserMock.defaultExpectation.expectationOrigins.origin, *m.UpdateUserMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUser != nil && afterGetUserCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.GetUser at\n%s", m.funcGetUserOrigin)
+	if m.funcUpdateUser != nil && afterUpdateUserCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.UpdateUser at\n%s", m.funcUpdateUserOrigin)
 	}
 
-	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	if !m.UpdateUserMock.invocationsDone() && afterUpdateUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UpdateUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateUserMock.expectedInvocations), m.UpdateUserMock.expectedInvocationsOrigin, afterUpdateUserCounter)
 	}
 }
 
-type mUserRepositoryMockMakeLog struct {
+type mUserRepositoryMockUseEmailVerificationToken struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockMakeLogExpectation
-	expectations       []*UserRepositoryMockMakeLogExpectation
+	defaultExpectation *UserRepositoryMockUseEmailVerificationTokenExpectation
+	expectations       []*UserRepositoryMockUseEmailVerificationTokenExpectation
 
-	callArgs []*UserRepositoryMockMakeLogParams
+	callArgs []*UserRepositoryMockUseEmailVerificationTokenParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockMakeLogExpectation specifies expectation struct of the UserRepository.MakeLog
-type UserRepositoryMockMakeLogExpectation struct {
+// UserRepositoryMockUseEmailVerificationTokenExpectation specifies expectation struct of the UserRepository.UseEmailVerificationToken
+type UserRepositoryMockUseEmailVerificationTokenExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockMakeLogParams
-	paramPtrs          *UserRepositoryMockMakeLogParamPtrs
-	expectationOrigins UserRepositoryMockMakeLogExpectationOrigins
-	results            *UserRepositoryMockMakeLogResults
+	params             *UserRepositoryMockUseEmailVerificationTokenParams
+	paramPtrs          *UserRepositoryMockUseEmailVerificationTokenParamPtrs
+	expectationOrigins UserRepositoryMockUseEmailVerificationTokenExpectationOrigins
+	results            *UserRepositoryMockUseEmailVerificationTokenResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockMakeLogParams contains parameters of the UserRepository.MakeLog
-type UserRepositoryMockMakeLogParams struct {
-	ctx context.Context
-	log model.Log
+// UserRepositoryMockUseEmailVerificationTokenParams contains parameters of the UserRepository.UseEmailVerificationToken
+type UserRepositoryMockUseEmailVerificationTokenParams struct {
+	ctx       context.Context
+	tokenHash string
 }
 
-// UserRepositoryMockMakeLogParamPtrs contains pointers to parameters of the UserRepository.MakeLog
-type UserRepositoryMockMakeLogParamPtrs struct {
-	ctx *context.Context
-	log *model.Log
+// UserRepositoryMockUseEmailVerificationTokenParamPtrs contains pointers to parameters of the UserRepository.UseEmailVerificationToken
+type UserRepositoryMockUseEmailVerificationTokenParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
 }
 
-// UserRepositoryMockMakeLogResults contains results of the UserRepository.MakeLog
-type UserRepositoryMockMakeLogResults struct {
+// UserRepositoryMockUseEmailVerificationTokenResults contains results of the UserRepository.UseEmailVerificationToken
+type UserRepositoryMockUseEmailVerificationTokenResults struct {
+	i1  int64
 	err error
 }
 
-// UserRepositoryMockMakeLogOrigins contains origins of expectations of the UserRepository.MakeLog
-type UserRepositoryMockMakeLogExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originLog string
+// UserRepositoryMockUseEmailVerificationTokenOrigins contains origins of expectations of the UserRepository.UseEmailVerificationToken
+type UserRepositoryMockUseEmailVerificationTokenExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1164,344 +3723,342 @@ type UserRepositoryMockMakeLogExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmMakeLog *mUserRepositoryMockMakeLog) Optional() *mUserRepositoryMockMakeLog {
-	mmMakeLog.optional = true
-	return mmMakeLog
+func (mmUseEmailVerificationToken *mUserRepositoryMockUseEmailVerificationToken) Optional() *mUserRepositoryMockUseEmailVerificationToken {
+	mmUseEmailVerificationToken.optional = true
+	return mmUseEmailVerificationToken
 }
 
-// Expect sets up expected params for UserRepository.MakeLog
-func (mmMakeLog *mUserRepositoryMockMakeLog) Expect(ctx context.Context, log model.Log) *mUserRepositoryMockMakeLog {
-	if mmMakeLog.mock.funcMakeLog != nil {
-		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Set")
+// Expect sets up expected params for UserRepository.UseEmailVerificationToken
+func (mmUseEmailVerificationToken *mUserRepositoryMockUseEmailVerificationToken) Expect(ctx context.Context, tokenHash string) *mUserRepositoryMockUseEmailVerificationToken {
+	if mmUseEmailVerificationToken.mock.funcUseEmailVerificationToken != nil {
+		mmUseEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.UseEmailVerificationToken mock is already set by Set")
 	}
 
-	if mmMakeLog.defaultExpectation == nil {
-		mmMakeLog.defaultExpectation = &UserRepositoryMockMakeLogExpectation{}
+	if mmUseEmailVerificationToken.defaultExpectation == nil {
+		mmUseEmailVerificationToken.defaultExpectation = &UserRepositoryMockUseEmailVerificationTokenExpectation{}
 	}
 
-	if mmMakeLog.defaultExpectation.paramPtrs != nil {
-		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by ExpectParams functions")
+	if mmUseEmailVerificationToken.defaultExpectation.paramPtrs != nil {
+		mmUseEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.UseEmailVerificationToken mock is already set by ExpectParams functions")
 	}
 
-	mmMakeLog.defaultExpectation.params = &UserRepositoryMockMakeLogParams{ctx, log}
-	mmMakeLog.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmMakeLog.expectations {
-		if minimock.Equal(e.params, mmMakeLog.defaultExpectation.params) {
-			mmMakeLog.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMakeLog.defaultExpectation.params)
+	mmUseEmailVerificationToken.defaultExpectation.params = &UserRepositoryMockUseEmailVerificationTokenParams{ctx, tokenHash}
+	mmUseEmailVerificationToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUseEmailVerificationToken.expectations {
+		if minimock.Equal(e.params, mmUseEmailVerificationToken.defaultExpectation.params) {
+			mmUseEmailVerificationToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUseEmailVerificationToken.defaultExpectation.params)
 		}
 	}
 
-	return mmMakeLog
+	return mmUseEmailVerificationToken
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.MakeLog
-func (mmMakeLog *mUserRepositoryMockMakeLog) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockMakeLog {
-	if mmMakeLog.mock.funcMakeLog != nil {
-		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UseEmailVerificationToken
+func (mmUseEmailVerificationToken *mUserRepositoryMockUseEmailVerificationToken) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUseEmailVerificationToken {
+	if mmUseEmailVerificationToken.mock.funcUseEmailVerificationToken != nil {
+		mmUseEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.UseEmailVerificationToken mock is already set by Set")
 	}
 
-	if mmMakeLog.defaultExpectation == nil {
-		mmMakeLog.defaultExpectation = &UserRepositoryMockMakeLogExpectation{}
+	if mmUseEmailVerificationToken.defaultExpectation == nil {
+		mmUseEmailVerificationToken.defaultExpectation = &UserRepositoryMockUseEmailVerificationTokenExpectation{}
 	}
 
-	if mmMakeLog.defaultExpectation.params != nil {
-		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Expect")
+	if mmUseEmailVerificationToken.defaultExpectation.params != nil {
+		mmUseEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.UseEmailVerificationToken mock is already set by Expect")
 	}
 
-	if mmMakeLog.defaultExpectation.paramPtrs == nil {
-		mmMakeLog.defaultExpectation.paramPtrs = &UserRepositoryMockMakeLogParamPtrs{}
+	if mmUseEmailVerificationToken.defaultExpectation.paramPtrs == nil {
+		mmUseEmailVerificationToken.defaultExpectation.paramPtrs = &UserRepositoryMockUseEmailVerificationTokenParamPtrs{}
 	}
-	mmMakeLog.defaultExpectation.paramPtrs.ctx = &ctx
-	mmMakeLog.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUseEmailVerificationToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUseEmailVerificationToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmMakeLog
+	return mmUseEmailVerificationToken
 }
 
-// ExpectLogParam2 sets up expected param log for UserRepository.MakeLog
-func (mmMakeLog *mUserRepositoryMockMakeLog) ExpectLogParam2(log model.Log) *mUserRepositoryMockMakeLog {
-	if mmMakeLog.mock.funcMakeLog != nil {
-		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Set")
+// ExpectTokenHashParam2 sets up expected param tokenHash for UserRepository.UseEmailVerificationToken
+func (mmUseEmailVerificationToken *mUserRepositoryMockUseEmailVerificationToken) ExpectTokenHashParam2(tokenHash string) *mUserRepositoryMockUseEmailVerificationToken {
+	if mmUseEmailVerificationToken.mock.funcUseEmailVerificationToken != nil {
+		mmUseEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.UseEmailVerificationToken mock is already set by Set")
 	}
 
-	if mmMakeLog.defaultExpectation == nil {
-		mmMakeLog.defaultExpectation = &UserRepositoryMockMakeLogExpectation{}
+	if mmUseEmailVerificationToken.defaultExpectation == nil {
+		mmUseEmailVerificationToken.defaultExpectation = &UserRepositoryMockUseEmailVerificationTokenExpectation{}
 	}
 
-	if mmMakeLog.defaultExpectation.params != nil {
-		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Expect")
+	if mmUseEmailVerificationToken.defaultExpectation.params != nil {
+		mmUseEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.UseEmailVerificationToken mock is already set by Expect")
 	}
 
-	if mmMakeLog.defaultExpectation.paramPtrs == nil {
-		mmMakeLog.defaultExpectation.paramPtrs = &UserRepositoryMockMakeLogParamPtrs{}
+	if mmUseEmailVerificationToken.defaultExpectation.paramPtrs == nil {
+		mmUseEmailVerificationToken.defaultExpectation.paramPtrs = &UserRepositoryMockUseEmailVerificationTokenParamPtrs{}
 	}
-	mmMakeLog.defaultExpectation.paramPtrs.log = &log
-	mmMakeLog.defaultExpectation.expectationOrigins.originLog = minimock.CallerInfo(1)
+	mmUseEmailVerificationToken.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmUseEmailVerificationToken.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
 
-	return mmMakeLog
+	return mmUseEmailVerificationToken
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.MakeLog
-func (mmMakeLog *mUserRepositoryMockMakeLog) Inspect(f func(ctx context.Context, log model.Log)) *mUserRepositoryMockMakeLog {
-	if mmMakeLog.mock.inspectFuncMakeLog != nil {
-		mmMakeLog.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.MakeLog")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UseEmailVerificationToken
+func (mmUseEmailVerificationToken *mUserRepositoryMockUseEmailVerificationToken) Inspect(f func(ctx context.Context, tokenHash string)) *mUserRepositoryMockUseEmailVerificationToken {
+	if mmUseEmailVerificationToken.mock.inspectFuncUseEmailVerificationToken != nil {
+		mmUseEmailVerificationToken.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UseEmailVerificationToken")
 	}
 
-	mmMakeLog.mock.inspectFuncMakeLog = f
+	mmUseEmailVerificationToken.mock.inspectFuncUseEmailVerificationToken = f
 
-	return mmMakeLog
+	return mmUseEmailVerificationToken
 }
 
-// Return sets up results that will be returned by UserRepository.MakeLog
-func (mmMakeLog *mUserRepositoryMockMakeLog) Return(err error) *UserRepositoryMock {
-	if mmMakeLog.mock.funcMakeLog != nil {
-		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Set")
+// Return sets up results that will be returned by UserRepository.UseEmailVerificationToken
+func (mmUseEmailVerificationToken *mUserRepositoryMockUseEmailVerificationToken) Return(i1 int64, err error) *UserRepositoryMock {
+	if mmUseEmailVerificationToken.mock.funcUseEmailVerificationToken != nil {
+		mmUseEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.UseEmailVerificationToken mock is already set by Set")
 	}
 
-	if mmMakeLog.defaultExpectation == nil {
-		mmMakeLog.defaultExpectation = &UserRepositoryMockMakeLogExpectation{mock: mmMakeLog.mock}
+	if mmUseEmailVerificationToken.defaultExpectation == nil {
+		mmUseEmailVerificationToken.defaultExpectation = &UserRepositoryMockUseEmailVerificationTokenExpectation{mock: mmUseEmailVerificationToken.mock}
 	}
-	mmMakeLog.defaultExpectation.results = &UserRepositoryMockMakeLogResults{err}
-	mmMakeLog.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmMakeLog.mock
+	mmUseEmailVerificationToken.defaultExpectation.results = &UserRepositoryMockUseEmailVerificationTokenResults{i1, err}
+	mmUseEmailVerificationToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUseEmailVerificationToken.mock
 }
 
-// Set uses given function f to mock the UserRepository.MakeLog method
-func (mmMakeLog *mUserRepositoryMockMakeLog) Set(f func(ctx context.Context, log model.Log) (err error)) *UserRepositoryMock {
-	if mmMakeLog.defaultExpectation != nil {
-		mmMakeLog.mock.t.Fatalf("Default expectation is already set for the UserRepository.MakeLog method")
+// Set uses given function f to mock the UserRepository.UseEmailVerificationToken method
+func (mmUseEmailVerificationToken *mUserRepositoryMockUseEmailVerificationToken) Set(f func(ctx context.Context, tokenHash string) (i1 int64, err error)) *UserRepositoryMock {
+	if mmUseEmailVerificationToken.defaultExpectation != nil {
+		mmUseEmailVerificationToken.mock.t.Fatalf("Default expectation is already set for the UserRepository.UseEmailVerificationToken method")
 	}
 
-	if len(mmMakeLog.expectations) > 0 {
-		mmMakeLog.mock.t.Fatalf("Some expectations are already set for the UserRepository.MakeLog method")
+	if len(mmUseEmailVerificationToken.expectations) > 0 {
+		mmUseEmailVerificationToken.mock.t.Fatalf("Some expectations are already set for the UserRepository.UseEmailVerificationToken method")
 	}
 
-	mmMakeLog.mock.funcMakeLog = f
-	mmMakeLog.mock.funcMakeLogOrigin = minimock.CallerInfo(1)
-	return mmMakeLog.mock
+	mmUseEmailVerificationToken.mock.funcUseEmailVerificationToken = f
+	mmUseEmailVerificationToken.mock.funcUseEmailVerificationTokenOrigin = minimock.CallerInfo(1)
+	return mmUseEmailVerificationToken.mock
 }
 
-// When sets expectation for the UserRepository.MakeLog which will trigger the result defined by the following
+// When sets expectation for the UserRepository.UseEmailVerificationToken which will trigger the result defined by the following
 // Then helper
-func (mmMakeLog *mUserRepositoryMockMakeLog) When(ctx context.Context, log model.Log) *UserRepositoryMockMakeLogExpectation {
-	if mmMakeLog.mock.funcMakeLog != nil {
-		mmMakeLog.mock.t.Fatalf("UserRepositoryMock.MakeLog mock is already set by Set")
+func (mmUseEmailVerificationToken *mUserRepositoryMockUseEmailVerificationToken) When(ctx context.Context, tokenHash string) *UserRepositoryMockUseEmailVerificationTokenExpectation {
+	if mmUseEmailVerificationToken.mock.funcUseEmailVerificationToken != nil {
+		mmUseEmailVerificationToken.mock.t.Fatalf("UserRepositoryMock.UseEmailVerificationToken mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockMakeLogExpectation{
-		mock:               mmMakeLog.mock,
-		params:             &UserRepositoryMockMakeLogParams{ctx, log},
-		expectationOrigins: UserRepositoryMockMakeLogExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockUseEmailVerificationTokenExpectation{
+		mock:               mmUseEmailVerificationToken.mock,
+		params:             &UserRepositoryMockUseEmailVerificationTokenParams{ctx, tokenHash},
+		expectationOrigins: UserRepositoryMockUseEmailVerificationTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmMakeLog.expectations = append(mmMakeLog.expectations, expectation)
+	mmUseEmailVerificationToken.expectations = append(mmUseEmailVerificationToken.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.MakeLog return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockMakeLogExpectation) Then(err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockMakeLogResults{err}
+// Then sets up UserRepository.UseEmailVerificationToken return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUseEmailVerificationTokenExpectation) Then(i1 int64, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUseEmailVerificationTokenResults{i1, err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.MakeLog should be invoked
-func (mmMakeLog *mUserRepositoryMockMakeLog) Times(n uint64) *mUserRepositoryMockMakeLog {
+// Times sets number of times UserRepository.UseEmailVerificationToken should be invoked
+func (mmUseEmailVerificationToken *mUserRepositoryMockUseEmailVerificationToken) Times(n uint64) *mUserRepositoryMockUseEmailVerificationToken {
 	if n == 0 {
-		mmMakeLog.mock.t.Fatalf("Times of UserRepositoryMock.MakeLog mock can not be zero")
+		mmUseEmailVerificationToken.mock.t.Fatalf("Times of UserRepositoryMock.UseEmailVerificationToken mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmMakeLog.expectedInvocations, n)
-	mmMakeLog.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmMakeLog
+	mm_atomic.StoreUint64(&mmUseEmailVerificationToken.expectedInvocations, n)
+	mmUseEmailVerificationToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUseEmailVerificationToken
 }
 
-func (mmMakeLog *mUserRepositoryMockMakeLog) invocationsDone() bool {
-	if len(mmMakeLog.expectations) == 0 && mmMakeLog.defaultExpectation == nil && mmMakeLog.mock.funcMakeLog == nil {
+func (mmUseEmailVerificationToken *mUserRepositoryMockUseEmailVerificationToken) invocationsDone() bool {
+	if len(mmUseEmailVerificationToken.expectations) == 0 && mmUseEmailVerificationToken.defaultExpectation == nil && mmUseEmailVerificationToken.mock.funcUseEmailVerificationToken == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmMakeLog.mock.afterMakeLogCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmMakeLog.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUseEmailVerificationToken.mock.afterUseEmailVerificationTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUseEmailVerificationToken.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// MakeLog implements mm_repository.UserRepository
-func (mmMakeLog *UserRepositoryMock) MakeLog(ctx context.Context, log model.Log) (err error) {
-	mm_atomic.AddUint64(&mmMakeLog.beforeMakeLogCounter, 1)
-	defer mm_atomic.AddUint64(&mmMakeLog.afterMakeLogCounter, 1)
+// UseEmailVerificationToken implements mm_repository.UserRepository
+func (mmUseEmailVerificationToken *UserRepositoryMock) UseEmailVerificationToken(ctx context.Context, tokenHash string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmUseEmailVerificationToken.beforeUseEmailVerificationTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmUseEmailVerificationToken.afterUseEmailVerificationTokenCounter, 1)
 
-	mmMakeLog.t.Helper()
+	mmUseEmailVerificationToken.t.Helper()
 
-	if mmMakeLog.inspectFuncMakeLog != nil {
-		mmMakeLog.inspectFuncMakeLog(ctx, log)
+	if mmUseEmailVerificationToken.inspectFuncUseEmailVerificationToken != nil {
+		mmUseEmailVerificationToken.inspectFuncUseEmailVerificationToken(ctx, tokenHash)
 	}
 
-	mm_params := UserRepositoryMockMakeLogParams{ctx, log}
+	mm_params := UserRepositoryMockUseEmailVerificationTokenParams{ctx, tokenHash}
 
 	// Record call args
-	mmMakeLog.MakeLogMock.mutex.Lock()
-	mmMakeLog.MakeLogMock.callArgs = append(mmMakeLog.MakeLogMock.callArgs, &mm_params)
-	mmMakeLog.MakeLogMock.mutex.Unlock()
+	mmUseEmailVerificationToken.UseEmailVerificationTokenMock.mutex.Lock()
+	mmUseEmailVerificationToken.UseEmailVerificationTokenMock.callArgs = append(mmUseEmailVerificationToken.UseEmailVerificationTokenMock.callArgs, &mm_params)
+	mmUseEmailVerificationToken.UseEmailVerificationTokenMock.mutex.Unlock()
 
-	for _, e := range mmMakeLog.MakeLogMock.expectations {
+	for _, e := range mmUseEmailVerificationToken.UseEmailVerificationTokenMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
-	if mmMakeLog.MakeLogMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmMakeLog.MakeLogMock.defaultExpectation.Counter, 1)
-		mm_want := mmMakeLog.MakeLogMock.defaultExpectation.params
-		mm_want_ptrs := mmMakeLog.MakeLogMock.defaultExpectation.paramPtrs
+	if mmUseEmailVerificationToken.UseEmailVerificationTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUseEmailVerificationToken.UseEmailVerificationTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmUseEmailVerificationToken.UseEmailVerificationTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmUseEmailVerificationToken.UseEmailVerificationTokenMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockMakeLogParams{ctx, log}
+		mm_got := UserRepositoryMockUseEmailVerificationTokenParams{ctx, tokenHash}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmMakeLog.t.Errorf("UserRepositoryMock.MakeLog got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUseEmailVerificationToken.t.Errorf("UserRepositoryMock.UseEmailVerificationToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseEmailVerificationToken.UseEmailVerificationTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.log != nil && !minimock.Equal(*mm_want_ptrs.log, mm_got.log) {
-				mmMakeLog.t.Errorf("UserRepositoryMock.MakeLog got unexpected parameter log, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.originLog, *mm_want_ptrs.log, mm_got.log, minimock.Diff(*mm_want_ptrs.log, mm_got.log))
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmUseEmailVerificationToken.t.Errorf("UserRepositoryMock.UseEmailVerificationToken got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseEmailVerificationToken.UseEmailVerificationTokenMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
 			}
 
-		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmMakeLog.t.Errorf("UserRepositoryMock.MakeLog got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmMakeLog.MakeLogMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUseEmailVerificationToken.t.Errorf("UserRepositoryMock.UseEmailVerificationToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUseEmailVerificationToken.UseEmailVerificationTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmMakeLog.MakeLogMock.defaultExpectation.results
+		mm_results := mmUseEmailVerificationToken.UseEmailVerificationTokenMock.defaultExpectation.results
 		if mm_results == nil {
-			mmMakeLog.t.Fatal("No results are set for the UserRepositoryMock.MakeLog")
+			mmUseEmailVerificationToken.t.Fatal("No results are set for the UserRepositoryMock.UseEmailVerificationToken")
 		}
-		return (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmMakeLog.funcMakeLog != nil {
-		return mmMakeLog.funcMakeLog(ctx, log)
+	if mmUseEmailVerificationToken.funcUseEmailVerificationToken != nil {
+		return mmUseEmailVerificationToken.funcUseEmailVerificationToken(ctx, tokenHash)
 	}
-	mmMakeLog.t.Fatalf("Unexpected call to UserRepositoryMock.MakeLog. %v %v", ctx, log)
+	mmUseEmailVerificationToken.t.Fatalf("Unexpected call to UserRepositoryMock.UseEmailVerificationToken. %v %v", ctx, tokenHash)
 	return
 }
 
-// MakeLogAfterCounter returns a count of finished UserRepositoryMock.MakeLog invocations
-func (mmMakeLog *UserRepositoryMock) MakeLogAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMakeLog.afterMakeLogCounter)
+// UseEmailVerificationTokenAfterCounter returns a count of finished UserRepositoryMock.UseEmailVerificationToken invocations
+func (mmUseEmailVerificationToken *UserRepositoryMock) UseEmailVerificationTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseEmailVerificationToken.afterUseEmailVerificationTokenCounter)
 }
 
-// MakeLogBeforeCounter returns a count of UserRepositoryMock.MakeLog invocations
-func (mmMakeLog *UserRepositoryMock) MakeLogBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMakeLog.beforeMakeLogCounter)
+// UseEmailVerificationTokenBeforeCounter returns a count of UserRepositoryMock.UseEmailVerificationToken invocations
+func (mmUseEmailVerificationToken *UserRepositoryMock) UseEmailVerificationTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseEmailVerificationToken.beforeUseEmailVerificationTokenCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.MakeLog.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UseEmailVerificationToken.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmMakeLog *mUserRepositoryMockMakeLog) Calls() []*UserRepositoryMockMakeLogParams {
-	mmMakeLog.mutex.RLock()
+func (mmUseEmailVerificationToken *mUserRepositoryMockUseEmailVerificationToken) Calls() []*UserRepositoryMockUseEmailVerificationTokenParams {
+	mmUseEmailVerificationToken.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockMakeLogParams, len(mmMakeLog.callArgs))
-	copy(argCopy, mmMakeLog.callArgs)
+	argCopy := make([]*UserRepositoryMockUseEmailVerificationTokenParams, len(mmUseEmailVerificationToken.callArgs))
+	copy(argCopy, mmUseEmailVerificationToken.callArgs)
 
-	mmMakeLog.mutex.RUnlock()
+	mmUseEmailVerificationToken.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockMakeLogDone returns true if the count of the MakeLog invocations corresponds
+// MinimockUseEmailVerificationTokenDone returns true if the count of the UseEmailVerificationToken invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockMakeLogDone() bool {
-	if m.MakeLogMock.optional {
+func (m *UserRepositoryMock) MinimockUseEmailVerificationTokenDone() bool {
+	if m.UseEmailVerificationTokenMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.MakeLogMock.expectations {
+	for _, e := range m.UseEmailVerificationTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.MakeLogMock.invocationsDone()
+	return m.UseEmailVerificationTokenMock.invocationsDone()
 }
 
-// MinimockMakeLogInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockMakeLogInspect() {
-	for _, e := range m.MakeLogMock.expectations {
+// MinimockUseEmailVerificationTokenInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUseEmailVerificationTokenInspect() {
+	for _, e := range m.UseEmailVerificationTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.MakeLog at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.UseEmailVerificationToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterMakeLogCounter := mm_atomic.LoadUint64(&m.afterMakeLogCounter)
+	afterUseEmailVerificationTokenCounter := mm_atomic.LoadUint64(&m.afterUseEmailVerificationTokenCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.MakeLogMock.defaultExpectation != nil && afterMakeLogCounter < 1 {
-		if m.MakeLogMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.MakeLog at\n%s", m.MakeLogMock.defaultExpectation.returnOrigin)
+	if m.UseEmailVerificationTokenMock.defaultExpectation != nil && afterUseEmailVerificationTokenCounter < 1 {
+		if m.UseEmailVerificationTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.UseEmailVerificationToken at\n%s", m.UseEmailVerificationTokenMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.MakeLog at\n%s with params: %#v", m.MakeLogMock.defaultExpectation.expectationOrigins.origin, *m.MakeLogMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.UseEmailVerificationToken at\n%s with params: %#v", m.UseEmailVerificationTokenMock.defaultExpectation.expectationOrigins.origin, *m.UseEmailVerificationTokenMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcMakeLog != nil && afterMakeLogCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.MakeLog at\n%s", m.funcMakeLogOrigin)
+	if m.funcUseEmailVerificationToken != nil && afterUseEmailVerificationTokenCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.UseEmailVerificationToken at\n%s", m.funcUseEmailVerificationTokenOrigin)
 	}
 
-	if !m.MakeLogMock.invocationsDone() && afterMakeLogCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.MakeLog at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.MakeLogMock.expectedInvocations), m.MakeLogMock.expectedInvocationsOrigin, afterMakeLogCounter)
+	if !m.UseEmailVerificationTokenMock.invocationsDone() && afterUseEmailVerificationTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UseEmailVerificationToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UseEmailVerificationTokenMock.expectedInvocations), m.UseEmailVerificationTokenMock.expectedInvocationsOrigin, afterUseEmailVerificationTokenCounter)
 	}
 }
 
-type mUserRepositoryMockUpdateUser struct {
+type mUserRepositoryMockUsePasswordResetToken struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockUpdateUserExpectation
-	expectations       []*UserRepositoryMockUpdateUserExpectation
+	defaultExpectation *UserRepositoryMockUsePasswordResetTokenExpectation
+	expectations       []*UserRepositoryMockUsePasswordResetTokenExpectation
 
-	callArgs []*UserRepositoryMockUpdateUserParams
+	callArgs []*UserRepositoryMockUsePasswordResetTokenParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockUpdateUserExpectation specifies expectation struct of the UserRepository.UpdateUser
-type UserRepositoryMockUpdateUserExpectation struct {
+// UserRepositoryMockUsePasswordResetTokenExpectation specifies expectation struct of the UserRepository.UsePasswordResetToken
+type UserRepositoryMockUsePasswordResetTokenExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockUpdateUserParams
-	paramPtrs          *UserRepositoryMockUpdateUserParamPtrs
-	expectationOrigins UserRepositoryMockUpdateUserExpectationOrigins
-	results            *UserRepositoryMockUpdateUserResults
+	params             *UserRepositoryMockUsePasswordResetTokenParams
+	paramPtrs          *UserRepositoryMockUsePasswordResetTokenParamPtrs
+	expectationOrigins UserRepositoryMockUsePasswordResetTokenExpectationOrigins
+	results            *UserRepositoryMockUsePasswordResetTokenResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockUpdateUserParams contains parameters of the UserRepository.UpdateUser
-type UserRepositoryMockUpdateUserParams struct {
-	ctx  context.Context
-	id   int64
-	info model.UserInfo
+// UserRepositoryMockUsePasswordResetTokenParams contains parameters of the UserRepository.UsePasswordResetToken
+type UserRepositoryMockUsePasswordResetTokenParams struct {
+	ctx       context.Context
+	tokenHash string
 }
 
-// UserRepositoryMockUpdateUserParamPtrs contains pointers to parameters of the UserRepository.UpdateUser
-type UserRepositoryMockUpdateUserParamPtrs struct {
-	ctx  *context.Context
-	id   *int64
-	info *model.UserInfo
+// UserRepositoryMockUsePasswordResetTokenParamPtrs contains pointers to parameters of the UserRepository.UsePasswordResetToken
+type UserRepositoryMockUsePasswordResetTokenParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
 }
 
-// UserRepositoryMockUpdateUserResults contains results of the UserRepository.UpdateUser
-type UserRepositoryMockUpdateUserResults struct {
+// UserRepositoryMockUsePasswordResetTokenResults contains results of the UserRepository.UsePasswordResetToken
+type UserRepositoryMockUsePasswordResetTokenResults struct {
+	i1  int64
 	err error
 }
 
-// UserRepositoryMockUpdateUserOrigins contains origins of expectations of the UserRepository.UpdateUser
-type UserRepositoryMockUpdateUserExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originId   string
-	originInfo string
+// UserRepositoryMockUsePasswordResetTokenOrigins contains origins of expectations of the UserRepository.UsePasswordResetToken
+type UserRepositoryMockUsePasswordResetTokenExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1509,320 +4066,292 @@ type UserRepositoryMockUpdateUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) Optional() *mUserRepositoryMockUpdateUser {
-	mmUpdateUser.optional = true
-	return mmUpdateUser
+func (mmUsePasswordResetToken *mUserRepositoryMockUsePasswordResetToken) Optional() *mUserRepositoryMockUsePasswordResetToken {
+	mmUsePasswordResetToken.optional = true
+	return mmUsePasswordResetToken
 }
 
-// Expect sets up expected params for UserRepository.UpdateUser
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) Expect(ctx context.Context, id int64, info model.UserInfo) *mUserRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+// Expect sets up expected params for UserRepository.UsePasswordResetToken
+func (mmUsePasswordResetToken *mUserRepositoryMockUsePasswordResetToken) Expect(ctx context.Context, tokenHash string) *mUserRepositoryMockUsePasswordResetToken {
+	if mmUsePasswordResetToken.mock.funcUsePasswordResetToken != nil {
+		mmUsePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.UsePasswordResetToken mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
+	if mmUsePasswordResetToken.defaultExpectation == nil {
+		mmUsePasswordResetToken.defaultExpectation = &UserRepositoryMockUsePasswordResetTokenExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by ExpectParams functions")
+	if mmUsePasswordResetToken.defaultExpectation.paramPtrs != nil {
+		mmUsePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.UsePasswordResetToken mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateUser.defaultExpectation.params = &UserRepositoryMockUpdateUserParams{ctx, id, info}
-	mmUpdateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdateUser.expectations {
-		if minimock.Equal(e.params, mmUpdateUser.defaultExpectation.params) {
-			mmUpdateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateUser.defaultExpectation.params)
+	mmUsePasswordResetToken.defaultExpectation.params = &UserRepositoryMockUsePasswordResetTokenParams{ctx, tokenHash}
+	mmUsePasswordResetToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUsePasswordResetToken.expectations {
+		if minimock.Equal(e.params, mmUsePasswordResetToken.defaultExpectation.params) {
+			mmUsePasswordResetToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUsePasswordResetToken.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateUser
-}
-
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdateUser
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
-	}
-
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
-	}
-
-	if mmUpdateUser.defaultExpectation.params != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Expect")
-	}
-
-	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
-		mmUpdateUser.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserParamPtrs{}
-	}
-	mmUpdateUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmUpdateUser
+	return mmUsePasswordResetToken
 }
 
-// ExpectIdParam2 sets up expected param id for UserRepository.UpdateUser
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) ExpectIdParam2(id int64) *mUserRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UsePasswordResetToken
+func (mmUsePasswordResetToken *mUserRepositoryMockUsePasswordResetToken) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUsePasswordResetToken {
+	if mmUsePasswordResetToken.mock.funcUsePasswordResetToken != nil {
+		mmUsePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.UsePasswordResetToken mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
+	if mmUsePasswordResetToken.defaultExpectation == nil {
+		mmUsePasswordResetToken.defaultExpectation = &UserRepositoryMockUsePasswordResetTokenExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.params != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Expect")
+	if mmUsePasswordResetToken.defaultExpectation.params != nil {
+		mmUsePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.UsePasswordResetToken mock is already set by Expect")
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
-		mmUpdateUser.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserParamPtrs{}
+	if mmUsePasswordResetToken.defaultExpectation.paramPtrs == nil {
+		mmUsePasswordResetToken.defaultExpectation.paramPtrs = &UserRepositoryMockUsePasswordResetTokenParamPtrs{}
 	}
-	mmUpdateUser.defaultExpectation.paramPtrs.id = &id
-	mmUpdateUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmUsePasswordResetToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUsePasswordResetToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdateUser
+	return mmUsePasswordResetToken
 }
 
-// ExpectInfoParam3 sets up expected param info for UserRepository.UpdateUser
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) ExpectInfoParam3(info model.UserInfo) *mUserRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+// ExpectTokenHashParam2 sets up expected param tokenHash for UserRepository.UsePasswordResetToken
+func (mmUsePasswordResetToken *mUserRepositoryMockUsePasswordResetToken) ExpectTokenHashParam2(tokenHash string) *mUserRepositoryMockUsePasswordResetToken {
+	if mmUsePasswordResetToken.mock.funcUsePasswordResetToken != nil {
+		mmUsePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.UsePasswordResetToken mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{}
+	if mmUsePasswordResetToken.defaultExpectation == nil {
+		mmUsePasswordResetToken.defaultExpectation = &UserRepositoryMockUsePasswordResetTokenExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.params != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Expect")
+	if mmUsePasswordResetToken.defaultExpectation.params != nil {
+		mmUsePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.UsePasswordResetToken mock is already set by Expect")
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
-		mmUpdateUser.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserParamPtrs{}
+	if mmUsePasswordResetToken.defaultExpectation.paramPtrs == nil {
+		mmUsePasswordResetToken.defaultExpectation.paramPtrs = &UserRepositoryMockUsePasswordResetTokenParamPtrs{}
 	}
-	mmUpdateUser.defaultExpectation.paramPtrs.info = &info
-	mmUpdateUser.defaultExpectation.expectationOrigins.originInfo = minimock.CallerInfo(1)
+	mmUsePasswordResetToken.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmUsePasswordResetToken.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
 
-	return mmUpdateUser
+	return mmUsePasswordResetToken
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdateUser
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) Inspect(f func(ctx context.Context, id int64, info model.UserInfo)) *mUserRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.inspectFuncUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdateUser")
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UsePasswordResetToken
+func (mmUsePasswordResetToken *mUserRepositoryMockUsePasswordResetToken) Inspect(f func(ctx context.Context, tokenHash string)) *mUserRepositoryMockUsePasswordResetToken {
+	if mmUsePasswordResetToken.mock.inspectFuncUsePasswordResetToken != nil {
+		mmUsePasswordResetToken.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UsePasswordResetToken")
 	}
 
-	mmUpdateUser.mock.inspectFuncUpdateUser = f
+	mmUsePasswordResetToken.mock.inspectFuncUsePasswordResetToken = f
 
-	return mmUpdateUser
+	return mmUsePasswordResetToken
 }
 
-// Return sets up results that will be returned by UserRepository.UpdateUser
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) Return(err error) *UserRepositoryMock {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+// Return sets up results that will be returned by UserRepository.UsePasswordResetToken
+func (mmUsePasswordResetToken *mUserRepositoryMockUsePasswordResetToken) Return(i1 int64, err error) *UserRepositoryMock {
+	if mmUsePasswordResetToken.mock.funcUsePasswordResetToken != nil {
+		mmUsePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.UsePasswordResetToken mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UserRepositoryMockUpdateUserExpectation{mock: mmUpdateUser.mock}
+	if mmUsePasswordResetToken.defaultExpectation == nil {
+		mmUsePasswordResetToken.defaultExpectation = &UserRepositoryMockUsePasswordResetTokenExpectation{mock: mmUsePasswordResetToken.mock}
 	}
-	mmUpdateUser.defaultExpectation.results = &UserRepositoryMockUpdateUserResults{err}
-	mmUpdateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmUpdateUser.mock
+	mmUsePasswordResetToken.defaultExpectation.results = &UserRepositoryMockUsePasswordResetTokenResults{i1, err}
+	mmUsePasswordResetToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUsePasswordResetToken.mock
 }
 
-// Set uses given function f to mock the UserRepository.UpdateUser method
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) Set(f func(ctx context.Context, id int64, info model.UserInfo) (err error)) *UserRepositoryMock {
-	if mmUpdateUser.defaultExpectation != nil {
-		mmUpdateUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.UpdateUser method")
+// Set uses given function f to mock the UserRepository.UsePasswordResetToken method
+func (mmUsePasswordResetToken *mUserRepositoryMockUsePasswordResetToken) Set(f func(ctx context.Context, tokenHash string) (i1 int64, err error)) *UserRepositoryMock {
+	if mmUsePasswordResetToken.defaultExpectation != nil {
+		mmUsePasswordResetToken.mock.t.Fatalf("Default expectation is already set for the UserRepository.UsePasswordResetToken method")
 	}
 
-	if len(mmUpdateUser.expectations) > 0 {
-		mmUpdateUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.UpdateUser method")
+	if len(mmUsePasswordResetToken.expectations) > 0 {
+		mmUsePasswordResetToken.mock.t.Fatalf("Some expectations are already set for the UserRepository.UsePasswordResetToken method")
 	}
 
-	mmUpdateUser.mock.funcUpdateUser = f
-	mmUpdateUser.mock.funcUpdateUserOrigin = minimock.CallerInfo(1)
-	return mmUpdateUser.mock
+	mmUsePasswordResetToken.mock.funcUsePasswordResetToken = f
+	mmUsePasswordResetToken.mock.funcUsePasswordResetTokenOrigin = minimock.CallerInfo(1)
+	return mmUsePasswordResetToken.mock
 }
 
-// When sets expectation for the UserRepository.UpdateUser which will trigger the result defined by the following
+// When sets expectation for the UserRepository.UsePasswordResetToken which will trigger the result defined by the following
 // Then helper
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) When(ctx context.Context, id int64, info model.UserInfo) *UserRepositoryMockUpdateUserExpectation {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UserRepositoryMock.UpdateUser mock is already set by Set")
+func (mmUsePasswordResetToken *mUserRepositoryMockUsePasswordResetToken) When(ctx context.Context, tokenHash string) *UserRepositoryMockUsePasswordResetTokenExpectation {
+	if mmUsePasswordResetToken.mock.funcUsePasswordResetToken != nil {
+		mmUsePasswordResetToken.mock.t.Fatalf("UserRepositoryMock.UsePasswordResetToken mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockUpdateUserExpectation{
-		mock:               mmUpdateUser.mock,
-		params:             &UserRepositoryMockUpdateUserParams{ctx, id, info},
-		expectationOrigins: UserRepositoryMockUpdateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockUsePasswordResetTokenExpectation{
+		mock:               mmUsePasswordResetToken.mock,
+		params:             &UserRepositoryMockUsePasswordResetTokenParams{ctx, tokenHash},
+		expectationOrigins: UserRepositoryMockUsePasswordResetTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmUpdateUser.expectations = append(mmUpdateUser.expectations, expectation)
+	mmUsePasswordResetToken.expectations = append(mmUsePasswordResetToken.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.UpdateUser return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockUpdateUserExpectation) Then(err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockUpdateUserResults{err}
+// Then sets up UserRepository.UsePasswordResetToken return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUsePasswordResetTokenExpectation) Then(i1 int64, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUsePasswordResetTokenResults{i1, err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.UpdateUser should be invoked
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) Times(n uint64) *mUserRepositoryMockUpdateUser {
+// Times sets number of times UserRepository.UsePasswordResetToken should be invoked
+func (mmUsePasswordResetToken *mUserRepositoryMockUsePasswordResetToken) Times(n uint64) *mUserRepositoryMockUsePasswordResetToken {
 	if n == 0 {
-		mmUpdateUser.mock.t.Fatalf("Times of UserRepositoryMock.UpdateUser mock can not be zero")
+		mmUsePasswordResetToken.mock.t.Fatalf("Times of UserRepositoryMock.UsePasswordResetToken mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdateUser.expectedInvocations, n)
-	mmUpdateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmUpdateUser
+	mm_atomic.StoreUint64(&mmUsePasswordResetToken.expectedInvocations, n)
+	mmUsePasswordResetToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUsePasswordResetToken
 }
 
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) invocationsDone() bool {
-	if len(mmUpdateUser.expectations) == 0 && mmUpdateUser.defaultExpectation == nil && mmUpdateUser.mock.funcUpdateUser == nil {
+func (mmUsePasswordResetToken *mUserRepositoryMockUsePasswordResetToken) invocationsDone() bool {
+	if len(mmUsePasswordResetToken.expectations) == 0 && mmUsePasswordResetToken.defaultExpectation == nil && mmUsePasswordResetToken.mock.funcUsePasswordResetToken == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdateUser.mock.afterUpdateUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUsePasswordResetToken.mock.afterUsePasswordResetTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUsePasswordResetToken.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdateUser implements mm_repository.UserRepository
-func (mmUpdateUser *UserRepositoryMock) UpdateUser(ctx context.Context, id int64, info model.UserInfo) (err error) {
-	mm_atomic.AddUint64(&mmUpdateUser.beforeUpdateUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateUser.afterUpdateUserCounter, 1)
+// UsePasswordResetToken implements mm_repository.UserRepository
+func (mmUsePasswordResetToken *UserRepositoryMock) UsePasswordResetToken(ctx context.Context, tokenHash string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmUsePasswordResetToken.beforeUsePasswordResetTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmUsePasswordResetToken.afterUsePasswordResetTokenCounter, 1)
 
-	mmUpdateUser.t.Helper()
+	mmUsePasswordResetToken.t.Helper()
 
-	if mmUpdateUser.inspectFuncUpdateUser != nil {
-		mmUpdateUser.inspectFuncUpdateUser(ctx, id, info)
+	if mmUsePasswordResetToken.inspectFuncUsePasswordResetToken != nil {
+		mmUsePasswordResetToken.inspectFuncUsePasswordResetToken(ctx, tokenHash)
 	}
 
-	mm_params := UserRepositoryMockUpdateUserParams{ctx, id, info}
+	mm_params := UserRepositoryMockUsePasswordResetTokenParams{ctx, tokenHash}
 
 	// Record call args
-	mmUpdateUser.UpdateUserMock.mutex.Lock()
-	mmUpdateUser.UpdateUserMock.callArgs = append(mmUpdateUser.UpdateUserMock.callArgs, &mm_params)
-	mmUpdateUser.UpdateUserMock.mutex.Unlock()
+	mmUsePasswordResetToken.UsePasswordResetTokenMock.mutex.Lock()
+	mmUsePasswordResetToken.UsePasswordResetTokenMock.callArgs = append(mmUsePasswordResetToken.UsePasswordResetTokenMock.callArgs, &mm_params)
+	mmUsePasswordResetToken.UsePasswordResetTokenMock.mutex.Unlock()
 
-	for _, e := range mmUpdateUser.UpdateUserMock.expectations {
+	for _, e := range mmUsePasswordResetToken.UsePasswordResetTokenMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
-	if mmUpdateUser.UpdateUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateUser.UpdateUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateUser.UpdateUserMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdateUser.UpdateUserMock.defaultExpectation.paramPtrs
+	if mmUsePasswordResetToken.UsePasswordResetTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUsePasswordResetToken.UsePasswordResetTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmUsePasswordResetToken.UsePasswordResetTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmUsePasswordResetToken.UsePasswordResetTokenMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockUpdateUserParams{ctx, id, info}
+		mm_got := UserRepositoryMockUsePasswordResetTokenParams{ctx, tokenHash}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdateUser.t.Errorf("UserRepositoryMock.UpdateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateUser.UpdateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmUpdateUser.t.Errorf("UserRepositoryMock.UpdateUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateUser.UpdateUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmUsePasswordResetToken.t.Errorf("UserRepositoryMock.UsePasswordResetToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUsePasswordResetToken.UsePasswordResetTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.info != nil && !minimock.Equal(*mm_want_ptrs.info, mm_got.info) {
-				mmUpdateUser.t.Errorf("UserRepositoryMock.UpdateUser got unexpected parameter info, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateUser.UpdateUserMock.defaultExpectation.expectationOrigins.originInfo, *mm_want_ptrs.info, mm_got.info, minimock.Diff(*mm_want_ptrs.info, mm_got.info))
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmUsePasswordResetToken.t.Errorf("UserRepositoryMock.UsePasswordResetToken got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUsePasswordResetToken.UsePasswordResetTokenMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateUser.t.Errorf("UserRepositoryMock.UpdateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmUpdateUser.UpdateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUsePasswordResetToken.t.Errorf("UserRepositoryMock.UsePasswordResetToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUsePasswordResetToken.UsePasswordResetTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateUser.UpdateUserMock.defaultExpectation.results
+		mm_results := mmUsePasswordResetToken.UsePasswordResetTokenMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateUser.t.Fatal("No results are set for the UserRepositoryMock.UpdateUser")
+			mmUsePasswordResetToken.t.Fatal("No results are set for the UserRepositoryMock.UsePasswordResetToken")
 		}
-		return (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmUpdateUser.funcUpdateUser != nil {
-		return mmUpdateUser.funcUpdateUser(ctx, id, info)
+	if mmUsePasswordResetToken.funcUsePasswordResetToken != nil {
+		return mmUsePasswordResetToken.funcUsePasswordResetToken(ctx, tokenHash)
 	}
-	mmUpdateUser.t.Fatalf("Unexpected call to UserRepositoryMock.UpdateUser. %v %v %v", ctx, id, info)
+	mmUsePasswordResetToken.t.Fatalf("Unexpected call to UserRepositoryMock.UsePasswordResetToken. %v %v", ctx, tokenHash)
 	return
 }
 
-// UpdateUserAfterCounter returns a count of finished UserRepositoryMock.UpdateUser invocations
-func (mmUpdateUser *UserRepositoryMock) UpdateUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateUser.afterUpdateUserCounter)
+// UsePasswordResetTokenAfterCounter returns a count of finished UserRepositoryMock.UsePasswordResetToken invocations
+func (mmUsePasswordResetToken *UserRepositoryMock) UsePasswordResetTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUsePasswordResetToken.afterUsePasswordResetTokenCounter)
 }
 
-// UpdateUserBeforeCounter returns a count of UserRepositoryMock.UpdateUser invocations
-func (mmUpdateUser *UserRepositoryMock) UpdateUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateUser.beforeUpdateUserCounter)
+// UsePasswordResetTokenBeforeCounter returns a count of UserRepositoryMock.UsePasswordResetToken invocations
+func (mmUsePasswordResetToken *UserRepositoryMock) UsePasswordResetTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUsePasswordResetToken.beforeUsePasswordResetTokenCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.UpdateUser.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UsePasswordResetToken.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateUser *mUserRepositoryMockUpdateUser) Calls() []*UserRepositoryMockUpdateUserParams {
-	mmUpdateUser.mutex.RLock()
+func (mmUsePasswordResetToken *mUserRepositoryMockUsePasswordResetToken) Calls() []*UserRepositoryMockUsePasswordResetTokenParams {
+	mmUsePasswordResetToken.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockUpdateUserParams, len(mmUpdateUser.callArgs))
-	copy(argCopy, mmUpdateUser.callArgs)
+	argCopy := make([]*UserRepositoryMockUsePasswordResetTokenParams, len(mmUsePasswordResetToken.callArgs))
+	copy(argCopy, mmUsePasswordResetToken.callArgs)
 
-	mmUpdateUser.mutex.RUnlock()
+	mmUsePasswordResetToken.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateUserDone returns true if the count of the UpdateUser invocations corresponds
+// MinimockUsePasswordResetTokenDone returns true if the count of the UsePasswordResetToken invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockUpdateUserDone() bool {
-	if m.UpdateUserMock.optional {
+func (m *UserRepositoryMock) MinimockUsePasswordResetTokenDone() bool {
+	if m.UsePasswordResetTokenMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateUserMock.expectations {
+	for _, e := range m.UsePasswordResetTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateUserMock.invocationsDone()
+	return m.UsePasswordResetTokenMock.invocationsDone()
 }
 
-// MinimockUpdateUserInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockUpdateUserInspect() {
-	for _, e := range m.UpdateUserMock.expectations {
+// MinimockUsePasswordResetTokenInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUsePasswordResetTokenInspect() {
+	for _, e := range m.UsePasswordResetTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.UpdateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.UsePasswordResetToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterUpdateUserCounter := mm_atomic.LoadUint64(&m.afterUpdateUserCounter)
+	afterUsePasswordResetTokenCounter := mm_atomic.LoadUint64(&m.afterUsePasswordResetTokenCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateUserMock.defaultExpectation != nil && afterUpdateUserCounter < 1 {
-		if m.UpdateUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.UpdateUser at\n%s", m.UpdateUserMock.defaultExpectation.returnOrigin)
+	if m.UsePasswordResetTokenMock.defaultExpectation != nil && afterUsePasswordResetTokenCounter < 1 {
+		if m.UsePasswordResetTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.UsePasswordResetToken at\n%s", m.UsePasswordResetTokenMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.UpdateUser at\n%s with params: %#v", m.UpdateUserMock.defaultExpectation.expectationOrigins.origin, *m.UpdateUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.UsePasswordResetToken at\n%s with params: %#v", m.UsePasswordResetTokenMock.defaultExpectation.expectationOrigins.origin, *m.UsePasswordResetTokenMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateUser != nil && afterUpdateUserCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.UpdateUser at\n%s", m.funcUpdateUserOrigin)
+	if m.funcUsePasswordResetToken != nil && afterUsePasswordResetTokenCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.UsePasswordResetToken at\n%s", m.funcUsePasswordResetTokenOrigin)
 	}
 
-	if !m.UpdateUserMock.invocationsDone() && afterUpdateUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.UpdateUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateUserMock.expectedInvocations), m.UpdateUserMock.expectedInvocationsOrigin, afterUpdateUserCounter)
+	if !m.UsePasswordResetTokenMock.invocationsDone() && afterUsePasswordResetTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UsePasswordResetToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UsePasswordResetTokenMock.expectedInvocations), m.UsePasswordResetTokenMock.expectedInvocationsOrigin, afterUsePasswordResetTokenCounter)
 	}
 }
 
@@ -1830,15 +4359,29 @@ func (m *UserRepositoryMock) MinimockUpdateUserInspect() {
 func (m *UserRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCreateEmailVerificationTokenInspect()
+
+			m.MinimockCreatePasswordResetTokenInspect()
+
 			m.MinimockCreateUserInspect()
 
 			m.MinimockDeleteUserInspect()
 
 			m.MinimockGetUserInspect()
 
+			m.MinimockGetUserByEmailInspect()
+
 			m.MinimockMakeLogInspect()
 
+			m.MinimockSetEmailVerifiedInspect()
+
+			m.MinimockUpdatePasswordInspect()
+
 			m.MinimockUpdateUserInspect()
+
+			m.MinimockUseEmailVerificationTokenInspect()
+
+			m.MinimockUsePasswordResetTokenInspect()
 		}
 	})
 }
@@ -1862,9 +4405,16 @@ func (m *UserRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UserRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCreateEmailVerificationTokenDone() &&
+		m.MinimockCreatePasswordResetTokenDone() &&
 		m.MinimockCreateUserDone() &&
 		m.MinimockDeleteUserDone() &&
 		m.MinimockGetUserDone() &&
+		m.MinimockGetUserByEmailDone() &&
 		m.MinimockMakeLogDone() &&
-		m.MinimockUpdateUserDone()
+		m.MinimockSetEmailVerifiedDone() &&
+		m.MinimockUpdatePasswordDone() &&
+		m.MinimockUpdateUserDone() &&
+		m.MinimockUseEmailVerificationTokenDone() &&
+		m.MinimockUsePasswordResetTokenDone()
 }
@@ -1,13 +1,16 @@
 package model
 
 type (
-	// UserRedis модель пользователя для redis.
+	// UserRedis модель пользователя для redis. Хеш пароля и другие секреты в кэш не попадают.
 	UserRedis struct {
 		ID        string `redis:"id"`
 		Name      string `redis:"name"`
 		Email     string `redis:"email"`
-		Role      string `redis:"role"`
-		Password  string `redis:"password"`
+		Roles     string `redis:"roles"`
 		CreatedAt string `redis:"created_at"`
+		// VerifiedAt пустая строка, пока email не подтвержден
+		VerifiedAt string `redis:"verified_at"`
+		// NotFound отметка негативного кэша: пользователя с таким ID нет, остальные поля пустые
+		NotFound bool `redis:"not_found"`
 	}
 )
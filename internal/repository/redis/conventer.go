@@ -3,6 +3,7 @@ package redis
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Ippolid/auth/internal/model"
@@ -15,13 +16,18 @@ const customTimeFormat = "2006-01-02 15:04:05.999999999"
 func toRedisModels(id int64, user model.User) redismodels.UserRedis {
 	idStr, timeNow := strconv.FormatInt(id, 10), time.Now()
 
+	var verifiedAt string
+	if user.VerifiedAt != nil {
+		verifiedAt = user.VerifiedAt.Format(customTimeFormat)
+	}
+
 	return redismodels.UserRedis{
-		ID:        idStr,
-		Name:      *user.User.Name,
-		Email:     *user.User.Email,
-		Password:  user.Password,
-		Role:      strconv.FormatBool(user.Role),
-		CreatedAt: timeNow.Format(customTimeFormat),
+		ID:         idStr,
+		Name:       *user.User.Name,
+		Email:      *user.User.Email,
+		Roles:      strings.Join(user.Roles, rolesSeparator),
+		CreatedAt:  timeNow.Format(customTimeFormat),
+		VerifiedAt: verifiedAt,
 	}
 }
 
@@ -36,9 +42,18 @@ func toServiceModels(user redismodels.UserRedis) (*model.User, error) {
 		return nil, fmt.Errorf("error with parse ID: %w", err)
 	}
 
-	role, err := strconv.ParseBool(user.Role)
-	if err != nil {
-		return nil, fmt.Errorf("error with parse Role: %w", err)
+	var verifiedAt *time.Time
+	if user.VerifiedAt != "" {
+		t, errVerified := time.Parse(customTimeFormat, user.VerifiedAt)
+		if errVerified != nil {
+			return nil, fmt.Errorf("error with time parse VerifiedAt: %w", errVerified)
+		}
+		verifiedAt = &t
+	}
+
+	roles := []string{}
+	if user.Roles != "" {
+		roles = strings.Split(user.Roles, rolesSeparator)
 	}
 
 	user1 := model.UserInfo{
@@ -46,10 +61,10 @@ func toServiceModels(user redismodels.UserRedis) (*model.User, error) {
 		Email: &user.Email,
 	}
 	return &model.User{
-		ID:        id,
-		User:      user1,
-		Password:  user.Password,
-		Role:      role,
-		CreatedAt: createdAt,
+		ID:         id,
+		User:       user1,
+		Roles:      roles,
+		CreatedAt:  createdAt,
+		VerifiedAt: verifiedAt,
 	}, nil
 }
@@ -3,22 +3,44 @@ package redis
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"time"
 
 	"github.com/Ippolid/auth/internal/model"
+	redismodels "github.com/Ippolid/auth/internal/repository/model"
+	"github.com/gomodule/redigo/redis"
 )
 
 func (c cache) Create(ctx context.Context, id int64, user model.User) error {
-	idFormatted := strconv.FormatInt(id, 10)
-
-	redisUser := toRedisModels(id, user)
-	if err := c.cl.HashSet(ctx, idFormatted, redisUser); err != nil {
-		return fmt.Errorf("failed to hash user: %w", err)
+	if err := c.setUser(ctx, id, toRedisModels(id, user), userTTL); err != nil {
+		return fmt.Errorf("failed to cache user: %w", err)
 	}
 
-	if err := c.cl.Expire(ctx, idFormatted, 5*time.Minute); err != nil {
-		return fmt.Errorf("failed to set expiration for user: %w", err)
+	return nil
+}
+
+// CreateUserNotFound запоминает на короткое время, что пользователя с таким ID нет
+func (c cache) CreateUserNotFound(ctx context.Context, id int64) error {
+	if err := c.setUser(ctx, id, redismodels.UserRedis{NotFound: true}, notFoundTTL); err != nil {
+		return fmt.Errorf("failed to cache missing user %d: %w", id, err)
 	}
+
 	return nil
 }
+
+// setUser заменяет хэш целиком: иначе поля негативной записи смешались бы с профилем
+func (c cache) setUser(ctx context.Context, id int64, user redismodels.UserRedis, ttl time.Duration) error {
+	key := userKey(id)
+
+	return c.cl.Execute(ctx, func(_ context.Context, conn redis.Conn) error {
+		if _, err := conn.Do("DEL", key); err != nil {
+			return err
+		}
+
+		if _, err := conn.Do("HSET", redis.Args{key}.AddFlat(user)...); err != nil {
+			return err
+		}
+
+		_, err := conn.Do("PEXPIRE", key, jitter(ttl).Milliseconds())
+		return err
+	})
+}
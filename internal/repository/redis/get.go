@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/Ippolid/auth/internal/model"
 	redismodels "github.com/Ippolid/auth/internal/repository/model"
@@ -12,8 +11,7 @@ import (
 )
 
 func (c cache) Get(ctx context.Context, id int64) (*model.User, error) {
-	var key string
-	key = strconv.FormatInt(id, 10)
+	key := userKey(id)
 
 	userCache, err := c.cl.HGetAll(ctx, key)
 	if err != nil {
@@ -33,7 +31,10 @@ func (c cache) Get(ctx context.Context, id int64) (*model.User, error) {
 		return nil, fmt.Errorf("error scanning user profile: %w", err)
 	}
 
-	fmt.Printf("%v", userProfile)
+	if userProfile.NotFound {
+		return nil, model.ErrCachedNotFound
+	}
+
 	user, err := toServiceModels(userProfile)
 	if err != nil {
 		return nil, fmt.Errorf("error converting user profile: %w", err)
@@ -0,0 +1,79 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	cacheclient "github.com/Ippolid/auth/internal/client/cache/redis"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/gomodule/redigo/redis"
+)
+
+// InvalidationChannel канал pub/sub, в который публикуется каждая инвалидация (model.CacheInvalidation в JSON)
+const InvalidationChannel = "auth:cache:invalidate"
+
+const (
+	// invalidateAttempts число попыток удалить ключи: после коммита это единственный шанс
+	// не отдавать устаревшие данные до истечения TTL
+	invalidateAttempts = 3
+	// invalidateBackoff пауза перед повторной попыткой, растет линейно
+	invalidateBackoff = 50 * time.Millisecond
+)
+
+// Delete удаляет закэшированный профиль пользователя
+func (c *cache) Delete(ctx context.Context, id int64) error {
+	return c.Invalidate(ctx, model.CacheInvalidation{UserIDs: []int64{id}})
+}
+
+// Invalidate удаляет закэшированные профили и роли пользователей и публикует инвалидацию в
+// InvalidationChannel, откуда ее применяют все экземпляры (см. ListenInvalidations).
+// При ошибке Redis повторяет попытку; возвращает последнюю ошибку.
+func (c *cache) Invalidate(ctx context.Context, inv model.CacheInvalidation) error {
+	if inv.Empty() {
+		return nil
+	}
+
+	keys := make([]interface{}, 0, len(inv.UserIDs)+len(inv.Usernames))
+	for _, id := range inv.UserIDs {
+		keys = append(keys, userKey(id))
+	}
+	for _, username := range inv.Usernames {
+		keys = append(keys, rolesKey(username))
+	}
+
+	payload, err := json.Marshal(inv)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache invalidation: %w", err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = c.cl.Execute(ctx, func(_ context.Context, conn redis.Conn) error {
+			if len(keys) > 0 {
+				if _, errEx := conn.Do("DEL", keys...); errEx != nil {
+					return errEx
+				}
+			}
+
+			_, errEx := conn.Do("PUBLISH", InvalidationChannel, payload)
+			return errEx
+		})
+		// Пока Redis недоступен, повторять бессмысленно: после восстановления кэшированные данные из базы сбрасываются
+		if err == nil || attempt == invalidateAttempts || errors.Is(err, cacheclient.ErrCircuitOpen) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to invalidate cache: %w", ctx.Err())
+		case <-time.After(time.Duration(attempt) * invalidateBackoff):
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to invalidate cache after %d attempts: %w", invalidateAttempts, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// loginKeyPrefix общий префикс счетчиков и блокировок входа
+var loginKeyPrefix = key("login") + ":"
+
+func loginFailuresKey(subject string) string {
+	return key("login", "fail", subject)
+}
+
+func loginBlockKey(subject string) string {
+	return key("login", "block", subject)
+}
+
+// IncLoginFailures увеличивает счетчик неудачных попыток входа. Окно отсчитывается от первой неудачи.
+func (c *cache) IncLoginFailures(ctx context.Context, subject string, window time.Duration) (int64, error) {
+	key := loginFailuresKey(subject)
+
+	var failures int64
+	err := c.cl.Execute(ctx, func(_ context.Context, conn redis.Conn) error {
+		var errEx error
+		failures, errEx = redis.Int64(conn.Do("INCR", key))
+		if errEx != nil {
+			return errEx
+		}
+
+		if failures == 1 {
+			_, errEx = conn.Do("PEXPIRE", key, window.Milliseconds())
+		}
+
+		return errEx
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count login failure for %s: %w", subject, err)
+	}
+
+	return failures, nil
+}
+
+// BlockLogin запрещает вход на ttl. Более длинная блокировка не сокращается.
+func (c *cache) BlockLogin(ctx context.Context, subject string, ttl time.Duration) error {
+	key := loginBlockKey(subject)
+
+	err := c.cl.Execute(ctx, func(_ context.Context, conn redis.Conn) error {
+		remaining, errEx := redis.Int64(conn.Do("PTTL", key))
+		if errEx != nil {
+			return errEx
+		}
+		if remaining >= ttl.Milliseconds() {
+			return nil
+		}
+
+		_, errEx = conn.Do("SET", key, 1, "PX", ttl.Milliseconds())
+		return errEx
+	})
+	if err != nil {
+		return fmt.Errorf("failed to block login for %s: %w", subject, err)
+	}
+
+	return nil
+}
+
+// GetLoginBlock возвращает, сколько еще действует блокировка входа; 0 — блокировки нет
+func (c *cache) GetLoginBlock(ctx context.Context, subject string) (time.Duration, error) {
+	var remaining int64
+	err := c.cl.Execute(ctx, func(_ context.Context, conn redis.Conn) error {
+		var errEx error
+		remaining, errEx = redis.Int64(conn.Do("PTTL", loginBlockKey(subject)))
+		return errEx
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get login block for %s: %w", subject, err)
+	}
+
+	// -2 — ключа нет, -1 — ключ без TTL (такие не создаются)
+	if remaining <= 0 {
+		return 0, nil
+	}
+
+	return time.Duration(remaining) * time.Millisecond, nil
+}
+
+// ResetLoginFailures сбрасывает счетчик неудачных попыток и блокировку
+func (c *cache) ResetLoginFailures(ctx context.Context, subject string) error {
+	err := c.cl.Execute(ctx, func(_ context.Context, conn redis.Conn) error {
+		_, errEx := conn.Do("DEL", loginFailuresKey(subject), loginBlockKey(subject))
+		return errEx
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset login failures for %s: %w", subject, err)
+	}
+
+	return nil
+}
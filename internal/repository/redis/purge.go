@@ -0,0 +1,181 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	cacheclient "github.com/Ippolid/auth/internal/client/cache/redis"
+	"github.com/gomodule/redigo/redis"
+)
+
+// purgeScanCount подсказка Redis, сколько ключей просматривать за один SCAN
+const purgeScanCount = 500
+
+const (
+	// legacyProfileTTL TTL, с которым писались профили старого формата
+	legacyProfileTTL = 5 * time.Minute
+	// legacyRolesTTL TTL, с которым писались роли старого формата
+	legacyRolesTTL = 6 * time.Minute
+)
+
+// PurgeLegacyKeys удаляет ключи кэша, записанные до введения keyPrefix, и только те форматы, которые
+// тогда писал сервис: хэши профилей по голому ID (в них лежал хеш пароля), строки ролей по голому имени
+// пользователя со значением "0" или "1" и множества эндпоинтов "role:admin" и "role:user".
+// Профили и роли создавались с TTL не длиннее legacyProfileTTL и legacyRolesTTL, поэтому ключи других
+// приложений без TTL или с более долгим TTL не затрагиваются; перед удалением стоит проверить список через dryRun.
+// При dryRun ключи только подсчитываются. Возвращает найденные ключи.
+func PurgeLegacyKeys(ctx context.Context, cl cacheclient.Client, dryRun bool) ([]string, error) {
+	var purged []string
+
+	err := cl.Execute(ctx, func(ctx context.Context, conn redis.Conn) error {
+		cursor := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			reply, err := redis.Values(conn.Do("SCAN", cursor, "COUNT", purgeScanCount))
+			if err != nil {
+				return err
+			}
+
+			var keys []string
+			if _, err = redis.Scan(reply, &cursor, &keys); err != nil {
+				return err
+			}
+
+			for _, key := range keys {
+				legacy, errKey := isLegacyKey(conn, key)
+				if errKey != nil {
+					return errKey
+				}
+				if !legacy {
+					continue
+				}
+
+				if !dryRun {
+					if _, errKey = conn.Do("DEL", key); errKey != nil {
+						return errKey
+					}
+				}
+				purged = append(purged, key)
+			}
+
+			if cursor == 0 {
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return purged, fmt.Errorf("failed to purge legacy cache keys: %w", err)
+	}
+
+	return purged, nil
+}
+
+func isLegacyKey(conn redis.Conn, key string) (bool, error) {
+	if strings.HasPrefix(key, keyPrefix) {
+		return false, nil
+	}
+
+	keyType, err := redis.String(conn.Do("TYPE", key))
+	if err != nil {
+		return false, err
+	}
+
+	switch keyType {
+	case "hash":
+		if _, errID := strconv.ParseInt(key, 10, 64); errID != nil {
+			return false, nil
+		}
+		hasPassword, errField := redis.Bool(conn.Do("HEXISTS", key, "password"))
+		if errField != nil || !hasPassword {
+			return false, errField
+		}
+
+		return hasTTL(conn, key, legacyProfileTTL)
+	case "set":
+		// Множества эндпоинтов писались без TTL
+		return key == "role:admin" || key == "role:user", nil
+	case "string":
+		// Роль пользователя по имени хранилась как bool, который redigo пишет "1" или "0"
+		value, errValue := redis.String(conn.Do("GET", key))
+		if errValue != nil || (value != "0" && value != "1") {
+			return false, ignoreNil(errValue)
+		}
+
+		return hasTTL(conn, key, legacyRolesTTL)
+	default:
+		return false, nil
+	}
+}
+
+// hasTTL сообщает, что у ключа есть TTL и он не длиннее limit
+func hasTTL(conn redis.Conn, key string, limit time.Duration) (bool, error) {
+	ttl, err := redis.Int64(conn.Do("PTTL", key))
+	if err != nil {
+		return false, err
+	}
+
+	return ttl > 0 && ttl <= limit.Milliseconds(), nil
+}
+
+// ignoreNil ключ мог истечь между SCAN и чтением — это не ошибка
+func ignoreNil(err error) error {
+	if errors.Is(err, redis.ErrNil) {
+		return nil
+	}
+
+	return err
+}
+
+// FlushNamespace удаляет ключи сервиса, источник истины для которых — Postgres: профили, роли и отзывы сессий.
+// Вызывается после восстановления Redis: пока он был недоступен, инвалидации не доходили,
+// и любая из этих записей могла устареть. Счетчики и блокировки входа живут только в Redis и не удаляются,
+// иначе каждый сбой Redis снимал бы защиту от перебора паролей.
+func FlushNamespace(ctx context.Context, cl cacheclient.Client) error {
+	err := cl.Execute(ctx, func(ctx context.Context, conn redis.Conn) error {
+		cursor := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			reply, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", keyPrefix+"*", "COUNT", purgeScanCount))
+			if err != nil {
+				return err
+			}
+
+			var keys []string
+			if _, err = redis.Scan(reply, &cursor, &keys); err != nil {
+				return err
+			}
+
+			stale := keys[:0]
+			for _, k := range keys {
+				if !strings.HasPrefix(k, loginKeyPrefix) {
+					stale = append(stale, k)
+				}
+			}
+
+			if len(stale) > 0 {
+				if _, err = conn.Do("DEL", redis.Args{}.AddFlat(stale)...); err != nil {
+					return err
+				}
+			}
+
+			if cursor == 0 {
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to flush cache namespace: %w", err)
+	}
+
+	return nil
+}
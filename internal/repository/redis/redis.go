@@ -1,6 +1,9 @@
 package redis
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/Ippolid/auth/internal/client/cache/redis"
 	"github.com/Ippolid/auth/internal/repository"
 )
@@ -13,3 +16,23 @@ type cache struct {
 func NewRedisCache(cl redis.Client) repository.CacheInterface {
 	return &cache{cl: cl}
 }
+
+// keyPrefix общий префикс ключей кэша. Версия меняется вместе с форматом значений,
+// чтобы новые экземпляры не читали записи старого формата.
+const keyPrefix = "auth:v1:"
+
+// key собирает ключ кэша из частей. Все ключи сервиса проходят через него, чтобы лежать под keyPrefix:
+// иначе их не сбросит FlushNamespace и не отличит от ключей старого формата PurgeLegacyKeys.
+func key(parts ...string) string {
+	return keyPrefix + strings.Join(parts, ":")
+}
+
+// userKey ключ хэша с профилем пользователя
+func userKey(id int64) string {
+	return key("user", strconv.FormatInt(id, 10))
+}
+
+// rolesKey ключ списка ролей пользователя
+func rolesKey(username string) string {
+	return key("role", username)
+}
@@ -0,0 +1,68 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// revokedTTL совпадает со сроком жизни refresh-токена: дольше семейство не может быть использовано
+	revokedTTL = 60 * time.Minute
+	// activeTTL ограничивает, как долго экземпляр может не видеть отзыв, который не удалось записать в кэш
+	activeTTL = 30 * time.Second
+)
+
+func revokedKey(familyID string) string {
+	return key("revoked", familyID)
+}
+
+// CreateRevokedFamily запоминает, что семейство refresh-токенов отозвано. Запись безусловная:
+// отзыв всегда перетирает закэшированное состояние «активно».
+func (c *cache) CreateRevokedFamily(ctx context.Context, familyID string) error {
+	err := c.cl.Execute(ctx, func(_ context.Context, conn redis.Conn) error {
+		_, err := conn.Do("SET", revokedKey(familyID), 1, "PX", revokedTTL.Milliseconds())
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cache revocation for family %s: %w", familyID, err)
+	}
+
+	return nil
+}
+
+// CreateActiveFamily запоминает, что семейство refresh-токенов активно. Запись выполняется
+// только при отсутствии ключа (NX), поэтому не может перетереть отзыв, записанный параллельно.
+func (c *cache) CreateActiveFamily(ctx context.Context, familyID string) error {
+	err := c.cl.Execute(ctx, func(_ context.Context, conn redis.Conn) error {
+		_, err := conn.Do("SET", revokedKey(familyID), 0, "PX", activeTTL.Milliseconds(), "NX")
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cache active state for family %s: %w", familyID, err)
+	}
+
+	return nil
+}
+
+// GetRevokedFamily сообщает закэшированное состояние семейства refresh-токенов.
+// Возвращает ErrCacheMiss, если записи нет.
+func (c *cache) GetRevokedFamily(ctx context.Context, familyID string) (bool, error) {
+	result, err := c.cl.Get(ctx, revokedKey(familyID))
+	if err != nil {
+		return false, fmt.Errorf("redis Get error: %w", err)
+	}
+	if result == nil {
+		return false, model.ErrCacheMiss
+	}
+
+	revoked, err := redis.Bool(result, nil)
+	if err != nil {
+		return false, fmt.Errorf("unexpected revocation value in cache for family %s: %w", familyID, err)
+	}
+
+	return revoked, nil
+}
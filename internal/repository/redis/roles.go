@@ -0,0 +1,68 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// rolesSeparator разделитель ролей в значении ключа; имена ролей его не содержат
+	rolesSeparator = ","
+	// rolesNotFound значение негативной записи: имя роли не может начинаться с '!'
+	rolesNotFound = "!not_found"
+)
+
+// CreateRoles сохраняет список ролей пользователя
+func (c cache) CreateRoles(ctx context.Context, username string, roles []string) error {
+	if err := c.setRoles(ctx, username, strings.Join(roles, rolesSeparator), rolesTTL); err != nil {
+		return fmt.Errorf("failed to set roles for username %s: %w", username, err)
+	}
+
+	return nil
+}
+
+// CreateRolesNotFound запоминает на короткое время, что пользователя с таким именем нет
+func (c cache) CreateRolesNotFound(ctx context.Context, username string) error {
+	if err := c.setRoles(ctx, username, rolesNotFound, notFoundTTL); err != nil {
+		return fmt.Errorf("failed to cache missing username %s: %w", username, err)
+	}
+
+	return nil
+}
+
+func (c cache) setRoles(ctx context.Context, username string, value string, ttl time.Duration) error {
+	return c.cl.Execute(ctx, func(_ context.Context, conn redis.Conn) error {
+		_, err := conn.Do("SET", rolesKey(username), value, "PX", jitter(ttl).Milliseconds())
+		return err
+	})
+}
+
+// GetRoles получает список ролей пользователя
+func (c cache) GetRoles(ctx context.Context, username string) ([]string, error) {
+	result, err := c.cl.Get(ctx, rolesKey(username))
+	if err != nil {
+		return nil, fmt.Errorf("redis Get error: %w", err)
+	}
+	if result == nil {
+		return nil, model.ErrUserNotFound
+	}
+
+	value, err := redis.String(result, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected roles type in cache for user %s: %w", username, err)
+	}
+
+	if value == rolesNotFound {
+		return nil, model.ErrCachedNotFound
+	}
+	if value == "" {
+		return []string{}, nil
+	}
+
+	return strings.Split(value, rolesSeparator), nil
+}
@@ -0,0 +1,102 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	cacheclient "github.com/Ippolid/auth/internal/client/cache/redis"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
+)
+
+// InvalidationHandler применяет к локальному состоянию экземпляра инвалидацию, полученную из InvalidationChannel
+type InvalidationHandler func(ctx context.Context, inv model.CacheInvalidation)
+
+// ListenInvalidations подписывается на InvalidationChannel и передает handle каждую опубликованную
+// инвалидацию, в том числе от этого экземпляра. Если подписка оборвалась, через retry подписывается снова.
+// Сообщения, опубликованные без подписки, потеряны, поэтому после каждой подписки handle получает
+// инвалидацию прав ролей. Раз в keepalive подписка отправляет PING: ответ на него не дает сработать
+// таймауту чтения соединения, пока сообщений нет. keepalive должен быть меньше таймаута чтения.
+// Завершается вместе с ctx.
+func ListenInvalidations(ctx context.Context, cl *cacheclient.Client, retry, keepalive time.Duration, handle InvalidationHandler) {
+	for {
+		err := cl.Execute(ctx, func(ctx context.Context, conn redis.Conn) error {
+			return receiveInvalidations(ctx, conn, keepalive, handle)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		// Пока цепь разомкнута, Probe сам сообщает о недоступности Redis
+		if !errors.Is(err, cacheclient.ErrCircuitOpen) {
+			logger.Warn("cache invalidation subscription lost", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+	}
+}
+
+// receiveInvalidations читает сообщения подписки до ошибки соединения или отмены ctx
+func receiveInvalidations(ctx context.Context, conn redis.Conn, keepalive time.Duration, handle InvalidationHandler) error {
+	psc := redis.PubSubConn{Conn: conn}
+	if err := psc.Subscribe(InvalidationChannel); err != nil {
+		return err
+	}
+
+	// Соединение допускает одного пишущего одновременно с читающим; пишущий должен завершиться
+	// до возврата соединения в пул
+	pingCtx, stopPing := context.WithCancel(ctx)
+	var pinger sync.WaitGroup
+	pinger.Add(1)
+	go func() {
+		defer pinger.Done()
+		keepAlive(pingCtx, psc, keepalive)
+	}()
+	defer func() {
+		stopPing()
+		pinger.Wait()
+	}()
+
+	for {
+		switch msg := psc.ReceiveContext(ctx).(type) {
+		case redis.Subscription:
+			if msg.Kind == "subscribe" {
+				handle(ctx, model.CacheInvalidation{Grants: true})
+			}
+		case redis.Message:
+			var inv model.CacheInvalidation
+			if err := json.Unmarshal(msg.Data, &inv); err != nil {
+				logger.Warn("failed to decode cache invalidation", zap.ByteString("payload", msg.Data), zap.Error(err))
+				continue
+			}
+			handle(ctx, inv)
+		case error:
+			return msg
+		}
+	}
+}
+
+// keepAlive раз в interval отправляет PING в подписку; ошибку записи увидит читающий
+func keepAlive(ctx context.Context, psc redis.PubSubConn, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := psc.Ping(""); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	cacheclient "github.com/Ippolid/auth/internal/client/cache/redis"
+	redisCache "github.com/Ippolid/auth/internal/repository/redis"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+)
+
+// entry ключ в памяти fakeRedis
+type entry struct {
+	kind   string
+	value  string
+	fields map[string]string
+	ttl    time.Duration
+}
+
+// fakeRedis Redis в памяти с командами, которые используют очистка и сброс кэша
+type fakeRedis struct {
+	mu   sync.Mutex
+	keys map[string]entry
+}
+
+func (f *fakeRedis) client() cacheclient.Client {
+	pool := &redis.Pool{
+		DialContext: func(context.Context) (redis.Conn, error) {
+			return &fakeConn{redis: f}, nil
+		},
+	}
+
+	return cacheclient.NewClient(pool, redisConfig{})
+}
+
+func (f *fakeRedis) remaining() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	keys := make([]string, 0, len(f.keys))
+	for key := range f.keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+type fakeConn struct {
+	redis *fakeRedis
+}
+
+func (c *fakeConn) Close() error                      { return nil }
+func (c *fakeConn) Err() error                        { return nil }
+func (c *fakeConn) Send(string, ...interface{}) error { return nil }
+func (c *fakeConn) Flush() error                      { return nil }
+func (c *fakeConn) Receive() (interface{}, error)     { return nil, nil }
+
+func (c *fakeConn) Do(cmd string, args ...interface{}) (interface{}, error) {
+	f := c.redis
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := func(i int) string { return args[i].(string) }
+
+	switch cmd {
+	case "":
+		return nil, nil
+	case "SCAN":
+		match := "*"
+		for i := 1; i+1 < len(args); i += 2 {
+			if args[i] == "MATCH" {
+				match = key(i + 1)
+			}
+		}
+		var keys []interface{}
+		for k := range f.keys {
+			if ok, _ := path.Match(match, k); ok {
+				keys = append(keys, []byte(k))
+			}
+		}
+		return []interface{}{[]byte("0"), keys}, nil
+	case "TYPE":
+		e, ok := f.keys[key(0)]
+		if !ok {
+			return "none", nil
+		}
+		return e.kind, nil
+	case "PTTL":
+		e, ok := f.keys[key(0)]
+		if !ok {
+			return int64(-2), nil
+		}
+		if e.ttl == 0 {
+			return int64(-1), nil
+		}
+		return e.ttl.Milliseconds(), nil
+	case "SET":
+		nx := false
+		ttl := time.Duration(0)
+		for i := 2; i < len(args); i++ {
+			switch args[i] {
+			case "NX":
+				nx = true
+			case "PX":
+				ttl = time.Duration(args[i+1].(int64)) * time.Millisecond
+				i++
+			}
+		}
+		if _, ok := f.keys[key(0)]; ok && nx {
+			return nil, nil
+		}
+		f.keys[key(0)] = entry{kind: "string", value: fmt.Sprint(args[1]), ttl: ttl}
+		return "OK", nil
+	case "GET":
+		e, ok := f.keys[key(0)]
+		if !ok {
+			return nil, nil
+		}
+		return []byte(e.value), nil
+	case "HEXISTS":
+		_, ok := f.keys[key(0)].fields[key(1)]
+		if ok {
+			return int64(1), nil
+		}
+		return int64(0), nil
+	case "DEL":
+		deleted := int64(0)
+		for i := range args {
+			if _, ok := f.keys[key(i)]; ok {
+				delete(f.keys, key(i))
+				deleted++
+			}
+		}
+		return deleted, nil
+	}
+
+	return nil, redis.Error("ERR unknown command " + cmd)
+}
+
+func TestPurgeLegacyKeys(t *testing.T) {
+	profile := map[string]string{"id": "42", "name": "alice", "password": "$2a$10$hash"}
+
+	f := &fakeRedis{keys: map[string]entry{
+		// Форматы, которые писал сервис до введения префикса
+		"42":         {kind: "hash", fields: profile, ttl: 3 * time.Minute},
+		"alice":      {kind: "string", value: "0", ttl: 5 * time.Minute},
+		"svc:admin":  {kind: "string", value: "1", ttl: time.Minute},
+		"role:admin": {kind: "set"},
+		"role:user":  {kind: "set"},
+
+		// Ключи сервиса и чужих приложений
+		"auth:v1:user:42":    {kind: "hash", fields: profile, ttl: 3 * time.Minute},
+		"foo:bar":            {kind: "string", value: "session-data", ttl: time.Minute},
+		"ratelimit:10.0.0.1": {kind: "string", value: "1", ttl: time.Hour},
+		"counter":            {kind: "string", value: "1"},
+		"7":                  {kind: "hash", fields: map[string]string{"hits": "3"}, ttl: time.Minute},
+		"role:editor":        {kind: "set"},
+	}}
+	client := f.client()
+
+	found, err := redisCache.PurgeLegacyKeys(context.Background(), client, true)
+	require.NoError(t, err)
+	sort.Strings(found)
+
+	legacy := []string{"42", "alice", "role:admin", "role:user", "svc:admin"}
+	require.Equal(t, legacy, found)
+	require.Len(t, f.remaining(), 11, "dry run must not delete keys")
+
+	purged, err := redisCache.PurgeLegacyKeys(context.Background(), client, false)
+	require.NoError(t, err)
+	sort.Strings(purged)
+	require.Equal(t, legacy, purged)
+
+	require.Equal(t, []string{"7", "auth:v1:user:42", "counter", "foo:bar", "ratelimit:10.0.0.1", "role:editor"}, f.remaining())
+}
+
+func TestFlushNamespaceKeepsLoginProtection(t *testing.T) {
+	f := &fakeRedis{keys: map[string]entry{
+		"auth:v1:user:42":            {kind: "hash", ttl: time.Minute},
+		"auth:v1:role:alice":         {kind: "string", value: "[]", ttl: time.Minute},
+		"auth:v1:revoked:family":     {kind: "string", value: "1", ttl: time.Minute},
+		"auth:v1:login:fail:alice":   {kind: "string", value: "4", ttl: time.Minute},
+		"auth:v1:login:block:alice":  {kind: "string", value: "1", ttl: time.Minute},
+		"auth:v1:login:fail:ip:10.0": {kind: "string", value: "9", ttl: time.Minute},
+		"other:app":                  {kind: "string", value: "x"},
+	}}
+
+	require.NoError(t, redisCache.FlushNamespace(context.Background(), f.client()))
+	require.Equal(t, []string{
+		"auth:v1:login:block:alice",
+		"auth:v1:login:fail:alice",
+		"auth:v1:login:fail:ip:10.0",
+		"other:app",
+	}, f.remaining())
+}
@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Ippolid/auth/internal/model"
+	redisCache "github.com/Ippolid/auth/internal/repository/redis"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokedFamilyCache(t *testing.T) {
+	ctx := context.Background()
+	cache := redisCache.NewRedisCache((&fakeRedis{keys: map[string]entry{}}).client())
+
+	_, err := cache.GetRevokedFamily(ctx, "family")
+	require.ErrorIs(t, err, model.ErrCacheMiss)
+
+	require.NoError(t, cache.CreateActiveFamily(ctx, "family"))
+	revoked, err := cache.GetRevokedFamily(ctx, "family")
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	// Отзыв перетирает закэшированное состояние «активно»
+	require.NoError(t, cache.CreateRevokedFamily(ctx, "family"))
+	revoked, err = cache.GetRevokedFamily(ctx, "family")
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// Запись «активно», опоздавшая после отзыва, его не перетирает
+	require.NoError(t, cache.CreateActiveFamily(ctx, "family"))
+	revoked, err = cache.GetRevokedFamily(ctx, "family")
+	require.NoError(t, err)
+	require.True(t, revoked)
+}
@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cacheclient "github.com/Ippolid/auth/internal/client/cache/redis"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	redisCache "github.com/Ippolid/auth/internal/repository/redis"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+type redisConfig struct{}
+
+func (redisConfig) Address() string                  { return "redis:6379" }
+func (redisConfig) ConnectionTimeout() time.Duration { return time.Second }
+func (redisConfig) MaxIdle() int                     { return 1 }
+func (redisConfig) IdleTimeout() time.Duration       { return time.Minute }
+func (redisConfig) BreakerFailures() int             { return 3 }
+func (redisConfig) ProbeInterval() time.Duration     { return 10 * time.Millisecond }
+func (redisConfig) IOTimeout() time.Duration         { return time.Second }
+
+// pubsubConn соединение, которое подтверждает SUBSCRIBE и затем отдает заранее заданные ответы.
+// Ответ-ошибка обрывает соединение, как разрыв TCP.
+type pubsubConn struct {
+	replies chan interface{}
+
+	pings atomic.Int32
+
+	mu  sync.Mutex
+	err error
+}
+
+func newPubSubConn(replies ...interface{}) *pubsubConn {
+	c := &pubsubConn{replies: make(chan interface{}, len(replies)+1)}
+	for _, reply := range replies {
+		c.replies <- reply
+	}
+	return c
+}
+
+func (c *pubsubConn) fail(err error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err == nil {
+		c.err = err
+	}
+	return c.err
+}
+
+func (c *pubsubConn) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+func (c *pubsubConn) Close() error                                   { return nil }
+func (c *pubsubConn) Do(string, ...interface{}) (interface{}, error) { return nil, c.Err() }
+func (c *pubsubConn) Flush() error                                   { return c.Err() }
+
+func (c *pubsubConn) DoContext(_ context.Context, cmd string, args ...interface{}) (interface{}, error) {
+	return c.Do(cmd, args...)
+}
+
+func (c *pubsubConn) Send(cmd string, args ...interface{}) error {
+	if cmd == "PING" {
+		c.pings.Add(1)
+	}
+	if cmd != "SUBSCRIBE" {
+		return c.Err()
+	}
+
+	// Подтверждение подписки приходит раньше любых сообщений
+	pending := make([]interface{}, 0, len(c.replies))
+	for len(c.replies) > 0 {
+		pending = append(pending, <-c.replies)
+	}
+	c.replies = make(chan interface{}, len(pending)+1)
+	c.replies <- []interface{}{[]byte("subscribe"), []byte(args[0].(string)), int64(1)}
+	for _, reply := range pending {
+		c.replies <- reply
+	}
+	return nil
+}
+
+func (c *pubsubConn) Receive() (interface{}, error) {
+	return nil, c.fail(io.EOF)
+}
+
+func (c *pubsubConn) ReceiveContext(ctx context.Context) (interface{}, error) {
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+
+	select {
+	case reply := <-c.replies:
+		if err, ok := reply.(error); ok {
+			return nil, c.fail(err)
+		}
+		return reply, nil
+	case <-ctx.Done():
+		return nil, c.fail(ctx.Err())
+	}
+}
+
+func message(payload string) []interface{} {
+	return []interface{}{[]byte("message"), []byte(redisCache.InvalidationChannel), []byte(payload)}
+}
+
+func TestListenInvalidations(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	conns := make(chan redis.Conn, 2)
+	// Первое соединение доставляет инвалидации и обрывается, второе только подписывается
+	conns <- newPubSubConn(
+		message(`{"user_ids":[7],"usernames":["alice"]}`),
+		message(`not json`),
+		message(`{"grants":true}`),
+		io.ErrUnexpectedEOF,
+	)
+	idle := newPubSubConn()
+	conns <- idle
+
+	pool := &redis.Pool{
+		DialContext: func(context.Context) (redis.Conn, error) {
+			return <-conns, nil
+		},
+	}
+	client := cacheclient.NewClient(pool, redisConfig{})
+
+	handled := make(chan model.CacheInvalidation, 8)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		redisCache.ListenInvalidations(ctx, &client, time.Millisecond, 5*time.Millisecond, func(_ context.Context, inv model.CacheInvalidation) {
+			handled <- inv
+		})
+	}()
+
+	next := func() model.CacheInvalidation {
+		select {
+		case inv := <-handled:
+			return inv
+		case <-time.After(time.Second):
+			t.Fatal("invalidation was not handled")
+			return model.CacheInvalidation{}
+		}
+	}
+
+	// После подписки права перечитываются: пока подписки не было, сообщения терялись
+	require.Equal(t, model.CacheInvalidation{Grants: true}, next())
+	require.Equal(t, model.CacheInvalidation{UserIDs: []int64{7}, Usernames: []string{"alice"}}, next())
+	// Нечитаемое сообщение пропускается, подписка продолжает работать
+	require.Equal(t, model.CacheInvalidation{Grants: true}, next())
+	// Оборванная подписка восстанавливается и снова перечитывает права
+	require.Equal(t, model.CacheInvalidation{Grants: true}, next())
+	// Пока сообщений нет, подписка пингует Redis, чтобы не сработал таймаут чтения
+	require.Eventually(t, func() bool { return idle.pings.Load() > 0 }, time.Second, time.Millisecond)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("listener did not stop after cancel")
+	}
+	require.Empty(t, handled)
+}
@@ -0,0 +1,28 @@
+package redis
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	// userTTL время жизни профиля пользователя
+	userTTL = 5 * time.Minute
+	// rolesTTL время жизни списка ролей пользователя
+	rolesTTL = 6 * time.Minute
+	// notFoundTTL время жизни негативной записи: короткое, чтобы созданный позже пользователь
+	// не оставался невидимым, если инвалидация не дошла
+	notFoundTTL = 30 * time.Second
+	// ttlJitter доля TTL, на которую он случайно сдвигается в обе стороны
+	ttlJitter = 0.1
+)
+
+// jitter разносит истечение записей, созданных одновременно, чтобы они не уходили в базу разом
+func jitter(ttl time.Duration) time.Duration {
+	spread := time.Duration(float64(ttl) * ttlJitter)
+	if spread <= 0 {
+		return ttl
+	}
+
+	return ttl - spread + rand.N(2*spread)
+}
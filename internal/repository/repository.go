@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Ippolid/auth/internal/model"
 )
@@ -12,6 +13,13 @@ type UserRepository interface {
 	GetUser(ctx context.Context, id int64) (*model.User, error)
 	DeleteUser(ctx context.Context, id int64) error
 	UpdateUser(ctx context.Context, id int64, info model.UserInfo) error
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	UpdatePassword(ctx context.Context, id int64, password string) error
+	SetEmailVerified(ctx context.Context, id int64, verified bool) error
+	CreateEmailVerificationToken(ctx context.Context, token model.EmailVerificationToken) error
+	UseEmailVerificationToken(ctx context.Context, tokenHash string) (int64, error)
+	CreatePasswordResetToken(ctx context.Context, token model.PasswordResetToken) error
+	UsePasswordResetToken(ctx context.Context, tokenHash string) (int64, error)
 	MakeLog(ctx context.Context, log model.Log) error
 }
 
@@ -19,16 +27,55 @@ type UserRepository interface {
 type AuthRepository interface {
 	Login(ctx context.Context, user model.LoginRequest) (*model.UserInfoJwt, error)
 	MakeLog(ctx context.Context, log model.Log) error
-	GetUserRole(ctx context.Context, username string) (bool, error)
-	GetUsersAccess(ctx context.Context, isAdmin bool) ([]string, error)
+	GetUserRoles(ctx context.Context, username string) ([]string, error)
+	GetAccessGrants(ctx context.Context) ([]model.RolePermission, error)
+	CreateRefreshToken(ctx context.Context, token model.RefreshToken) error
+	MarkRefreshTokenRotated(ctx context.Context, id string) (bool, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	RevokeUserRefreshTokens(ctx context.Context, username string, keepFamilyID string) ([]string, error)
+	IsRefreshTokenFamilyRevoked(ctx context.Context, familyID string) (bool, error)
+	GetUserID(ctx context.Context, username string) (int64, error)
+	GetTOTP(ctx context.Context, userID int64) (*model.TOTP, error)
+	SaveTOTPSecret(ctx context.Context, userID int64, secret string) error
+	ConfirmTOTP(ctx context.Context, userID int64, step int64) error
+	UseTOTPStep(ctx context.Context, userID int64, step int64) error
+	ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error
+	CreateMFAChallenge(ctx context.Context, challenge model.MFAChallenge) error
+	GetMFAChallenge(ctx context.Context, tokenHash string) (*model.MFAChallenge, error)
+	IncMFAChallengeAttempts(ctx context.Context, tokenHash string) error
+	UseMFAChallenge(ctx context.Context, tokenHash string) error
+}
+
+// RoleRepository интерфейс для работы с ролями и правами
+type RoleRepository interface {
+	CreateRole(ctx context.Context, name string) (int64, error)
+	ListRoles(ctx context.Context) ([]model.Role, error)
+	DeleteRole(ctx context.Context, name string) ([]model.RoleHolder, error)
+	AddPermission(ctx context.Context, perm model.RolePermission) error
+	RemovePermission(ctx context.Context, perm model.RolePermission) error
+	AssignRole(ctx context.Context, userRole model.UserRole) error
+	UnassignRole(ctx context.Context, userRole model.UserRole) error
+	GetUserRoles(ctx context.Context, userID int64) (*model.UserRoles, error)
+	CreateAudit(ctx context.Context, audit model.Audit) error
+	MakeLog(ctx context.Context, log model.Log) error
 }
 
 // CacheInterface интерфейс для работы с кэшем
 type CacheInterface interface {
 	Create(ctx context.Context, id int64, user model.User) error
 	Get(ctx context.Context, id int64) (*model.User, error)
-	GetRole(ctx context.Context, username string) (*bool, error)
-	CreateRole(ctx context.Context, username string, role bool) error
-	CreateRoleEndpoints(ctx context.Context, isAdmin bool, endpoints []string) error
-	GetRoleEndpoints(ctx context.Context, isAdmin bool) ([]string, error)
+	CreateUserNotFound(ctx context.Context, id int64) error
+	Delete(ctx context.Context, id int64) error
+	Invalidate(ctx context.Context, inv model.CacheInvalidation) error
+	GetRoles(ctx context.Context, username string) ([]string, error)
+	CreateRoles(ctx context.Context, username string, roles []string) error
+	CreateRolesNotFound(ctx context.Context, username string) error
+	CreateRevokedFamily(ctx context.Context, familyID string) error
+	CreateActiveFamily(ctx context.Context, familyID string) error
+	GetRevokedFamily(ctx context.Context, familyID string) (bool, error)
+	IncLoginFailures(ctx context.Context, subject string, window time.Duration) (int64, error)
+	BlockLogin(ctx context.Context, subject string, ttl time.Duration) error
+	GetLoginBlock(ctx context.Context, subject string) (time.Duration, error)
+	ResetLoginFailures(ctx context.Context, subject string) error
 }
@@ -0,0 +1,457 @@
+package role
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/repository"
+	"github.com/Ippolid/platform_libary/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+const (
+	tableRolesName     = "roles"
+	tableUserRolesName = "user_roles"
+	tableAccessName    = "access"
+	tableUsersName     = "users_table"
+	tableAuditName     = "audit_log"
+	tableLogName       = "logs"
+
+	idColumn        = "id"
+	nameColumn      = "name"
+	createdAtColumn = "created_at"
+	userIDColumn    = "user_id"
+	roleIDColumn    = "role_id"
+	endpointColumn  = "endpoint"
+	actorColumn     = "actor"
+	actionColumn    = "action"
+	targetColumn    = "target"
+	methodColumn    = "method_name"
+	ctxColumn       = "ctx"
+)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository создает новый экземпляр репозитория
+func NewRepository(db db.Client) repository.RoleRepository {
+	return &repo{db: db}
+}
+
+// CreateRole создает роль и возвращает ее ID
+func (r *repo) CreateRole(ctx context.Context, name string) (int64, error) {
+	builder := sq.Insert(tableRolesName).
+		Columns(nameColumn).
+		Values(name).
+		Suffix("ON CONFLICT (" + nameColumn + ") DO NOTHING RETURNING " + idColumn).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.CreateRole",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, model.ErrRoleAlreadyExists
+		}
+		return 0, fmt.Errorf("failed to create role: %w", err)
+	}
+
+	return id, nil
+}
+
+// ListRoles возвращает все роли вместе с их эндпоинтами
+func (r *repo) ListRoles(ctx context.Context) ([]model.Role, error) {
+	builder := sq.Select("r."+idColumn, "r."+nameColumn, "r."+createdAtColumn, "a."+endpointColumn).
+		From(tableRolesName+" r").
+		LeftJoin(tableAccessName+" a ON a."+roleIDColumn+" = r."+idColumn).
+		OrderBy("r."+nameColumn, "a."+endpointColumn).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.ListRoles",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	defer rows.Close()
+
+	roles := make([]model.Role, 0)
+	for rows.Next() {
+		var (
+			role     model.Role
+			endpoint sql.NullString
+		)
+		if err := rows.Scan(&role.ID, &role.Name, &role.CreatedAt, &endpoint); err != nil {
+			return nil, fmt.Errorf("failed to scan role: %w", err)
+		}
+
+		// Строки одной роли идут подряд благодаря сортировке
+		if len(roles) == 0 || roles[len(roles)-1].ID != role.ID {
+			role.Endpoints = make([]string, 0)
+			roles = append(roles, role)
+		}
+		if endpoint.Valid {
+			last := &roles[len(roles)-1]
+			last.Endpoints = append(last.Endpoints, endpoint.String)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return roles, nil
+}
+
+// DeleteRole удаляет роль вместе с ее правами и назначениями.
+// Возвращает пользователей, у которых была эта роль.
+func (r *repo) DeleteRole(ctx context.Context, name string) ([]model.RoleHolder, error) {
+	roleID, err := r.roleID(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	holders, err := r.roleHolders(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := sq.Delete(tableRolesName).
+		Where(sq.Eq{idColumn: roleID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.DeleteRole",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	return holders, nil
+}
+
+// AddPermission выдает роли доступ к эндпоинту
+func (r *repo) AddPermission(ctx context.Context, perm model.RolePermission) error {
+	roleID, err := r.roleID(ctx, perm.Role)
+	if err != nil {
+		return err
+	}
+
+	builder := sq.Insert(tableAccessName).
+		Columns(roleIDColumn, endpointColumn).
+		Values(roleID, perm.Endpoint).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.AddPermission",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to add permission: %w", err)
+	}
+
+	return nil
+}
+
+// RemovePermission отзывает у роли доступ к эндпоинту
+func (r *repo) RemovePermission(ctx context.Context, perm model.RolePermission) error {
+	roleID, err := r.roleID(ctx, perm.Role)
+	if err != nil {
+		return err
+	}
+
+	builder := sq.Delete(tableAccessName).
+		Where(sq.Eq{
+			roleIDColumn:   roleID,
+			endpointColumn: perm.Endpoint,
+		}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.RemovePermission",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to remove permission: %w", err)
+	}
+
+	return nil
+}
+
+// AssignRole назначает роль пользователю
+func (r *repo) AssignRole(ctx context.Context, userRole model.UserRole) error {
+	roleID, err := r.roleID(ctx, userRole.Role)
+	if err != nil {
+		return err
+	}
+
+	builder := sq.Insert(tableUserRolesName).
+		Columns(userIDColumn, roleIDColumn).
+		Values(userRole.UserID, roleID).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.AssignRole",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to assign role: %w", err)
+	}
+
+	return nil
+}
+
+// UnassignRole снимает роль с пользователя
+func (r *repo) UnassignRole(ctx context.Context, userRole model.UserRole) error {
+	roleID, err := r.roleID(ctx, userRole.Role)
+	if err != nil {
+		return err
+	}
+
+	builder := sq.Delete(tableUserRolesName).
+		Where(sq.Eq{
+			userIDColumn: userRole.UserID,
+			roleIDColumn: roleID,
+		}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.UnassignRole",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to unassign role: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserRoles возвращает имя пользователя и его роли
+func (r *repo) GetUserRoles(ctx context.Context, userID int64) (*model.UserRoles, error) {
+	builder := sq.Select("u."+nameColumn, "r."+nameColumn).
+		From(tableUsersName + " u").
+		LeftJoin(tableUserRolesName + " ur ON ur." + userIDColumn + " = u." + idColumn).
+		LeftJoin(tableRolesName + " r ON r." + idColumn + " = ur." + roleIDColumn).
+		Where(sq.Eq{"u." + idColumn: userID}).
+		OrderBy("r." + nameColumn).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.GetUserRoles",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+	defer rows.Close()
+
+	var userRoles *model.UserRoles
+	for rows.Next() {
+		var (
+			username string
+			role     sql.NullString
+		)
+		if err := rows.Scan(&username, &role); err != nil {
+			return nil, fmt.Errorf("failed to scan user role: %w", err)
+		}
+
+		if userRoles == nil {
+			userRoles = &model.UserRoles{Username: username, Roles: make([]string, 0)}
+		}
+		if role.Valid {
+			userRoles.Roles = append(userRoles.Roles, role.String)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	if userRoles == nil {
+		return nil, model.ErrUserNotFound
+	}
+
+	return userRoles, nil
+}
+
+// CreateAudit записывает изменение в журнал аудита
+func (r *repo) CreateAudit(ctx context.Context, audit model.Audit) error {
+	builder := sq.Insert(tableAuditName).
+		Columns(actorColumn, actionColumn, targetColumn, createdAtColumn).
+		Values(audit.Actor, audit.Action, audit.Target, audit.CreatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.CreateAudit",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repo) MakeLog(ctx context.Context, info model.Log) error {
+	builder := sq.Insert(tableLogName).
+		Columns(methodColumn, createdAtColumn, ctxColumn).
+		Values(info.Method, info.CreatedAt, info.Ctx).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "role_repository.Log",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) roleID(ctx context.Context, name string) (int64, error) {
+	builder := sq.Select(idColumn).
+		From(tableRolesName).
+		Where(sq.Eq{nameColumn: name}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.RoleID",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, model.ErrRoleNotFound
+		}
+		return 0, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *repo) roleHolders(ctx context.Context, roleID int64) ([]model.RoleHolder, error) {
+	builder := sq.Select("u."+idColumn, "u."+nameColumn).
+		From(tableUserRolesName + " ur").
+		Join(tableUsersName + " u ON u." + idColumn + " = ur." + userIDColumn).
+		Where(sq.Eq{"ur." + roleIDColumn: roleID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "role_repository.RoleHolders",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	holders := make([]model.RoleHolder, 0)
+	for rows.Next() {
+		var holder model.RoleHolder
+		if err := rows.Scan(&holder.UserID, &holder.Username); err != nil {
+			return nil, fmt.Errorf("failed to scan role holder: %w", err)
+		}
+		holders = append(holders, holder)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return holders, nil
+}
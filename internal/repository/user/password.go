@@ -0,0 +1,162 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/Ippolid/platform_libary/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+const (
+	tableResetTokensName = "password_reset_tokens"
+
+	tokenHashColumn = "token_hash"
+	expiresAtColumn = "expires_at"
+	usedAtColumn    = "used_at"
+)
+
+// GetUserByEmail ищет пользователя по email без учета регистра. Если адрес указан у нескольких
+// пользователей, возвращается самый ранний.
+func (r *repo) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	builder := sq.Select(idColumn, nameColumn, emailColumn, createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr("LOWER("+emailColumn+") = LOWER(?)", utils.NormalizeIdentifier(email))).
+		OrderBy(idColumn).
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.GetUserByEmail",
+		QueryRaw: query,
+	}
+
+	var user model.User
+	err = r.db.DB().ScanOneContext(ctx, &user, q, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// UpdatePassword сохраняет хеш нового пароля пользователя
+func (r *repo) UpdatePassword(ctx context.Context, id int64, password string) error {
+	passwordHash, err := r.hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(passwordColumn, passwordHash).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.UpdatePassword",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: id %d", model.ErrUserNotFound, id)
+	}
+
+	return nil
+}
+
+// CreatePasswordResetToken сохраняет хеш токена сброса. Ранее выданные неиспользованные токены
+// пользователя удаляются: действует только последний.
+func (r *repo) CreatePasswordResetToken(ctx context.Context, token model.PasswordResetToken) error {
+	deleteBuilder := sq.Delete(tableResetTokensName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: token.UserID, usedAtColumn: nil})
+
+	query, args, err := deleteBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.DeletePasswordResetTokens",
+		QueryRaw: query,
+	}
+
+	if _, err = r.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return fmt.Errorf("failed to delete previous reset tokens: %w", err)
+	}
+
+	insertBuilder := sq.Insert(tableResetTokensName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tokenHashColumn, userIDColumn, expiresAtColumn).
+		Values(token.TokenHash, token.UserID, token.ExpiresAt)
+
+	query, args, err = insertBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	q = db.Query{
+		Name:     "user_repository.CreatePasswordResetToken",
+		QueryRaw: query,
+	}
+
+	if _, err = r.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return fmt.Errorf("failed to create reset token: %w", err)
+	}
+
+	return nil
+}
+
+// UsePasswordResetToken помечает токен использованным и возвращает ID пользователя.
+// Одним UPDATE, чтобы один токен нельзя было использовать дважды параллельными запросами.
+func (r *repo) UsePasswordResetToken(ctx context.Context, tokenHash string) (int64, error) {
+	builder := sq.Update(tableResetTokensName).
+		PlaceholderFormat(sq.Dollar).
+		Set(usedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{tokenHashColumn: tokenHash, usedAtColumn: nil}).
+		Where(sq.Expr(expiresAtColumn + " > NOW()")).
+		Suffix("RETURNING " + userIDColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.UsePasswordResetToken",
+		QueryRaw: query,
+	}
+
+	var userID int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, model.ErrInvalidResetToken
+		}
+		return 0, fmt.Errorf("failed to use reset token: %w", err)
+	}
+
+	return userID, nil
+}
@@ -6,47 +6,58 @@ import (
 	"errors"
 	"fmt"
 
-	"golang.org/x/crypto/bcrypt"
-
 	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/passwordhash"
 	"github.com/Ippolid/auth/internal/repository"
 	"github.com/Ippolid/platform_libary/pkg/db"
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 const (
 	tableName = "users_table"
 
-	idColumn        = "id"
-	nameColumn      = "name"
-	emailColumn     = "email"
-	createdAtColumn = "created_at"
-	roleColumn      = "role"
-	passwordColumn  = "password"
-	tableLogName    = "logs"
-	methodColumn    = "method_name"
-	ctxColumn       = "ctx"
+	// uniqueViolationCode код ошибки Postgres unique_violation
+	uniqueViolationCode = "23505"
+	// emailUniqueIndex уникальный индекс по LOWER(email)
+	emailUniqueIndex = "users_email_lower_key"
+
+	idColumn         = "id"
+	nameColumn       = "name"
+	emailColumn      = "email"
+	createdAtColumn  = "created_at"
+	passwordColumn   = "password"
+	verifiedAtColumn = "verified_at"
+	tableLogName     = "logs"
+	methodColumn     = "method_name"
+	ctxColumn        = "ctx"
+
+	tableRolesName     = "roles"
+	tableUserRolesName = "user_roles"
+	userIDColumn       = "user_id"
+	roleIDColumn       = "role_id"
 )
 
 type repo struct {
-	db db.Client
+	db     db.Client
+	hasher *passwordhash.Set
 }
 
-// NewRepository создает новый экземпляр репозитория
-func NewRepository(db db.Client) repository.UserRepository {
-	return &repo{db: db}
+// NewRepository создает новый экземпляр репозитория. hasher хеширует пароли перед сохранением.
+func NewRepository(db db.Client, hasher *passwordhash.Set) repository.UserRepository {
+	return &repo{db: db, hasher: hasher}
 }
 
 // InsertUser вставляет нового пользователя в базу данных и возвращает его ID
 func (r *repo) CreateUser(ctx context.Context, user model.User) (int64, error) {
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	passwordHash, err := r.hasher.Hash(user.Password)
 	if err != nil {
-		return 0, fmt.Errorf("failed to hash password: %w", err)
+		return 0, err
 	}
 	builder := sq.Insert(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Columns(nameColumn, emailColumn, passwordColumn, roleColumn).
-		Values(user.User.Name, user.User.Email, passwordHash, user.Role).
+		Columns(nameColumn, emailColumn, passwordColumn).
+		Values(user.User.Name, user.User.Email, passwordHash).
 		Suffix("RETURNING id")
 
 	query, args, err := builder.ToSql()
@@ -62,15 +73,115 @@ func (r *repo) CreateUser(ctx context.Context, user model.User) (int64, error) {
 	var id int64
 	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&id)
 	if err != nil {
+		return 0, uniqueViolationError(err)
+	}
+
+	roles := user.Roles
+	if len(roles) == 0 {
+		roles = []string{model.RoleUser}
+	}
+
+	if err = r.assignRoles(ctx, id, roles); err != nil {
 		return 0, err
 	}
 
 	return id, nil
 }
 
+// assignRoles выдает пользователю роли по именам. Неизвестная роль — ошибка.
+func (r *repo) assignRoles(ctx context.Context, userID int64, roles []string) error {
+	builder := sq.Insert(tableUserRolesName).
+		Columns(userIDColumn, roleIDColumn).
+		Select(
+			sq.Select().
+				Column(sq.Expr("CAST(? AS INT)", userID)).
+				Column(idColumn).
+				From(tableRolesName).
+				Where(sq.Eq{nameColumn: roles}),
+		).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.AssignRoles",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to assign roles: %w", err)
+	}
+
+	if tag.RowsAffected() != int64(len(uniqueStrings(roles))) {
+		return fmt.Errorf("%w in %v", model.ErrUnknownRole, roles)
+	}
+
+	return nil
+}
+
+// getRoles возвращает имена ролей пользователя
+func (r *repo) getRoles(ctx context.Context, userID int64) ([]string, error) {
+	builder := sq.Select("r." + nameColumn).
+		From(tableUserRolesName + " ur").
+		Join(tableRolesName + " r ON r." + idColumn + " = ur." + roleIDColumn).
+		Where(sq.Eq{"ur." + userIDColumn: userID}).
+		OrderBy("r." + nameColumn).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.GetRoles",
+		QueryRaw: query,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+	defer rows.Close()
+
+	roles := make([]string, 0)
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, fmt.Errorf("failed to scan user role: %w", err)
+		}
+		roles = append(roles, role)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return roles, nil
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+
+	return result
+}
+
 // GetUser получает пользователя по ID из базы данных
 func (r *repo) GetUser(ctx context.Context, id int64) (*model.User, error) {
-	builder := sq.Select(nameColumn, emailColumn, roleColumn, createdAtColumn).
+	builder := sq.Select(nameColumn, emailColumn, createdAtColumn, verifiedAtColumn).
 		From(tableName).
 		PlaceholderFormat(sq.Dollar).
 		Where(sq.Eq{idColumn: id})
@@ -89,11 +200,16 @@ func (r *repo) GetUser(ctx context.Context, id int64) (*model.User, error) {
 	err = r.db.DB().ScanOneContext(ctx, &user, q, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("user with id %d not found", id)
+			return nil, fmt.Errorf("%w: id %d", model.ErrUserNotFound, id)
 		}
 		return nil, err
 	}
 
+	user.Roles, err = r.getRoles(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	return &user, nil
 }
 
@@ -120,7 +236,7 @@ func (r *repo) DeleteUser(ctx context.Context, id int64) error {
 
 	// Опционально: проверка, что запись действительно была удалена
 	if tag.RowsAffected() == 0 {
-		return fmt.Errorf("user with id %d not found", id)
+		return fmt.Errorf("%w: id %d", model.ErrUserNotFound, id)
 	}
 
 	return nil
@@ -146,17 +262,30 @@ func (r *repo) UpdateUser(ctx context.Context, id int64, info model.UserInfo) er
 
 	tag, err := r.db.DB().ExecContext(ctx, q, args...)
 	if err != nil {
-		return err
+		return uniqueViolationError(err)
 	}
 
 	if tag.RowsAffected() == 0 {
-		return fmt.Errorf("user with id %d not found", id)
+		return fmt.Errorf("%w: id %d", model.ErrUserNotFound, id)
 	}
 
 	return nil
 
 }
 
+// uniqueViolationError переводит нарушение уникальности имени или email в доменную ошибку
+func uniqueViolationError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolationCode {
+		return err
+	}
+	if pgErr.ConstraintName == emailUniqueIndex {
+		return model.ErrEmailAlreadyExists
+	}
+
+	return model.ErrUserAlreadyExists
+}
+
 func (r *repo) MakeLog(ctx context.Context, info model.Log) error {
 	builder := sq.Insert(tableLogName).
 		PlaceholderFormat(sq.Dollar).
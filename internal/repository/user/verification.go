@@ -0,0 +1,126 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/platform_libary/pkg/db"
+	sq "github.com/Masterminds/squirrel"
+)
+
+const tableVerificationTokensName = "email_verification_tokens"
+
+// SetEmailVerified отмечает email пользователя подтвержденным или снимает отметку
+func (r *repo) SetEmailVerified(ctx context.Context, id int64, verified bool) error {
+	var verifiedAt interface{}
+	if verified {
+		verifiedAt = sq.Expr("NOW()")
+	}
+
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(verifiedAtColumn, verifiedAt).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.SetEmailVerified",
+		QueryRaw: query,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: id %d", model.ErrUserNotFound, id)
+	}
+
+	return nil
+}
+
+// CreateEmailVerificationToken сохраняет хеш токена подтверждения. Ранее выданные неиспользованные
+// токены пользователя удаляются: действует только последний.
+func (r *repo) CreateEmailVerificationToken(ctx context.Context, token model.EmailVerificationToken) error {
+	deleteBuilder := sq.Delete(tableVerificationTokensName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{userIDColumn: token.UserID, usedAtColumn: nil})
+
+	query, args, err := deleteBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.DeleteEmailVerificationTokens",
+		QueryRaw: query,
+	}
+
+	if _, err = r.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return fmt.Errorf("failed to delete previous verification tokens: %w", err)
+	}
+
+	insertBuilder := sq.Insert(tableVerificationTokensName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(tokenHashColumn, userIDColumn, emailColumn, expiresAtColumn).
+		Values(token.TokenHash, token.UserID, token.Email, token.ExpiresAt)
+
+	query, args, err = insertBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	q = db.Query{
+		Name:     "user_repository.CreateEmailVerificationToken",
+		QueryRaw: query,
+	}
+
+	if _, err = r.db.DB().ExecContext(ctx, q, args...); err != nil {
+		return fmt.Errorf("failed to create verification token: %w", err)
+	}
+
+	return nil
+}
+
+// UseEmailVerificationToken помечает токен использованным и возвращает ID пользователя.
+// Токен действует, только если email пользователя не менялся после его выдачи.
+func (r *repo) UseEmailVerificationToken(ctx context.Context, tokenHash string) (int64, error) {
+	builder := sq.Update(tableVerificationTokensName+" t").
+		PlaceholderFormat(sq.Dollar).
+		Set(usedAtColumn, sq.Expr("NOW()")).
+		From(tableName + " u").
+		Where(sq.Eq{"t." + tokenHashColumn: tokenHash, "t." + usedAtColumn: nil}).
+		Where(sq.Expr("t." + expiresAtColumn + " > NOW()")).
+		Where(sq.Expr("u." + idColumn + " = t." + userIDColumn)).
+		Where(sq.Expr("LOWER(u." + emailColumn + ") = LOWER(t." + emailColumn + ")")).
+		Suffix("RETURNING t." + userIDColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	q := db.Query{
+		Name:     "user_repository.UseEmailVerificationToken",
+		QueryRaw: query,
+	}
+
+	var userID int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, model.ErrInvalidVerificationToken
+		}
+		return 0, fmt.Errorf("failed to use verification token: %w", err)
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,68 @@
+package requestctx
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/Ippolid/auth/internal/model"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type claimsKey struct{}
+
+// ClaimsFromContext возвращает claims вызывающего, проверенные интерцептором авторизации
+func ClaimsFromContext(ctx context.Context) (*model.UserClaims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*model.UserClaims)
+	return claims, ok
+}
+
+// ContextWithClaims кладет claims вызывающего в контекст; так делает интерцептор авторизации
+func ContextWithClaims(ctx context.Context, claims *model.UserClaims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClientIP адрес клиента. x-forwarded-for учитывается, только если запрос пришел с локального адреса
+// (grpc-gateway или прокси рядом с сервисом), иначе клиент мог бы подставить любой адрес.
+func ClientIP(ctx context.Context) net.IP {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil
+	}
+
+	if ip.IsLoopback() {
+		if forwarded := forwardedFor(ctx); forwarded != nil {
+			return forwarded
+		}
+	}
+
+	return ip
+}
+
+// forwardedFor последний адрес из x-forwarded-for — тот, что добавил ближайший к сервису прокси
+func forwardedFor(ctx context.Context) net.IP {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	values := md.Get("x-forwarded-for")
+	if len(values) == 0 {
+		return nil
+	}
+
+	hops := strings.Split(values[len(values)-1], ",")
+
+	return net.ParseIP(strings.TrimSpace(hops[len(hops)-1]))
+}
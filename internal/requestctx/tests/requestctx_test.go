@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/requestctx"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClaims(t *testing.T) {
+	_, ok := requestctx.ClaimsFromContext(context.Background())
+	require.False(t, ok)
+
+	claims := &model.UserClaims{Username: "alice"}
+	got, ok := requestctx.ClaimsFromContext(requestctx.ContextWithClaims(context.Background(), claims))
+	require.True(t, ok)
+	require.Same(t, claims, got)
+}
+
+func TestClientIP(t *testing.T) {
+	from := func(addr string, forwarded ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 5000}})
+		if len(forwarded) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwarded[0]))
+		}
+		return ctx
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "no peer", ctx: context.Background(), want: "<nil>"},
+		{name: "direct client", ctx: from("203.0.113.7"), want: "203.0.113.7"},
+		{name: "local proxy", ctx: from("127.0.0.1", "198.51.100.1, 203.0.113.7"), want: "203.0.113.7"},
+		{name: "remote client cannot spoof", ctx: from("203.0.113.7", "198.51.100.1"), want: "203.0.113.7"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, requestctx.ClientIP(tt.ctx).String())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/repository"
+	"go.uber.org/zap"
+)
+
+// Repository хранилище семейств refresh-токенов
+type Repository interface {
+	IsRefreshTokenFamilyRevoked(ctx context.Context, familyID string) (bool, error)
+}
+
+// Checker проверяет, завершена ли сессия (семейство refresh-токенов). Один и тот же Checker
+// используют интерцептор авторизации и сервис auth, чтобы отзыв сессии действовал на все методы.
+type Checker struct {
+	cache repository.CacheInterface
+	repo  Repository
+}
+
+// NewChecker создает новый экземпляр Checker
+func NewChecker(cache repository.CacheInterface, repo Repository) *Checker {
+	return &Checker{cache: cache, repo: repo}
+}
+
+// IsRevoked сообщает, завершена ли сессия. При промахе или недоступности кэша ответ дает Postgres,
+// и он кэшируется. Активное состояние пишется с NX и коротким TTL, а отзыв безусловно,
+// поэтому параллельный Logout не может быть перетерт записью «активно».
+func (c *Checker) IsRevoked(ctx context.Context, familyID string) (bool, error) {
+	revoked, errCache := c.cache.GetRevokedFamily(ctx, familyID)
+	switch {
+	case errCache == nil:
+		return revoked, nil
+	case errors.Is(errCache, model.ErrCacheUnavailable):
+		logger.Debug("revocation cache is unavailable, using database", zap.String("family_id", familyID))
+	case !errors.Is(errCache, model.ErrCacheMiss):
+		logger.Warn("revocation cache error, using database", zap.String("family_id", familyID), zap.Error(errCache))
+	}
+
+	revoked, err := c.repo.IsRefreshTokenFamilyRevoked(ctx, familyID)
+	if err != nil {
+		return false, fmt.Errorf("error checking token revocation: %w", err)
+	}
+	switch {
+	case errors.Is(errCache, model.ErrCacheUnavailable):
+	case revoked:
+		c.Remember(ctx, familyID)
+	default:
+		if err = c.cache.CreateActiveFamily(ctx, familyID); err != nil {
+			logger.Warn("failed to cache active token family", zap.String("family_id", familyID), zap.Error(err))
+		}
+	}
+
+	return revoked, nil
+}
+
+// Remember переносит в кэш отзыв, уже зафиксированный в Postgres.
+// Ошибка кэша не фатальна: без записи в кэше отзыв читается из базы.
+func (c *Checker) Remember(ctx context.Context, familyIDs ...string) {
+	for _, familyID := range familyIDs {
+		if err := c.cache.CreateRevokedFamily(ctx, familyID); err != nil {
+			logger.Warn("failed to cache token revocation", zap.String("family_id", familyID), zap.Error(err))
+		}
+	}
+}
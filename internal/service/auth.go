@@ -9,7 +9,15 @@ import (
 // AuthService интерфейс для работы с авторизацией
 type AuthService interface {
 	Check(ctx context.Context, request model.CheckRequest) error
+	CheckMany(ctx context.Context, request model.CheckManyRequest) ([]model.EndpointDecision, error)
+	ExplainAccess(ctx context.Context, request model.ExplainAccessRequest) (*model.EndpointDecision, error)
 	Login(ctx context.Context, request model.LoginRequest) (*model.LoginResponse, error)
 	GetRefreshToken(ctx context.Context, request model.GetRefreshTokenRequest) (*model.GetRefreshTokenResponse, error)
 	GetAccessToken(ctx context.Context, req model.GetAccessTokenRequest) (*model.GetAccessTokenResponse, error)
+	Logout(ctx context.Context, req model.LogoutRequest) error
+	LogoutAll(ctx context.Context, req model.LogoutAllRequest) error
+	UnlockLogin(ctx context.Context, req model.UnlockLoginRequest) error
+	EnrollTOTP(ctx context.Context) (*model.EnrollTOTPResponse, error)
+	ConfirmTOTP(ctx context.Context, code string) ([]string, error)
+	VerifyMFA(ctx context.Context, req model.VerifyMFARequest) (*model.LoginResponse, error)
 }
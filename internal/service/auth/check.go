@@ -2,61 +2,183 @@ package auth
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"strings"
 
+	"github.com/Ippolid/auth/internal/access"
+	"github.com/Ippolid/auth/internal/logger"
 	"github.com/Ippolid/auth/internal/model"
 	"github.com/Ippolid/auth/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+// errorDomain домен причин в errdetails.ErrorInfo
+const errorDomain = "auth"
+
+// Причины отказа в аутентификации для errdetails.ErrorInfo
+const (
+	reasonMetadataMissing = "METADATA_MISSING"
+	reasonHeaderMissing   = "AUTHORIZATION_HEADER_MISSING"
+	reasonHeaderInvalid   = "AUTHORIZATION_HEADER_INVALID"
+	reasonTokenInvalid    = "TOKEN_INVALID"
+	reasonTokenRevoked    = "TOKEN_REVOKED"
+)
+
+const accessDeniedMessage = "access denied for this endpoint"
+
 func (s *serv) Check(ctx context.Context, request model.CheckRequest) error {
+	claims, err := s.callerClaims(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Текущая политика доступа; подменяется атомарно при перезагрузке правил
+	decision := decide(s.access.Policy(), request.EndpointAddress, claims.Roles)
+	if !decision.Allowed {
+		// В доступе отказано: объясняем, какое правило сработало и каких ролей не хватило
+		return deniedError(decision)
+	}
+
+	return nil
+}
+
+// callerClaims извлекает access-токен из метаданных, проверяет его и то,
+// что сессия, в рамках которой он выдан, не завершена
+func (s *serv) callerClaims(ctx context.Context) (*model.UserClaims, error) {
 	// Извлекаем метаданные из контекста
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return errors.New("metadata is not provided")
+		return nil, unauthenticatedError(reasonMetadataMissing, "metadata is not provided")
 	}
 
 	// Проверяем наличие заголовка авторизации
 	authHeader, ok := md["authorization"]
 	if !ok || len(authHeader) == 0 {
-		return errors.New("authorization header is not provided")
+		return nil, unauthenticatedError(reasonHeaderMissing, "authorization header is not provided")
 	}
 
 	// Проверяем формат заголовка авторизации
 	if !strings.HasPrefix(authHeader[0], "Bearer ") {
-		return errors.New("invalid authorization header format")
+		return nil, unauthenticatedError(reasonHeaderInvalid, "invalid authorization header format")
 	}
 
 	// Извлекаем токен доступа
 	accessToken := strings.TrimPrefix(authHeader[0], "Bearer ")
 
 	// Проверяем токен и извлекаем claims
-	claims, err := utils.VerifyToken(accessToken, []byte(s.token.AccessToken()))
+	claims, err := utils.VerifyToken(accessToken, s.keys.Access())
 	if err != nil {
-		return fmt.Errorf("access token is invalid: %w", err)
+		// Причина остается в журнале: подробности проверки подписи клиенту не нужны
+		logger.Debug("access token verification failed", zap.Error(err))
+		return nil, unauthenticatedError(reasonTokenInvalid, model.ErrAccessTokenInvalid.Error())
 	}
 
-	// Получаем карту доступных ролей для эндпоинтов
-	accessibleMap, err := s.accessibleRoles(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get accessible roles: %w", err)
+	// Проверяем, не завершена ли сессия, в рамках которой выдан токен
+	if claims.FamilyID != "" {
+		revoked, errRevoked := s.revocations.IsRevoked(ctx, claims.FamilyID)
+		if errRevoked != nil {
+			logger.Error("failed to check token revocation", zap.Error(errRevoked))
+			return nil, status.Error(codes.Internal, "failed to check token revocation")
+		}
+		if revoked {
+			return nil, unauthenticatedError(reasonTokenRevoked, "access token is invalid: "+model.ErrTokenRevoked.Error())
+		}
 	}
 
-	// Проверяем, есть ли запрашиваемый эндпоинт в карте доступа
-	requiredRole, ok := accessibleMap[request.EndpointAddress]
-	if !ok {
-		// Эндпоинт не требует проверки доступа
-		return nil
+	return claims, nil
+}
+
+// decide принимает решение о доступе к эндпоинту для ролей пользователя
+func decide(policy *access.Policy, endpoint string, roles []string) model.EndpointDecision {
+	decision := model.EndpointDecision{
+		EndpointAddress: endpoint,
+		CallerRoles:     roles,
+	}
+
+	// Самое конкретное подошедшее правило решает, разрешен ли эндпоинт ролям пользователя
+	evaluated := policy.Evaluate(endpoint, roles)
+
+	// Явный запрет не снимается ни ролью администратора, ни правами из таблицы access
+	if evaluated.Final {
+		decision.Rule = evaluated.Rule
+		decision.Reason = model.ReasonRuleDeny
+		return decision
+	}
+
+	// Остальные эндпоинты администратору доступны
+	if model.HasRole(roles, model.RoleAdmin) {
+		decision.Allowed = true
+		decision.Reason = model.ReasonAdmin
+		return decision
+	}
+
+	decision.Rule = evaluated.Rule
+	if evaluated.Rule != nil && evaluated.Rule.Effect == model.AccessAllow {
+		decision.RequiredRoles = evaluated.Rule.Roles
+	}
+
+	switch {
+	case evaluated.Allowed && evaluated.Rule != nil:
+		decision.Allowed = true
+		decision.Reason = model.ReasonRuleAllow
+		return decision
+	case evaluated.Allowed:
+		decision.Allowed = true
+		decision.Reason = model.ReasonDefaultAllow
+		return decision
 	}
 
-	// Проверяем, соответствует ли роль пользователя требуемой роли
-	if requiredRole == claims.Role || claims.Role == "admin" {
-		// Пользователь имеет нужную роль или является администратором
-		return nil
+	// Права, выданные ролям пользователя в таблице access
+	if grant := policy.Grant(endpoint, roles); grant != nil {
+		decision.Allowed = true
+		decision.Reason = model.ReasonAccessGrant
+		decision.Grant = grant
+		return decision
+	}
+
+	decision.Reason = model.ReasonRoleNotAllowed
+	if evaluated.Rule == nil {
+		decision.Reason = model.ReasonDefaultDeny
+	}
+
+	return decision
+}
+
+// deniedError ошибка PermissionDenied с объяснением решения в errdetails.ErrorInfo
+func deniedError(decision model.EndpointDecision) error {
+	info := &errdetails.ErrorInfo{
+		Reason: strings.ToUpper(decision.Reason),
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"endpoint":     decision.EndpointAddress,
+			"caller_roles": strings.Join(decision.CallerRoles, ","),
+		},
+	}
+	if decision.Rule != nil {
+		info.Metadata["rule"] = decision.Rule.Pattern
+		info.Metadata["effect"] = decision.Rule.Effect
+		info.Metadata["required_roles"] = strings.Join(decision.RequiredRoles, ",")
+	}
+
+	return withDetails(status.New(codes.PermissionDenied, accessDeniedMessage), info)
+}
+
+// unauthenticatedError ошибка Unauthenticated с причиной в errdetails.ErrorInfo
+func unauthenticatedError(reason, message string) error {
+	return withDetails(status.New(codes.Unauthenticated, message), &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+	})
+}
+
+func withDetails(st *status.Status, info *errdetails.ErrorInfo) error {
+	detailed, err := st.WithDetails(info)
+	if err != nil {
+		return st.Err()
 	}
 
-	// В доступе отказано
-	return errors.New("access denied for this endpoint")
+	return detailed.Err()
 }
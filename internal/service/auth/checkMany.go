@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/model"
+)
+
+// CheckMany проверяет доступ к нескольким эндпоинтам. Токен разбирается и проверяется один раз,
+// а все эндпоинты оцениваются по одной и той же версии политики.
+// Ошибка возвращается только для невалидного токена; отказ в доступе — это результат, а не ошибка.
+func (s *serv) CheckMany(ctx context.Context, request model.CheckManyRequest) ([]model.EndpointDecision, error) {
+	claims, err := s.callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := s.access.Policy()
+
+	decisions := make([]model.EndpointDecision, 0, len(request.EndpointAddresses))
+	for _, endpoint := range request.EndpointAddresses {
+		decisions = append(decisions, decide(policy, endpoint, claims.Roles))
+	}
+
+	return decisions, nil
+}
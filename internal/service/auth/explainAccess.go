@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/model"
+)
+
+// ExplainAccess пробная проверка политики для администратора: показывает, какое решение получил бы
+// пользователь (или набор ролей) для эндпоинта и почему. Сам доступ не проверяется и не выдается.
+// Права администратора проверяет интерцептор авторизации.
+func (s *serv) ExplainAccess(ctx context.Context, request model.ExplainAccessRequest) (*model.EndpointDecision, error) {
+	roles := request.Roles
+	if request.Username != "" {
+		var err error
+		roles, err = s.authRepository.GetUserRoles(ctx, request.Username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	decision := decide(s.access.Policy(), request.EndpointAddress, roles)
+
+	return &decision, nil
+}
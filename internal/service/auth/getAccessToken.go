@@ -6,64 +6,60 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/metric"
 	"github.com/Ippolid/auth/internal/model"
 	"github.com/Ippolid/auth/internal/utils"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// rolesCacheName метка кэша ролей в метриках
+	rolesCacheName = "roles"
+	// loadTimeout сколько ждать общей загрузки ролей из базы
+	loadTimeout = 5 * time.Second
 )
 
 func (s *serv) GetAccessToken(ctx context.Context, req model.GetAccessTokenRequest) (*model.GetAccessTokenResponse, error) {
-	claims, err := utils.VerifyToken(req.RefreshToken, []byte(s.token.RefreshToken()))
+	claims, err := utils.VerifyToken(req.RefreshToken, s.keys.Refresh())
 	if err != nil {
-		return nil, status.Errorf(codes.Aborted, "invalid refresh token")
+		return nil, model.ErrInvalidRefreshToken
 	}
 
-	var userRole bool
-	rolePtr, errCache := s.cache.GetRole(ctx, claims.Username)
-
-	if errCache != nil {
-		if errors.Is(errCache, model.ErrUserNotFound) {
-			err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-				var errTx error
-				userRole, errTx = s.authRepository.GetUserRole(ctx, claims.Username)
-				if errTx != nil {
-					return fmt.Errorf("error getting user role: %w", errTx)
-				}
-
-				errTx = s.authRepository.MakeLog(ctx, model.Log{
-					Method:    "Get user Role",
-					CreatedAt: time.Now(),
-					Ctx:       fmt.Sprintf("%v", ctx),
-				})
-				if errTx != nil {
-					return fmt.Errorf("error creating log: %w", errTx)
-				}
-
-				if errTx = s.cache.CreateRole(ctx, claims.Username, userRole); errTx != nil {
-					return fmt.Errorf("error caching user role: %w", errTx)
-				}
-
-				return nil
-			})
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			// Только если ошибка не ErrUserNotFound, возвращаем ошибку кеша
-			return nil, fmt.Errorf("error with cache: %w", errCache)
+	if claims.FamilyID != "" {
+		revoked, errRevoked := s.revocations.IsRevoked(ctx, claims.FamilyID)
+		if errRevoked != nil {
+			return nil, errRevoked
+		}
+		if revoked {
+			return nil, model.ErrTokenRevoked
 		}
-	} else if rolePtr != nil {
-		// Если роль успешно получена из кеша
-		userRole = *rolePtr
-	} else {
-		return nil, fmt.Errorf("роль пользователя не найдена")
 	}
 
+	if claims.Limited {
+		// Сессия без подтвержденного email: роли не подгружаем, чтобы не расширить доступ
+		return s.generateAccessToken(claims, []string{model.RoleUser})
+	}
+
+	userRoles, err := s.userRoles(ctx, claims.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.generateAccessToken(claims, userRoles)
+}
+
+func (s *serv) generateAccessToken(claims *model.UserClaims, roles []string) (*model.GetAccessTokenResponse, error) {
 	accessToken, err := utils.GenerateToken(model.UserInfoJwt{
 		Username: claims.Username,
-		Role:     userRole,
+		Roles:    roles,
+		// Access-токен наследует семейство, чтобы Check видел завершение сессии
+		FamilyID: claims.FamilyID,
+		Limited:  claims.Limited,
+		UserID:   claims.UserID,
 	},
-		[]byte(s.token.AccessToken()),
+		s.keys.Access().Active,
 		accessTokenExpiration,
 	)
 	if err != nil {
@@ -72,3 +68,96 @@ func (s *serv) GetAccessToken(ctx context.Context, req model.GetAccessTokenReque
 
 	return &model.GetAccessTokenResponse{AccessToken: accessToken}, nil
 }
+
+// userRoles возвращает роли пользователя из кэша, а при промахе — из базы. Одновременные промахи
+// по одному имени ждут один запрос в базу; отсутствие пользователя кэшируется на короткое время.
+func (s *serv) userRoles(ctx context.Context, username string) ([]string, error) {
+	roles, errCache := s.cache.GetRoles(ctx, username)
+	switch {
+	case errCache == nil:
+		metric.IncCacheLookupCounter(rolesCacheName, "hit")
+		return roles, nil
+	case errors.Is(errCache, model.ErrCachedNotFound):
+		metric.IncCacheLookupCounter(rolesCacheName, "negative_hit")
+		return nil, fmt.Errorf("error getting user roles: %w", model.ErrUserNotFound)
+	case !errors.Is(errCache, model.ErrUserNotFound):
+		// Кэш необязателен: при его недоступности читаем роли из базы
+		cacheUnavailable(errCache, zap.String("username", username))
+	}
+
+	// Запрос общий, поэтому идет в контексте без отмены первого вызывающего,
+	// а каждый вызывающий ждет его не дольше своего ctx
+	loaded := false
+	load := s.loads.DoChan(username, func() (interface{}, error) {
+		loaded = true
+		metric.IncCacheLookupCounter(rolesCacheName, "miss")
+
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+		defer cancel()
+
+		return s.loadUserRoles(loadCtx, username)
+	})
+
+	var res singleflight.Result
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res = <-load:
+	}
+	if !loaded {
+		metric.IncCacheLookupCounter(rolesCacheName, "coalesced")
+	}
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	return res.Val.([]string), nil
+}
+
+// loadUserRoles читает роли из базы и кэширует их после коммита; ошибки кэша не фатальны
+func (s *serv) loadUserRoles(ctx context.Context, username string) ([]string, error) {
+	var roles []string
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		roles, errTx = s.authRepository.GetUserRoles(ctx, username)
+		if errTx != nil {
+			return fmt.Errorf("error getting user roles: %w", errTx)
+		}
+
+		errTx = s.authRepository.MakeLog(ctx, model.Log{
+			Method:    "Get user Role",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+		if errTx != nil {
+			return fmt.Errorf("error creating log: %w", errTx)
+		}
+
+		return nil
+	})
+	if errors.Is(err, model.ErrUserNotFound) {
+		if errCache := s.cache.CreateRolesNotFound(ctx, username); errCache != nil {
+			cacheUnavailable(errCache, zap.String("username", username))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if errCache := s.cache.CreateRoles(ctx, username, roles); errCache != nil {
+		cacheUnavailable(errCache, zap.String("username", username))
+	}
+
+	return roles, nil
+}
+
+// cacheUnavailable логирует ошибку кэша, после которой запрос обслуживается из базы.
+// Пока Redis признан недоступным, ошибка ожидаема и пишется только в debug.
+func cacheUnavailable(err error, fields ...zap.Field) {
+	fields = append(fields, zap.Error(err))
+	if errors.Is(err, model.ErrCacheUnavailable) {
+		logger.Debug("auth cache is unavailable, using database", fields...)
+		return
+	}
+	logger.Warn("auth cache error, using database", fields...)
+}
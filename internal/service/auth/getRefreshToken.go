@@ -2,29 +2,84 @@ package auth
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/Ippolid/auth/internal/logger"
 	"github.com/Ippolid/auth/internal/model"
 	"github.com/Ippolid/auth/internal/utils"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"go.uber.org/zap"
 )
 
-func (s *serv) GetRefreshToken(_ context.Context, req model.GetRefreshTokenRequest) (*model.GetRefreshTokenResponse, error) {
-	claims, err := utils.VerifyToken(req.OldToken, []byte(s.token.RefreshToken()))
+func (s *serv) GetRefreshToken(ctx context.Context, req model.GetRefreshTokenRequest) (*model.GetRefreshTokenResponse, error) {
+	claims, err := utils.VerifyToken(req.OldToken, s.keys.Refresh())
 	if err != nil {
-		return nil, status.Errorf(codes.Aborted, "invalid refresh token")
+		return nil, model.ErrInvalidRefreshToken
 	}
 
-	refreshToken, err := utils.GenerateToken(model.UserInfoJwt{
-		Username: claims.Username,
-		Role:     claims.Role == "1" || claims.Role == "true",
-	},
-		[]byte(s.token.RefreshToken()),
-		refreshTokenExpiration,
+	// Токены без jti и семейства выпущены до введения ротации и не могут быть обменяны
+	if claims.ID == "" || claims.FamilyID == "" {
+		return nil, model.ErrInvalidRefreshToken
+	}
+
+	var (
+		refreshToken string
+		reused       bool
 	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		rotated, errTx := s.authRepository.MarkRefreshTokenRotated(ctx, claims.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !rotated {
+			familyRevoked, errTx := s.authRepository.IsRefreshTokenFamilyRevoked(ctx, claims.FamilyID)
+			if errTx != nil {
+				return errTx
+			}
+			if familyRevoked {
+				// Сессия уже завершена (logout или ранее обнаруженная кража)
+				return model.ErrTokenRevoked
+			}
+
+			// Токен уже был обменян: им пользуется кто-то еще, поэтому отзываем всё семейство
+			reused = true
+			return s.authRepository.RevokeRefreshTokenFamily(ctx, claims.FamilyID)
+		}
+
+		errTx = s.authRepository.MakeLog(ctx, model.Log{
+			Method:    "GetRefreshToken",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+		if errTx != nil {
+			return fmt.Errorf("error creating log: %w", errTx)
+		}
+
+		refreshToken, errTx = s.issueRefreshToken(ctx, model.UserInfoJwt{
+			Username: claims.Username,
+			Roles:    claims.Roles,
+			Limited:  claims.Limited,
+			UserID:   claims.UserID,
+		}, claims.FamilyID)
+
+		return errTx
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	if reused {
+		s.revocations.Remember(ctx, claims.FamilyID)
+
+		logger.Warn("refresh token reuse detected, token family revoked (suspected theft)",
+			zap.String("username", claims.Username),
+			zap.String("family_id", claims.FamilyID),
+			zap.String("jti", claims.ID),
+		)
+
+		return nil, model.ErrRefreshTokenReused
+	}
+
 	return &model.GetRefreshTokenResponse{RefreshToken: refreshToken}, nil
 }
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/utils"
+)
+
+// issueRefreshToken выпускает новый refresh-токен в семействе familyID и сохраняет его.
+// Пустой familyID означает начало нового семейства (новая сессия).
+func (s *serv) issueRefreshToken(ctx context.Context, user model.UserInfoJwt, familyID string) (string, error) {
+	tokenID, err := utils.NewTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	if familyID == "" {
+		familyID, err = utils.NewTokenID()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	user.TokenID = tokenID
+	user.FamilyID = familyID
+
+	refreshToken, err := utils.GenerateToken(user,
+		s.keys.Refresh().Active,
+		refreshTokenExpiration,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	err = s.authRepository.CreateRefreshToken(ctx, model.RefreshToken{
+		ID:        tokenID,
+		FamilyID:  familyID,
+		Username:  user.Username,
+		ExpiresAt: time.Now().Add(refreshTokenExpiration),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return refreshToken, nil
+}
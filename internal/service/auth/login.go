@@ -6,17 +6,31 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Ippolid/auth/internal/config"
+	"github.com/Ippolid/auth/internal/loginguard"
 	"github.com/Ippolid/auth/internal/model"
-	"github.com/Ippolid/auth/internal/utils"
 )
 
 func (s *serv) Login(ctx context.Context, req model.LoginRequest) (*model.LoginResponse, error) {
+	subjects := s.loginGuard.Subjects(ctx, req.Username)
+	if err := s.loginGuard.CheckBlocked(ctx, subjects); err != nil {
+		return nil, err
+	}
+
 	var resp model.LoginResponse
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		user, errTx := s.authRepository.Login(ctx, req)
 		if errTx != nil {
 			return errTx
 		}
+		if errTx = s.applyUnverifiedLogin(user); errTx != nil {
+			return errTx
+		}
+
+		needMFA, errTx := s.mfaRequired(ctx, user.UserID)
+		if errTx != nil {
+			return errTx
+		}
 
 		err1 := s.authRepository.MakeLog(ctx, model.Log{
 			Method:    "Login",
@@ -28,13 +42,15 @@ func (s *serv) Login(ctx context.Context, req model.LoginRequest) (*model.LoginR
 			return err1
 		}
 
-		refreshToken, err := utils.GenerateToken(*user,
-			[]byte(s.token.RefreshToken()),
-			refreshTokenExpiration,
-		)
+		if needMFA {
+			// Пароль верен, но refresh-токен выдаст только VerifyMFA
+			resp.MFAToken, errTx = s.issueMFAChallenge(ctx, *user)
+			return errTx
+		}
 
+		refreshToken, err := s.issueRefreshToken(ctx, *user, "")
 		if err != nil {
-			return errors.New("failed to generate token")
+			return err
 		}
 
 		resp.Token = refreshToken
@@ -42,9 +58,38 @@ func (s *serv) Login(ctx context.Context, req model.LoginRequest) (*model.LoginR
 		return nil
 	})
 
+	if errors.Is(err, model.ErrUserNotFound) {
+		// Пользователь удален между проверкой пароля и загрузкой ролей: отвечаем как на неверный пароль
+		err = model.ErrInvalidCredentials
+	}
 	if err != nil {
+		if loginguard.IsFailure(err) {
+			s.loginGuard.RegisterFailure(ctx, subjects)
+		}
 		return nil, err
 	}
 
+	if resp.MFAToken == "" {
+		// С включенным TOTP счетчик сбрасывает VerifyMFA: перебор кодов тоже считается неудачей
+		s.loginGuard.Reset(ctx, subjects)
+	}
+
 	return &resp, nil
 }
+
+// applyUnverifiedLogin применяет политику входа для пользователя без подтвержденного email
+func (s *serv) applyUnverifiedLogin(user *model.UserInfoJwt) error {
+	if user.EmailVerified || s.emailVerification == nil {
+		return nil
+	}
+
+	switch s.emailVerification.UnverifiedLogin() {
+	case config.UnverifiedLoginDeny:
+		return model.ErrEmailNotVerified
+	case config.UnverifiedLoginLimited:
+		user.Roles = []string{model.RoleUser}
+		user.Limited = true
+	}
+
+	return nil
+}
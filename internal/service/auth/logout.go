@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/utils"
+)
+
+func (s *serv) Logout(ctx context.Context, req model.LogoutRequest) error {
+	claims, err := utils.VerifyToken(req.RefreshToken, s.keys.Refresh())
+	if err != nil || claims.FamilyID == "" {
+		return model.ErrInvalidRefreshToken
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.authRepository.RevokeRefreshTokenFamily(ctx, claims.FamilyID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.authRepository.MakeLog(ctx, model.Log{
+			Method:    "Logout",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+		if errTx != nil {
+			return fmt.Errorf("error creating log: %w", errTx)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.revocations.Remember(ctx, claims.FamilyID)
+
+	return nil
+}
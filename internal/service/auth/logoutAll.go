@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/utils"
+)
+
+func (s *serv) LogoutAll(ctx context.Context, req model.LogoutAllRequest) error {
+	claims, err := utils.VerifyToken(req.RefreshToken, s.keys.Refresh())
+	if err != nil || claims.FamilyID == "" {
+		return model.ErrInvalidRefreshToken
+	}
+
+	revoked, err := s.revocations.IsRevoked(ctx, claims.FamilyID)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return model.ErrTokenRevoked
+	}
+
+	var families []string
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		families, errTx = s.authRepository.RevokeUserRefreshTokens(ctx, claims.Username, "")
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.authRepository.MakeLog(ctx, model.Log{
+			Method:    "LogoutAll",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+		if errTx != nil {
+			return fmt.Errorf("error creating log: %w", errTx)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.revocations.Remember(ctx, families...)
+
+	return nil
+}
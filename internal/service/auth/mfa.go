@@ -0,0 +1,291 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Ippolid/auth/internal/loginguard"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/totp"
+	"github.com/Ippolid/auth/internal/utils"
+)
+
+const (
+	// mfaChallengeExpiration сколько действует токен второго шага входа
+	mfaChallengeExpiration = 5 * time.Minute
+	// mfaMaxAttempts сколько неверных кодов принимается по одному токену второго шага
+	mfaMaxAttempts = 5
+
+	recoveryCodeCount = 10
+	// recoveryCodeBytes 80 бит случайности: 16 символов base32, выдаются группами по 4
+	recoveryCodeBytes = 10
+	recoveryCodeGroup = 4
+
+	defaultTOTPIssuer = "auth"
+)
+
+// EnrollTOTP начинает подключение TOTP: создает секрет и ссылку otpauth:// для приложения-аутентификатора.
+// TOTP включается только после ConfirmTOTP; повторный вызов до подтверждения заменяет секрет.
+func (s *serv) EnrollTOTP(ctx context.Context) (*model.EnrollTOTPResponse, error) {
+	claims, err := s.callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		userID, errTx := s.authRepository.GetUserID(ctx, claims.Username)
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.authRepository.SaveTOTPSecret(ctx, userID, secret); errTx != nil {
+			return errTx
+		}
+
+		return s.authRepository.MakeLog(ctx, model.Log{
+			Method:    "EnrollTOTP",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.EnrollTOTPResponse{
+		Secret: secret,
+		URI:    totp.URI(s.totpIssuer(), claims.Username, secret),
+	}, nil
+}
+
+// ConfirmTOTP включает TOTP по первому коду из приложения и возвращает коды восстановления.
+// Коды показываются один раз, в базе хранятся только их хеши.
+func (s *serv) ConfirmTOTP(ctx context.Context, code string) ([]string, error) {
+	claims, err := s.callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		userID, errTx := s.authRepository.GetUserID(ctx, claims.Username)
+		if errTx != nil {
+			return errTx
+		}
+
+		setup, errTx := s.authRepository.GetTOTP(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+		if setup.ConfirmedAt != nil {
+			return model.ErrTOTPAlreadyEnabled
+		}
+
+		step, ok := totp.Validate(setup.Secret, code, time.Now())
+		if !ok {
+			return model.ErrInvalidMFACode
+		}
+
+		if errTx = s.authRepository.ConfirmTOTP(ctx, userID, step); errTx != nil {
+			return errTx
+		}
+		if errTx = s.authRepository.ReplaceRecoveryCodes(ctx, userID, hashes); errTx != nil {
+			return errTx
+		}
+
+		return s.authRepository.MakeLog(ctx, model.Log{
+			Method:    "ConfirmTOTP",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// VerifyMFA завершает вход: обменивает токен второго шага и код TOTP или код восстановления
+// на refresh-токен. Неверные коды считаются и по токену, и защитой Login от перебора.
+func (s *serv) VerifyMFA(ctx context.Context, req model.VerifyMFARequest) (*model.LoginResponse, error) {
+	tokenHash := utils.HashToken(req.MFAToken)
+
+	var (
+		resp     model.LoginResponse
+		subjects []loginguard.Subject
+		rejected bool
+	)
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// Вход блокируется до конца транзакции, поэтому параллельные запросы не превысят лимит попыток
+		challenge, errTx := s.authRepository.GetMFAChallenge(ctx, tokenHash)
+		if errTx != nil {
+			return errTx
+		}
+		if challenge.Attempts >= mfaMaxAttempts {
+			return model.ErrInvalidMFAChallenge
+		}
+
+		subjects = s.loginGuard.Subjects(ctx, challenge.Username)
+		if errTx = s.loginGuard.CheckBlocked(ctx, subjects); errTx != nil {
+			return errTx
+		}
+
+		errTx = s.checkSecondFactor(ctx, challenge.UserID, req.Code)
+		if errors.Is(errTx, model.ErrInvalidMFACode) {
+			// Попытку нужно сохранить, поэтому транзакция завершается без ошибки
+			rejected = true
+			return s.authRepository.IncMFAChallengeAttempts(ctx, tokenHash)
+		}
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.authRepository.UseMFAChallenge(ctx, tokenHash); errTx != nil {
+			return errTx
+		}
+
+		roles, errTx := s.authRepository.GetUserRoles(ctx, challenge.Username)
+		if errTx != nil {
+			return errTx
+		}
+
+		user := model.UserInfoJwt{UserID: challenge.UserID, Username: challenge.Username, Roles: roles}
+		if challenge.Limited {
+			user.Roles = []string{model.RoleUser}
+			user.Limited = true
+		}
+
+		errTx = s.authRepository.MakeLog(ctx, model.Log{
+			Method:    "VerifyMFA",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		resp.Token, errTx = s.issueRefreshToken(ctx, user, "")
+
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rejected {
+		s.loginGuard.RegisterFailure(ctx, subjects)
+		return nil, model.ErrInvalidMFACode
+	}
+
+	s.loginGuard.Reset(ctx, subjects)
+
+	return &resp, nil
+}
+
+// checkSecondFactor принимает код TOTP, а если он не подошел — код восстановления.
+// Каждый код действует один раз. Вызывается внутри транзакции.
+func (s *serv) checkSecondFactor(ctx context.Context, userID int64, code string) error {
+	setup, err := s.authRepository.GetTOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if step, ok := totp.Validate(setup.Secret, code, time.Now()); ok {
+		return s.authRepository.UseTOTPStep(ctx, userID, step)
+	}
+
+	return s.authRepository.UseRecoveryCode(ctx, userID, utils.HashToken(normalizeRecoveryCode(code)))
+}
+
+// mfaRequired включен ли у пользователя подтвержденный TOTP. Вызывается внутри транзакции.
+func (s *serv) mfaRequired(ctx context.Context, userID int64) (bool, error) {
+	setup, err := s.authRepository.GetTOTP(ctx, userID)
+	if errors.Is(err, model.ErrTOTPNotEnrolled) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return setup.ConfirmedAt != nil, nil
+}
+
+// issueMFAChallenge создает токен второго шага входа. Токен возвращается только клиенту,
+// в базе хранится его хеш.
+func (s *serv) issueMFAChallenge(ctx context.Context, user model.UserInfoJwt) (string, error) {
+	token, err := utils.NewTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	err = s.authRepository.CreateMFAChallenge(ctx, model.MFAChallenge{
+		TokenHash: utils.HashToken(token),
+		UserID:    user.UserID,
+		Limited:   user.Limited,
+		ExpiresAt: time.Now().Add(mfaChallengeExpiration),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (s *serv) totpIssuer() string {
+	if s.mfa == nil {
+		return defaultTOTPIssuer
+	}
+
+	return s.mfa.Issuer()
+}
+
+// newRecoveryCodes создает коды восстановления и их хеши
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+
+	buf := make([]byte, recoveryCodeBytes)
+	for i := 0; i < recoveryCodeCount; i++ {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+
+		raw := strings.ToLower(base32.StdEncoding.EncodeToString(buf))
+
+		groups := make([]string, 0, len(raw)/recoveryCodeGroup)
+		for j := 0; j < len(raw); j += recoveryCodeGroup {
+			groups = append(groups, raw[j:j+recoveryCodeGroup])
+		}
+
+		codes = append(codes, strings.Join(groups, "-"))
+		hashes = append(hashes, utils.HashToken(raw))
+	}
+
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode приводит введенный код к виду, от которого считался хеш:
+// без разделителей и пробелов, в нижнем регистре
+func normalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+}
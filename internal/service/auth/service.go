@@ -3,10 +3,15 @@ package auth
 import (
 	"time"
 
+	"github.com/Ippolid/auth/internal/access"
 	"github.com/Ippolid/auth/internal/config"
+	"github.com/Ippolid/auth/internal/keyring"
+	"github.com/Ippolid/auth/internal/loginguard"
 	"github.com/Ippolid/auth/internal/repository"
+	"github.com/Ippolid/auth/internal/revocation"
 	"github.com/Ippolid/auth/internal/service"
 	"github.com/Ippolid/platform_libary/pkg/db"
+	"golang.org/x/sync/singleflight"
 )
 
 const (
@@ -18,23 +23,42 @@ type serv struct {
 	authRepository repository.AuthRepository
 	txManager      db.TxManager
 	cache          repository.CacheInterface
-	token          config.JWTConfig
-	access         config.AccessConfig
+	keys           keyring.Source
+	access         access.Source
+	revocations    *revocation.Checker
+	loginGuard     *loginguard.Guard
+
+	emailVerification config.EmailVerificationConfig
+	mfa               config.MFAConfig
+
+	// loads объединяет одновременные загрузки ролей из базы при промахе кэша
+	loads singleflight.Group
 }
 
-// NewService создает новый экземпляр AuthService
+// NewService создает новый экземпляр AuthService.
+// loginProtection задает защиту Login от перебора паролей; nil — защита выключена.
+// emailVerification задает политику входа без подтвержденного email; nil — вход разрешен.
+// mfa задает параметры TOTP; nil — значения по умолчанию.
 func NewService(
 	authRepository repository.AuthRepository,
 	txManager db.TxManager,
 	cache repository.CacheInterface,
-	token config.JWTConfig,
-	access config.AccessConfig,
+	keys keyring.Source,
+	access access.Source,
+	loginProtection config.LoginProtectionConfig,
+	emailVerification config.EmailVerificationConfig,
+	mfa config.MFAConfig,
 ) service.AuthService {
 	return &serv{
 		authRepository: authRepository,
 		txManager:      txManager,
 		cache:          cache,
-		token:          token,
+		keys:           keys,
 		access:         access,
+		revocations:    revocation.NewChecker(cache, authRepository),
+		loginGuard:     loginguard.NewGuard(cache, loginProtection),
+
+		emailVerification: emailVerification,
+		mfa:               mfa,
 	}
 }
@@ -0,0 +1,261 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/access"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/service/auth"
+	"github.com/Ippolid/auth/internal/service/mocks"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheck(t *testing.T) {
+	const (
+		adminEndpoint   = "/v1/user/delete"
+		supportEndpoint = "/v1/user/get"
+		publicEndpoint  = "/v1/public"
+		chatEndpoint    = "/chat_server_v1.ChatV1/Send"
+		chatDelete      = "/chat_server_v1.ChatV1/Delete"
+		reportEndpoint  = "/report_v1.ReportV1/Export"
+		debugEndpoint   = "/debug_v1.DebugV1/Dump"
+	)
+
+	rules := []model.AccessRule{
+		{Pattern: adminEndpoint, Effect: model.AccessAllow, Roles: []string{model.RoleAdmin}},
+		{Pattern: supportEndpoint, Effect: model.AccessAllow, Roles: []string{model.RoleAdmin, "support"}},
+		{Pattern: "/chat_server_v1.ChatV1/*", Effect: model.AccessAllow},
+		{Pattern: chatDelete, Effect: model.AccessAllow, Roles: []string{"moderator"}},
+		{Pattern: "/report_v1.**", Effect: model.AccessAllow, Roles: []string{"billing"}},
+		{Pattern: reportEndpoint, Effect: model.AccessDeny, Roles: []string{"intern"}},
+		{Pattern: "/debug_v1.**", Effect: model.AccessDeny},
+	}
+	grants := []model.RolePermission{
+		{Role: "billing", Endpoint: adminEndpoint},
+		{Role: "support", Endpoint: "/chat_server_v1.ChatV1/**"},
+		{Role: "intern", Endpoint: reportEndpoint},
+	}
+
+	policy, err := access.NewPolicy(rules, false)
+	require.NoError(t, err)
+	policies := access.NewStatic(policy.WithGrants(grants))
+
+	tokenFor := func(roles ...string) context.Context {
+		token, err := utils.GenerateToken(model.UserInfoJwt{
+			Username: gofakeit.Username(),
+			Roles:    roles,
+		}, keys.Access().Active, time.Minute)
+		require.NoError(t, err)
+
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		endpoint string
+		wantErr  bool
+	}{
+		{
+			name:     "admin has access to everything",
+			ctx:      tokenFor(model.RoleAdmin),
+			endpoint: adminEndpoint,
+		},
+		{
+			name:     "admin is not bound by roles of allow rules",
+			ctx:      tokenFor(model.RoleAdmin),
+			endpoint: chatDelete,
+		},
+		{
+			name:     "explicit deny applies to admin",
+			ctx:      tokenFor(model.RoleAdmin),
+			endpoint: debugEndpoint,
+			wantErr:  true,
+		},
+		{
+			name:     "deny for a role applies to admin with that role",
+			ctx:      tokenFor(model.RoleAdmin, "intern"),
+			endpoint: reportEndpoint,
+			wantErr:  true,
+		},
+		{
+			name:     "role listed in access config",
+			ctx:      tokenFor("support"),
+			endpoint: supportEndpoint,
+		},
+		{
+			name:     "endpoint without rules",
+			ctx:      tokenFor(model.RoleUser),
+			endpoint: publicEndpoint,
+		},
+		{
+			name:     "permission granted to role in access table",
+			ctx:      tokenFor("billing"),
+			endpoint: adminEndpoint,
+		},
+		{
+			name:     "no matching role",
+			ctx:      tokenFor(model.RoleUser, "support"),
+			endpoint: adminEndpoint,
+			wantErr:  true,
+		},
+		{
+			name:     "glob rule allows every method of a service",
+			ctx:      tokenFor(model.RoleUser),
+			endpoint: chatEndpoint,
+		},
+		{
+			name:     "exact rule is more specific than glob",
+			ctx:      tokenFor(model.RoleUser),
+			endpoint: chatDelete,
+			wantErr:  true,
+		},
+		{
+			name:     "prefix rule",
+			ctx:      tokenFor("billing"),
+			endpoint: reportEndpoint,
+		},
+		{
+			name:     "explicit deny is not lifted by access table",
+			ctx:      tokenFor("billing", "intern"),
+			endpoint: reportEndpoint,
+			wantErr:  true,
+		},
+		{
+			name:     "access table grant by pattern",
+			ctx:      tokenFor("support"),
+			endpoint: chatDelete,
+		},
+		{
+			name:     "missing token",
+			ctx:      metadata.NewIncomingContext(context.Background(), metadata.MD{}),
+			endpoint: publicEndpoint,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			t.Cleanup(mc.Finish)
+
+			service := auth.NewService(
+				repoMocks.NewAuthRepositoryMock(mc),
+				mocks.NewTxManagerMock(mc),
+				repoMocks.NewCacheInterfaceMock(mc),
+				keys,
+				policies,
+				nil,
+				nil,
+				nil,
+			)
+
+			err := service.Check(tt.ctx, model.CheckRequest{EndpointAddress: tt.endpoint})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckInvalidTokenHidesCause(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	mc := minimock.NewController(t)
+	t.Cleanup(mc.Finish)
+
+	service := auth.NewService(
+		repoMocks.NewAuthRepositoryMock(mc),
+		mocks.NewTxManagerMock(mc),
+		repoMocks.NewCacheInterfaceMock(mc),
+		keys,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer not.a.jwt"))
+	err := service.Check(ctx, model.CheckRequest{EndpointAddress: "/v1/public"})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.Unauthenticated, st.Code())
+	require.Equal(t, model.ErrAccessTokenInvalid.Error(), st.Message())
+}
+
+func TestCheckMany(t *testing.T) {
+	rules := []model.AccessRule{
+		{Pattern: "/chat_server_v1.ChatV1/*", Effect: model.AccessAllow},
+		{Pattern: "/chat_server_v1.ChatV1/Delete", Effect: model.AccessAllow, Roles: []string{"moderator"}},
+		{Pattern: "/chat_server_v1.ChatV1/Ban", Effect: model.AccessDeny},
+	}
+	grants := []model.RolePermission{{Role: "support", Endpoint: "/report_v1.**"}}
+
+	policy, err := access.NewPolicy(rules, true)
+	require.NoError(t, err)
+
+	token, err := utils.GenerateToken(model.UserInfoJwt{
+		Username: gofakeit.Username(),
+		Roles:    []string{"support"},
+	}, keys.Access().Active, time.Minute)
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	mc := minimock.NewController(t)
+	t.Cleanup(mc.Finish)
+
+	service := auth.NewService(
+		repoMocks.NewAuthRepositoryMock(mc),
+		mocks.NewTxManagerMock(mc),
+		repoMocks.NewCacheInterfaceMock(mc),
+		keys,
+		access.NewStatic(policy.WithGrants(grants)),
+		nil,
+		nil,
+		nil,
+	)
+
+	decisions, err := service.CheckMany(ctx, model.CheckManyRequest{EndpointAddresses: []string{
+		"/chat_server_v1.ChatV1/Send",
+		"/chat_server_v1.ChatV1/Delete",
+		"/chat_server_v1.ChatV1/Ban",
+		"/report_v1.ReportV1/Export",
+		"/unknown.Service/Method",
+	}})
+	require.NoError(t, err)
+	want := []struct {
+		allowed bool
+		reason  string
+	}{
+		{true, model.ReasonRuleAllow},
+		{false, model.ReasonRoleNotAllowed},
+		{false, model.ReasonRuleDeny},
+		{true, model.ReasonAccessGrant},
+		{false, model.ReasonDefaultDeny},
+	}
+	require.Len(t, decisions, len(want))
+	for i, w := range want {
+		require.Equal(t, w.allowed, decisions[i].Allowed, decisions[i].EndpointAddress)
+		require.Equal(t, w.reason, decisions[i].Reason, decisions[i].EndpointAddress)
+	}
+	require.Equal(t, []string{"moderator"}, decisions[1].RequiredRoles)
+	require.Equal(t, &model.RolePermission{Role: "support", Endpoint: "/report_v1.**"}, decisions[3].Grant)
+
+	_, err = service.CheckMany(context.Background(), model.CheckManyRequest{EndpointAddresses: []string{"/chat_server_v1.ChatV1/Send"}})
+	require.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/access"
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/model"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/service/auth"
+	"github.com/Ippolid/auth/internal/service/mocks"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const deleteEndpoint = "/user_v1.UserV1/Delete"
+
+func incomingToken(t *testing.T, roles ...string) context.Context {
+	token, err := utils.GenerateToken(model.UserInfoJwt{
+		Username: gofakeit.Username(),
+		Roles:    roles,
+	}, keys.Access().Active, time.Minute)
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestCheckDeniedDetails(t *testing.T) {
+	policy, err := access.NewPolicy([]model.AccessRule{
+		{Pattern: "/user_v1.UserV1/*", Effect: model.AccessAllow, Roles: []string{"support"}},
+	}, false)
+	require.NoError(t, err)
+
+	mc := minimock.NewController(t)
+	t.Cleanup(mc.Finish)
+
+	service := auth.NewService(
+		repoMocks.NewAuthRepositoryMock(mc),
+		mocks.NewTxManagerMock(mc),
+		repoMocks.NewCacheInterfaceMock(mc),
+		keys,
+		access.NewStatic(policy),
+		nil,
+		nil,
+		nil,
+	)
+
+	err = service.Check(incomingToken(t, model.RoleUser), model.CheckRequest{EndpointAddress: deleteEndpoint})
+	st := status.Convert(err)
+	require.Equal(t, codes.PermissionDenied, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, "ROLE_NOT_ALLOWED", info.GetReason())
+	require.Equal(t, map[string]string{
+		"endpoint":       deleteEndpoint,
+		"caller_roles":   model.RoleUser,
+		"rule":           "/user_v1.UserV1/*",
+		"effect":         model.AccessAllow,
+		"required_roles": "support",
+	}, info.GetMetadata())
+
+	err = service.Check(context.Background(), model.CheckRequest{EndpointAddress: deleteEndpoint})
+	require.Equal(t, codes.Unauthenticated, status.Code(interceptor.ToStatus(err)))
+}
+
+func TestExplainAccess(t *testing.T) {
+	username := gofakeit.Username()
+
+	const purgeEndpoint = "/user_v1.UserV1/Purge"
+
+	policy, err := access.NewPolicy([]model.AccessRule{
+		{Pattern: "/user_v1.UserV1/*", Effect: model.AccessAllow, Roles: []string{"support"}},
+		{Pattern: purgeEndpoint, Effect: model.AccessDeny},
+	}, false)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		request    model.ExplainAccessRequest
+		wantCode   codes.Code
+		wantReason string
+		wantRule   string
+	}{
+		{
+			name:       "roles of a user from the database",
+			ctx:        incomingToken(t, model.RoleAdmin),
+			request:    model.ExplainAccessRequest{EndpointAddress: deleteEndpoint, Username: username},
+			wantReason: model.ReasonRuleAllow,
+			wantRule:   "/user_v1.UserV1/*",
+		},
+		{
+			name:       "explicit roles",
+			ctx:        incomingToken(t, model.RoleAdmin),
+			request:    model.ExplainAccessRequest{EndpointAddress: deleteEndpoint, Roles: []string{"billing"}},
+			wantReason: model.ReasonRoleNotAllowed,
+			wantRule:   "/user_v1.UserV1/*",
+		},
+		{
+			name:       "admin",
+			ctx:        incomingToken(t, model.RoleAdmin),
+			request:    model.ExplainAccessRequest{EndpointAddress: deleteEndpoint, Roles: []string{model.RoleAdmin}},
+			wantReason: model.ReasonAdmin,
+		},
+		{
+			name:       "explicit deny overrides admin",
+			ctx:        incomingToken(t, model.RoleAdmin),
+			request:    model.ExplainAccessRequest{EndpointAddress: purgeEndpoint, Roles: []string{model.RoleAdmin}},
+			wantReason: model.ReasonRuleDeny,
+			wantRule:   purgeEndpoint,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			t.Cleanup(mc.Finish)
+
+			authRepo := repoMocks.NewAuthRepositoryMock(mc)
+			if tt.request.Username != "" {
+				authRepo.GetUserRolesMock.Expect(minimock.AnyContext, username).Return([]string{"support"}, nil)
+			}
+
+			service := auth.NewService(authRepo, mocks.NewTxManagerMock(mc), repoMocks.NewCacheInterfaceMock(mc), keys, access.NewStatic(policy), nil, nil, nil)
+
+			decision, err := service.ExplainAccess(tt.ctx, tt.request)
+			require.Equal(t, tt.wantCode, status.Code(interceptor.ToStatus(err)))
+			if tt.wantCode != codes.OK {
+				return
+			}
+			require.Equal(t, tt.wantReason, decision.Reason)
+			require.Equal(t, tt.wantReason == model.ReasonAdmin || tt.wantReason == model.ReasonRuleAllow, decision.Allowed)
+			if tt.wantRule == "" {
+				require.Nil(t, decision.Rule)
+				return
+			}
+			require.Equal(t, tt.wantRule, decision.Rule.Pattern)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/repository"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/service/auth"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetAccessTokenRolesCache(t *testing.T) {
+	type authRepositoryMockFunc func(mc *minimock.Controller) repository.AuthRepository
+	type cacheMockFunc func(mc *minimock.Controller) repository.CacheInterface
+
+	logger.Init(zapcore.NewNopCore())
+
+	username := gofakeit.Username()
+	roles := []string{model.RoleUser, "support"}
+
+	refreshToken, err := utils.GenerateToken(model.UserInfoJwt{
+		Username: username,
+		Roles:    roles,
+	}, keys.Refresh().Active, time.Minute)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		wantCode           codes.Code
+		authRepositoryMock authRepositoryMockFunc
+		cacheMock          cacheMockFunc
+	}{
+		{
+			name:     "roles served from cache",
+			wantCode: codes.OK,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				return repoMocks.NewAuthRepositoryMock(mc)
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetRolesMock.Expect(minimock.AnyContext, username).Return(roles, nil)
+				return mock
+			},
+		},
+		{
+			name:     "cache miss loads roles",
+			wantCode: codes.OK,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.GetUserRolesMock.Expect(minimock.AnyContext, username).Return(roles, nil)
+				mock.MakeLogMock.Return(nil)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetRolesMock.Expect(minimock.AnyContext, username).Return(nil, model.ErrUserNotFound)
+				mock.CreateRolesMock.Expect(minimock.AnyContext, username, roles).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:     "missing user is cached",
+			wantCode: codes.NotFound,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.GetUserRolesMock.Expect(minimock.AnyContext, username).Return(nil, model.ErrUserNotFound)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetRolesMock.Expect(minimock.AnyContext, username).Return(nil, model.ErrUserNotFound)
+				mock.CreateRolesNotFoundMock.Expect(minimock.AnyContext, username).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:     "cache unavailable falls back to database",
+			wantCode: codes.OK,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.GetUserRolesMock.Expect(minimock.AnyContext, username).Return(roles, nil)
+				mock.MakeLogMock.Return(nil)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetRolesMock.Expect(minimock.AnyContext, username).Return(nil, model.ErrCacheUnavailable)
+				mock.CreateRolesMock.Expect(minimock.AnyContext, username, roles).Return(model.ErrCacheUnavailable)
+				return mock
+			},
+		},
+		{
+			name:     "cached miss does not reach the database",
+			wantCode: codes.NotFound,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				return repoMocks.NewAuthRepositoryMock(mc)
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetRolesMock.Expect(minimock.AnyContext, username).Return(nil, model.ErrCachedNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			t.Cleanup(mc.Finish)
+
+			service := auth.NewService(tt.authRepositoryMock(mc), passthroughTx(mc), tt.cacheMock(mc), keys, nil, nil, nil, nil)
+
+			_, err := service.GetAccessToken(context.Background(), model.GetAccessTokenRequest{RefreshToken: refreshToken})
+			require.Equal(t, tt.wantCode, status.Code(interceptor.ToStatus(err)))
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/keyring"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/repository"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/service/auth"
+	"github.com/Ippolid/auth/internal/service/mocks"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/Ippolid/platform_libary/pkg/db"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var keys = keyring.NewStatic(
+	utils.NewHMACKey([]byte("access-secret")),
+	utils.NewHMACKey([]byte("refresh-secret")),
+)
+
+func TestGetRefreshToken(t *testing.T) {
+	type authRepositoryMockFunc func(mc *minimock.Controller) repository.AuthRepository
+	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
+	type cacheMockFunc func(mc *minimock.Controller) repository.CacheInterface
+
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		ctx      = context.Background()
+		username = gofakeit.Username()
+		tokenID  = gofakeit.UUID()
+		familyID = gofakeit.UUID()
+	)
+
+	oldToken, err := utils.GenerateToken(model.UserInfoJwt{
+		Username: username,
+		Roles:    []string{model.RoleAdmin},
+		TokenID:  tokenID,
+		FamilyID: familyID,
+	}, keys.Refresh().Active, time.Minute)
+	require.NoError(t, err)
+
+	txManager := func(mc *minimock.Controller) db.TxManager {
+		mock := mocks.NewTxManagerMock(mc)
+		mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+			return f(ctx)
+		})
+		return mock
+	}
+
+	tests := []struct {
+		name               string
+		oldToken           string
+		wantCode           codes.Code
+		authRepositoryMock authRepositoryMockFunc
+		txManagerMock      txManagerMockFunc
+		cacheMock          cacheMockFunc
+	}{
+		{
+			name:     "token rotated within its family",
+			oldToken: oldToken,
+			wantCode: codes.OK,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.MarkRefreshTokenRotatedMock.Expect(minimock.AnyContext, tokenID).Return(true, nil)
+				mock.MakeLogMock.Return(nil)
+				mock.CreateRefreshTokenMock.Set(func(_ context.Context, token model.RefreshToken) error {
+					if token.FamilyID != familyID || token.ID == tokenID || token.Username != username {
+						t.Errorf("unexpected refresh token record: %+v", token)
+					}
+					return nil
+				})
+				return mock
+			},
+			txManagerMock: txManager,
+		},
+		{
+			name:     "reused token revokes the family",
+			oldToken: oldToken,
+			wantCode: codes.Unauthenticated,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.MarkRefreshTokenRotatedMock.Expect(minimock.AnyContext, tokenID).Return(false, nil)
+				mock.IsRefreshTokenFamilyRevokedMock.Expect(minimock.AnyContext, familyID).Return(false, nil)
+				mock.RevokeRefreshTokenFamilyMock.Expect(minimock.AnyContext, familyID).Return(nil)
+				return mock
+			},
+			txManagerMock: txManager,
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.CreateRevokedFamilyMock.Expect(minimock.AnyContext, familyID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:     "token of a finished session",
+			oldToken: oldToken,
+			wantCode: codes.Unauthenticated,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.MarkRefreshTokenRotatedMock.Expect(minimock.AnyContext, tokenID).Return(false, nil)
+				mock.IsRefreshTokenFamilyRevokedMock.Expect(minimock.AnyContext, familyID).Return(true, nil)
+				return mock
+			},
+			txManagerMock: txManager,
+		},
+		{
+			name:     "invalid token",
+			oldToken: "not-a-token",
+			wantCode: codes.Unauthenticated,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				// Не должен вызываться
+				return repoMocks.NewAuthRepositoryMock(mc)
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				// Не должен вызываться
+				return mocks.NewTxManagerMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			t.Cleanup(mc.Finish)
+
+			var cache repository.CacheInterface = repoMocks.NewCacheInterfaceMock(mc)
+			if tt.cacheMock != nil {
+				cache = tt.cacheMock(mc)
+			}
+
+			service := auth.NewService(tt.authRepositoryMock(mc), tt.txManagerMock(mc), cache, keys, nil, nil, nil, nil)
+
+			resp, err := service.GetRefreshToken(ctx, model.GetRefreshTokenRequest{OldToken: tt.oldToken})
+			require.Equal(t, tt.wantCode, status.Code(interceptor.ToStatus(err)))
+			if tt.wantCode != codes.OK {
+				require.Nil(t, resp)
+				return
+			}
+
+			claims, err := utils.VerifyToken(resp.RefreshToken, keys.Refresh())
+			require.NoError(t, err)
+			require.Equal(t, familyID, claims.FamilyID)
+			require.NotEqual(t, tokenID, claims.ID)
+			require.Equal(t, []string{model.RoleAdmin}, claims.Roles)
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/config"
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/repository"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/service/auth"
+	"github.com/Ippolid/auth/internal/service/mocks"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/Ippolid/platform_libary/pkg/db"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type loginProtection struct{}
+
+func (loginProtection) MaxFailures() int64             { return 5 }
+func (loginProtection) IPMaxFailures() int64           { return 50 }
+func (loginProtection) FailureWindow() time.Duration   { return 15 * time.Minute }
+func (loginProtection) LockoutDuration() time.Duration { return 15 * time.Minute }
+func (loginProtection) BackoffBase() time.Duration     { return time.Second }
+func (loginProtection) BackoffMax() time.Duration      { return 30 * time.Second }
+
+func TestLoginProtection(t *testing.T) {
+	type authRepositoryMockFunc func(mc *minimock.Controller) repository.AuthRepository
+	type cacheMockFunc func(mc *minimock.Controller) repository.CacheInterface
+
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		username = gofakeit.Username()
+		userKey  = "user:" + strings.ToLower(username)
+		ipKey    = "ip:203.0.113.7"
+		req      = model.LoginRequest{Username: username, Password: gofakeit.Password(true, true, true, false, false, 12)}
+		cacheErr = fmt.Errorf("redis is down")
+
+		ctx = peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 51234},
+		})
+	)
+
+	tests := []struct {
+		name               string
+		wantCode           codes.Code
+		authRepositoryMock authRepositoryMockFunc
+		cacheMock          cacheMockFunc
+	}{
+		{
+			name:     "locked username is rejected before password check",
+			wantCode: codes.ResourceExhausted,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				return repoMocks.NewAuthRepositoryMock(mc)
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetLoginBlockMock.Expect(minimock.AnyContext, userKey).Return(10*time.Minute, nil)
+				return mock
+			},
+		},
+		{
+			name:     "failure doubles backoff for username only",
+			wantCode: codes.Unauthenticated,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.LoginMock.Return(nil, model.ErrInvalidCredentials)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetLoginBlockMock.Return(0, nil)
+				mock.IncLoginFailuresMock.Set(func(_ context.Context, subject string, _ time.Duration) (int64, error) {
+					if subject == userKey {
+						return 3, nil
+					}
+					return 7, nil
+				})
+				mock.BlockLoginMock.Expect(minimock.AnyContext, userKey, 4*time.Second).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:     "threshold locks username out",
+			wantCode: codes.Unauthenticated,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.LoginMock.Return(nil, model.ErrUserNotFound)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetLoginBlockMock.Return(0, nil)
+				mock.IncLoginFailuresMock.Set(func(_ context.Context, subject string, _ time.Duration) (int64, error) {
+					if subject == userKey {
+						return 5, nil
+					}
+					return 1, nil
+				})
+				mock.BlockLoginMock.Expect(minimock.AnyContext, userKey, 15*time.Minute).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:     "success resets username counter",
+			wantCode: codes.OK,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.LoginMock.Return(&model.UserInfoJwt{Username: username}, nil)
+				mock.GetTOTPMock.Return(nil, model.ErrTOTPNotEnrolled)
+				mock.MakeLogMock.Return(nil)
+				mock.CreateRefreshTokenMock.Return(nil)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetLoginBlockMock.Return(0, nil)
+				mock.ResetLoginFailuresMock.Expect(minimock.AnyContext, userKey).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:     "cache failure does not block login",
+			wantCode: codes.Unauthenticated,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.LoginMock.Return(nil, model.ErrInvalidCredentials)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetLoginBlockMock.Return(0, cacheErr)
+				mock.IncLoginFailuresMock.Return(0, cacheErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			t.Cleanup(mc.Finish)
+
+			txManager := mocks.NewTxManagerMock(mc)
+			txManager.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			service := auth.NewService(tt.authRepositoryMock(mc), txManager, tt.cacheMock(mc), keys, nil, loginProtection{}, nil, nil)
+
+			_, err := service.Login(ctx, req)
+			require.Equal(t, tt.wantCode, status.Code(interceptor.ToStatus(err)))
+		})
+	}
+
+	t.Run("ip counter is checked too", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		cache := repoMocks.NewCacheInterfaceMock(mc)
+		cache.GetLoginBlockMock.Set(func(_ context.Context, subject string) (time.Duration, error) {
+			if subject == ipKey {
+				return time.Minute, nil
+			}
+			return 0, nil
+		})
+
+		service := auth.NewService(repoMocks.NewAuthRepositoryMock(mc), mocks.NewTxManagerMock(mc), cache, keys, nil, loginProtection{}, nil, nil)
+
+		_, err := service.Login(ctx, req)
+		require.ErrorIs(t, err, model.ErrLoginLocked)
+	})
+}
+
+type emailVerification string
+
+func passthroughTx(mc *minimock.Controller) db.TxManager {
+	mock := mocks.NewTxManagerMock(mc)
+	mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+	return mock
+}
+
+func (p emailVerification) UnverifiedLogin() string { return string(p) }
+
+func TestUnverifiedLoginPolicy(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		username = gofakeit.Username()
+		req      = model.LoginRequest{Username: username, Password: gofakeit.Password(true, true, true, false, false, 12)}
+		roles    = []string{model.RoleUser, model.RoleAdmin}
+	)
+
+	unverifiedRepo := func(mc *minimock.Controller) *repoMocks.AuthRepositoryMock {
+		mock := repoMocks.NewAuthRepositoryMock(mc)
+		mock.LoginMock.Return(&model.UserInfoJwt{Username: username, Roles: roles}, nil)
+		return mock
+	}
+
+	t.Run("deny rejects unverified email", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		service := auth.NewService(unverifiedRepo(mc), passthroughTx(mc), nil, keys, nil, nil, emailVerification(config.UnverifiedLoginDeny), nil)
+
+		_, err := service.Login(context.Background(), req)
+		require.ErrorIs(t, err, model.ErrEmailNotVerified)
+		require.Equal(t, codes.FailedPrecondition, status.Code(interceptor.ToStatus(err)))
+	})
+
+	t.Run("limited keeps only user role across refresh", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		authRepo := unverifiedRepo(mc)
+		authRepo.GetTOTPMock.Return(nil, model.ErrTOTPNotEnrolled)
+		authRepo.MakeLogMock.Return(nil)
+		authRepo.CreateRefreshTokenMock.Return(nil)
+
+		cache := repoMocks.NewCacheInterfaceMock(mc)
+		cache.GetRevokedFamilyMock.Return(false, model.ErrCacheMiss)
+		cache.CreateActiveFamilyMock.Return(nil)
+		authRepo.IsRefreshTokenFamilyRevokedMock.Return(false, nil)
+
+		service := auth.NewService(authRepo, passthroughTx(mc), cache, keys, nil, nil, emailVerification(config.UnverifiedLoginLimited), nil)
+
+		resp, err := service.Login(context.Background(), req)
+		require.NoError(t, err)
+
+		refresh, err := utils.VerifyToken(resp.Token, keys.Refresh())
+		require.NoError(t, err)
+		require.True(t, refresh.Limited)
+		require.Equal(t, []string{model.RoleUser}, refresh.Roles)
+
+		// Роли из кеша и базы не запрашиваются: cache.GetRoles не ожидается
+		access, err := service.GetAccessToken(context.Background(), model.GetAccessTokenRequest{RefreshToken: resp.Token})
+		require.NoError(t, err)
+
+		claims, err := utils.VerifyToken(access.AccessToken, keys.Access())
+		require.NoError(t, err)
+		require.Equal(t, []string{model.RoleUser}, claims.Roles)
+	})
+
+	t.Run("verified email is not limited", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		authRepo := repoMocks.NewAuthRepositoryMock(mc)
+		authRepo.LoginMock.Return(&model.UserInfoJwt{Username: username, Roles: roles, EmailVerified: true}, nil)
+		authRepo.GetTOTPMock.Return(nil, model.ErrTOTPNotEnrolled)
+		authRepo.MakeLogMock.Return(nil)
+		authRepo.CreateRefreshTokenMock.Return(nil)
+
+		service := auth.NewService(authRepo, passthroughTx(mc), nil, keys, nil, nil, emailVerification(config.UnverifiedLoginDeny), nil)
+
+		resp, err := service.Login(context.Background(), req)
+		require.NoError(t, err)
+
+		refresh, err := utils.VerifyToken(resp.Token, keys.Refresh())
+		require.NoError(t, err)
+		require.False(t, refresh.Limited)
+		require.Equal(t, roles, refresh.Roles)
+	})
+}
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/repository"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/service/auth"
+	"github.com/Ippolid/auth/internal/service/mocks"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/Ippolid/platform_libary/pkg/db"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLogoutAll(t *testing.T) {
+	type authRepositoryMockFunc func(mc *minimock.Controller) repository.AuthRepository
+	type cacheMockFunc func(mc *minimock.Controller) repository.CacheInterface
+
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		ctx      = context.Background()
+		username = gofakeit.Username()
+		familyID = gofakeit.UUID()
+		other    = gofakeit.UUID()
+		repoErr  = fmt.Errorf("repo error")
+	)
+
+	refreshToken, err := utils.GenerateToken(model.UserInfoJwt{
+		Username: username,
+		TokenID:  gofakeit.UUID(),
+		FamilyID: familyID,
+	}, keys.Refresh().Active, time.Minute)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		wantCode           codes.Code
+		authRepositoryMock authRepositoryMockFunc
+		cacheMock          cacheMockFunc
+	}{
+		{
+			name:     "all sessions revoked and cached",
+			wantCode: codes.OK,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.IsRefreshTokenFamilyRevokedMock.Expect(minimock.AnyContext, familyID).Return(false, nil)
+				mock.RevokeUserRefreshTokensMock.Expect(minimock.AnyContext, username, "").Return([]string{familyID, other}, nil)
+				mock.MakeLogMock.Return(nil)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetRevokedFamilyMock.Expect(minimock.AnyContext, familyID).Return(false, model.ErrCacheMiss)
+				mock.CreateActiveFamilyMock.Expect(minimock.AnyContext, familyID).Return(nil)
+				mock.CreateRevokedFamilyMock.Set(func(_ context.Context, id string) error {
+					require.Contains(t, []string{familyID, other}, id)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:     "session already finished",
+			wantCode: codes.Unauthenticated,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.IsRefreshTokenFamilyRevokedMock.Expect(minimock.AnyContext, familyID).Return(true, nil)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetRevokedFamilyMock.Expect(minimock.AnyContext, familyID).Return(false, model.ErrCacheMiss)
+				mock.CreateRevokedFamilyMock.Expect(minimock.AnyContext, familyID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:     "repository error is not cached",
+			wantCode: codes.Internal,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.IsRefreshTokenFamilyRevokedMock.Expect(minimock.AnyContext, familyID).Return(false, nil)
+				mock.RevokeUserRefreshTokensMock.Expect(minimock.AnyContext, username, "").Return(nil, repoErr)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetRevokedFamilyMock.Expect(minimock.AnyContext, familyID).Return(false, model.ErrCacheMiss)
+				mock.CreateActiveFamilyMock.Expect(minimock.AnyContext, familyID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:     "active session answered from cache",
+			wantCode: codes.OK,
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.RevokeUserRefreshTokensMock.Expect(minimock.AnyContext, username, "").Return([]string{familyID}, nil)
+				mock.MakeLogMock.Return(nil)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.GetRevokedFamilyMock.Expect(minimock.AnyContext, familyID).Return(false, nil)
+				mock.CreateRevokedFamilyMock.Expect(minimock.AnyContext, familyID).Return(nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			t.Cleanup(mc.Finish)
+
+			txManager := mocks.NewTxManagerMock(mc)
+			txManager.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			service := auth.NewService(tt.authRepositoryMock(mc), txManager, tt.cacheMock(mc), keys, nil, nil, nil, nil)
+
+			err := service.LogoutAll(ctx, model.LogoutAllRequest{RefreshToken: refreshToken})
+			require.Equal(t, tt.wantCode, status.Code(interceptor.ToStatus(err)))
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/service/auth"
+	"github.com/Ippolid/auth/internal/totp"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTOTPLogin(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		userID   = gofakeit.Int64()
+		username = gofakeit.Username()
+		roles    = []string{model.RoleUser}
+		req      = model.LoginRequest{Username: username, Password: gofakeit.Password(true, true, true, false, false, 12)}
+	)
+
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	confirmed := time.Now().Add(-time.Hour)
+	setup := &model.TOTP{UserID: userID, Secret: secret, ConfirmedAt: &confirmed}
+
+	t.Run("login returns mfa challenge instead of refresh token", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		var challengeHash string
+		authRepo := repoMocks.NewAuthRepositoryMock(mc)
+		authRepo.LoginMock.Return(&model.UserInfoJwt{UserID: userID, Username: username, Roles: roles, EmailVerified: true}, nil)
+		authRepo.GetTOTPMock.Expect(minimock.AnyContext, userID).Return(setup, nil)
+		authRepo.MakeLogMock.Return(nil)
+		authRepo.CreateMFAChallengeMock.Set(func(_ context.Context, challenge model.MFAChallenge) error {
+			require.Equal(t, userID, challenge.UserID)
+			require.WithinDuration(t, time.Now().Add(5*time.Minute), challenge.ExpiresAt, time.Minute)
+			challengeHash = challenge.TokenHash
+			return nil
+		})
+
+		service := auth.NewService(authRepo, passthroughTx(mc), nil, keys, nil, nil, nil, nil)
+
+		resp, err := service.Login(context.Background(), req)
+		require.NoError(t, err)
+		require.Empty(t, resp.Token)
+		require.Equal(t, challengeHash, utils.HashToken(resp.MFAToken))
+	})
+
+	challenge := func(attempts int) *model.MFAChallenge {
+		return &model.MFAChallenge{UserID: userID, Username: username, Attempts: attempts, ExpiresAt: time.Now().Add(time.Minute)}
+	}
+
+	t.Run("valid totp code completes login", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		mfaToken := gofakeit.UUID()
+		code, err := totp.Code(secret, totp.Step(time.Now()))
+		require.NoError(t, err)
+
+		authRepo := repoMocks.NewAuthRepositoryMock(mc)
+		authRepo.GetMFAChallengeMock.Expect(minimock.AnyContext, utils.HashToken(mfaToken)).Return(challenge(0), nil)
+		authRepo.GetTOTPMock.Return(setup, nil)
+		authRepo.UseTOTPStepMock.Set(func(_ context.Context, id int64, step int64) error {
+			require.Equal(t, userID, id)
+			require.InDelta(t, totp.Step(time.Now()), step, 1)
+			return nil
+		})
+		authRepo.UseMFAChallengeMock.Expect(minimock.AnyContext, utils.HashToken(mfaToken)).Return(nil)
+		authRepo.GetUserRolesMock.Expect(minimock.AnyContext, username).Return(roles, nil)
+		authRepo.MakeLogMock.Return(nil)
+		authRepo.CreateRefreshTokenMock.Return(nil)
+
+		service := auth.NewService(authRepo, passthroughTx(mc), nil, keys, nil, nil, nil, nil)
+
+		resp, err := service.VerifyMFA(context.Background(), model.VerifyMFARequest{MFAToken: mfaToken, Code: code})
+		require.NoError(t, err)
+
+		claims, err := utils.VerifyToken(resp.Token, keys.Refresh())
+		require.NoError(t, err)
+		require.Equal(t, username, claims.Username)
+	})
+
+	t.Run("recovery code is accepted once", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		authRepo := repoMocks.NewAuthRepositoryMock(mc)
+		authRepo.GetMFAChallengeMock.Return(challenge(0), nil)
+		authRepo.GetTOTPMock.Return(setup, nil)
+		authRepo.UseRecoveryCodeMock.Expect(minimock.AnyContext, userID, utils.HashToken("abcdefghijklmnop")).Return(nil)
+		authRepo.UseMFAChallengeMock.Return(nil)
+		authRepo.GetUserRolesMock.Return(roles, nil)
+		authRepo.MakeLogMock.Return(nil)
+		authRepo.CreateRefreshTokenMock.Return(nil)
+
+		service := auth.NewService(authRepo, passthroughTx(mc), nil, keys, nil, nil, nil, nil)
+
+		// Код вводится как показан: группами через дефис, регистр не важен
+		_, err := service.VerifyMFA(context.Background(), model.VerifyMFARequest{MFAToken: gofakeit.UUID(), Code: "ABCD-efgh-ijkl-mnop"})
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong code is counted", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		mfaToken := gofakeit.UUID()
+		authRepo := repoMocks.NewAuthRepositoryMock(mc)
+		authRepo.GetMFAChallengeMock.Return(challenge(2), nil)
+		authRepo.GetTOTPMock.Return(setup, nil)
+		authRepo.UseRecoveryCodeMock.Return(model.ErrInvalidMFACode)
+		authRepo.IncMFAChallengeAttemptsMock.Expect(minimock.AnyContext, utils.HashToken(mfaToken)).Return(nil)
+
+		service := auth.NewService(authRepo, passthroughTx(mc), nil, keys, nil, nil, nil, nil)
+
+		_, err := service.VerifyMFA(context.Background(), model.VerifyMFARequest{MFAToken: mfaToken, Code: "000000"})
+		require.ErrorIs(t, err, model.ErrInvalidMFACode)
+		require.Equal(t, codes.Unauthenticated, status.Code(interceptor.ToStatus(err)))
+	})
+
+	t.Run("challenge without attempts left is rejected", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		authRepo := repoMocks.NewAuthRepositoryMock(mc)
+		authRepo.GetMFAChallengeMock.Return(challenge(5), nil)
+
+		service := auth.NewService(authRepo, passthroughTx(mc), nil, keys, nil, nil, nil, nil)
+
+		_, err := service.VerifyMFA(context.Background(), model.VerifyMFARequest{MFAToken: gofakeit.UUID(), Code: "000000"})
+		require.ErrorIs(t, err, model.ErrInvalidMFAChallenge)
+	})
+}
+
+func TestTOTPEnrollment(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	userID := gofakeit.Int64()
+
+	t.Run("enroll returns otpauth uri", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		var saved string
+		authRepo := repoMocks.NewAuthRepositoryMock(mc)
+		authRepo.GetUserIDMock.Return(userID, nil)
+		authRepo.SaveTOTPSecretMock.Set(func(_ context.Context, id int64, secret string) error {
+			require.Equal(t, userID, id)
+			saved = secret
+			return nil
+		})
+		authRepo.MakeLogMock.Return(nil)
+
+		service := auth.NewService(authRepo, passthroughTx(mc), nil, keys, nil, nil, nil, nil)
+
+		resp, err := service.EnrollTOTP(incomingToken(t, model.RoleUser))
+		require.NoError(t, err)
+		require.Equal(t, saved, resp.Secret)
+		require.True(t, strings.HasPrefix(resp.URI, "otpauth://totp/auth:"))
+		require.Contains(t, resp.URI, "secret="+saved)
+	})
+
+	t.Run("confirm enables totp and returns recovery codes", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		secret, err := totp.GenerateSecret()
+		require.NoError(t, err)
+		code, err := totp.Code(secret, totp.Step(time.Now()))
+		require.NoError(t, err)
+
+		var storedHashes []string
+		authRepo := repoMocks.NewAuthRepositoryMock(mc)
+		authRepo.GetUserIDMock.Return(userID, nil)
+		authRepo.GetTOTPMock.Return(&model.TOTP{UserID: userID, Secret: secret}, nil)
+		authRepo.ConfirmTOTPMock.Return(nil)
+		authRepo.ReplaceRecoveryCodesMock.Set(func(_ context.Context, _ int64, hashes []string) error {
+			storedHashes = hashes
+			return nil
+		})
+		authRepo.MakeLogMock.Return(nil)
+
+		service := auth.NewService(authRepo, passthroughTx(mc), nil, keys, nil, nil, nil, nil)
+
+		recovery, err := service.ConfirmTOTP(incomingToken(t, model.RoleUser), code)
+		require.NoError(t, err)
+		require.Len(t, recovery, 10)
+		require.Len(t, storedHashes, 10)
+
+		for i, c := range recovery {
+			require.Len(t, c, 19)
+			require.Equal(t, storedHashes[i], utils.HashToken(strings.ReplaceAll(c, "-", "")))
+		}
+	})
+
+	t.Run("confirm rejects wrong code", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		secret, err := totp.GenerateSecret()
+		require.NoError(t, err)
+
+		authRepo := repoMocks.NewAuthRepositoryMock(mc)
+		authRepo.GetUserIDMock.Return(userID, nil)
+		authRepo.GetTOTPMock.Return(&model.TOTP{UserID: userID, Secret: secret}, nil)
+
+		service := auth.NewService(authRepo, passthroughTx(mc), nil, keys, nil, nil, nil, nil)
+
+		_, err = service.ConfirmTOTP(incomingToken(t, model.RoleUser), "abcdef")
+		require.ErrorIs(t, err, model.ErrInvalidMFACode)
+	})
+}
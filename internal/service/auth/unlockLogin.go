@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/loginguard"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/requestctx"
+	"go.uber.org/zap"
+)
+
+// UnlockLogin снимает блокировку входа и сбрасывает счетчики неудачных попыток
+// для имени пользователя и/или адреса клиента. Доступно только администратору:
+// права проверяет интерцептор авторизации.
+func (s *serv) UnlockLogin(ctx context.Context, req model.UnlockLoginRequest) error {
+	claims, ok := requestctx.ClaimsFromContext(ctx)
+	if !ok {
+		return model.ErrAccessTokenInvalid
+	}
+
+	var subjects []string
+	if req.Username != "" {
+		subjects = append(subjects, loginguard.UserKey(req.Username))
+	}
+	if ip := net.ParseIP(req.IP); ip != nil {
+		subjects = append(subjects, loginguard.IPKey(ip))
+	}
+	if len(subjects) == 0 {
+		return model.ErrUnlockTargetRequired
+	}
+
+	err := s.authRepository.MakeLog(ctx, model.Log{
+		Method:    "UnlockLogin",
+		CreatedAt: time.Now(),
+		Ctx:       fmt.Sprintf("%v", ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating log: %w", err)
+	}
+
+	for _, subject := range subjects {
+		if err = s.cache.ResetLoginFailures(ctx, subject); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("login unlocked", zap.String("admin", claims.Username), zap.Strings("subjects", subjects))
+
+	return nil
+}
@@ -19,6 +19,20 @@ type UserServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcChangePassword          func(ctx context.Context, req model.ChangePasswordRequest) (err error)
+	funcChangePasswordOrigin    string
+	inspectFuncChangePassword   func(ctx context.Context, req model.ChangePasswordRequest)
+	afterChangePasswordCounter  uint64
+	beforeChangePasswordCounter uint64
+	ChangePasswordMock          mUserServiceMockChangePassword
+
+	funcConfirmPasswordReset          func(ctx context.Context, req model.ConfirmPasswordResetRequest) (err error)
+	funcConfirmPasswordResetOrigin    string
+	inspectFuncConfirmPasswordReset   func(ctx context.Context, req model.ConfirmPasswordResetRequest)
+	afterConfirmPasswordResetCounter  uint64
+	beforeConfirmPasswordResetCounter uint64
+	ConfirmPasswordResetMock          mUserServiceMockConfirmPasswordReset
+
 	funcCreate          func(ctx context.Context, info *model.User) (i1 int64, err error)
 	funcCreateOrigin    string
 	inspectFuncCreate   func(ctx context.Context, info *model.User)
@@ -40,12 +54,33 @@ type UserServiceMock struct {
 	beforeGetCounter uint64
 	GetMock          mUserServiceMockGet
 
+	funcRequestPasswordReset          func(ctx context.Context, email string) (err error)
+	funcRequestPasswordResetOrigin    string
+	inspectFuncRequestPasswordReset   func(ctx context.Context, email string)
+	afterRequestPasswordResetCounter  uint64
+	beforeRequestPasswordResetCounter uint64
+	RequestPasswordResetMock          mUserServiceMockRequestPasswordReset
+
+	funcResetPassword          func(ctx context.Context, id int64) (rp1 *model.ResetPasswordResponse, err error)
+	funcResetPasswordOrigin    string
+	inspectFuncResetPassword   func(ctx context.Context, id int64)
+	afterResetPasswordCounter  uint64
+	beforeResetPasswordCounter uint64
+	ResetPasswordMock          mUserServiceMockResetPassword
+
 	funcUpdate          func(ctx context.Context, id int64, info *model.UserInfo) (err error)
 	funcUpdateOrigin    string
 	inspectFuncUpdate   func(ctx context.Context, id int64, info *model.UserInfo)
 	afterUpdateCounter  uint64
 	beforeUpdateCounter uint64
 	UpdateMock          mUserServiceMockUpdate
+
+	funcVerifyEmail          func(ctx context.Context, token string) (err error)
+	funcVerifyEmailOrigin    string
+	inspectFuncVerifyEmail   func(ctx context.Context, token string)
+	afterVerifyEmailCounter  uint64
+	beforeVerifyEmailCounter uint64
+	VerifyEmailMock          mUserServiceMockVerifyEmail
 }
 
 // NewUserServiceMock returns a mock for mm_service.UserService
@@ -56,6 +91,12 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.ChangePasswordMock = mUserServiceMockChangePassword{mock: m}
+	m.ChangePasswordMock.callArgs = []*UserServiceMockChangePasswordParams{}
+
+	m.ConfirmPasswordResetMock = mUserServiceMockConfirmPasswordReset{mock: m}
+	m.ConfirmPasswordResetMock.callArgs = []*UserServiceMockConfirmPasswordResetParams{}
+
 	m.CreateMock = mUserServiceMockCreate{mock: m}
 	m.CreateMock.callArgs = []*UserServiceMockCreateParams{}
 
@@ -65,61 +106,69 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 	m.GetMock = mUserServiceMockGet{mock: m}
 	m.GetMock.callArgs = []*UserServiceMockGetParams{}
 
+	m.RequestPasswordResetMock = mUserServiceMockRequestPasswordReset{mock: m}
+	m.RequestPasswordResetMock.callArgs = []*UserServiceMockRequestPasswordResetParams{}
+
+	m.ResetPasswordMock = mUserServiceMockResetPassword{mock: m}
+	m.ResetPasswordMock.callArgs = []*UserServiceMockResetPasswordParams{}
+
 	m.UpdateMock = mUserServiceMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*UserServiceMockUpdateParams{}
 
+	m.VerifyEmailMock = mUserServiceMockVerifyEmail{mock: m}
+	m.VerifyEmailMock.callArgs = []*UserServiceMockVerifyEmailParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mUserServiceMockCreate struct {
+type mUserServiceMockChangePassword struct {
 	optional           bool
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockCreateExpectation
-	expectations       []*UserServiceMockCreateExpectation
+	defaultExpectation *UserServiceMockChangePasswordExpectation
+	expectations       []*UserServiceMockChangePasswordExpectation
 
-	callArgs []*UserServiceMockCreateParams
+	callArgs []*UserServiceMockChangePasswordParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserServiceMockCreateExpectation specifies expectation struct of the UserService.Create
-type UserServiceMockCreateExpectation struct {
+// UserServiceMockChangePasswordExpectation specifies expectation struct of the UserService.ChangePassword
+type UserServiceMockChangePasswordExpectation struct {
 	mock               *UserServiceMock
-	params             *UserServiceMockCreateParams
-	paramPtrs          *UserServiceMockCreateParamPtrs
-	expectationOrigins UserServiceMockCreateExpectationOrigins
-	results            *UserServiceMockCreateResults
+	params             *UserServiceMockChangePasswordParams
+	paramPtrs          *UserServiceMockChangePasswordParamPtrs
+	expectationOrigins UserServiceMockChangePasswordExpectationOrigins
+	results            *UserServiceMockChangePasswordResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserServiceMockCreateParams contains parameters of the UserService.Create
-type UserServiceMockCreateParams struct {
-	ctx  context.Context
-	info *model.User
+// UserServiceMockChangePasswordParams contains parameters of the UserService.ChangePassword
+type UserServiceMockChangePasswordParams struct {
+	ctx context.Context
+	req model.ChangePasswordRequest
 }
 
-// UserServiceMockCreateParamPtrs contains pointers to parameters of the UserService.Create
-type UserServiceMockCreateParamPtrs struct {
-	ctx  *context.Context
-	info **model.User
+// UserServiceMockChangePasswordParamPtrs contains pointers to parameters of the UserService.ChangePassword
+type UserServiceMockChangePasswordParamPtrs struct {
+	ctx *context.Context
+	req *model.ChangePasswordRequest
 }
 
-// UserServiceMockCreateResults contains results of the UserService.Create
-type UserServiceMockCreateResults struct {
-	i1  int64
+// UserServiceMockChangePasswordResults contains results of the UserService.ChangePassword
+type UserServiceMockChangePasswordResults struct {
 	err error
 }
 
-// UserServiceMockCreateOrigins contains origins of expectations of the UserService.Create
-type UserServiceMockCreateExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originInfo string
+// UserServiceMockChangePasswordOrigins contains origins of expectations of the UserService.ChangePassword
+type UserServiceMockChangePasswordExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -127,341 +176,341 @@ type UserServiceMockCreateExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreate *mUserServiceMockCreate) Optional() *mUserServiceMockCreate {
-	mmCreate.optional = true
-	return mmCreate
+func (mmChangePassword *mUserServiceMockChangePassword) Optional() *mUserServiceMockChangePassword {
+	mmChangePassword.optional = true
+	return mmChangePassword
 }
 
-// Expect sets up expected params for UserService.Create
-func (mmCreate *mUserServiceMockCreate) Expect(ctx context.Context, info *model.User) *mUserServiceMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+// Expect sets up expected params for UserService.ChangePassword
+func (mmChangePassword *mUserServiceMockChangePassword) Expect(ctx context.Context, req model.ChangePasswordRequest) *mUserServiceMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UserServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UserServiceMockChangePasswordExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs != nil {
-		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by ExpectParams functions")
+	if mmChangePassword.defaultExpectation.paramPtrs != nil {
+		mmChangePassword.mock.t.Fatalf("UserServiceMock.ChangePassword mock is already set by ExpectParams functions")
 	}
 
-	mmCreate.defaultExpectation.params = &UserServiceMockCreateParams{ctx, info}
-	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreate.expectations {
-		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
-			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+	mmChangePassword.defaultExpectation.params = &UserServiceMockChangePasswordParams{ctx, req}
+	mmChangePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmChangePassword.expectations {
+		if minimock.Equal(e.params, mmChangePassword.defaultExpectation.params) {
+			mmChangePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChangePassword.defaultExpectation.params)
 		}
 	}
 
-	return mmCreate
+	return mmChangePassword
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserService.Create
-func (mmCreate *mUserServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mUserServiceMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserService.ChangePassword
+func (mmChangePassword *mUserServiceMockChangePassword) ExpectCtxParam1(ctx context.Context) *mUserServiceMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UserServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UserServiceMockChangePasswordExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.params != nil {
-		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Expect")
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("UserServiceMock.ChangePassword mock is already set by Expect")
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs == nil {
-		mmCreate.defaultExpectation.paramPtrs = &UserServiceMockCreateParamPtrs{}
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &UserServiceMockChangePasswordParamPtrs{}
 	}
-	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmChangePassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmChangePassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreate
+	return mmChangePassword
 }
 
-// ExpectInfoParam2 sets up expected param info for UserService.Create
-func (mmCreate *mUserServiceMockCreate) ExpectInfoParam2(info *model.User) *mUserServiceMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for UserService.ChangePassword
+func (mmChangePassword *mUserServiceMockChangePassword) ExpectReqParam2(req model.ChangePasswordRequest) *mUserServiceMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UserServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UserServiceMockChangePasswordExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.params != nil {
-		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Expect")
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("UserServiceMock.ChangePassword mock is already set by Expect")
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs == nil {
-		mmCreate.defaultExpectation.paramPtrs = &UserServiceMockCreateParamPtrs{}
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &UserServiceMockChangePasswordParamPtrs{}
 	}
-	mmCreate.defaultExpectation.paramPtrs.info = &info
-	mmCreate.defaultExpectation.expectationOrigins.originInfo = minimock.CallerInfo(1)
+	mmChangePassword.defaultExpectation.paramPtrs.req = &req
+	mmChangePassword.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmCreate
+	return mmChangePassword
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.Create
-func (mmCreate *mUserServiceMockCreate) Inspect(f func(ctx context.Context, info *model.User)) *mUserServiceMockCreate {
-	if mmCreate.mock.inspectFuncCreate != nil {
-		mmCreate.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Create")
+// Inspect accepts an inspector function that has same arguments as the UserService.ChangePassword
+func (mmChangePassword *mUserServiceMockChangePassword) Inspect(f func(ctx context.Context, req model.ChangePasswordRequest)) *mUserServiceMockChangePassword {
+	if mmChangePassword.mock.inspectFuncChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ChangePassword")
 	}
 
-	mmCreate.mock.inspectFuncCreate = f
+	mmChangePassword.mock.inspectFuncChangePassword = f
 
-	return mmCreate
+	return mmChangePassword
 }
 
-// Return sets up results that will be returned by UserService.Create
-func (mmCreate *mUserServiceMockCreate) Return(i1 int64, err error) *UserServiceMock {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+// Return sets up results that will be returned by UserService.ChangePassword
+func (mmChangePassword *mUserServiceMockChangePassword) Return(err error) *UserServiceMock {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UserServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{mock: mmCreate.mock}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UserServiceMockChangePasswordExpectation{mock: mmChangePassword.mock}
 	}
-	mmCreate.defaultExpectation.results = &UserServiceMockCreateResults{i1, err}
-	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreate.mock
+	mmChangePassword.defaultExpectation.results = &UserServiceMockChangePasswordResults{err}
+	mmChangePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmChangePassword.mock
 }
 
-// Set uses given function f to mock the UserService.Create method
-func (mmCreate *mUserServiceMockCreate) Set(f func(ctx context.Context, info *model.User) (i1 int64, err error)) *UserServiceMock {
-	if mmCreate.defaultExpectation != nil {
-		mmCreate.mock.t.Fatalf("Default expectation is already set for the UserService.Create method")
+// Set uses given function f to mock the UserService.ChangePassword method
+func (mmChangePassword *mUserServiceMockChangePassword) Set(f func(ctx context.Context, req model.ChangePasswordRequest) (err error)) *UserServiceMock {
+	if mmChangePassword.defaultExpectation != nil {
+		mmChangePassword.mock.t.Fatalf("Default expectation is already set for the UserService.ChangePassword method")
 	}
 
-	if len(mmCreate.expectations) > 0 {
-		mmCreate.mock.t.Fatalf("Some expectations are already set for the UserService.Create method")
+	if len(mmChangePassword.expectations) > 0 {
+		mmChangePassword.mock.t.Fatalf("Some expectations are already set for the UserService.ChangePassword method")
 	}
 
-	mmCreate.mock.funcCreate = f
-	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
-	return mmCreate.mock
+	mmChangePassword.mock.funcChangePassword = f
+	mmChangePassword.mock.funcChangePasswordOrigin = minimock.CallerInfo(1)
+	return mmChangePassword.mock
 }
 
-// When sets expectation for the UserService.Create which will trigger the result defined by the following
+// When sets expectation for the UserService.ChangePassword which will trigger the result defined by the following
 // Then helper
-func (mmCreate *mUserServiceMockCreate) When(ctx context.Context, info *model.User) *UserServiceMockCreateExpectation {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+func (mmChangePassword *mUserServiceMockChangePassword) When(ctx context.Context, req model.ChangePasswordRequest) *UserServiceMockChangePasswordExpectation {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UserServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	expectation := &UserServiceMockCreateExpectation{
-		mock:               mmCreate.mock,
-		params:             &UserServiceMockCreateParams{ctx, info},
-		expectationOrigins: UserServiceMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserServiceMockChangePasswordExpectation{
+		mock:               mmChangePassword.mock,
+		params:             &UserServiceMockChangePasswordParams{ctx, req},
+		expectationOrigins: UserServiceMockChangePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	mmChangePassword.expectations = append(mmChangePassword.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserService.Create return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockCreateExpectation) Then(i1 int64, err error) *UserServiceMock {
-	e.results = &UserServiceMockCreateResults{i1, err}
+// Then sets up UserService.ChangePassword return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockChangePasswordExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockChangePasswordResults{err}
 	return e.mock
 }
 
-// Times sets number of times UserService.Create should be invoked
-func (mmCreate *mUserServiceMockCreate) Times(n uint64) *mUserServiceMockCreate {
+// Times sets number of times UserService.ChangePassword should be invoked
+func (mmChangePassword *mUserServiceMockChangePassword) Times(n uint64) *mUserServiceMockChangePassword {
 	if n == 0 {
-		mmCreate.mock.t.Fatalf("Times of UserServiceMock.Create mock can not be zero")
+		mmChangePassword.mock.t.Fatalf("Times of UserServiceMock.ChangePassword mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
-	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreate
+	mm_atomic.StoreUint64(&mmChangePassword.expectedInvocations, n)
+	mmChangePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmChangePassword
 }
 
-func (mmCreate *mUserServiceMockCreate) invocationsDone() bool {
-	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+func (mmChangePassword *mUserServiceMockChangePassword) invocationsDone() bool {
+	if len(mmChangePassword.expectations) == 0 && mmChangePassword.defaultExpectation == nil && mmChangePassword.mock.funcChangePassword == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmChangePassword.mock.afterChangePasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmChangePassword.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Create implements mm_service.UserService
-func (mmCreate *UserServiceMock) Create(ctx context.Context, info *model.User) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+// ChangePassword implements mm_service.UserService
+func (mmChangePassword *UserServiceMock) ChangePassword(ctx context.Context, req model.ChangePasswordRequest) (err error) {
+	mm_atomic.AddUint64(&mmChangePassword.beforeChangePasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmChangePassword.afterChangePasswordCounter, 1)
 
-	mmCreate.t.Helper()
+	mmChangePassword.t.Helper()
 
-	if mmCreate.inspectFuncCreate != nil {
-		mmCreate.inspectFuncCreate(ctx, info)
+	if mmChangePassword.inspectFuncChangePassword != nil {
+		mmChangePassword.inspectFuncChangePassword(ctx, req)
 	}
 
-	mm_params := UserServiceMockCreateParams{ctx, info}
+	mm_params := UserServiceMockChangePasswordParams{ctx, req}
 
 	// Record call args
-	mmCreate.CreateMock.mutex.Lock()
-	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
-	mmCreate.CreateMock.mutex.Unlock()
+	mmChangePassword.ChangePasswordMock.mutex.Lock()
+	mmChangePassword.ChangePasswordMock.callArgs = append(mmChangePassword.ChangePasswordMock.callArgs, &mm_params)
+	mmChangePassword.ChangePasswordMock.mutex.Unlock()
 
-	for _, e := range mmCreate.CreateMock.expectations {
+	for _, e := range mmChangePassword.ChangePasswordMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreate.CreateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreate.CreateMock.defaultExpectation.params
-		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+	if mmChangePassword.ChangePasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmChangePassword.ChangePasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmChangePassword.ChangePasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmChangePassword.ChangePasswordMock.defaultExpectation.paramPtrs
 
-		mm_got := UserServiceMockCreateParams{ctx, info}
+		mm_got := UserServiceMockChangePasswordParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreate.t.Errorf("UserServiceMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmChangePassword.t.Errorf("UserServiceMock.ChangePassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.info != nil && !minimock.Equal(*mm_want_ptrs.info, mm_got.info) {
-				mmCreate.t.Errorf("UserServiceMock.Create got unexpected parameter info, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originInfo, *mm_want_ptrs.info, mm_got.info, minimock.Diff(*mm_want_ptrs.info, mm_got.info))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmChangePassword.t.Errorf("UserServiceMock.ChangePassword got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreate.t.Errorf("UserServiceMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmChangePassword.t.Errorf("UserServiceMock.ChangePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		mm_results := mmChangePassword.ChangePasswordMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreate.t.Fatal("No results are set for the UserServiceMock.Create")
+			mmChangePassword.t.Fatal("No results are set for the UserServiceMock.ChangePassword")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreate.funcCreate != nil {
-		return mmCreate.funcCreate(ctx, info)
+	if mmChangePassword.funcChangePassword != nil {
+		return mmChangePassword.funcChangePassword(ctx, req)
 	}
-	mmCreate.t.Fatalf("Unexpected call to UserServiceMock.Create. %v %v", ctx, info)
+	mmChangePassword.t.Fatalf("Unexpected call to UserServiceMock.ChangePassword. %v %v", ctx, req)
 	return
 }
 
-// CreateAfterCounter returns a count of finished UserServiceMock.Create invocations
-func (mmCreate *UserServiceMock) CreateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+// ChangePasswordAfterCounter returns a count of finished UserServiceMock.ChangePassword invocations
+func (mmChangePassword *UserServiceMock) ChangePasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangePassword.afterChangePasswordCounter)
 }
 
-// CreateBeforeCounter returns a count of UserServiceMock.Create invocations
-func (mmCreate *UserServiceMock) CreateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+// ChangePasswordBeforeCounter returns a count of UserServiceMock.ChangePassword invocations
+func (mmChangePassword *UserServiceMock) ChangePasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangePassword.beforeChangePasswordCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserServiceMock.Create.
+// Calls returns a list of arguments used in each call to UserServiceMock.ChangePassword.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreate *mUserServiceMockCreate) Calls() []*UserServiceMockCreateParams {
-	mmCreate.mutex.RLock()
+func (mmChangePassword *mUserServiceMockChangePassword) Calls() []*UserServiceMockChangePasswordParams {
+	mmChangePassword.mutex.RLock()
 
-	argCopy := make([]*UserServiceMockCreateParams, len(mmCreate.callArgs))
-	copy(argCopy, mmCreate.callArgs)
+	argCopy := make([]*UserServiceMockChangePasswordParams, len(mmChangePassword.callArgs))
+	copy(argCopy, mmChangePassword.callArgs)
 
-	mmCreate.mutex.RUnlock()
+	mmChangePassword.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// MinimockChangePasswordDone returns true if the count of the ChangePassword invocations corresponds
 // the number of defined expectations
-func (m *UserServiceMock) MinimockCreateDone() bool {
-	if m.CreateMock.optional {
+func (m *UserServiceMock) MinimockChangePasswordDone() bool {
+	if m.ChangePasswordMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateMock.expectations {
+	for _, e := range m.ChangePasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateMock.invocationsDone()
+	return m.ChangePasswordMock.invocationsDone()
 }
 
-// MinimockCreateInspect logs each unmet expectation
-func (m *UserServiceMock) MinimockCreateInspect() {
-	for _, e := range m.CreateMock.expectations {
+// MinimockChangePasswordInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockChangePasswordInspect() {
+	for _, e := range m.ChangePasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserServiceMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserServiceMock.ChangePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	afterChangePasswordCounter := mm_atomic.LoadUint64(&m.afterChangePasswordCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
-		if m.CreateMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserServiceMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+	if m.ChangePasswordMock.defaultExpectation != nil && afterChangePasswordCounter < 1 {
+		if m.ChangePasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.ChangePassword at\n%s", m.ChangePasswordMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserServiceMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserServiceMock.ChangePassword at\n%s with params: %#v", m.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *m.ChangePasswordMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreate != nil && afterCreateCounter < 1 {
-		m.t.Errorf("Expected call to UserServiceMock.Create at\n%s", m.funcCreateOrigin)
+	if m.funcChangePassword != nil && afterChangePasswordCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.ChangePassword at\n%s", m.funcChangePasswordOrigin)
 	}
 
-	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserServiceMock.Create at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	if !m.ChangePasswordMock.invocationsDone() && afterChangePasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.ChangePassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ChangePasswordMock.expectedInvocations), m.ChangePasswordMock.expectedInvocationsOrigin, afterChangePasswordCounter)
 	}
 }
 
-type mUserServiceMockDelete struct {
+type mUserServiceMockConfirmPasswordReset struct {
 	optional           bool
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockDeleteExpectation
-	expectations       []*UserServiceMockDeleteExpectation
+	defaultExpectation *UserServiceMockConfirmPasswordResetExpectation
+	expectations       []*UserServiceMockConfirmPasswordResetExpectation
 
-	callArgs []*UserServiceMockDeleteParams
+	callArgs []*UserServiceMockConfirmPasswordResetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserServiceMockDeleteExpectation specifies expectation struct of the UserService.Delete
-type UserServiceMockDeleteExpectation struct {
+// UserServiceMockConfirmPasswordResetExpectation specifies expectation struct of the UserService.ConfirmPasswordReset
+type UserServiceMockConfirmPasswordResetExpectation struct {
 	mock               *UserServiceMock
-	params             *UserServiceMockDeleteParams
-	paramPtrs          *UserServiceMockDeleteParamPtrs
-	expectationOrigins UserServiceMockDeleteExpectationOrigins
-	results            *UserServiceMockDeleteResults
+	params             *UserServiceMockConfirmPasswordResetParams
+	paramPtrs          *UserServiceMockConfirmPasswordResetParamPtrs
+	expectationOrigins UserServiceMockConfirmPasswordResetExpectationOrigins
+	results            *UserServiceMockConfirmPasswordResetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserServiceMockDeleteParams contains parameters of the UserService.Delete
-type UserServiceMockDeleteParams struct {
+// UserServiceMockConfirmPasswordResetParams contains parameters of the UserService.ConfirmPasswordReset
+type UserServiceMockConfirmPasswordResetParams struct {
 	ctx context.Context
-	id  int64
+	req model.ConfirmPasswordResetRequest
 }
 
-// UserServiceMockDeleteParamPtrs contains pointers to parameters of the UserService.Delete
-type UserServiceMockDeleteParamPtrs struct {
+// UserServiceMockConfirmPasswordResetParamPtrs contains pointers to parameters of the UserService.ConfirmPasswordReset
+type UserServiceMockConfirmPasswordResetParamPtrs struct {
 	ctx *context.Context
-	id  *int64
+	req *model.ConfirmPasswordResetRequest
 }
 
-// UserServiceMockDeleteResults contains results of the UserService.Delete
-type UserServiceMockDeleteResults struct {
+// UserServiceMockConfirmPasswordResetResults contains results of the UserService.ConfirmPasswordReset
+type UserServiceMockConfirmPasswordResetResults struct {
 	err error
 }
 
-// UserServiceMockDeleteOrigins contains origins of expectations of the UserService.Delete
-type UserServiceMockDeleteExpectationOrigins struct {
+// UserServiceMockConfirmPasswordResetOrigins contains origins of expectations of the UserService.ConfirmPasswordReset
+type UserServiceMockConfirmPasswordResetExpectationOrigins struct {
 	origin    string
 	originCtx string
-	originId  string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -469,80 +518,765 @@ type UserServiceMockDeleteExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDelete *mUserServiceMockDelete) Optional() *mUserServiceMockDelete {
-	mmDelete.optional = true
-	return mmDelete
+func (mmConfirmPasswordReset *mUserServiceMockConfirmPasswordReset) Optional() *mUserServiceMockConfirmPasswordReset {
+	mmConfirmPasswordReset.optional = true
+	return mmConfirmPasswordReset
 }
 
-// Expect sets up expected params for UserService.Delete
-func (mmDelete *mUserServiceMockDelete) Expect(ctx context.Context, id int64) *mUserServiceMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+// Expect sets up expected params for UserService.ConfirmPasswordReset
+func (mmConfirmPasswordReset *mUserServiceMockConfirmPasswordReset) Expect(ctx context.Context, req model.ConfirmPasswordResetRequest) *mUserServiceMockConfirmPasswordReset {
+	if mmConfirmPasswordReset.mock.funcConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("UserServiceMock.ConfirmPasswordReset mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{}
+	if mmConfirmPasswordReset.defaultExpectation == nil {
+		mmConfirmPasswordReset.defaultExpectation = &UserServiceMockConfirmPasswordResetExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs != nil {
-		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by ExpectParams functions")
+	if mmConfirmPasswordReset.defaultExpectation.paramPtrs != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("UserServiceMock.ConfirmPasswordReset mock is already set by ExpectParams functions")
 	}
 
-	mmDelete.defaultExpectation.params = &UserServiceMockDeleteParams{ctx, id}
-	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDelete.expectations {
-		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
-			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+	mmConfirmPasswordReset.defaultExpectation.params = &UserServiceMockConfirmPasswordResetParams{ctx, req}
+	mmConfirmPasswordReset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConfirmPasswordReset.expectations {
+		if minimock.Equal(e.params, mmConfirmPasswordReset.defaultExpectation.params) {
+			mmConfirmPasswordReset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConfirmPasswordReset.defaultExpectation.params)
 		}
 	}
 
-	return mmDelete
+	return mmConfirmPasswordReset
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserService.Delete
-func (mmDelete *mUserServiceMockDelete) ExpectCtxParam1(ctx context.Context) *mUserServiceMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserService.ConfirmPasswordReset
+func (mmConfirmPasswordReset *mUserServiceMockConfirmPasswordReset) ExpectCtxParam1(ctx context.Context) *mUserServiceMockConfirmPasswordReset {
+	if mmConfirmPasswordReset.mock.funcConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("UserServiceMock.ConfirmPasswordReset mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{}
+	if mmConfirmPasswordReset.defaultExpectation == nil {
+		mmConfirmPasswordReset.defaultExpectation = &UserServiceMockConfirmPasswordResetExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Expect")
+	if mmConfirmPasswordReset.defaultExpectation.params != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("UserServiceMock.ConfirmPasswordReset mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &UserServiceMockDeleteParamPtrs{}
+	if mmConfirmPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmConfirmPasswordReset.defaultExpectation.paramPtrs = &UserServiceMockConfirmPasswordResetParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmConfirmPasswordReset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConfirmPasswordReset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmConfirmPasswordReset
 }
 
-// ExpectIdParam2 sets up expected param id for UserService.Delete
-func (mmDelete *mUserServiceMockDelete) ExpectIdParam2(id int64) *mUserServiceMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for UserService.ConfirmPasswordReset
+func (mmConfirmPasswordReset *mUserServiceMockConfirmPasswordReset) ExpectReqParam2(req model.ConfirmPasswordResetRequest) *mUserServiceMockConfirmPasswordReset {
+	if mmConfirmPasswordReset.mock.funcConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("UserServiceMock.ConfirmPasswordReset mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{}
+	if mmConfirmPasswordReset.defaultExpectation == nil {
+		mmConfirmPasswordReset.defaultExpectation = &UserServiceMockConfirmPasswordResetExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Expect")
+	if mmConfirmPasswordReset.defaultExpectation.params != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("UserServiceMock.ConfirmPasswordReset mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &UserServiceMockDeleteParamPtrs{}
+	if mmConfirmPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmConfirmPasswordReset.defaultExpectation.paramPtrs = &UserServiceMockConfirmPasswordResetParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.id = &id
-	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmConfirmPasswordReset.defaultExpectation.paramPtrs.req = &req
+	mmConfirmPasswordReset.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmConfirmPasswordReset
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ConfirmPasswordReset
+func (mmConfirmPasswordReset *mUserServiceMockConfirmPasswordReset) Inspect(f func(ctx context.Context, req model.ConfirmPasswordResetRequest)) *mUserServiceMockConfirmPasswordReset {
+	if mmConfirmPasswordReset.mock.inspectFuncConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ConfirmPasswordReset")
+	}
+
+	mmConfirmPasswordReset.mock.inspectFuncConfirmPasswordReset = f
+
+	return mmConfirmPasswordReset
+}
+
+// Return sets up results that will be returned by UserService.ConfirmPasswordReset
+func (mmConfirmPasswordReset *mUserServiceMockConfirmPasswordReset) Return(err error) *UserServiceMock {
+	if mmConfirmPasswordReset.mock.funcConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("UserServiceMock.ConfirmPasswordReset mock is already set by Set")
+	}
+
+	if mmConfirmPasswordReset.defaultExpectation == nil {
+		mmConfirmPasswordReset.defaultExpectation = &UserServiceMockConfirmPasswordResetExpectation{mock: mmConfirmPasswordReset.mock}
+	}
+	mmConfirmPasswordReset.defaultExpectation.results = &UserServiceMockConfirmPasswordResetResults{err}
+	mmConfirmPasswordReset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConfirmPasswordReset.mock
+}
+
+// Set uses given function f to mock the UserService.ConfirmPasswordReset method
+func (mmConfirmPasswordReset *mUserServiceMockConfirmPasswordReset) Set(f func(ctx context.Context, req model.ConfirmPasswordResetRequest) (err error)) *UserServiceMock {
+	if mmConfirmPasswordReset.defaultExpectation != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("Default expectation is already set for the UserService.ConfirmPasswordReset method")
+	}
+
+	if len(mmConfirmPasswordReset.expectations) > 0 {
+		mmConfirmPasswordReset.mock.t.Fatalf("Some expectations are already set for the UserService.ConfirmPasswordReset method")
+	}
+
+	mmConfirmPasswordReset.mock.funcConfirmPasswordReset = f
+	mmConfirmPasswordReset.mock.funcConfirmPasswordResetOrigin = minimock.CallerInfo(1)
+	return mmConfirmPasswordReset.mock
+}
+
+// When sets expectation for the UserService.ConfirmPasswordReset which will trigger the result defined by the following
+// Then helper
+func (mmConfirmPasswordReset *mUserServiceMockConfirmPasswordReset) When(ctx context.Context, req model.ConfirmPasswordResetRequest) *UserServiceMockConfirmPasswordResetExpectation {
+	if mmConfirmPasswordReset.mock.funcConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("UserServiceMock.ConfirmPasswordReset mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockConfirmPasswordResetExpectation{
+		mock:               mmConfirmPasswordReset.mock,
+		params:             &UserServiceMockConfirmPasswordResetParams{ctx, req},
+		expectationOrigins: UserServiceMockConfirmPasswordResetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConfirmPasswordReset.expectations = append(mmConfirmPasswordReset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ConfirmPasswordReset return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockConfirmPasswordResetExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockConfirmPasswordResetResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.ConfirmPasswordReset should be invoked
+func (mmConfirmPasswordReset *mUserServiceMockConfirmPasswordReset) Times(n uint64) *mUserServiceMockConfirmPasswordReset {
+	if n == 0 {
+		mmConfirmPasswordReset.mock.t.Fatalf("Times of UserServiceMock.ConfirmPasswordReset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConfirmPasswordReset.expectedInvocations, n)
+	mmConfirmPasswordReset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConfirmPasswordReset
+}
+
+func (mmConfirmPasswordReset *mUserServiceMockConfirmPasswordReset) invocationsDone() bool {
+	if len(mmConfirmPasswordReset.expectations) == 0 && mmConfirmPasswordReset.defaultExpectation == nil && mmConfirmPasswordReset.mock.funcConfirmPasswordReset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConfirmPasswordReset.mock.afterConfirmPasswordResetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConfirmPasswordReset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConfirmPasswordReset implements mm_service.UserService
+func (mmConfirmPasswordReset *UserServiceMock) ConfirmPasswordReset(ctx context.Context, req model.ConfirmPasswordResetRequest) (err error) {
+	mm_atomic.AddUint64(&mmConfirmPasswordReset.beforeConfirmPasswordResetCounter, 1)
+	defer mm_atomic.AddUint64(&mmConfirmPasswordReset.afterConfirmPasswordResetCounter, 1)
+
+	mmConfirmPasswordReset.t.Helper()
+
+	if mmConfirmPasswordReset.inspectFuncConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.inspectFuncConfirmPasswordReset(ctx, req)
+	}
+
+	mm_params := UserServiceMockConfirmPasswordResetParams{ctx, req}
+
+	// Record call args
+	mmConfirmPasswordReset.ConfirmPasswordResetMock.mutex.Lock()
+	mmConfirmPasswordReset.ConfirmPasswordResetMock.callArgs = append(mmConfirmPasswordReset.ConfirmPasswordResetMock.callArgs, &mm_params)
+	mmConfirmPasswordReset.ConfirmPasswordResetMock.mutex.Unlock()
+
+	for _, e := range mmConfirmPasswordReset.ConfirmPasswordResetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.Counter, 1)
+		mm_want := mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.params
+		mm_want_ptrs := mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockConfirmPasswordResetParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConfirmPasswordReset.t.Errorf("UserServiceMock.ConfirmPasswordReset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmConfirmPasswordReset.t.Errorf("UserServiceMock.ConfirmPasswordReset got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConfirmPasswordReset.t.Errorf("UserServiceMock.ConfirmPasswordReset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConfirmPasswordReset.t.Fatal("No results are set for the UserServiceMock.ConfirmPasswordReset")
+		}
+		return (*mm_results).err
+	}
+	if mmConfirmPasswordReset.funcConfirmPasswordReset != nil {
+		return mmConfirmPasswordReset.funcConfirmPasswordReset(ctx, req)
+	}
+	mmConfirmPasswordReset.t.Fatalf("Unexpected call to UserServiceMock.ConfirmPasswordReset. %v %v", ctx, req)
+	return
+}
+
+// ConfirmPasswordResetAfterCounter returns a count of finished UserServiceMock.ConfirmPasswordReset invocations
+func (mmConfirmPasswordReset *UserServiceMock) ConfirmPasswordResetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmPasswordReset.afterConfirmPasswordResetCounter)
+}
+
+// ConfirmPasswordResetBeforeCounter returns a count of UserServiceMock.ConfirmPasswordReset invocations
+func (mmConfirmPasswordReset *UserServiceMock) ConfirmPasswordResetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmPasswordReset.beforeConfirmPasswordResetCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ConfirmPasswordReset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConfirmPasswordReset *mUserServiceMockConfirmPasswordReset) Calls() []*UserServiceMockConfirmPasswordResetParams {
+	mmConfirmPasswordReset.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockConfirmPasswordResetParams, len(mmConfirmPasswordReset.callArgs))
+	copy(argCopy, mmConfirmPasswordReset.callArgs)
+
+	mmConfirmPasswordReset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConfirmPasswordResetDone returns true if the count of the ConfirmPasswordReset invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockConfirmPasswordResetDone() bool {
+	if m.ConfirmPasswordResetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConfirmPasswordResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConfirmPasswordResetMock.invocationsDone()
+}
+
+// MinimockConfirmPasswordResetInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockConfirmPasswordResetInspect() {
+	for _, e := range m.ConfirmPasswordResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ConfirmPasswordReset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConfirmPasswordResetCounter := mm_atomic.LoadUint64(&m.afterConfirmPasswordResetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConfirmPasswordResetMock.defaultExpectation != nil && afterConfirmPasswordResetCounter < 1 {
+		if m.ConfirmPasswordResetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.ConfirmPasswordReset at\n%s", m.ConfirmPasswordResetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ConfirmPasswordReset at\n%s with params: %#v", m.ConfirmPasswordResetMock.defaultExpectation.expectationOrigins.origin, *m.ConfirmPasswordResetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConfirmPasswordReset != nil && afterConfirmPasswordResetCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.ConfirmPasswordReset at\n%s", m.funcConfirmPasswordResetOrigin)
+	}
+
+	if !m.ConfirmPasswordResetMock.invocationsDone() && afterConfirmPasswordResetCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.ConfirmPasswordReset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConfirmPasswordResetMock.expectedInvocations), m.ConfirmPasswordResetMock.expectedInvocationsOrigin, afterConfirmPasswordResetCounter)
+	}
+}
+
+type mUserServiceMockCreate struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockCreateExpectation
+	expectations       []*UserServiceMockCreateExpectation
+
+	callArgs []*UserServiceMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockCreateExpectation specifies expectation struct of the UserService.Create
+type UserServiceMockCreateExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockCreateParams
+	paramPtrs          *UserServiceMockCreateParamPtrs
+	expectationOrigins UserServiceMockCreateExpectationOrigins
+	results            *UserServiceMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockCreateParams contains parameters of the UserService.Create
+type UserServiceMockCreateParams struct {
+	ctx  context.Context
+	info *model.User
+}
+
+// UserServiceMockCreateParamPtrs contains pointers to parameters of the UserService.Create
+type UserServiceMockCreateParamPtrs struct {
+	ctx  *context.Context
+	info **model.User
+}
+
+// UserServiceMockCreateResults contains results of the UserService.Create
+type UserServiceMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// UserServiceMockCreateOrigins contains origins of expectations of the UserService.Create
+type UserServiceMockCreateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originInfo string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mUserServiceMockCreate) Optional() *mUserServiceMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for UserService.Create
+func (mmCreate *mUserServiceMockCreate) Expect(ctx context.Context, info *model.User) *mUserServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &UserServiceMockCreateParams{ctx, info}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.Create
+func (mmCreate *mUserServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mUserServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &UserServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectInfoParam2 sets up expected param info for UserService.Create
+func (mmCreate *mUserServiceMockCreate) ExpectInfoParam2(info *model.User) *mUserServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &UserServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.info = &info
+	mmCreate.defaultExpectation.expectationOrigins.originInfo = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Create
+func (mmCreate *mUserServiceMockCreate) Inspect(f func(ctx context.Context, info *model.User)) *mUserServiceMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by UserService.Create
+func (mmCreate *mUserServiceMockCreate) Return(i1 int64, err error) *UserServiceMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &UserServiceMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &UserServiceMockCreateResults{i1, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the UserService.Create method
+func (mmCreate *mUserServiceMockCreate) Set(f func(ctx context.Context, info *model.User) (i1 int64, err error)) *UserServiceMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the UserService.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the UserService.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the UserService.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mUserServiceMockCreate) When(ctx context.Context, info *model.User) *UserServiceMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("UserServiceMock.Create mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &UserServiceMockCreateParams{ctx, info},
+		expectationOrigins: UserServiceMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Create return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockCreateExpectation) Then(i1 int64, err error) *UserServiceMock {
+	e.results = &UserServiceMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.Create should be invoked
+func (mmCreate *mUserServiceMockCreate) Times(n uint64) *mUserServiceMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of UserServiceMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mUserServiceMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_service.UserService
+func (mmCreate *UserServiceMock) Create(ctx context.Context, info *model.User) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, info)
+	}
+
+	mm_params := UserServiceMockCreateParams{ctx, info}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockCreateParams{ctx, info}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("UserServiceMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.info != nil && !minimock.Equal(*mm_want_ptrs.info, mm_got.info) {
+				mmCreate.t.Errorf("UserServiceMock.Create got unexpected parameter info, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originInfo, *mm_want_ptrs.info, mm_got.info, minimock.Diff(*mm_want_ptrs.info, mm_got.info))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("UserServiceMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the UserServiceMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, info)
+	}
+	mmCreate.t.Fatalf("Unexpected call to UserServiceMock.Create. %v %v", ctx, info)
+	return
+}
+
+// CreateAfterCounter returns a count of finished UserServiceMock.Create invocations
+func (mmCreate *UserServiceMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of UserServiceMock.Create invocations
+func (mmCreate *UserServiceMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mUserServiceMockCreate) Calls() []*UserServiceMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mUserServiceMockDelete struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockDeleteExpectation
+	expectations       []*UserServiceMockDeleteExpectation
+
+	callArgs []*UserServiceMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockDeleteExpectation specifies expectation struct of the UserService.Delete
+type UserServiceMockDeleteExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockDeleteParams
+	paramPtrs          *UserServiceMockDeleteParamPtrs
+	expectationOrigins UserServiceMockDeleteExpectationOrigins
+	results            *UserServiceMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockDeleteParams contains parameters of the UserService.Delete
+type UserServiceMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserServiceMockDeleteParamPtrs contains pointers to parameters of the UserService.Delete
+type UserServiceMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserServiceMockDeleteResults contains results of the UserService.Delete
+type UserServiceMockDeleteResults struct {
+	err error
+}
+
+// UserServiceMockDeleteOrigins contains origins of expectations of the UserService.Delete
+type UserServiceMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mUserServiceMockDelete) Optional() *mUserServiceMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for UserService.Delete
+func (mmDelete *mUserServiceMockDelete) Expect(ctx context.Context, id int64) *mUserServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &UserServiceMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.Delete
+func (mmDelete *mUserServiceMockDelete) ExpectCtxParam1(ctx context.Context) *mUserServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &UserServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for UserService.Delete
+func (mmDelete *mUserServiceMockDelete) ExpectIdParam2(id int64) *mUserServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &UserServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
 }
 
 // Inspect accepts an inspector function that has same arguments as the UserService.Delete
@@ -551,257 +1285,942 @@ func (mmDelete *mUserServiceMockDelete) Inspect(f func(ctx context.Context, id i
 		mmDelete.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Delete")
 	}
 
-	mmDelete.mock.inspectFuncDelete = f
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by UserService.Delete
+func (mmDelete *mUserServiceMockDelete) Return(err error) *UserServiceMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &UserServiceMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the UserService.Delete method
+func (mmDelete *mUserServiceMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *UserServiceMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the UserService.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the UserService.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the UserService.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mUserServiceMockDelete) When(ctx context.Context, id int64) *UserServiceMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &UserServiceMockDeleteParams{ctx, id},
+		expectationOrigins: UserServiceMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Delete return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockDeleteExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.Delete should be invoked
+func (mmDelete *mUserServiceMockDelete) Times(n uint64) *mUserServiceMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of UserServiceMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mUserServiceMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_service.UserService
+func (mmDelete *UserServiceMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := UserServiceMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("UserServiceMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("UserServiceMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("UserServiceMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the UserServiceMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to UserServiceMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished UserServiceMock.Delete invocations
+func (mmDelete *UserServiceMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of UserServiceMock.Delete invocations
+func (mmDelete *UserServiceMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mUserServiceMockDelete) Calls() []*UserServiceMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mUserServiceMockGet struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockGetExpectation
+	expectations       []*UserServiceMockGetExpectation
+
+	callArgs []*UserServiceMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockGetExpectation specifies expectation struct of the UserService.Get
+type UserServiceMockGetExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockGetParams
+	paramPtrs          *UserServiceMockGetParamPtrs
+	expectationOrigins UserServiceMockGetExpectationOrigins
+	results            *UserServiceMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockGetParams contains parameters of the UserService.Get
+type UserServiceMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserServiceMockGetParamPtrs contains pointers to parameters of the UserService.Get
+type UserServiceMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserServiceMockGetResults contains results of the UserService.Get
+type UserServiceMockGetResults struct {
+	up1 *model.User
+	err error
+}
+
+// UserServiceMockGetOrigins contains origins of expectations of the UserService.Get
+type UserServiceMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mUserServiceMockGet) Optional() *mUserServiceMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for UserService.Get
+func (mmGet *mUserServiceMockGet) Expect(ctx context.Context, id int64) *mUserServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &UserServiceMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.Get
+func (mmGet *mUserServiceMockGet) ExpectCtxParam1(ctx context.Context) *mUserServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &UserServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for UserService.Get
+func (mmGet *mUserServiceMockGet) ExpectIdParam2(id int64) *mUserServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &UserServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.Get
+func (mmGet *mUserServiceMockGet) Inspect(f func(ctx context.Context, id int64)) *mUserServiceMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by UserService.Get
+func (mmGet *mUserServiceMockGet) Return(up1 *model.User, err error) *UserServiceMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &UserServiceMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &UserServiceMockGetResults{up1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the UserService.Get method
+func (mmGet *mUserServiceMockGet) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *UserServiceMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the UserService.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the UserService.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the UserService.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mUserServiceMockGet) When(ctx context.Context, id int64) *UserServiceMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &UserServiceMockGetParams{ctx, id},
+		expectationOrigins: UserServiceMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.Get return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockGetExpectation) Then(up1 *model.User, err error) *UserServiceMock {
+	e.results = &UserServiceMockGetResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.Get should be invoked
+func (mmGet *mUserServiceMockGet) Times(n uint64) *mUserServiceMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of UserServiceMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mUserServiceMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_service.UserService
+func (mmGet *UserServiceMock) Get(ctx context.Context, id int64) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := UserServiceMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("UserServiceMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("UserServiceMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("UserServiceMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the UserServiceMock.Get")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to UserServiceMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished UserServiceMock.Get invocations
+func (mmGet *UserServiceMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of UserServiceMock.Get invocations
+func (mmGet *UserServiceMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mUserServiceMockGet) Calls() []*UserServiceMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mUserServiceMockRequestPasswordReset struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockRequestPasswordResetExpectation
+	expectations       []*UserServiceMockRequestPasswordResetExpectation
 
-	return mmDelete
+	callArgs []*UserServiceMockRequestPasswordResetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
 }
 
-// Return sets up results that will be returned by UserService.Delete
-func (mmDelete *mUserServiceMockDelete) Return(err error) *UserServiceMock {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+// UserServiceMockRequestPasswordResetExpectation specifies expectation struct of the UserService.RequestPasswordReset
+type UserServiceMockRequestPasswordResetExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockRequestPasswordResetParams
+	paramPtrs          *UserServiceMockRequestPasswordResetParamPtrs
+	expectationOrigins UserServiceMockRequestPasswordResetExpectationOrigins
+	results            *UserServiceMockRequestPasswordResetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockRequestPasswordResetParams contains parameters of the UserService.RequestPasswordReset
+type UserServiceMockRequestPasswordResetParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UserServiceMockRequestPasswordResetParamPtrs contains pointers to parameters of the UserService.RequestPasswordReset
+type UserServiceMockRequestPasswordResetParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// UserServiceMockRequestPasswordResetResults contains results of the UserService.RequestPasswordReset
+type UserServiceMockRequestPasswordResetResults struct {
+	err error
+}
+
+// UserServiceMockRequestPasswordResetOrigins contains origins of expectations of the UserService.RequestPasswordReset
+type UserServiceMockRequestPasswordResetExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequestPasswordReset *mUserServiceMockRequestPasswordReset) Optional() *mUserServiceMockRequestPasswordReset {
+	mmRequestPasswordReset.optional = true
+	return mmRequestPasswordReset
+}
+
+// Expect sets up expected params for UserService.RequestPasswordReset
+func (mmRequestPasswordReset *mUserServiceMockRequestPasswordReset) Expect(ctx context.Context, email string) *mUserServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UserServiceMock.RequestPasswordReset mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &UserServiceMockDeleteExpectation{mock: mmDelete.mock}
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &UserServiceMockRequestPasswordResetExpectation{}
 	}
-	mmDelete.defaultExpectation.results = &UserServiceMockDeleteResults{err}
-	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UserServiceMock.RequestPasswordReset mock is already set by ExpectParams functions")
+	}
+
+	mmRequestPasswordReset.defaultExpectation.params = &UserServiceMockRequestPasswordResetParams{ctx, email}
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequestPasswordReset.expectations {
+		if minimock.Equal(e.params, mmRequestPasswordReset.defaultExpectation.params) {
+			mmRequestPasswordReset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestPasswordReset.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestPasswordReset
 }
 
-// Set uses given function f to mock the UserService.Delete method
-func (mmDelete *mUserServiceMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *UserServiceMock {
-	if mmDelete.defaultExpectation != nil {
-		mmDelete.mock.t.Fatalf("Default expectation is already set for the UserService.Delete method")
+// ExpectCtxParam1 sets up expected param ctx for UserService.RequestPasswordReset
+func (mmRequestPasswordReset *mUserServiceMockRequestPasswordReset) ExpectCtxParam1(ctx context.Context) *mUserServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UserServiceMock.RequestPasswordReset mock is already set by Set")
 	}
 
-	if len(mmDelete.expectations) > 0 {
-		mmDelete.mock.t.Fatalf("Some expectations are already set for the UserService.Delete method")
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &UserServiceMockRequestPasswordResetExpectation{}
 	}
 
-	mmDelete.mock.funcDelete = f
-	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+	if mmRequestPasswordReset.defaultExpectation.params != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UserServiceMock.RequestPasswordReset mock is already set by Expect")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmRequestPasswordReset.defaultExpectation.paramPtrs = &UserServiceMockRequestPasswordResetParamPtrs{}
+	}
+	mmRequestPasswordReset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRequestPasswordReset
 }
 
-// When sets expectation for the UserService.Delete which will trigger the result defined by the following
+// ExpectEmailParam2 sets up expected param email for UserService.RequestPasswordReset
+func (mmRequestPasswordReset *mUserServiceMockRequestPasswordReset) ExpectEmailParam2(email string) *mUserServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UserServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &UserServiceMockRequestPasswordResetExpectation{}
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.params != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UserServiceMock.RequestPasswordReset mock is already set by Expect")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmRequestPasswordReset.defaultExpectation.paramPtrs = &UserServiceMockRequestPasswordResetParamPtrs{}
+	}
+	mmRequestPasswordReset.defaultExpectation.paramPtrs.email = &email
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmRequestPasswordReset
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.RequestPasswordReset
+func (mmRequestPasswordReset *mUserServiceMockRequestPasswordReset) Inspect(f func(ctx context.Context, email string)) *mUserServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.inspectFuncRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("Inspect function is already set for UserServiceMock.RequestPasswordReset")
+	}
+
+	mmRequestPasswordReset.mock.inspectFuncRequestPasswordReset = f
+
+	return mmRequestPasswordReset
+}
+
+// Return sets up results that will be returned by UserService.RequestPasswordReset
+func (mmRequestPasswordReset *mUserServiceMockRequestPasswordReset) Return(err error) *UserServiceMock {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UserServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &UserServiceMockRequestPasswordResetExpectation{mock: mmRequestPasswordReset.mock}
+	}
+	mmRequestPasswordReset.defaultExpectation.results = &UserServiceMockRequestPasswordResetResults{err}
+	mmRequestPasswordReset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset.mock
+}
+
+// Set uses given function f to mock the UserService.RequestPasswordReset method
+func (mmRequestPasswordReset *mUserServiceMockRequestPasswordReset) Set(f func(ctx context.Context, email string) (err error)) *UserServiceMock {
+	if mmRequestPasswordReset.defaultExpectation != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("Default expectation is already set for the UserService.RequestPasswordReset method")
+	}
+
+	if len(mmRequestPasswordReset.expectations) > 0 {
+		mmRequestPasswordReset.mock.t.Fatalf("Some expectations are already set for the UserService.RequestPasswordReset method")
+	}
+
+	mmRequestPasswordReset.mock.funcRequestPasswordReset = f
+	mmRequestPasswordReset.mock.funcRequestPasswordResetOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset.mock
+}
+
+// When sets expectation for the UserService.RequestPasswordReset which will trigger the result defined by the following
 // Then helper
-func (mmDelete *mUserServiceMockDelete) When(ctx context.Context, id int64) *UserServiceMockDeleteExpectation {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("UserServiceMock.Delete mock is already set by Set")
+func (mmRequestPasswordReset *mUserServiceMockRequestPasswordReset) When(ctx context.Context, email string) *UserServiceMockRequestPasswordResetExpectation {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UserServiceMock.RequestPasswordReset mock is already set by Set")
 	}
 
-	expectation := &UserServiceMockDeleteExpectation{
-		mock:               mmDelete.mock,
-		params:             &UserServiceMockDeleteParams{ctx, id},
-		expectationOrigins: UserServiceMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserServiceMockRequestPasswordResetExpectation{
+		mock:               mmRequestPasswordReset.mock,
+		params:             &UserServiceMockRequestPasswordResetParams{ctx, email},
+		expectationOrigins: UserServiceMockRequestPasswordResetExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	mmRequestPasswordReset.expectations = append(mmRequestPasswordReset.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserService.Delete return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockDeleteExpectation) Then(err error) *UserServiceMock {
-	e.results = &UserServiceMockDeleteResults{err}
+// Then sets up UserService.RequestPasswordReset return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockRequestPasswordResetExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockRequestPasswordResetResults{err}
 	return e.mock
 }
 
-// Times sets number of times UserService.Delete should be invoked
-func (mmDelete *mUserServiceMockDelete) Times(n uint64) *mUserServiceMockDelete {
+// Times sets number of times UserService.RequestPasswordReset should be invoked
+func (mmRequestPasswordReset *mUserServiceMockRequestPasswordReset) Times(n uint64) *mUserServiceMockRequestPasswordReset {
 	if n == 0 {
-		mmDelete.mock.t.Fatalf("Times of UserServiceMock.Delete mock can not be zero")
+		mmRequestPasswordReset.mock.t.Fatalf("Times of UserServiceMock.RequestPasswordReset mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
-	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDelete
+	mm_atomic.StoreUint64(&mmRequestPasswordReset.expectedInvocations, n)
+	mmRequestPasswordReset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset
 }
 
-func (mmDelete *mUserServiceMockDelete) invocationsDone() bool {
-	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+func (mmRequestPasswordReset *mUserServiceMockRequestPasswordReset) invocationsDone() bool {
+	if len(mmRequestPasswordReset.expectations) == 0 && mmRequestPasswordReset.defaultExpectation == nil && mmRequestPasswordReset.mock.funcRequestPasswordReset == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRequestPasswordReset.mock.afterRequestPasswordResetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequestPasswordReset.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Delete implements mm_service.UserService
-func (mmDelete *UserServiceMock) Delete(ctx context.Context, id int64) (err error) {
-	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
-	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+// RequestPasswordReset implements mm_service.UserService
+func (mmRequestPasswordReset *UserServiceMock) RequestPasswordReset(ctx context.Context, email string) (err error) {
+	mm_atomic.AddUint64(&mmRequestPasswordReset.beforeRequestPasswordResetCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestPasswordReset.afterRequestPasswordResetCounter, 1)
 
-	mmDelete.t.Helper()
+	mmRequestPasswordReset.t.Helper()
 
-	if mmDelete.inspectFuncDelete != nil {
-		mmDelete.inspectFuncDelete(ctx, id)
+	if mmRequestPasswordReset.inspectFuncRequestPasswordReset != nil {
+		mmRequestPasswordReset.inspectFuncRequestPasswordReset(ctx, email)
 	}
 
-	mm_params := UserServiceMockDeleteParams{ctx, id}
+	mm_params := UserServiceMockRequestPasswordResetParams{ctx, email}
 
 	// Record call args
-	mmDelete.DeleteMock.mutex.Lock()
-	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
-	mmDelete.DeleteMock.mutex.Unlock()
+	mmRequestPasswordReset.RequestPasswordResetMock.mutex.Lock()
+	mmRequestPasswordReset.RequestPasswordResetMock.callArgs = append(mmRequestPasswordReset.RequestPasswordResetMock.callArgs, &mm_params)
+	mmRequestPasswordReset.RequestPasswordResetMock.mutex.Unlock()
 
-	for _, e := range mmDelete.DeleteMock.expectations {
+	for _, e := range mmRequestPasswordReset.RequestPasswordResetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDelete.DeleteMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
-		mm_want := mmDelete.DeleteMock.defaultExpectation.params
-		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+	if mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.params
+		mm_want_ptrs := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.paramPtrs
 
-		mm_got := UserServiceMockDeleteParams{ctx, id}
+		mm_got := UserServiceMockRequestPasswordResetParams{ctx, email}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDelete.t.Errorf("UserServiceMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmRequestPasswordReset.t.Errorf("UserServiceMock.RequestPasswordReset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDelete.t.Errorf("UserServiceMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmRequestPasswordReset.t.Errorf("UserServiceMock.RequestPasswordReset got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDelete.t.Errorf("UserServiceMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRequestPasswordReset.t.Errorf("UserServiceMock.RequestPasswordReset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		mm_results := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDelete.t.Fatal("No results are set for the UserServiceMock.Delete")
+			mmRequestPasswordReset.t.Fatal("No results are set for the UserServiceMock.RequestPasswordReset")
 		}
 		return (*mm_results).err
 	}
-	if mmDelete.funcDelete != nil {
-		return mmDelete.funcDelete(ctx, id)
+	if mmRequestPasswordReset.funcRequestPasswordReset != nil {
+		return mmRequestPasswordReset.funcRequestPasswordReset(ctx, email)
 	}
-	mmDelete.t.Fatalf("Unexpected call to UserServiceMock.Delete. %v %v", ctx, id)
+	mmRequestPasswordReset.t.Fatalf("Unexpected call to UserServiceMock.RequestPasswordReset. %v %v", ctx, email)
 	return
 }
 
-// DeleteAfterCounter returns a count of finished UserServiceMock.Delete invocations
-func (mmDelete *UserServiceMock) DeleteAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+// RequestPasswordResetAfterCounter returns a count of finished UserServiceMock.RequestPasswordReset invocations
+func (mmRequestPasswordReset *UserServiceMock) RequestPasswordResetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestPasswordReset.afterRequestPasswordResetCounter)
 }
 
-// DeleteBeforeCounter returns a count of UserServiceMock.Delete invocations
-func (mmDelete *UserServiceMock) DeleteBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+// RequestPasswordResetBeforeCounter returns a count of UserServiceMock.RequestPasswordReset invocations
+func (mmRequestPasswordReset *UserServiceMock) RequestPasswordResetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestPasswordReset.beforeRequestPasswordResetCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserServiceMock.Delete.
+// Calls returns a list of arguments used in each call to UserServiceMock.RequestPasswordReset.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDelete *mUserServiceMockDelete) Calls() []*UserServiceMockDeleteParams {
-	mmDelete.mutex.RLock()
+func (mmRequestPasswordReset *mUserServiceMockRequestPasswordReset) Calls() []*UserServiceMockRequestPasswordResetParams {
+	mmRequestPasswordReset.mutex.RLock()
 
-	argCopy := make([]*UserServiceMockDeleteParams, len(mmDelete.callArgs))
-	copy(argCopy, mmDelete.callArgs)
+	argCopy := make([]*UserServiceMockRequestPasswordResetParams, len(mmRequestPasswordReset.callArgs))
+	copy(argCopy, mmRequestPasswordReset.callArgs)
 
-	mmDelete.mutex.RUnlock()
+	mmRequestPasswordReset.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// MinimockRequestPasswordResetDone returns true if the count of the RequestPasswordReset invocations corresponds
 // the number of defined expectations
-func (m *UserServiceMock) MinimockDeleteDone() bool {
-	if m.DeleteMock.optional {
+func (m *UserServiceMock) MinimockRequestPasswordResetDone() bool {
+	if m.RequestPasswordResetMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteMock.expectations {
+	for _, e := range m.RequestPasswordResetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteMock.invocationsDone()
+	return m.RequestPasswordResetMock.invocationsDone()
 }
 
-// MinimockDeleteInspect logs each unmet expectation
-func (m *UserServiceMock) MinimockDeleteInspect() {
-	for _, e := range m.DeleteMock.expectations {
+// MinimockRequestPasswordResetInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockRequestPasswordResetInspect() {
+	for _, e := range m.RequestPasswordResetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserServiceMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserServiceMock.RequestPasswordReset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	afterRequestPasswordResetCounter := mm_atomic.LoadUint64(&m.afterRequestPasswordResetCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
-		if m.DeleteMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserServiceMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+	if m.RequestPasswordResetMock.defaultExpectation != nil && afterRequestPasswordResetCounter < 1 {
+		if m.RequestPasswordResetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.RequestPasswordReset at\n%s", m.RequestPasswordResetMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserServiceMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserServiceMock.RequestPasswordReset at\n%s with params: %#v", m.RequestPasswordResetMock.defaultExpectation.expectationOrigins.origin, *m.RequestPasswordResetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDelete != nil && afterDeleteCounter < 1 {
-		m.t.Errorf("Expected call to UserServiceMock.Delete at\n%s", m.funcDeleteOrigin)
+	if m.funcRequestPasswordReset != nil && afterRequestPasswordResetCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.RequestPasswordReset at\n%s", m.funcRequestPasswordResetOrigin)
 	}
 
-	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserServiceMock.Delete at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	if !m.RequestPasswordResetMock.invocationsDone() && afterRequestPasswordResetCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.RequestPasswordReset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequestPasswordResetMock.expectedInvocations), m.RequestPasswordResetMock.expectedInvocationsOrigin, afterRequestPasswordResetCounter)
 	}
 }
 
-type mUserServiceMockGet struct {
+type mUserServiceMockResetPassword struct {
 	optional           bool
 	mock               *UserServiceMock
-	defaultExpectation *UserServiceMockGetExpectation
-	expectations       []*UserServiceMockGetExpectation
+	defaultExpectation *UserServiceMockResetPasswordExpectation
+	expectations       []*UserServiceMockResetPasswordExpectation
 
-	callArgs []*UserServiceMockGetParams
+	callArgs []*UserServiceMockResetPasswordParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserServiceMockGetExpectation specifies expectation struct of the UserService.Get
-type UserServiceMockGetExpectation struct {
+// UserServiceMockResetPasswordExpectation specifies expectation struct of the UserService.ResetPassword
+type UserServiceMockResetPasswordExpectation struct {
 	mock               *UserServiceMock
-	params             *UserServiceMockGetParams
-	paramPtrs          *UserServiceMockGetParamPtrs
-	expectationOrigins UserServiceMockGetExpectationOrigins
-	results            *UserServiceMockGetResults
+	params             *UserServiceMockResetPasswordParams
+	paramPtrs          *UserServiceMockResetPasswordParamPtrs
+	expectationOrigins UserServiceMockResetPasswordExpectationOrigins
+	results            *UserServiceMockResetPasswordResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserServiceMockGetParams contains parameters of the UserService.Get
-type UserServiceMockGetParams struct {
+// UserServiceMockResetPasswordParams contains parameters of the UserService.ResetPassword
+type UserServiceMockResetPasswordParams struct {
 	ctx context.Context
 	id  int64
 }
 
-// UserServiceMockGetParamPtrs contains pointers to parameters of the UserService.Get
-type UserServiceMockGetParamPtrs struct {
+// UserServiceMockResetPasswordParamPtrs contains pointers to parameters of the UserService.ResetPassword
+type UserServiceMockResetPasswordParamPtrs struct {
 	ctx *context.Context
 	id  *int64
 }
 
-// UserServiceMockGetResults contains results of the UserService.Get
-type UserServiceMockGetResults struct {
-	up1 *model.User
+// UserServiceMockResetPasswordResults contains results of the UserService.ResetPassword
+type UserServiceMockResetPasswordResults struct {
+	rp1 *model.ResetPasswordResponse
 	err error
 }
 
-// UserServiceMockGetOrigins contains origins of expectations of the UserService.Get
-type UserServiceMockGetExpectationOrigins struct {
+// UserServiceMockResetPasswordOrigins contains origins of expectations of the UserService.ResetPassword
+type UserServiceMockResetPasswordExpectationOrigins struct {
 	origin    string
 	originCtx string
 	originId  string
@@ -812,292 +2231,292 @@ type UserServiceMockGetExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGet *mUserServiceMockGet) Optional() *mUserServiceMockGet {
-	mmGet.optional = true
-	return mmGet
+func (mmResetPassword *mUserServiceMockResetPassword) Optional() *mUserServiceMockResetPassword {
+	mmResetPassword.optional = true
+	return mmResetPassword
 }
 
-// Expect sets up expected params for UserService.Get
-func (mmGet *mUserServiceMockGet) Expect(ctx context.Context, id int64) *mUserServiceMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+// Expect sets up expected params for UserService.ResetPassword
+func (mmResetPassword *mUserServiceMockResetPassword) Expect(ctx context.Context, id int64) *mUserServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UserServiceMock.ResetPassword mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &UserServiceMockGetExpectation{}
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UserServiceMockResetPasswordExpectation{}
 	}
 
-	if mmGet.defaultExpectation.paramPtrs != nil {
-		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by ExpectParams functions")
+	if mmResetPassword.defaultExpectation.paramPtrs != nil {
+		mmResetPassword.mock.t.Fatalf("UserServiceMock.ResetPassword mock is already set by ExpectParams functions")
 	}
 
-	mmGet.defaultExpectation.params = &UserServiceMockGetParams{ctx, id}
-	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGet.expectations {
-		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
-			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+	mmResetPassword.defaultExpectation.params = &UserServiceMockResetPasswordParams{ctx, id}
+	mmResetPassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmResetPassword.expectations {
+		if minimock.Equal(e.params, mmResetPassword.defaultExpectation.params) {
+			mmResetPassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResetPassword.defaultExpectation.params)
 		}
 	}
 
-	return mmGet
+	return mmResetPassword
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserService.Get
-func (mmGet *mUserServiceMockGet) ExpectCtxParam1(ctx context.Context) *mUserServiceMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserService.ResetPassword
+func (mmResetPassword *mUserServiceMockResetPassword) ExpectCtxParam1(ctx context.Context) *mUserServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UserServiceMock.ResetPassword mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &UserServiceMockGetExpectation{}
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UserServiceMockResetPasswordExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Expect")
+	if mmResetPassword.defaultExpectation.params != nil {
+		mmResetPassword.mock.t.Fatalf("UserServiceMock.ResetPassword mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &UserServiceMockGetParamPtrs{}
+	if mmResetPassword.defaultExpectation.paramPtrs == nil {
+		mmResetPassword.defaultExpectation.paramPtrs = &UserServiceMockResetPasswordParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmResetPassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmResetPassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmResetPassword
 }
 
-// ExpectIdParam2 sets up expected param id for UserService.Get
-func (mmGet *mUserServiceMockGet) ExpectIdParam2(id int64) *mUserServiceMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for UserService.ResetPassword
+func (mmResetPassword *mUserServiceMockResetPassword) ExpectIdParam2(id int64) *mUserServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UserServiceMock.ResetPassword mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &UserServiceMockGetExpectation{}
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UserServiceMockResetPasswordExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Expect")
+	if mmResetPassword.defaultExpectation.params != nil {
+		mmResetPassword.mock.t.Fatalf("UserServiceMock.ResetPassword mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &UserServiceMockGetParamPtrs{}
+	if mmResetPassword.defaultExpectation.paramPtrs == nil {
+		mmResetPassword.defaultExpectation.paramPtrs = &UserServiceMockResetPasswordParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.id = &id
-	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmResetPassword.defaultExpectation.paramPtrs.id = &id
+	mmResetPassword.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmResetPassword
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserService.Get
-func (mmGet *mUserServiceMockGet) Inspect(f func(ctx context.Context, id int64)) *mUserServiceMockGet {
-	if mmGet.mock.inspectFuncGet != nil {
-		mmGet.mock.t.Fatalf("Inspect function is already set for UserServiceMock.Get")
+// Inspect accepts an inspector function that has same arguments as the UserService.ResetPassword
+func (mmResetPassword *mUserServiceMockResetPassword) Inspect(f func(ctx context.Context, id int64)) *mUserServiceMockResetPassword {
+	if mmResetPassword.mock.inspectFuncResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ResetPassword")
 	}
 
-	mmGet.mock.inspectFuncGet = f
+	mmResetPassword.mock.inspectFuncResetPassword = f
 
-	return mmGet
+	return mmResetPassword
 }
 
-// Return sets up results that will be returned by UserService.Get
-func (mmGet *mUserServiceMockGet) Return(up1 *model.User, err error) *UserServiceMock {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+// Return sets up results that will be returned by UserService.ResetPassword
+func (mmResetPassword *mUserServiceMockResetPassword) Return(rp1 *model.ResetPasswordResponse, err error) *UserServiceMock {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UserServiceMock.ResetPassword mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &UserServiceMockGetExpectation{mock: mmGet.mock}
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UserServiceMockResetPasswordExpectation{mock: mmResetPassword.mock}
 	}
-	mmGet.defaultExpectation.results = &UserServiceMockGetResults{up1, err}
-	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+	mmResetPassword.defaultExpectation.results = &UserServiceMockResetPasswordResults{rp1, err}
+	mmResetPassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmResetPassword.mock
 }
 
-// Set uses given function f to mock the UserService.Get method
-func (mmGet *mUserServiceMockGet) Set(f func(ctx context.Context, id int64) (up1 *model.User, err error)) *UserServiceMock {
-	if mmGet.defaultExpectation != nil {
-		mmGet.mock.t.Fatalf("Default expectation is already set for the UserService.Get method")
+// Set uses given function f to mock the UserService.ResetPassword method
+func (mmResetPassword *mUserServiceMockResetPassword) Set(f func(ctx context.Context, id int64) (rp1 *model.ResetPasswordResponse, err error)) *UserServiceMock {
+	if mmResetPassword.defaultExpectation != nil {
+		mmResetPassword.mock.t.Fatalf("Default expectation is already set for the UserService.ResetPassword method")
 	}
 
-	if len(mmGet.expectations) > 0 {
-		mmGet.mock.t.Fatalf("Some expectations are already set for the UserService.Get method")
+	if len(mmResetPassword.expectations) > 0 {
+		mmResetPassword.mock.t.Fatalf("Some expectations are already set for the UserService.ResetPassword method")
 	}
 
-	mmGet.mock.funcGet = f
-	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+	mmResetPassword.mock.funcResetPassword = f
+	mmResetPassword.mock.funcResetPasswordOrigin = minimock.CallerInfo(1)
+	return mmResetPassword.mock
 }
 
-// When sets expectation for the UserService.Get which will trigger the result defined by the following
+// When sets expectation for the UserService.ResetPassword which will trigger the result defined by the following
 // Then helper
-func (mmGet *mUserServiceMockGet) When(ctx context.Context, id int64) *UserServiceMockGetExpectation {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("UserServiceMock.Get mock is already set by Set")
+func (mmResetPassword *mUserServiceMockResetPassword) When(ctx context.Context, id int64) *UserServiceMockResetPasswordExpectation {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UserServiceMock.ResetPassword mock is already set by Set")
 	}
 
-	expectation := &UserServiceMockGetExpectation{
-		mock:               mmGet.mock,
-		params:             &UserServiceMockGetParams{ctx, id},
-		expectationOrigins: UserServiceMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserServiceMockResetPasswordExpectation{
+		mock:               mmResetPassword.mock,
+		params:             &UserServiceMockResetPasswordParams{ctx, id},
+		expectationOrigins: UserServiceMockResetPasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGet.expectations = append(mmGet.expectations, expectation)
+	mmResetPassword.expectations = append(mmResetPassword.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserService.Get return parameters for the expectation previously defined by the When method
-func (e *UserServiceMockGetExpectation) Then(up1 *model.User, err error) *UserServiceMock {
-	e.results = &UserServiceMockGetResults{up1, err}
+// Then sets up UserService.ResetPassword return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockResetPasswordExpectation) Then(rp1 *model.ResetPasswordResponse, err error) *UserServiceMock {
+	e.results = &UserServiceMockResetPasswordResults{rp1, err}
 	return e.mock
 }
 
-// Times sets number of times UserService.Get should be invoked
-func (mmGet *mUserServiceMockGet) Times(n uint64) *mUserServiceMockGet {
+// Times sets number of times UserService.ResetPassword should be invoked
+func (mmResetPassword *mUserServiceMockResetPassword) Times(n uint64) *mUserServiceMockResetPassword {
 	if n == 0 {
-		mmGet.mock.t.Fatalf("Times of UserServiceMock.Get mock can not be zero")
+		mmResetPassword.mock.t.Fatalf("Times of UserServiceMock.ResetPassword mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
-	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGet
+	mm_atomic.StoreUint64(&mmResetPassword.expectedInvocations, n)
+	mmResetPassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmResetPassword
 }
 
-func (mmGet *mUserServiceMockGet) invocationsDone() bool {
-	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+func (mmResetPassword *mUserServiceMockResetPassword) invocationsDone() bool {
+	if len(mmResetPassword.expectations) == 0 && mmResetPassword.defaultExpectation == nil && mmResetPassword.mock.funcResetPassword == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmResetPassword.mock.afterResetPasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResetPassword.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Get implements mm_service.UserService
-func (mmGet *UserServiceMock) Get(ctx context.Context, id int64) (up1 *model.User, err error) {
-	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
-	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+// ResetPassword implements mm_service.UserService
+func (mmResetPassword *UserServiceMock) ResetPassword(ctx context.Context, id int64) (rp1 *model.ResetPasswordResponse, err error) {
+	mm_atomic.AddUint64(&mmResetPassword.beforeResetPasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmResetPassword.afterResetPasswordCounter, 1)
 
-	mmGet.t.Helper()
+	mmResetPassword.t.Helper()
 
-	if mmGet.inspectFuncGet != nil {
-		mmGet.inspectFuncGet(ctx, id)
+	if mmResetPassword.inspectFuncResetPassword != nil {
+		mmResetPassword.inspectFuncResetPassword(ctx, id)
 	}
 
-	mm_params := UserServiceMockGetParams{ctx, id}
+	mm_params := UserServiceMockResetPasswordParams{ctx, id}
 
 	// Record call args
-	mmGet.GetMock.mutex.Lock()
-	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
-	mmGet.GetMock.mutex.Unlock()
+	mmResetPassword.ResetPasswordMock.mutex.Lock()
+	mmResetPassword.ResetPasswordMock.callArgs = append(mmResetPassword.ResetPasswordMock.callArgs, &mm_params)
+	mmResetPassword.ResetPasswordMock.mutex.Unlock()
 
-	for _, e := range mmGet.GetMock.expectations {
+	for _, e := range mmResetPassword.ResetPasswordMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.rp1, e.results.err
 		}
 	}
 
-	if mmGet.GetMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
-		mm_want := mmGet.GetMock.defaultExpectation.params
-		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+	if mmResetPassword.ResetPasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResetPassword.ResetPasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmResetPassword.ResetPasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmResetPassword.ResetPasswordMock.defaultExpectation.paramPtrs
 
-		mm_got := UserServiceMockGetParams{ctx, id}
+		mm_got := UserServiceMockResetPasswordParams{ctx, id}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGet.t.Errorf("UserServiceMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmResetPassword.t.Errorf("UserServiceMock.ResetPassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGet.t.Errorf("UserServiceMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmResetPassword.t.Errorf("UserServiceMock.ResetPassword got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGet.t.Errorf("UserServiceMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmResetPassword.t.Errorf("UserServiceMock.ResetPassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGet.GetMock.defaultExpectation.results
+		mm_results := mmResetPassword.ResetPasswordMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGet.t.Fatal("No results are set for the UserServiceMock.Get")
+			mmResetPassword.t.Fatal("No results are set for the UserServiceMock.ResetPassword")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).rp1, (*mm_results).err
 	}
-	if mmGet.funcGet != nil {
-		return mmGet.funcGet(ctx, id)
+	if mmResetPassword.funcResetPassword != nil {
+		return mmResetPassword.funcResetPassword(ctx, id)
 	}
-	mmGet.t.Fatalf("Unexpected call to UserServiceMock.Get. %v %v", ctx, id)
+	mmResetPassword.t.Fatalf("Unexpected call to UserServiceMock.ResetPassword. %v %v", ctx, id)
 	return
 }
 
-// GetAfterCounter returns a count of finished UserServiceMock.Get invocations
-func (mmGet *UserServiceMock) GetAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+// ResetPasswordAfterCounter returns a count of finished UserServiceMock.ResetPassword invocations
+func (mmResetPassword *UserServiceMock) ResetPasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetPassword.afterResetPasswordCounter)
 }
 
-// GetBeforeCounter returns a count of UserServiceMock.Get invocations
-func (mmGet *UserServiceMock) GetBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+// ResetPasswordBeforeCounter returns a count of UserServiceMock.ResetPassword invocations
+func (mmResetPassword *UserServiceMock) ResetPasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetPassword.beforeResetPasswordCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserServiceMock.Get.
+// Calls returns a list of arguments used in each call to UserServiceMock.ResetPassword.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGet *mUserServiceMockGet) Calls() []*UserServiceMockGetParams {
-	mmGet.mutex.RLock()
+func (mmResetPassword *mUserServiceMockResetPassword) Calls() []*UserServiceMockResetPasswordParams {
+	mmResetPassword.mutex.RLock()
 
-	argCopy := make([]*UserServiceMockGetParams, len(mmGet.callArgs))
-	copy(argCopy, mmGet.callArgs)
+	argCopy := make([]*UserServiceMockResetPasswordParams, len(mmResetPassword.callArgs))
+	copy(argCopy, mmResetPassword.callArgs)
 
-	mmGet.mutex.RUnlock()
+	mmResetPassword.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetDone returns true if the count of the Get invocations corresponds
+// MinimockResetPasswordDone returns true if the count of the ResetPassword invocations corresponds
 // the number of defined expectations
-func (m *UserServiceMock) MinimockGetDone() bool {
-	if m.GetMock.optional {
+func (m *UserServiceMock) MinimockResetPasswordDone() bool {
+	if m.ResetPasswordMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetMock.expectations {
+	for _, e := range m.ResetPasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetMock.invocationsDone()
+	return m.ResetPasswordMock.invocationsDone()
 }
 
-// MinimockGetInspect logs each unmet expectation
-func (m *UserServiceMock) MinimockGetInspect() {
-	for _, e := range m.GetMock.expectations {
+// MinimockResetPasswordInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockResetPasswordInspect() {
+	for _, e := range m.ResetPasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserServiceMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserServiceMock.ResetPassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	afterResetPasswordCounter := mm_atomic.LoadUint64(&m.afterResetPasswordCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
-		if m.GetMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserServiceMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+	if m.ResetPasswordMock.defaultExpectation != nil && afterResetPasswordCounter < 1 {
+		if m.ResetPasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.ResetPassword at\n%s", m.ResetPasswordMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserServiceMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserServiceMock.ResetPassword at\n%s with params: %#v", m.ResetPasswordMock.defaultExpectation.expectationOrigins.origin, *m.ResetPasswordMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGet != nil && afterGetCounter < 1 {
-		m.t.Errorf("Expected call to UserServiceMock.Get at\n%s", m.funcGetOrigin)
+	if m.funcResetPassword != nil && afterResetPasswordCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.ResetPassword at\n%s", m.funcResetPasswordOrigin)
 	}
 
-	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserServiceMock.Get at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	if !m.ResetPasswordMock.invocationsDone() && afterResetPasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.ResetPassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResetPasswordMock.expectedInvocations), m.ResetPasswordMock.expectedInvocationsOrigin, afterResetPasswordCounter)
 	}
 }
 
@@ -1474,17 +2893,369 @@ func (m *UserServiceMock) MinimockUpdateInspect() {
 	}
 }
 
+type mUserServiceMockVerifyEmail struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockVerifyEmailExpectation
+	expectations       []*UserServiceMockVerifyEmailExpectation
+
+	callArgs []*UserServiceMockVerifyEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserServiceMockVerifyEmailExpectation specifies expectation struct of the UserService.VerifyEmail
+type UserServiceMockVerifyEmailExpectation struct {
+	mock               *UserServiceMock
+	params             *UserServiceMockVerifyEmailParams
+	paramPtrs          *UserServiceMockVerifyEmailParamPtrs
+	expectationOrigins UserServiceMockVerifyEmailExpectationOrigins
+	results            *UserServiceMockVerifyEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserServiceMockVerifyEmailParams contains parameters of the UserService.VerifyEmail
+type UserServiceMockVerifyEmailParams struct {
+	ctx   context.Context
+	token string
+}
+
+// UserServiceMockVerifyEmailParamPtrs contains pointers to parameters of the UserService.VerifyEmail
+type UserServiceMockVerifyEmailParamPtrs struct {
+	ctx   *context.Context
+	token *string
+}
+
+// UserServiceMockVerifyEmailResults contains results of the UserService.VerifyEmail
+type UserServiceMockVerifyEmailResults struct {
+	err error
+}
+
+// UserServiceMockVerifyEmailOrigins contains origins of expectations of the UserService.VerifyEmail
+type UserServiceMockVerifyEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Optional() *mUserServiceMockVerifyEmail {
+	mmVerifyEmail.optional = true
+	return mmVerifyEmail
+}
+
+// Expect sets up expected params for UserService.VerifyEmail
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Expect(ctx context.Context, token string) *mUserServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UserServiceMockVerifyEmailExpectation{}
+	}
+
+	if mmVerifyEmail.defaultExpectation.paramPtrs != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by ExpectParams functions")
+	}
+
+	mmVerifyEmail.defaultExpectation.params = &UserServiceMockVerifyEmailParams{ctx, token}
+	mmVerifyEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmVerifyEmail.expectations {
+		if minimock.Equal(e.params, mmVerifyEmail.defaultExpectation.params) {
+			mmVerifyEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerifyEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmVerifyEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.VerifyEmail
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) ExpectCtxParam1(ctx context.Context) *mUserServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UserServiceMockVerifyEmailExpectation{}
+	}
+
+	if mmVerifyEmail.defaultExpectation.params != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Expect")
+	}
+
+	if mmVerifyEmail.defaultExpectation.paramPtrs == nil {
+		mmVerifyEmail.defaultExpectation.paramPtrs = &UserServiceMockVerifyEmailParamPtrs{}
+	}
+	mmVerifyEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmVerifyEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmVerifyEmail
+}
+
+// ExpectTokenParam2 sets up expected param token for UserService.VerifyEmail
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) ExpectTokenParam2(token string) *mUserServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UserServiceMockVerifyEmailExpectation{}
+	}
+
+	if mmVerifyEmail.defaultExpectation.params != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Expect")
+	}
+
+	if mmVerifyEmail.defaultExpectation.paramPtrs == nil {
+		mmVerifyEmail.defaultExpectation.paramPtrs = &UserServiceMockVerifyEmailParamPtrs{}
+	}
+	mmVerifyEmail.defaultExpectation.paramPtrs.token = &token
+	mmVerifyEmail.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmVerifyEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.VerifyEmail
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Inspect(f func(ctx context.Context, token string)) *mUserServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.inspectFuncVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("Inspect function is already set for UserServiceMock.VerifyEmail")
+	}
+
+	mmVerifyEmail.mock.inspectFuncVerifyEmail = f
+
+	return mmVerifyEmail
+}
+
+// Return sets up results that will be returned by UserService.VerifyEmail
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Return(err error) *UserServiceMock {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UserServiceMockVerifyEmailExpectation{mock: mmVerifyEmail.mock}
+	}
+	mmVerifyEmail.defaultExpectation.results = &UserServiceMockVerifyEmailResults{err}
+	mmVerifyEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmVerifyEmail.mock
+}
+
+// Set uses given function f to mock the UserService.VerifyEmail method
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Set(f func(ctx context.Context, token string) (err error)) *UserServiceMock {
+	if mmVerifyEmail.defaultExpectation != nil {
+		mmVerifyEmail.mock.t.Fatalf("Default expectation is already set for the UserService.VerifyEmail method")
+	}
+
+	if len(mmVerifyEmail.expectations) > 0 {
+		mmVerifyEmail.mock.t.Fatalf("Some expectations are already set for the UserService.VerifyEmail method")
+	}
+
+	mmVerifyEmail.mock.funcVerifyEmail = f
+	mmVerifyEmail.mock.funcVerifyEmailOrigin = minimock.CallerInfo(1)
+	return mmVerifyEmail.mock
+}
+
+// When sets expectation for the UserService.VerifyEmail which will trigger the result defined by the following
+// Then helper
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) When(ctx context.Context, token string) *UserServiceMockVerifyEmailExpectation {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UserServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockVerifyEmailExpectation{
+		mock:               mmVerifyEmail.mock,
+		params:             &UserServiceMockVerifyEmailParams{ctx, token},
+		expectationOrigins: UserServiceMockVerifyEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmVerifyEmail.expectations = append(mmVerifyEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.VerifyEmail return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockVerifyEmailExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockVerifyEmailResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.VerifyEmail should be invoked
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Times(n uint64) *mUserServiceMockVerifyEmail {
+	if n == 0 {
+		mmVerifyEmail.mock.t.Fatalf("Times of UserServiceMock.VerifyEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerifyEmail.expectedInvocations, n)
+	mmVerifyEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmVerifyEmail
+}
+
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) invocationsDone() bool {
+	if len(mmVerifyEmail.expectations) == 0 && mmVerifyEmail.defaultExpectation == nil && mmVerifyEmail.mock.funcVerifyEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerifyEmail.mock.afterVerifyEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerifyEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// VerifyEmail implements mm_service.UserService
+func (mmVerifyEmail *UserServiceMock) VerifyEmail(ctx context.Context, token string) (err error) {
+	mm_atomic.AddUint64(&mmVerifyEmail.beforeVerifyEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerifyEmail.afterVerifyEmailCounter, 1)
+
+	mmVerifyEmail.t.Helper()
+
+	if mmVerifyEmail.inspectFuncVerifyEmail != nil {
+		mmVerifyEmail.inspectFuncVerifyEmail(ctx, token)
+	}
+
+	mm_params := UserServiceMockVerifyEmailParams{ctx, token}
+
+	// Record call args
+	mmVerifyEmail.VerifyEmailMock.mutex.Lock()
+	mmVerifyEmail.VerifyEmailMock.callArgs = append(mmVerifyEmail.VerifyEmailMock.callArgs, &mm_params)
+	mmVerifyEmail.VerifyEmailMock.mutex.Unlock()
+
+	for _, e := range mmVerifyEmail.VerifyEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmVerifyEmail.VerifyEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerifyEmail.VerifyEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerifyEmail.VerifyEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmVerifyEmail.VerifyEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockVerifyEmailParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmVerifyEmail.t.Errorf("UserServiceMock.VerifyEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyEmail.VerifyEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmVerifyEmail.t.Errorf("UserServiceMock.VerifyEmail got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyEmail.VerifyEmailMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerifyEmail.t.Errorf("UserServiceMock.VerifyEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmVerifyEmail.VerifyEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerifyEmail.VerifyEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerifyEmail.t.Fatal("No results are set for the UserServiceMock.VerifyEmail")
+		}
+		return (*mm_results).err
+	}
+	if mmVerifyEmail.funcVerifyEmail != nil {
+		return mmVerifyEmail.funcVerifyEmail(ctx, token)
+	}
+	mmVerifyEmail.t.Fatalf("Unexpected call to UserServiceMock.VerifyEmail. %v %v", ctx, token)
+	return
+}
+
+// VerifyEmailAfterCounter returns a count of finished UserServiceMock.VerifyEmail invocations
+func (mmVerifyEmail *UserServiceMock) VerifyEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyEmail.afterVerifyEmailCounter)
+}
+
+// VerifyEmailBeforeCounter returns a count of UserServiceMock.VerifyEmail invocations
+func (mmVerifyEmail *UserServiceMock) VerifyEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyEmail.beforeVerifyEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.VerifyEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerifyEmail *mUserServiceMockVerifyEmail) Calls() []*UserServiceMockVerifyEmailParams {
+	mmVerifyEmail.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockVerifyEmailParams, len(mmVerifyEmail.callArgs))
+	copy(argCopy, mmVerifyEmail.callArgs)
+
+	mmVerifyEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyEmailDone returns true if the count of the VerifyEmail invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockVerifyEmailDone() bool {
+	if m.VerifyEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyEmailMock.invocationsDone()
+}
+
+// MinimockVerifyEmailInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockVerifyEmailInspect() {
+	for _, e := range m.VerifyEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.VerifyEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterVerifyEmailCounter := mm_atomic.LoadUint64(&m.afterVerifyEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyEmailMock.defaultExpectation != nil && afterVerifyEmailCounter < 1 {
+		if m.VerifyEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserServiceMock.VerifyEmail at\n%s", m.VerifyEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.VerifyEmail at\n%s with params: %#v", m.VerifyEmailMock.defaultExpectation.expectationOrigins.origin, *m.VerifyEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerifyEmail != nil && afterVerifyEmailCounter < 1 {
+		m.t.Errorf("Expected call to UserServiceMock.VerifyEmail at\n%s", m.funcVerifyEmailOrigin)
+	}
+
+	if !m.VerifyEmailMock.invocationsDone() && afterVerifyEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.VerifyEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyEmailMock.expectedInvocations), m.VerifyEmailMock.expectedInvocationsOrigin, afterVerifyEmailCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *UserServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockChangePasswordInspect()
+
+			m.MinimockConfirmPasswordResetInspect()
+
 			m.MinimockCreateInspect()
 
 			m.MinimockDeleteInspect()
 
 			m.MinimockGetInspect()
 
+			m.MinimockRequestPasswordResetInspect()
+
+			m.MinimockResetPasswordInspect()
+
 			m.MinimockUpdateInspect()
+
+			m.MinimockVerifyEmailInspect()
 		}
 	})
 }
@@ -1508,8 +3279,13 @@ func (m *UserServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UserServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockChangePasswordDone() &&
+		m.MinimockConfirmPasswordResetDone() &&
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
 		m.MinimockGetDone() &&
-		m.MinimockUpdateDone()
+		m.MinimockRequestPasswordResetDone() &&
+		m.MinimockResetPasswordDone() &&
+		m.MinimockUpdateDone() &&
+		m.MinimockVerifyEmailDone()
 }
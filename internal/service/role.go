@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/model"
+)
+
+// RoleService интерфейс для управления ролями и правами
+type RoleService interface {
+	Create(ctx context.Context, name string) (int64, error)
+	List(ctx context.Context) ([]model.Role, error)
+	Delete(ctx context.Context, name string) error
+	AddPermission(ctx context.Context, perm model.RolePermission) error
+	RemovePermission(ctx context.Context, perm model.RolePermission) error
+	AssignRole(ctx context.Context, userRole model.UserRole) error
+	UnassignRole(ctx context.Context, userRole model.UserRole) error
+}
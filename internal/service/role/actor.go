@@ -0,0 +1,19 @@
+package role
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/requestctx"
+)
+
+// actor возвращает имя администратора для журнала аудита. Права администратора на все методы
+// RoleV1 проверяет интерцептор авторизации, здесь claims только читаются из контекста.
+func (s *serv) actor(ctx context.Context) (string, error) {
+	claims, ok := requestctx.ClaimsFromContext(ctx)
+	if !ok {
+		return "", model.ErrAccessTokenInvalid
+	}
+
+	return claims.Username, nil
+}
@@ -0,0 +1,58 @@
+package role
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Ippolid/auth/internal/model"
+)
+
+func (s *serv) AssignRole(ctx context.Context, userRole model.UserRole) error {
+	return s.changeUserRole(ctx, userRole, "RoleAssign", "user_role.assign", s.roleRepository.AssignRole)
+}
+
+func (s *serv) UnassignRole(ctx context.Context, userRole model.UserRole) error {
+	return s.changeUserRole(ctx, userRole, "RoleUnassign", "user_role.unassign", s.roleRepository.UnassignRole)
+}
+
+func (s *serv) changeUserRole(
+	ctx context.Context,
+	userRole model.UserRole,
+	method, action string,
+	change func(context.Context, model.UserRole) error,
+) error {
+	actor, err := s.actor(ctx)
+	if err != nil {
+		return err
+	}
+
+	var userRoles *model.UserRoles
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// Проверяем пользователя до изменения, чтобы не назначать роль несуществующему ID
+		_, errTx := s.roleRepository.GetUserRoles(ctx, userRole.UserID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = change(ctx, userRole)
+		if errTx != nil {
+			return errTx
+		}
+
+		target := "user:" + strconv.FormatInt(userRole.UserID, 10) + " " + userRole.Role
+		errTx = s.audit(ctx, method, actor, action, target)
+		if errTx != nil {
+			return errTx
+		}
+
+		userRoles, errTx = s.roleRepository.GetUserRoles(ctx, userRole.UserID)
+		return errTx
+	})
+	if err != nil {
+		return err
+	}
+
+	s.updateUserRoles(ctx, userRole.UserID, userRoles)
+
+	return nil
+}
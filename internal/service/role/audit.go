@@ -0,0 +1,36 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ippolid/auth/internal/model"
+)
+
+// audit записывает изменение в журнал аудита и общий лог. Вызывается внутри транзакции изменения,
+// поэтому изменение без записи в журнале не зафиксируется.
+func (s *serv) audit(ctx context.Context, method, actor, action, target string) error {
+	now := time.Now()
+
+	err := s.roleRepository.CreateAudit(ctx, model.Audit{
+		Actor:     actor,
+		Action:    action,
+		Target:    target,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.roleRepository.MakeLog(ctx, model.Log{
+		Method:    method,
+		CreatedAt: now,
+		Ctx:       fmt.Sprintf("%v", ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating log: %w", err)
+	}
+
+	return nil
+}
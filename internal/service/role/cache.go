@@ -0,0 +1,49 @@
+package role
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"go.uber.org/zap"
+)
+
+const updateRolesCacheFailed = "failed to update user roles cache"
+
+// Кэш и политика обновляются после фиксации транзакции: база — источник истины, ошибка только
+// логируется и исправится по истечении TTL или при периодической перезагрузке политики.
+
+// grantsChanged применяет изменение прав ролей к политике доступа этого экземпляра, сбрасывает
+// закэшированные роли и профили затронутых пользователей и оповещает остальные экземпляры,
+// чтобы они перечитали права, не дожидаясь периодической перезагрузки политики.
+func (s *serv) grantsChanged(ctx context.Context, holders []model.RoleHolder) {
+	if s.grants != nil {
+		if err := s.grants.ReloadGrants(ctx); err != nil {
+			logger.Warn("failed to reload access grants", zap.Error(err))
+		}
+	}
+
+	inv := model.CacheInvalidation{Grants: true}
+	for _, holder := range holders {
+		inv.UserIDs = append(inv.UserIDs, holder.UserID)
+		inv.Usernames = append(inv.Usernames, holder.Username)
+	}
+	if err := s.cache.Invalidate(ctx, inv); err != nil {
+		logger.Warn("failed to broadcast access grants change", zap.Strings("usernames", inv.Usernames), zap.Error(err))
+	}
+}
+
+// updateUserRoles заменяет закэшированные роли пользователя актуальными и сбрасывает его профиль:
+// профиль тоже хранит роли. Если роли записать не удалось, сбрасывает и их, чтобы GetAccessToken
+// перечитал роли из базы, а не ждал истечения TTL.
+func (s *serv) updateUserRoles(ctx context.Context, userID int64, userRoles *model.UserRoles) {
+	inv := model.CacheInvalidation{UserIDs: []int64{userID}}
+	if err := s.cache.CreateRoles(ctx, userRoles.Username, userRoles.Roles); err != nil {
+		logger.Warn(updateRolesCacheFailed, zap.String("username", userRoles.Username), zap.Error(err))
+		inv.Usernames = []string{userRoles.Username}
+	}
+
+	if err := s.cache.Invalidate(ctx, inv); err != nil {
+		logger.Warn("failed to invalidate user roles cache", zap.Int64("user_id", userID), zap.Error(err))
+	}
+}
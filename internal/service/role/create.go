@@ -0,0 +1,28 @@
+package role
+
+import (
+	"context"
+)
+
+func (s *serv) Create(ctx context.Context, name string) (int64, error) {
+	actor, err := s.actor(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		id, errTx = s.roleRepository.CreateRole(ctx, name)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.audit(ctx, "RoleCreate", actor, "role.create", name)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,36 @@
+package role
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/model"
+)
+
+func (s *serv) Delete(ctx context.Context, name string) error {
+	actor, err := s.actor(ctx)
+	if err != nil {
+		return err
+	}
+
+	if name == model.RoleAdmin || name == model.RoleUser {
+		return model.ErrBuiltinRole
+	}
+
+	var holders []model.RoleHolder
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		holders, errTx = s.roleRepository.DeleteRole(ctx, name)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.audit(ctx, "RoleDelete", actor, "role.delete", name)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.grantsChanged(ctx, holders)
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package role
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/model"
+)
+
+func (s *serv) List(ctx context.Context) ([]model.Role, error) {
+	roles, err := s.roleRepository.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,50 @@
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ippolid/auth/internal/access"
+	"github.com/Ippolid/auth/internal/model"
+)
+
+func (s *serv) AddPermission(ctx context.Context, perm model.RolePermission) error {
+	// Эндпоинт права может быть шаблоном, как и правила в access.yaml
+	if err := access.ValidatePattern(perm.Endpoint); err != nil {
+		return fmt.Errorf("%w: %v", model.ErrInvalidEndpointPattern, err)
+	}
+
+	return s.changePermission(ctx, perm, "RoleAddPermission", "permission.add", s.roleRepository.AddPermission)
+}
+
+func (s *serv) RemovePermission(ctx context.Context, perm model.RolePermission) error {
+	return s.changePermission(ctx, perm, "RoleRemovePermission", "permission.remove", s.roleRepository.RemovePermission)
+}
+
+func (s *serv) changePermission(
+	ctx context.Context,
+	perm model.RolePermission,
+	method, action string,
+	change func(context.Context, model.RolePermission) error,
+) error {
+	actor, err := s.actor(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := change(ctx, perm)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.audit(ctx, method, actor, action, perm.Role+" "+perm.Endpoint)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.grantsChanged(ctx, nil)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package role
+
+import (
+	"github.com/Ippolid/auth/internal/access"
+	"github.com/Ippolid/auth/internal/repository"
+	"github.com/Ippolid/auth/internal/service"
+	"github.com/Ippolid/platform_libary/pkg/db"
+)
+
+type serv struct {
+	roleRepository repository.RoleRepository
+	txManager      db.TxManager
+	cache          repository.CacheInterface
+	grants         access.GrantsReloader
+}
+
+// NewService создает новый экземпляр RoleService.
+// grants перечитывает политику доступа после изменения прав ролей; nil — права подхватит
+// периодическая перезагрузка политики.
+func NewService(
+	roleRepository repository.RoleRepository,
+	txManager db.TxManager,
+	cache repository.CacheInterface,
+	grants access.GrantsReloader,
+) service.RoleService {
+	return &serv{
+		roleRepository: roleRepository,
+		txManager:      txManager,
+		cache:          cache,
+		grants:         grants,
+	}
+}
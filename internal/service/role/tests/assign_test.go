@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/repository"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/service/mocks"
+	"github.com/Ippolid/auth/internal/service/role"
+	"github.com/Ippolid/platform_libary/pkg/db"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAssignRole(t *testing.T) {
+	type roleRepositoryMockFunc func(mc *minimock.Controller) repository.RoleRepository
+	type cacheMockFunc func(mc *minimock.Controller) repository.CacheInterface
+
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		admin    = gofakeit.Username()
+		username = gofakeit.Username()
+		userRole = model.UserRole{UserID: gofakeit.Int64(), Role: "support"}
+		before   = &model.UserRoles{Username: username, Roles: []string{model.RoleUser}}
+		after    = &model.UserRoles{Username: username, Roles: []string{"support", model.RoleUser}}
+		cacheErr = fmt.Errorf("cache error")
+	)
+
+	repoMock := func(mc *minimock.Controller) repository.RoleRepository {
+		mock := repoMocks.NewRoleRepositoryMock(mc)
+		mock.GetUserRolesMock.Set(func(_ context.Context, userID int64) (*model.UserRoles, error) {
+			require.Equal(t, userRole.UserID, userID)
+			if mock.AssignRoleAfterCounter() == 0 {
+				return before, nil
+			}
+			return after, nil
+		})
+		mock.AssignRoleMock.Expect(minimock.AnyContext, userRole).Return(nil)
+		mock.CreateAuditMock.Return(nil)
+		mock.MakeLogMock.Return(nil)
+		return mock
+	}
+
+	tests := []struct {
+		name               string
+		wantCode           codes.Code
+		roleRepositoryMock roleRepositoryMockFunc
+		cacheMock          cacheMockFunc
+	}{
+		{
+			name:               "roles cached and profile invalidated",
+			wantCode:           codes.OK,
+			roleRepositoryMock: repoMock,
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.CreateRolesMock.Expect(minimock.AnyContext, username, after.Roles).Return(nil)
+				mock.InvalidateMock.Expect(minimock.AnyContext, model.CacheInvalidation{UserIDs: []int64{userRole.UserID}}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:               "roles and profile invalidated when cache write fails",
+			wantCode:           codes.OK,
+			roleRepositoryMock: repoMock,
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.CreateRolesMock.Expect(minimock.AnyContext, username, after.Roles).Return(cacheErr)
+				mock.InvalidateMock.Expect(minimock.AnyContext, model.CacheInvalidation{
+					UserIDs:   []int64{userRole.UserID},
+					Usernames: []string{username},
+				}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:     "unknown user",
+			wantCode: codes.NotFound,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.GetUserRolesMock.Expect(minimock.AnyContext, userRole.UserID).Return(nil, model.ErrUserNotFound)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				return repoMocks.NewCacheInterfaceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			t.Cleanup(mc.Finish)
+
+			txManager := mocks.NewTxManagerMock(mc)
+			txManager.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			service := role.NewService(tt.roleRepositoryMock(mc), txManager, tt.cacheMock(mc), &grantsReloader{})
+
+			err := service.AssignRole(withCaller(admin, model.RoleAdmin), userRole)
+			require.Equal(t, tt.wantCode, status.Code(interceptor.ToStatus(err)))
+		})
+	}
+}
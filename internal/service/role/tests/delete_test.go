@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/repository"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/service/mocks"
+	"github.com/Ippolid/auth/internal/service/role"
+	"github.com/Ippolid/platform_libary/pkg/db"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeleteRole(t *testing.T) {
+	type roleRepositoryMockFunc func(mc *minimock.Controller) repository.RoleRepository
+	type cacheMockFunc func(mc *minimock.Controller) repository.CacheInterface
+
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		admin   = gofakeit.Username()
+		name    = "support"
+		holders = []model.RoleHolder{
+			{UserID: 7, Username: "alice"},
+			{UserID: 9, Username: "bob"},
+		}
+	)
+
+	tests := []struct {
+		name               string
+		role               string
+		wantCode           codes.Code
+		roleRepositoryMock roleRepositoryMockFunc
+		cacheMock          cacheMockFunc
+		wantReload         bool
+	}{
+		{
+			name:     "roles and profiles of every holder invalidated",
+			role:     name,
+			wantCode: codes.OK,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.DeleteRoleMock.Expect(minimock.AnyContext, name).Return(holders, nil)
+				mock.CreateAuditMock.Return(nil)
+				mock.MakeLogMock.Return(nil)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.InvalidateMock.Expect(minimock.AnyContext, model.CacheInvalidation{
+					UserIDs:   []int64{7, 9},
+					Usernames: []string{"alice", "bob"},
+					Grants:    true,
+				}).Return(nil)
+				return mock
+			},
+			wantReload: true,
+		},
+		{
+			name:     "builtin role",
+			role:     model.RoleAdmin,
+			wantCode: codes.FailedPrecondition,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				return repoMocks.NewRoleRepositoryMock(mc)
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				return repoMocks.NewCacheInterfaceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			t.Cleanup(mc.Finish)
+
+			txManager := mocks.NewTxManagerMock(mc)
+			txManager.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			grants := &grantsReloader{}
+			service := role.NewService(tt.roleRepositoryMock(mc), txManager, tt.cacheMock(mc), grants)
+
+			err := service.Delete(withCaller(admin, model.RoleAdmin), tt.role)
+			require.Equal(t, tt.wantCode, status.Code(interceptor.ToStatus(err)))
+			require.Equal(t, tt.wantReload, grants.reloads == 1)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/repository"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/requestctx"
+	"github.com/Ippolid/auth/internal/service/mocks"
+	"github.com/Ippolid/auth/internal/service/role"
+	"github.com/Ippolid/platform_libary/pkg/db"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// withCaller контекст вызова с claims, проверенными интерцептором авторизации
+func withCaller(username string, roles ...string) context.Context {
+	return requestctx.ContextWithClaims(context.Background(), &model.UserClaims{Username: username, Roles: roles})
+}
+
+// grantsReloader считает перезагрузки прав ролей
+type grantsReloader struct {
+	reloads int
+}
+
+func (g *grantsReloader) ReloadGrants(context.Context) error {
+	g.reloads++
+	return nil
+}
+
+func TestAddPermission(t *testing.T) {
+	type roleRepositoryMockFunc func(mc *minimock.Controller) repository.RoleRepository
+	type cacheMockFunc func(mc *minimock.Controller) repository.CacheInterface
+
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		admin   = gofakeit.Username()
+		perm    = model.RolePermission{Role: "support", Endpoint: "/user_v1.UserV1/Get"}
+		repoErr = fmt.Errorf("repo error")
+	)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		wantCode           codes.Code
+		roleRepositoryMock roleRepositoryMockFunc
+		cacheMock          cacheMockFunc
+		wantReload         bool
+	}{
+		{
+			name:     "permission added, audited, policy reloaded and change broadcast",
+			ctx:      withCaller(admin, model.RoleAdmin),
+			wantCode: codes.OK,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.AddPermissionMock.Expect(minimock.AnyContext, perm).Return(nil)
+				mock.CreateAuditMock.Set(func(_ context.Context, audit model.Audit) error {
+					require.Equal(t, admin, audit.Actor)
+					require.Equal(t, "permission.add", audit.Action)
+					return nil
+				})
+				mock.MakeLogMock.Return(nil)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.InvalidateMock.Expect(minimock.AnyContext, model.CacheInvalidation{Grants: true}).Return(nil)
+				return mock
+			},
+			wantReload: true,
+		},
+		{
+			name:     "unknown role",
+			ctx:      withCaller(admin, model.RoleAdmin),
+			wantCode: codes.NotFound,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.AddPermissionMock.Expect(minimock.AnyContext, perm).Return(model.ErrRoleNotFound)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				return repoMocks.NewCacheInterfaceMock(mc)
+			},
+		},
+		{
+			name:     "repository error leaves policy untouched",
+			ctx:      withCaller(admin, model.RoleAdmin),
+			wantCode: codes.Internal,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				mock := repoMocks.NewRoleRepositoryMock(mc)
+				mock.AddPermissionMock.Expect(minimock.AnyContext, perm).Return(repoErr)
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				return repoMocks.NewCacheInterfaceMock(mc)
+			},
+		},
+		{
+			name:     "no access token",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+			roleRepositoryMock: func(mc *minimock.Controller) repository.RoleRepository {
+				return repoMocks.NewRoleRepositoryMock(mc)
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				return repoMocks.NewCacheInterfaceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			t.Cleanup(mc.Finish)
+
+			txManager := mocks.NewTxManagerMock(mc)
+			txManager.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			grants := &grantsReloader{}
+			service := role.NewService(tt.roleRepositoryMock(mc), txManager, tt.cacheMock(mc), grants)
+
+			err := service.AddPermission(tt.ctx, perm)
+			require.Equal(t, tt.wantCode, status.Code(interceptor.ToStatus(err)))
+			require.Equal(t, tt.wantReload, grants.reloads == 1)
+		})
+	}
+}
@@ -12,4 +12,9 @@ type UserService interface {
 	Get(ctx context.Context, id int64) (*model.User, error)
 	Delete(ctx context.Context, id int64) error
 	Update(ctx context.Context, id int64, info *model.UserInfo) error
+	ChangePassword(ctx context.Context, req model.ChangePasswordRequest) error
+	ResetPassword(ctx context.Context, id int64) (*model.ResetPasswordResponse, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, req model.ConfirmPasswordResetRequest) error
+	VerifyEmail(ctx context.Context, token string) error
 }
@@ -0,0 +1,54 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"go.uber.org/zap"
+)
+
+// invalidateCache сбрасывает кэш после фиксации транзакции, чтобы Get и GetAccessToken не отдавали
+// удаленного или измененного пользователя до истечения TTL. Кэш сам повторяет попытки;
+// окончательная ошибка только логируется — запись уже зафиксирована в базе.
+func (s *serv) invalidateCache(ctx context.Context, inv model.CacheInvalidation) {
+	if s.cache == nil {
+		return
+	}
+
+	if err := s.cache.Invalidate(ctx, inv); err != nil {
+		if errors.Is(err, model.ErrCacheUnavailable) {
+			// Недоставленные инвалидации покрывает сброс кэша после восстановления Redis
+			logger.Debug("user cache is unavailable, invalidation skipped", zap.Error(err))
+			return
+		}
+		logger.Warn("failed to invalidate user cache",
+			zap.Int64s("user_ids", inv.UserIDs),
+			zap.Strings("usernames", inv.Usernames),
+			zap.Error(err),
+		)
+	}
+}
+
+// cacheUnavailable логирует ошибку кэша, после которой запрос обслуживается из базы.
+// Пока Redis признан недоступным, ошибка ожидаема и пишется только в debug.
+func cacheUnavailable(err error, fields ...zap.Field) {
+	fields = append(fields, zap.Error(err))
+	if errors.Is(err, model.ErrCacheUnavailable) {
+		logger.Debug("user cache is unavailable, using database", fields...)
+		return
+	}
+	logger.Warn("user cache error, using database", fields...)
+}
+
+// renamedUsers возвращает имена, роли по которым устарели после изменения учетной записи:
+// старое имя освобождается, а под новым в кэше могли остаться роли прежнего владельца
+func renamedUsers(before, after *string) []string {
+	oldName, newName := stringValue(before), stringValue(after)
+	if after == nil || oldName == newName {
+		return nil
+	}
+
+	return []string{oldName, newName}
+}
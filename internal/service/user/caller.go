@@ -0,0 +1,34 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/requestctx"
+	"go.uber.org/zap"
+)
+
+// caller возвращает claims вызывающего, проверенные интерцептором авторизации
+func (s *serv) caller(ctx context.Context) (*model.UserClaims, error) {
+	claims, ok := requestctx.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, model.ErrAccessTokenInvalid
+	}
+
+	return claims, nil
+}
+
+// cacheRevokedFamilies переносит в кэш отзыв сессий, уже зафиксированный в Postgres.
+// Ошибка кэша не фатальна: без записи в кэше отзыв читается из базы.
+func (s *serv) cacheRevokedFamilies(ctx context.Context, familyIDs []string) {
+	if s.cache == nil {
+		return
+	}
+
+	for _, familyID := range familyIDs {
+		if err := s.cache.CreateRevokedFamily(ctx, familyID); err != nil {
+			logger.Warn("failed to cache token revocation", zap.String("family_id", familyID), zap.Error(err))
+		}
+	}
+}
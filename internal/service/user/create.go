@@ -7,14 +7,26 @@ import (
 	"time"
 
 	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/utils"
 )
 
 func (s *serv) Create(ctx context.Context, info *model.User) (int64, error) {
 	if info == nil {
-		return 0, fmt.Errorf("user info is nil")
+		return 0, model.ErrUserInfoRequired
+	}
+	info.User = normalizeUserInfo(info.User)
+	if err := validateUsername(info.User.Name); err != nil {
+		return 0, err
+	}
+
+	if err := s.passwords.Validate(info.Password, stringValue(info.User.Name), stringValue(info.User.Email)); err != nil {
+		return 0, err
 	}
 
-	var id int64
+	var (
+		id     int64
+		notice *model.EmailVerificationNotice
+	)
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		var errTx error
 		id, errTx = s.userRepository.CreateUser(ctx, *info)
@@ -35,6 +47,11 @@ func (s *serv) Create(ctx context.Context, info *model.User) (int64, error) {
 			return errTx
 		}
 
+		notice, errTx = s.issueEmailVerification(ctx, id, info.User)
+		if errTx != nil {
+			return errTx
+		}
+
 		return nil
 	})
 
@@ -49,6 +66,34 @@ func (s *serv) Create(ctx context.Context, info *model.User) (int64, error) {
 	} else {
 		log.Println("cache is not initialized, skipping cache creation")
 	}
+	// Пока имя было свободно, GetAccessToken мог закэшировать, что такого пользователя нет
+	s.invalidateCache(ctx, model.CacheInvalidation{Usernames: []string{stringValue(info.User.Name)}})
+
+	s.sendEmailVerification(ctx, notice)
 
 	return id, nil
 }
+
+// normalizeUserInfo приводит имя и email к форме, в которой их ищет Login
+func normalizeUserInfo(info model.UserInfo) model.UserInfo {
+	return model.UserInfo{
+		Name:  utils.NormalizeIdentifierPtr(info.Name),
+		Email: utils.NormalizeIdentifierPtr(info.Email),
+	}
+}
+
+// validateUsername запрещает пустое имя и '@' в нем: Login принимает идентификатор с '@' за email
+func validateUsername(name *string) error {
+	if name == nil || *name == "" || utils.IsEmailIdentifier(*name) {
+		return model.ErrInvalidUsername
+	}
+
+	return nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
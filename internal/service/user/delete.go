@@ -9,8 +9,18 @@ import (
 )
 
 func (s *serv) Delete(ctx context.Context, id int64) error {
+	inv := model.CacheInvalidation{UserIDs: []int64{id}}
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		errTx := s.userRepository.DeleteUser(ctx, id)
+		user, errTx := s.userRepository.GetUser(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+		// Роли кэшируются по имени: без сброса GetAccessToken выдал бы их по еще живому refresh-токену
+		if name := stringValue(user.User.Name); name != "" {
+			inv.Usernames = []string{name}
+		}
+
+		errTx = s.userRepository.DeleteUser(ctx, id)
 		if errTx != nil {
 			return errTx
 		}
@@ -24,11 +34,6 @@ func (s *serv) Delete(ctx context.Context, id int64) error {
 			return err
 		}
 
-		_, errTx = s.userRepository.GetUser(ctx, id)
-		if errTx == nil {
-			return errTx
-		}
-
 		return nil
 	})
 
@@ -36,5 +41,7 @@ func (s *serv) Delete(ctx context.Context, id int64) error {
 		return err
 	}
 
+	s.invalidateCache(ctx, inv)
+
 	return nil
 }
@@ -4,55 +4,108 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
 
+	"github.com/Ippolid/auth/internal/metric"
 	"github.com/Ippolid/auth/internal/model"
 )
 
+const (
+	// cacheName метка кэша профилей в метриках
+	cacheName = "user"
+	// loadTimeout сколько ждать общей загрузки профиля из базы
+	loadTimeout = 5 * time.Second
+)
+
 // Get получает профиль пользователя по ID
 func (s *serv) Get(ctx context.Context, id int64) (*model.User, error) {
-	var (
-		userProfile *model.User
-		errCache    error
-		err         error
-	)
 	span, ctx := opentracing.StartSpanFromContext(ctx, "get USER")
 	defer span.Finish()
-	userProfile, errCache = s.cache.Get(ctx, id)
-	if errCache != nil {
-		if errors.Is(errCache, model.ErrUserNotFound) {
-			err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-				var errTx error
-				userProfile, errTx = s.userRepository.GetUser(ctx, id)
-				if errTx != nil {
-					return fmt.Errorf("error getting user profile: %w", errTx)
-				}
-
-				errTx = s.userRepository.MakeLog(ctx, model.Log{
-					Method:    "GET",
-					CreatedAt: time.Now(),
-					Ctx:       fmt.Sprintf("%v", ctx),
-				})
-				if errTx != nil {
-					return fmt.Errorf("error creating log: %w", errTx)
-				}
-
-				if errTx = s.cache.Create(ctx, userProfile.ID, *userProfile); errTx != nil {
-					return fmt.Errorf("error caching user profile: %w", errTx)
-				}
-
-				return nil
-			})
-			if err != nil {
-				return nil, err
-			}
-			// если успешно получили из БД, возвращаем результат
-			return userProfile, nil
+
+	userProfile, errCache := s.cache.Get(ctx, id)
+	switch {
+	case errCache == nil:
+		metric.IncCacheLookupCounter(cacheName, "hit")
+		return userProfile, nil
+	case errors.Is(errCache, model.ErrCachedNotFound):
+		metric.IncCacheLookupCounter(cacheName, "negative_hit")
+		return nil, fmt.Errorf("%w: id %d", model.ErrUserNotFound, id)
+	case !errors.Is(errCache, model.ErrUserNotFound):
+		// Кэш необязателен: при его недоступности читаем из базы
+		cacheUnavailable(errCache)
+	}
+
+	// Одновременные промахи по одному ID ждут один запрос в базу. Запрос общий, поэтому идет в контексте
+	// без отмены первого вызывающего, а каждый вызывающий ждет его не дольше своего ctx.
+	loaded := false
+	load := s.loads.DoChan(strconv.FormatInt(id, 10), func() (interface{}, error) {
+		loaded = true
+		metric.IncCacheLookupCounter(cacheName, "miss")
+
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+		defer cancel()
+
+		return s.loadUser(loadCtx, id)
+	})
+
+	var res singleflight.Result
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res = <-load:
+	}
+	if !loaded {
+		metric.IncCacheLookupCounter(cacheName, "coalesced")
+	}
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	// Результат общий для всех ожидавших: каждый получает свою копию
+	loadedUser := *res.Val.(*model.User)
+	return &loadedUser, nil
+}
+
+// loadUser читает профиль из базы и кэширует его после коммита. Отсутствие пользователя тоже
+// кэшируется на короткое время, чтобы запросы несуществующих ID не доходили до базы.
+// Ошибки кэша не фатальны.
+func (s *serv) loadUser(ctx context.Context, id int64) (*model.User, error) {
+	var userProfile *model.User
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		userProfile, errTx = s.userRepository.GetUser(ctx, id)
+		if errTx != nil {
+			return fmt.Errorf("error getting user profile: %w", errTx)
 		}
-		// если ошибка другая — возвращаем ошибку кеша
-		return nil, fmt.Errorf("error with cache: %w", errCache)
+
+		errTx = s.userRepository.MakeLog(ctx, model.Log{
+			Method:    "GET",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+		if errTx != nil {
+			return fmt.Errorf("error creating log: %w", errTx)
+		}
+
+		return nil
+	})
+	if errors.Is(err, model.ErrUserNotFound) {
+		if errCache := s.cache.CreateUserNotFound(ctx, id); errCache != nil {
+			cacheUnavailable(errCache, zap.Int64("id", id))
+		}
+	}
+	if err != nil {
+		return nil, err
 	}
+
+	if errCache := s.cache.Create(ctx, id, *userProfile); errCache != nil {
+		cacheUnavailable(errCache, zap.Int64("id", id))
+	}
+
 	return userProfile, nil
 }
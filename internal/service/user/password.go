@@ -0,0 +1,162 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ippolid/auth/internal/loginguard"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/utils"
+)
+
+// resetTokenExpiration срок действия токена сброса пароля
+const resetTokenExpiration = time.Hour
+
+// ChangePassword меняет пароль владельцу учетной записи. Нужен текущий пароль; все сессии,
+// кроме той, в которой выдан access-токен, завершаются. Неверный текущий пароль учитывается
+// защитой Login от перебора: украденный access-токен не дает подбирать пароль в обход блокировок.
+func (s *serv) ChangePassword(ctx context.Context, req model.ChangePasswordRequest) error {
+	claims, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+
+	subjects := s.loginGuard.Subjects(ctx, claims.Username)
+	if err = s.loginGuard.CheckBlocked(ctx, subjects); err != nil {
+		return err
+	}
+
+	var families []string
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, errTx := s.userRepository.GetUser(ctx, req.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		username := stringValue(user.User.Name)
+		if username != claims.Username {
+			return model.ErrNotAccountOwner
+		}
+
+		if _, errTx = s.authRepository.Login(ctx, model.LoginRequest{
+			Username: username,
+			Password: req.CurrentPassword,
+		}); errTx != nil {
+			return errTx
+		}
+
+		families, errTx = s.setPassword(ctx, req.ID, user, req.NewPassword, claims.FamilyID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.userRepository.MakeLog(ctx, model.Log{
+			Method:    "ChangePassword",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+	})
+	if err != nil {
+		if loginguard.IsFailure(err) {
+			s.loginGuard.RegisterFailure(ctx, subjects)
+		}
+		return err
+	}
+
+	s.loginGuard.Reset(ctx, subjects)
+	s.cacheRevokedFamilies(ctx, families)
+
+	return nil
+}
+
+// ResetPassword выдает администратору одноразовый токен сброса пароля пользователя.
+// Токен возвращается только в ответе, в базе хранится его хеш. Права администратора
+// проверяет интерцептор авторизации.
+func (s *serv) ResetPassword(ctx context.Context, id int64) (*model.ResetPasswordResponse, error) {
+	token, err := utils.NewTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &model.ResetPasswordResponse{
+		Token:     token,
+		ExpiresAt: time.Now().Add(resetTokenExpiration),
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if _, errTx := s.userRepository.GetUser(ctx, id); errTx != nil {
+			return errTx
+		}
+
+		errTx := s.userRepository.CreatePasswordResetToken(ctx, model.PasswordResetToken{
+			TokenHash: utils.HashToken(token),
+			UserID:    id,
+			ExpiresAt: resp.ExpiresAt,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.userRepository.MakeLog(ctx, model.Log{
+			Method:    "ResetPassword",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ConfirmPasswordReset устанавливает новый пароль по токену сброса и завершает все сессии пользователя.
+// Если пароль не прошел политику, транзакция откатывается и токен остается действительным.
+func (s *serv) ConfirmPasswordReset(ctx context.Context, req model.ConfirmPasswordResetRequest) error {
+	var families []string
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		id, errTx := s.userRepository.UsePasswordResetToken(ctx, utils.HashToken(req.Token))
+		if errTx != nil {
+			return errTx
+		}
+
+		user, errTx := s.userRepository.GetUser(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		families, errTx = s.setPassword(ctx, id, user, req.NewPassword, "")
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.userRepository.MakeLog(ctx, model.Log{
+			Method:    "ConfirmPasswordReset",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	s.cacheRevokedFamilies(ctx, families)
+
+	return nil
+}
+
+// setPassword проверяет пароль политикой, сохраняет его и завершает сессии пользователя,
+// кроме keepFamilyID. Вызывается внутри транзакции.
+func (s *serv) setPassword(ctx context.Context, id int64, user *model.User, password string, keepFamilyID string) ([]string, error) {
+	username := stringValue(user.User.Name)
+	if err := s.passwords.Validate(password, username, stringValue(user.User.Email)); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepository.UpdatePassword(ctx, id, password); err != nil {
+		return nil, err
+	}
+
+	return s.authRepository.RevokeUserRefreshTokens(ctx, username, keepFamilyID)
+}
@@ -0,0 +1,84 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/utils"
+	"go.uber.org/zap"
+)
+
+// RequestPasswordReset отправляет владельцу email ссылку для сброса пароля. Ответ не зависит от того,
+// есть ли такой email: ошибки нет, а уведомление только ставится в очередь доставки, чтобы время ответа
+// не выдавало адрес.
+func (s *serv) RequestPasswordReset(ctx context.Context, email string) error {
+	token, err := utils.NewTokenID()
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(resetTokenExpiration)
+
+	var notice *model.PasswordResetNotice
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.userRepository.MakeLog(ctx, model.Log{
+			Method:    "RequestPasswordReset",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		user, errTx := s.userRepository.GetUserByEmail(ctx, email)
+		if errors.Is(errTx, model.ErrUserNotFound) {
+			return nil
+		}
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.userRepository.CreatePasswordResetToken(ctx, model.PasswordResetToken{
+			TokenHash: utils.HashToken(token),
+			UserID:    user.ID,
+			ExpiresAt: expiresAt,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		notice = &model.PasswordResetNotice{
+			Email:     stringValue(user.User.Email),
+			Name:      stringValue(user.User.Name),
+			Token:     token,
+			ExpiresAt: expiresAt,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if notice != nil {
+		s.notifyPasswordReset(ctx, *notice)
+	}
+
+	return nil
+}
+
+// notifyPasswordReset передает уведомление notifier; в приложении это очередь, которая доставляет
+// его в фоне. Ошибка только логируется: ответ клиенту от нее не зависит.
+func (s *serv) notifyPasswordReset(ctx context.Context, notice model.PasswordResetNotice) {
+	if s.notifier == nil {
+		logger.Warn("notifier is not configured, password reset notification dropped")
+		return
+	}
+
+	if err := s.notifier.NotifyPasswordReset(ctx, notice); err != nil {
+		logger.Error("failed to deliver password reset notification", zap.Error(err))
+	}
+}
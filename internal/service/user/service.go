@@ -1,43 +1,78 @@
 package user
 
 import (
+	"github.com/Ippolid/auth/internal/client/notifier"
+	"github.com/Ippolid/auth/internal/config"
+	"github.com/Ippolid/auth/internal/loginguard"
+	"github.com/Ippolid/auth/internal/passwordpolicy"
 	"github.com/Ippolid/auth/internal/repository"
 	"github.com/Ippolid/auth/internal/service"
 	"github.com/Ippolid/platform_libary/pkg/db"
+	"golang.org/x/sync/singleflight"
 )
 
 type serv struct {
 	userRepository repository.UserRepository
 	txManager      db.TxManager
 	cache          repository.CacheInterface
+	passwords      *passwordpolicy.Policy
+	authRepository repository.AuthRepository
+	loginGuard     *loginguard.Guard
+	notifier       notifier.Notifier
+
+	// loads объединяет одновременные загрузки профиля из базы при промахе кэша
+	loads singleflight.Group
 }
 
-// NewService создает новый экземпляр AuthService
+// NewService создает новый экземпляр AuthService.
+// passwords — политика паролей; nil отключает проверку. authRepository нужен для смены пароля:
+// проверки текущего пароля и завершения сессий; loginProtection защищает проверку текущего пароля
+// от перебора так же, как Login, nil — защита выключена; notifier доставляет пользователю ссылку для сброса пароля.
 func NewService(
 	userRepository repository.UserRepository,
 	txManager db.TxManager,
 	cache repository.CacheInterface,
+	passwords *passwordpolicy.Policy,
+	authRepository repository.AuthRepository,
+	loginProtection config.LoginProtectionConfig,
+	notifier notifier.Notifier,
 ) service.UserService {
 	return &serv{
 		userRepository: userRepository,
 		txManager:      txManager,
 		cache:          cache,
+		passwords:      passwords,
+		authRepository: authRepository,
+		loginGuard:     loginguard.NewGuard(cache, loginProtection),
+		notifier:       notifier,
 	}
 }
 
 // NewMockService создает новый экземпляр AuthService для тестирования
 func NewMockService(deps ...interface{}) service.UserService {
 	srv := serv{}
+	var loginProtection config.LoginProtectionConfig
 
 	for _, v := range deps {
 		switch s := v.(type) {
 		case repository.UserRepository:
 			srv.userRepository = s
+		case repository.CacheInterface:
+			srv.cache = s
 		case db.TxManager:
 			srv.txManager = s
+		case *passwordpolicy.Policy:
+			srv.passwords = s
+		case repository.AuthRepository:
+			srv.authRepository = s
+		case notifier.Notifier:
+			srv.notifier = s
+		case config.LoginProtectionConfig:
+			loginProtection = s
 		}
 
 	}
+	srv.loginGuard = loginguard.NewGuard(srv.cache, loginProtection)
 
 	return &srv
 }
@@ -6,7 +6,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Ippolid/auth/internal/logger"
 	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/passwordpolicy"
 	"github.com/Ippolid/auth/internal/repository"
 	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
 	"github.com/Ippolid/auth/internal/service/mocks"
@@ -15,6 +17,7 @@ import (
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
 )
 
 func ptr[T any](v T) *T {
@@ -26,6 +29,8 @@ func TestCreate(t *testing.T) {
 	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
 	type cacheMockFunc func(mc *minimock.Controller) repository.CacheInterface
 
+	logger.Init(zapcore.NewNopCore())
+
 	type args struct {
 		ctx  context.Context
 		user *model.User
@@ -41,12 +46,25 @@ func TestCreate(t *testing.T) {
 				Name:  ptr(gofakeit.Name()),
 				Email: ptr(gofakeit.Email()),
 			},
-			Role:      gofakeit.Bool(),
+			Roles:     []string{gofakeit.RandomString([]string{model.RoleUser, model.RoleAdmin})},
 			Password:  gofakeit.Password(true, true, true, true, false, 10),
 			CreatedAt: time.Now(),
 		}
+		weakUser = &model.User{
+			User:     user.User,
+			Roles:    user.Roles,
+			Password: "password",
+		}
+		emailNamedUser = &model.User{
+			User:     model.UserInfo{Name: ptr(gofakeit.Email()), Email: user.User.Email},
+			Roles:    user.Roles,
+			Password: user.Password,
+		}
 	)
 
+	policy, err := passwordpolicy.New(passwordpolicy.Rules{MinLength: 8, MaxLength: 72, MinCharClasses: 3}, "")
+	require.NoError(t, err)
+
 	tests := []struct {
 		name               string
 		args               args
@@ -76,6 +94,12 @@ func TestCreate(t *testing.T) {
 				mock.GetUserMock.Set(func(_ context.Context, _ int64) (*model.User, error) {
 					return user, nil
 				})
+				mock.CreateEmailVerificationTokenMock.Set(func(_ context.Context, token model.EmailVerificationToken) error {
+					if token.UserID != id || token.Email != *user.User.Email {
+						return fmt.Errorf("unexpected verification token: %+v", token)
+					}
+					return nil
+				})
 				return mock
 			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
@@ -88,6 +112,7 @@ func TestCreate(t *testing.T) {
 			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
 				mock := repoMocks.NewCacheInterfaceMock(mc)
 				mock.CreateMock.Expect(ctx, id, *user).Return(nil)
+				mock.InvalidateMock.Expect(ctx, model.CacheInvalidation{Usernames: []string{*user.User.Name}}).Return(nil)
 				return mock
 			},
 		},
@@ -118,6 +143,43 @@ func TestCreate(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "weak password rejected before transaction",
+			args: args{
+				ctx:  ctx,
+				user: weakUser,
+			},
+			wantID:  0,
+			wantErr: model.ErrWeakPassword,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				return mocks.NewTxManagerMock(mc)
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				return repoMocks.NewCacheInterfaceMock(mc)
+			},
+		},
+		{
+			// Иначе такое имя перехватило бы вход по email другого пользователя
+			name: "username that looks like an email rejected",
+			args: args{
+				ctx:  ctx,
+				user: emailNamedUser,
+			},
+			wantID:  0,
+			wantErr: model.ErrInvalidUsername,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				return mocks.NewTxManagerMock(mc)
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				return repoMocks.NewCacheInterfaceMock(mc)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -129,7 +191,7 @@ func TestCreate(t *testing.T) {
 			userRepoMock := tt.userRepositoryMock(mc)
 			txManagerMock := tt.txManagerMock(mc)
 			cacheMock := tt.cacheMock(mc)
-			service := user1.NewService(userRepoMock, txManagerMock, cacheMock)
+			service := user1.NewService(userRepoMock, txManagerMock, cacheMock, policy, nil, nil, nil)
 
 			gotID, err := service.Create(tt.args.ctx, tt.args.user)
 			require.ErrorIs(t, err, tt.wantErr)
@@ -20,6 +20,7 @@ import (
 func TestDelete(t *testing.T) {
 	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
 	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
+	type cacheMockFunc func(mc *minimock.Controller) repository.CacheInterface
 
 	type args struct {
 		ctx context.Context
@@ -30,6 +31,8 @@ func TestDelete(t *testing.T) {
 		ctx     = context.Background()
 		mc      = minimock.NewController(t)
 		id      = gofakeit.Int64()
+		name    = gofakeit.Username()
+		stored  = &model.User{ID: id, User: model.UserInfo{Name: &name}}
 		repoErr = fmt.Errorf("repo error")
 	)
 	t.Cleanup(mc.Finish)
@@ -40,6 +43,7 @@ func TestDelete(t *testing.T) {
 		err                error
 		userRepositoryMock userRepositoryMockFunc
 		txManagerMock      txManagerMockFunc
+		cacheMock          cacheMockFunc
 	}{
 		{
 			name: "success case",
@@ -50,6 +54,7 @@ func TestDelete(t *testing.T) {
 			err: nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(ctx, id).Return(stored, nil)
 				mock.DeleteUserMock.Expect(ctx, id).Return(nil)
 				mock.MakeLogMock.Set(func(_ context.Context, log model.Log) error {
 					if log.Method != "Delete" || log.Ctx != Ctxstring {
@@ -57,9 +62,14 @@ func TestDelete(t *testing.T) {
 					}
 					return nil
 				})
-				mock.GetUserMock.Set(func(_ context.Context, _ int64) (*model.User, error) {
-					return nil, fmt.Errorf("user not found")
-				})
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				mock := repoMocks.NewCacheInterfaceMock(mc)
+				mock.InvalidateMock.Expect(ctx, model.CacheInvalidation{
+					UserIDs:   []int64{id},
+					Usernames: []string{name},
+				}).Return(nil)
 				return mock
 			},
 
@@ -80,6 +90,7 @@ func TestDelete(t *testing.T) {
 			err: repoErr,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(ctx, id).Return(stored, nil)
 				mock.DeleteUserMock.Expect(ctx, id).Return(repoErr)
 				return mock
 			},
@@ -90,6 +101,32 @@ func TestDelete(t *testing.T) {
 				})
 				return mock
 			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				return repoMocks.NewCacheInterfaceMock(mc)
+			},
+		},
+		{
+			name: "user not found case",
+			args: args{
+				ctx: ctx,
+				id:  id,
+			},
+			err: model.ErrUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(ctx, id).Return(nil, model.ErrUserNotFound)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+					return f(ctx)
+				})
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
+				return repoMocks.NewCacheInterfaceMock(mc)
+			},
 		},
 	}
 
@@ -101,7 +138,7 @@ func TestDelete(t *testing.T) {
 
 			userRepoMock := tt.userRepositoryMock(mc)
 			txManagerMock := tt.txManagerMock(mc)
-			service := user.NewMockService(userRepoMock, txManagerMock)
+			service := user.NewMockService(userRepoMock, txManagerMock, tt.cacheMock(mc))
 
 			err := service.Delete(tt.args.ctx, tt.args.id)
 			require.ErrorIs(t, err, tt.err)
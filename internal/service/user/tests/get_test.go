@@ -36,7 +36,7 @@
 //		name := gofakeit.Name()
 //		email := gofakeit.Email()
 //		password := gofakeit.Password(true, true, true, true, false, 10)
-//		role := gofakeit.Bool()
+//		roles := []string{gofakeit.RandomString([]string{model.RoleUser, model.RoleAdmin})}
 //		createdAt := time.Now()
 //		repoErr := fmt.Errorf("repo error")
 //
@@ -46,7 +46,7 @@
 //				Name:  &name,
 //				Email: &email,
 //			},
-//			Role:      role,
+//			Roles:     roles,
 //			Password:  password,
 //			CreatedAt: createdAt,
 //		}
@@ -165,6 +165,8 @@ package tests
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -176,6 +178,7 @@ import (
 	"github.com/Ippolid/platform_libary/pkg/db"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -196,7 +199,7 @@ func TestGet(t *testing.T) {
 	name := gofakeit.Name()
 	email := gofakeit.Email()
 	password := gofakeit.Password(true, true, true, true, false, 10)
-	role := gofakeit.Bool()
+	roles := []string{gofakeit.RandomString([]string{model.RoleUser, model.RoleAdmin})}
 	createdAt := time.Now()
 	repoErr := fmt.Errorf("repo error")
 
@@ -206,7 +209,7 @@ func TestGet(t *testing.T) {
 			Name:  &name,
 			Email: &email,
 		},
-		Role:      role,
+		Roles:     roles,
 		Password:  password,
 		CreatedAt: createdAt,
 	}
@@ -270,24 +273,30 @@ func TestGet(t *testing.T) {
 			},
 		},
 		{
-			name: "cache returns unexpected error",
+			name: "cache unavailable, user read from database",
 			args: args{
 				ctx: ctx,
 				id:  id,
 			},
-			wantUser: nil,
-			wantErr:  fmt.Errorf("error with cache: %w", repoErr),
+			wantUser: expectedUser,
+			wantErr:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
-				// Не должен вызываться
-				return repoMocks.NewUserRepositoryMock(mc)
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(minimock.AnyContext, id).Return(expectedUser, nil)
+				mock.MakeLogMock.Return(nil)
+				return mock
 			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
-				// Не должен вызываться
-				return mocks.NewTxManagerMock(mc)
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+					return f(ctx)
+				})
+				return mock
 			},
 			cacheMock: func(mc *minimock.Controller) repository.CacheInterface {
 				mock := repoMocks.NewCacheInterfaceMock(mc)
-				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, repoErr)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, model.ErrCacheUnavailable)
+				mock.CreateMock.Expect(minimock.AnyContext, id, *expectedUser).Return(model.ErrCacheUnavailable)
 				return mock
 			},
 		},
@@ -302,16 +311,12 @@ func TestGet(t *testing.T) {
 			userRepoMock := tt.userRepositoryMock(mc)
 			txManagerMock := tt.txManagerMock(mc)
 			cacheMock := tt.cacheMock(mc)
-			service := user.NewService(userRepoMock, txManagerMock, cacheMock)
+			service := user.NewService(userRepoMock, txManagerMock, cacheMock, nil, nil, nil, nil)
 
 			user, err := service.Get(tt.args.ctx, tt.args.id)
 			if tt.wantErr != nil {
 				require.Error(t, err)
-				if tt.name == "cache returns unexpected error" {
-					require.Contains(t, err.Error(), "error with cache")
-				} else {
-					require.ErrorIs(t, err, tt.wantErr)
-				}
+				require.ErrorIs(t, err, tt.wantErr)
 				require.Equal(t, tt.wantUser, user)
 			} else {
 				require.NoError(t, err)
@@ -320,3 +325,142 @@ func TestGet(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNegativeCache(t *testing.T) {
+	mc := minimock.NewController(t)
+	t.Cleanup(mc.Finish)
+
+	id := gofakeit.Int64()
+
+	t.Run("missing user is cached", func(t *testing.T) {
+		userRepo := repoMocks.NewUserRepositoryMock(mc)
+		userRepo.GetUserMock.Expect(minimock.AnyContext, id).Return(nil, model.ErrUserNotFound)
+
+		cache := repoMocks.NewCacheInterfaceMock(mc)
+		cache.GetMock.Expect(minimock.AnyContext, id).Return(nil, model.ErrUserNotFound)
+		cache.CreateUserNotFoundMock.Expect(minimock.AnyContext, id).Return(nil)
+
+		service := user.NewService(userRepo, passthroughTx(mc), cache, nil, nil, nil, nil)
+		_, err := service.Get(context.Background(), id)
+		require.ErrorIs(t, err, model.ErrUserNotFound)
+	})
+
+	t.Run("cached miss does not reach the database", func(t *testing.T) {
+		cache := repoMocks.NewCacheInterfaceMock(mc)
+		cache.GetMock.Expect(minimock.AnyContext, id).Return(nil, model.ErrCachedNotFound)
+
+		service := user.NewService(repoMocks.NewUserRepositoryMock(mc), mocks.NewTxManagerMock(mc), cache, nil, nil, nil, nil)
+		_, err := service.Get(context.Background(), id)
+		require.ErrorIs(t, err, model.ErrUserNotFound)
+	})
+}
+
+func TestGetCoalescesMisses(t *testing.T) {
+	mc := minimock.NewController(t)
+	t.Cleanup(mc.Finish)
+
+	const callers = 10
+
+	var (
+		id      = gofakeit.Int64()
+		name    = gofakeit.Name()
+		stored  = &model.User{ID: id, User: model.UserInfo{Name: &name}}
+		arrived sync.WaitGroup
+		release = make(chan struct{})
+		loads   atomic.Int32
+	)
+	arrived.Add(callers)
+
+	cache := repoMocks.NewCacheInterfaceMock(mc)
+	cache.GetMock.Set(func(_ context.Context, _ int64) (*model.User, error) {
+		arrived.Done()
+		return nil, model.ErrUserNotFound
+	})
+	cache.CreateMock.Return(nil)
+
+	userRepo := repoMocks.NewUserRepositoryMock(mc)
+	userRepo.GetUserMock.Set(func(_ context.Context, _ int64) (*model.User, error) {
+		loads.Add(1)
+		<-release
+		return stored, nil
+	})
+	userRepo.MakeLogMock.Return(nil)
+
+	service := user.NewService(userRepo, passthroughTx(mc), cache, nil, nil, nil, nil)
+
+	var done sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			got, err := service.Get(context.Background(), id)
+			assert.NoError(t, err)
+			assert.Equal(t, stored, got)
+		}()
+	}
+
+	// Все промахнулись по кэшу; даем им встать в очередь за первым запросом в базу
+	arrived.Wait()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	done.Wait()
+
+	require.Equal(t, int32(1), loads.Load())
+}
+
+func TestGetCanceledCallerDoesNotFailWaiters(t *testing.T) {
+	mc := minimock.NewController(t)
+	t.Cleanup(mc.Finish)
+
+	var (
+		id      = gofakeit.Int64()
+		name    = gofakeit.Name()
+		stored  = &model.User{ID: id, User: model.UserInfo{Name: &name}}
+		started = make(chan struct{})
+		release = make(chan struct{})
+		loads   atomic.Int32
+	)
+
+	cache := repoMocks.NewCacheInterfaceMock(mc)
+	cache.GetMock.Return(nil, model.ErrUserNotFound)
+	cache.CreateMock.Return(nil)
+
+	userRepo := repoMocks.NewUserRepositoryMock(mc)
+	userRepo.GetUserMock.Set(func(ctx context.Context, _ int64) (*model.User, error) {
+		loads.Add(1)
+		close(started)
+		<-release
+		// Отмена первого вызывающего не должна прерывать общую загрузку
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return stored, nil
+	})
+	userRepo.MakeLogMock.Return(nil)
+
+	service := user.NewService(userRepo, passthroughTx(mc), cache, nil, nil, nil, nil)
+
+	firstCtx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := service.Get(firstCtx, id)
+		first <- err
+	}()
+	<-started
+
+	waiter := make(chan error, 1)
+	go func() {
+		got, err := service.Get(context.Background(), id)
+		assert.Equal(t, stored, got)
+		waiter <- err
+	}()
+	// Даем второму вызывающему встать в очередь за первым запросом в базу
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-first, context.Canceled)
+
+	close(release)
+	require.NoError(t, <-waiter)
+	require.Equal(t, int32(1), loads.Load())
+}
@@ -0,0 +1,382 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/passwordpolicy"
+	"github.com/Ippolid/auth/internal/repository"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/requestctx"
+	"github.com/Ippolid/auth/internal/service/mocks"
+	"github.com/Ippolid/auth/internal/service/user"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/Ippolid/platform_libary/pkg/db"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeNotifier передает уведомления в каналы. Сервис вызывает notifier синхронно: в приложении это очередь доставки
+type fakeNotifier struct {
+	resets        chan model.PasswordResetNotice
+	verifications chan model.EmailVerificationNotice
+}
+
+func newFakeNotifier() *fakeNotifier {
+	return &fakeNotifier{
+		resets:        make(chan model.PasswordResetNotice, 1),
+		verifications: make(chan model.EmailVerificationNotice, 1),
+	}
+}
+
+func (n *fakeNotifier) NotifyPasswordReset(_ context.Context, notice model.PasswordResetNotice) error {
+	n.resets <- notice
+	return nil
+}
+
+func (n *fakeNotifier) NotifyEmailVerification(_ context.Context, notice model.EmailVerificationNotice) error {
+	n.verifications <- notice
+	return nil
+}
+
+// withCaller контекст вызова с claims, проверенными интерцептором авторизации
+func withCaller(claims model.UserClaims) context.Context {
+	return requestctx.ContextWithClaims(context.Background(), &claims)
+}
+
+func passthroughTx(mc *minimock.Controller) db.TxManager {
+	mock := mocks.NewTxManagerMock(mc)
+	mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+	return mock
+}
+
+func TestChangePassword(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+	type authRepositoryMockFunc func(mc *minimock.Controller) repository.AuthRepository
+
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		id          = gofakeit.Int64()
+		username    = gofakeit.Username()
+		current     = "Old-Secret-11"
+		newPassword = "New-Secret-22"
+		familyID    = gofakeit.UUID()
+		other       = gofakeit.UUID()
+		stored      = &model.User{User: model.UserInfo{Name: ptr(username), Email: ptr(gofakeit.Email())}}
+
+		ownerCtx = withCaller(model.UserClaims{Username: username, FamilyID: familyID})
+	)
+
+	policy, err := passwordpolicy.New(passwordpolicy.Rules{MinLength: 8, MaxLength: 72, MinCharClasses: 3}, "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		newPassword        string
+		wantCode           codes.Code
+		userRepositoryMock userRepositoryMockFunc
+		authRepositoryMock authRepositoryMockFunc
+		wantRevoked        []string
+	}{
+		{
+			name:        "other sessions revoked",
+			ctx:         ownerCtx,
+			newPassword: newPassword,
+			wantCode:    codes.OK,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(minimock.AnyContext, id).Return(stored, nil)
+				mock.UpdatePasswordMock.Expect(minimock.AnyContext, id, newPassword).Return(nil)
+				mock.MakeLogMock.Return(nil)
+				return mock
+			},
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.LoginMock.Expect(minimock.AnyContext, model.LoginRequest{Username: username, Password: current}).
+					Return(&model.UserInfoJwt{Username: username}, nil)
+				mock.RevokeUserRefreshTokensMock.Expect(minimock.AnyContext, username, familyID).Return([]string{other}, nil)
+				return mock
+			},
+			wantRevoked: []string{other},
+		},
+		{
+			name:        "someone else's account",
+			ctx:         withCaller(model.UserClaims{Username: gofakeit.Username()}),
+			newPassword: newPassword,
+			wantCode:    codes.PermissionDenied,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Return(stored, nil)
+				return mock
+			},
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				return repoMocks.NewAuthRepositoryMock(mc)
+			},
+		},
+		{
+			name:        "wrong current password",
+			ctx:         ownerCtx,
+			newPassword: newPassword,
+			wantCode:    codes.Unauthenticated,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Return(stored, nil)
+				return mock
+			},
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.LoginMock.Return(nil, model.ErrInvalidCredentials)
+				return mock
+			},
+		},
+		{
+			name:        "weak new password",
+			ctx:         ownerCtx,
+			newPassword: username,
+			wantCode:    codes.InvalidArgument,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Return(stored, nil)
+				return mock
+			},
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				mock := repoMocks.NewAuthRepositoryMock(mc)
+				mock.LoginMock.Return(&model.UserInfoJwt{Username: username}, nil)
+				return mock
+			},
+		},
+		{
+			name:        "no access token",
+			ctx:         context.Background(),
+			newPassword: newPassword,
+			wantCode:    codes.Unauthenticated,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			authRepositoryMock: func(mc *minimock.Controller) repository.AuthRepository {
+				return repoMocks.NewAuthRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			t.Cleanup(mc.Finish)
+
+			cache := repoMocks.NewCacheInterfaceMock(mc)
+			var revoked []string
+			cache.CreateRevokedFamilyMock.Optional().Set(func(_ context.Context, familyID string) error {
+				revoked = append(revoked, familyID)
+				return nil
+			})
+
+			service := user.NewService(tt.userRepositoryMock(mc), passthroughTx(mc), cache, policy, tt.authRepositoryMock(mc), nil, nil)
+
+			err := service.ChangePassword(tt.ctx, model.ChangePasswordRequest{
+				ID:              id,
+				CurrentPassword: current,
+				NewPassword:     tt.newPassword,
+			})
+			require.Equal(t, tt.wantCode, status.Code(interceptor.ToStatus(err)))
+			require.Equal(t, tt.wantRevoked, revoked)
+		})
+	}
+}
+
+// loginProtection параметры защиты от перебора паролей
+type loginProtection struct{}
+
+func (loginProtection) MaxFailures() int64             { return 5 }
+func (loginProtection) IPMaxFailures() int64           { return 50 }
+func (loginProtection) FailureWindow() time.Duration   { return 15 * time.Minute }
+func (loginProtection) LockoutDuration() time.Duration { return 15 * time.Minute }
+func (loginProtection) BackoffBase() time.Duration     { return time.Second }
+func (loginProtection) BackoffMax() time.Duration      { return 30 * time.Second }
+
+func TestChangePasswordLoginProtection(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		id       = gofakeit.Int64()
+		username = gofakeit.Username()
+		stored   = &model.User{User: model.UserInfo{Name: ptr(username), Email: ptr(gofakeit.Email())}}
+		subject  = "user:" + strings.ToLower(username)
+		req      = model.ChangePasswordRequest{ID: id, CurrentPassword: "Guess-1234", NewPassword: "New-Secret-22"}
+	)
+
+	t.Run("wrong current password counted as login failure", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		userRepo := repoMocks.NewUserRepositoryMock(mc)
+		userRepo.GetUserMock.Return(stored, nil)
+		authRepo := repoMocks.NewAuthRepositoryMock(mc)
+		authRepo.LoginMock.Return(nil, model.ErrInvalidCredentials)
+
+		cache := repoMocks.NewCacheInterfaceMock(mc)
+		cache.GetLoginBlockMock.Expect(minimock.AnyContext, subject).Return(0, nil)
+		cache.IncLoginFailuresMock.Expect(minimock.AnyContext, subject, 15*time.Minute).Return(1, nil)
+		cache.BlockLoginMock.Expect(minimock.AnyContext, subject, time.Second).Return(nil)
+
+		service := user.NewService(userRepo, passthroughTx(mc), cache, nil, authRepo, loginProtection{}, nil)
+
+		err := service.ChangePassword(withCaller(model.UserClaims{Username: username}), req)
+		require.ErrorIs(t, err, model.ErrInvalidCredentials)
+	})
+
+	t.Run("locked account rejected before password check", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		cache := repoMocks.NewCacheInterfaceMock(mc)
+		cache.GetLoginBlockMock.Expect(minimock.AnyContext, subject).Return(time.Minute, nil)
+
+		service := user.NewService(repoMocks.NewUserRepositoryMock(mc), passthroughTx(mc), cache, nil,
+			repoMocks.NewAuthRepositoryMock(mc), loginProtection{}, nil)
+
+		err := service.ChangePassword(withCaller(model.UserClaims{Username: username}), req)
+		require.ErrorIs(t, err, model.ErrLoginLocked)
+	})
+}
+
+func TestPasswordReset(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		id       = gofakeit.Int64()
+		username = gofakeit.Username()
+		stored   = &model.User{User: model.UserInfo{Name: ptr(username), Email: ptr(gofakeit.Email())}}
+	)
+
+	policy, err := passwordpolicy.New(passwordpolicy.Rules{MinLength: 8, MaxLength: 72, MinCharClasses: 3}, "")
+	require.NoError(t, err)
+
+	t.Run("token stored hashed and redeemed once", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		var storedHash string
+		userRepo := repoMocks.NewUserRepositoryMock(mc)
+		userRepo.GetUserMock.Expect(minimock.AnyContext, id).Return(stored, nil)
+		userRepo.CreatePasswordResetTokenMock.Set(func(_ context.Context, token model.PasswordResetToken) error {
+			require.Equal(t, id, token.UserID)
+			storedHash = token.TokenHash
+			return nil
+		})
+		userRepo.UsePasswordResetTokenMock.Set(func(_ context.Context, tokenHash string) (int64, error) {
+			require.Equal(t, storedHash, tokenHash)
+			return id, nil
+		})
+		userRepo.UpdatePasswordMock.Expect(minimock.AnyContext, id, "Fresh-Start-33").Return(nil)
+		userRepo.MakeLogMock.Return(nil)
+
+		authRepo := repoMocks.NewAuthRepositoryMock(mc)
+		authRepo.RevokeUserRefreshTokensMock.Expect(minimock.AnyContext, username, "").Return(nil, nil)
+
+		service := user.NewService(userRepo, passthroughTx(mc), nil, policy, authRepo, nil, nil)
+
+		resp, err := service.ResetPassword(withCaller(model.UserClaims{Username: "root", Roles: []string{model.RoleAdmin}}), id)
+		require.NoError(t, err)
+		require.NotEqual(t, resp.Token, storedHash)
+		require.Equal(t, utils.HashToken(resp.Token), storedHash)
+		require.True(t, resp.ExpiresAt.After(time.Now()))
+
+		err = service.ConfirmPasswordReset(context.Background(), model.ConfirmPasswordResetRequest{
+			Token:       resp.Token,
+			NewPassword: "Fresh-Start-33",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("weak password rejected before update", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		userRepo := repoMocks.NewUserRepositoryMock(mc)
+		userRepo.UsePasswordResetTokenMock.Return(id, nil)
+		userRepo.GetUserMock.Return(stored, nil)
+
+		service := user.NewService(userRepo, passthroughTx(mc), nil, policy, repoMocks.NewAuthRepositoryMock(mc), nil, nil)
+
+		err := service.ConfirmPasswordReset(context.Background(), model.ConfirmPasswordResetRequest{
+			Token:       gofakeit.UUID(),
+			NewPassword: "short",
+		})
+		require.ErrorIs(t, err, model.ErrWeakPassword)
+	})
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		id    = gofakeit.Int64()
+		email = gofakeit.Email()
+		name  = gofakeit.Username()
+	)
+
+	t.Run("known email gets a link", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		var storedHash string
+		userRepo := repoMocks.NewUserRepositoryMock(mc)
+		userRepo.MakeLogMock.Return(nil)
+		userRepo.GetUserByEmailMock.Expect(minimock.AnyContext, email).
+			Return(&model.User{ID: id, User: model.UserInfo{Name: ptr(name), Email: ptr(email)}}, nil)
+		userRepo.CreatePasswordResetTokenMock.Set(func(_ context.Context, token model.PasswordResetToken) error {
+			require.Equal(t, id, token.UserID)
+			storedHash = token.TokenHash
+			return nil
+		})
+
+		notifications := newFakeNotifier()
+		service := user.NewService(userRepo, passthroughTx(mc), nil, nil, nil, nil, notifications)
+
+		require.NoError(t, service.RequestPasswordReset(context.Background(), email))
+
+		select {
+		case notice := <-notifications.resets:
+			require.Equal(t, email, notice.Email)
+			require.Equal(t, name, notice.Name)
+			require.Equal(t, storedHash, utils.HashToken(notice.Token))
+		case <-time.After(time.Second):
+			t.Fatal("notification was not sent")
+		}
+	})
+
+	t.Run("unknown email looks the same to the caller", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		userRepo := repoMocks.NewUserRepositoryMock(mc)
+		userRepo.MakeLogMock.Return(nil)
+		userRepo.GetUserByEmailMock.Return(nil, model.ErrUserNotFound)
+
+		notifications := newFakeNotifier()
+		service := user.NewService(userRepo, passthroughTx(mc), nil, nil, nil, nil, notifications)
+
+		require.NoError(t, service.RequestPasswordReset(context.Background(), email))
+
+		select {
+		case <-notifications.resets:
+			t.Fatal("notification sent for unknown email")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+}
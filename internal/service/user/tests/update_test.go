@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Ippolid/auth/internal/logger"
 	"github.com/Ippolid/auth/internal/model"
 	"github.com/Ippolid/auth/internal/repository"
 	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
@@ -14,12 +15,15 @@ import (
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
 )
 
 func TestUpdate(t *testing.T) {
 	type userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
 	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
 
+	logger.Init(zapcore.NewNopCore())
+
 	type args struct {
 		ctx  context.Context
 		id   int64
@@ -34,6 +38,7 @@ func TestUpdate(t *testing.T) {
 			Name:  ptr(gofakeit.Name()),
 			Email: ptr(gofakeit.Email()),
 		}
+		stored = &model.User{ID: id, User: info}
 	)
 
 	tests := []struct {
@@ -53,6 +58,7 @@ func TestUpdate(t *testing.T) {
 			wantErr: nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(ctx, id).Return(stored, nil)
 				mock.UpdateUserMock.Expect(ctx, id, info).Return(nil)
 				mock.MakeLogMock.Set(func(_ context.Context, log model.Log) error {
 					if log.Method != "Update" || log.Ctx != Ctxstring {
@@ -71,6 +77,54 @@ func TestUpdate(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "email changed case",
+			args: args{
+				ctx:  ctx,
+				id:   id,
+				info: info,
+			},
+			wantErr: nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(ctx, id).Return(&model.User{ID: id, User: model.UserInfo{
+					Name:  info.Name,
+					Email: ptr("old-" + *info.Email),
+				}}, nil)
+				mock.UpdateUserMock.Expect(ctx, id, info).Return(nil)
+				mock.SetEmailVerifiedMock.Expect(ctx, id, false).Return(nil)
+				mock.CreateEmailVerificationTokenMock.Set(func(_ context.Context, token model.EmailVerificationToken) error {
+					if token.UserID != id || token.Email != *info.Email {
+						return fmt.Errorf("unexpected verification token: %+v", token)
+					}
+					return nil
+				})
+				mock.MakeLogMock.Return(nil)
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				mock := mocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+					return f(ctx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "username that looks like an email rejected",
+			args: args{
+				ctx:  ctx,
+				id:   id,
+				info: model.UserInfo{Name: ptr(gofakeit.Email()), Email: info.Email},
+			},
+			wantErr: model.ErrInvalidUsername,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repoMocks.NewUserRepositoryMock(mc)
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				return mocks.NewTxManagerMock(mc)
+			},
+		},
 		{
 			name: "UpdateUser error case",
 			args: args{
@@ -81,6 +135,7 @@ func TestUpdate(t *testing.T) {
 			wantErr: repoErr,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repoMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(ctx, id).Return(stored, nil)
 				mock.UpdateUserMock.Expect(ctx, id, info).Return(repoErr)
 				// MakeLog и GetUser не должны вызываться при ошибке UpdateUser
 				return mock
@@ -103,10 +158,38 @@ func TestUpdate(t *testing.T) {
 
 			userRepoMock := tt.userRepositoryMock(mc)
 			txManagerMock := tt.txManagerMock(mc)
-			service := user.NewService(userRepoMock, txManagerMock, nil)
+			service := user.NewService(userRepoMock, txManagerMock, nil, nil, nil, nil, nil)
 
 			err := service.Update(tt.args.ctx, tt.args.id, &tt.args.info)
 			require.ErrorIs(t, err, tt.wantErr)
 		})
 	}
 }
+
+func TestUpdateInvalidatesCache(t *testing.T) {
+	mc := minimock.NewController(t)
+	t.Cleanup(mc.Finish)
+
+	var (
+		ctx     = context.Background()
+		id      = gofakeit.Int64()
+		oldName = gofakeit.Username()
+		email   = gofakeit.Email()
+		info    = model.UserInfo{Name: ptr("new-" + oldName), Email: &email}
+	)
+
+	userRepo := repoMocks.NewUserRepositoryMock(mc)
+	userRepo.GetUserMock.Expect(ctx, id).Return(&model.User{ID: id, User: model.UserInfo{Name: &oldName, Email: &email}}, nil)
+	userRepo.UpdateUserMock.Expect(ctx, id, info).Return(nil)
+	userRepo.MakeLogMock.Return(nil)
+
+	// После переименования сбрасываются роли и под старым, и под новым именем
+	cache := repoMocks.NewCacheInterfaceMock(mc)
+	cache.InvalidateMock.Expect(ctx, model.CacheInvalidation{
+		UserIDs:   []int64{id},
+		Usernames: []string{oldName, *info.Name},
+	}).Return(nil)
+
+	service := user.NewService(userRepo, passthroughTx(mc), cache, nil, nil, nil, nil)
+	require.NoError(t, service.Update(ctx, id, &info))
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/interceptor"
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	repoMocks "github.com/Ippolid/auth/internal/repository/mocks"
+	"github.com/Ippolid/auth/internal/service/user"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEmailVerification(t *testing.T) {
+	logger.Init(zapcore.NewNopCore())
+
+	var (
+		id    = gofakeit.Int64()
+		name  = gofakeit.Username()
+		email = gofakeit.Email()
+		info  = &model.User{
+			User:     model.UserInfo{Name: &name, Email: &email},
+			Password: "Secret-Pass-11",
+		}
+	)
+
+	t.Run("create sends a verification link", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		var storedHash string
+		userRepo := repoMocks.NewUserRepositoryMock(mc)
+		userRepo.CreateUserMock.Return(id, nil)
+		userRepo.MakeLogMock.Return(nil)
+		userRepo.GetUserMock.Return(info, nil)
+		userRepo.CreateEmailVerificationTokenMock.Set(func(_ context.Context, token model.EmailVerificationToken) error {
+			require.Equal(t, id, token.UserID)
+			require.Equal(t, email, token.Email)
+			storedHash = token.TokenHash
+			return nil
+		})
+
+		cache := repoMocks.NewCacheInterfaceMock(mc)
+		cache.CreateMock.Return(nil)
+		cache.InvalidateMock.Return(nil)
+
+		notifications := newFakeNotifier()
+		service := user.NewService(userRepo, passthroughTx(mc), cache, nil, nil, nil, notifications)
+
+		_, err := service.Create(context.Background(), info)
+		require.NoError(t, err)
+
+		select {
+		case notice := <-notifications.verifications:
+			require.Equal(t, email, notice.Email)
+			require.Equal(t, storedHash, utils.HashToken(notice.Token))
+		case <-time.After(time.Second):
+			t.Fatal("verification notification was not sent")
+		}
+	})
+
+	t.Run("valid token marks email verified", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		token := gofakeit.UUID()
+		userRepo := repoMocks.NewUserRepositoryMock(mc)
+		userRepo.UseEmailVerificationTokenMock.Expect(minimock.AnyContext, utils.HashToken(token)).Return(id, nil)
+		userRepo.SetEmailVerifiedMock.Expect(minimock.AnyContext, id, true).Return(nil)
+		userRepo.MakeLogMock.Return(nil)
+
+		service := user.NewService(userRepo, passthroughTx(mc), nil, nil, nil, nil, nil)
+		require.NoError(t, service.VerifyEmail(context.Background(), token))
+	})
+
+	t.Run("unknown token is rejected", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		t.Cleanup(mc.Finish)
+
+		userRepo := repoMocks.NewUserRepositoryMock(mc)
+		userRepo.UseEmailVerificationTokenMock.Return(0, model.ErrInvalidVerificationToken)
+
+		service := user.NewService(userRepo, passthroughTx(mc), nil, nil, nil, nil, nil)
+		err := service.VerifyEmail(context.Background(), gofakeit.UUID())
+		require.ErrorIs(t, err, model.ErrInvalidVerificationToken)
+		require.Equal(t, codes.InvalidArgument, status.Code(interceptor.ToStatus(err)))
+	})
+}
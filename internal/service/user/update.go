@@ -9,12 +9,43 @@ import (
 )
 
 func (s *serv) Update(ctx context.Context, id int64, info *model.UserInfo) error {
+	if info == nil {
+		return model.ErrUserInfoRequired
+	}
+	normalized := normalizeUserInfo(*info)
+	info = &normalized
+	if err := validateUsername(info.Name); err != nil {
+		return err
+	}
+
+	var (
+		notice *model.EmailVerificationNotice
+		inv    = model.CacheInvalidation{UserIDs: []int64{id}}
+	)
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		errTx := s.userRepository.UpdateUser(ctx, id, *info)
+		before, errTx := s.userRepository.GetUser(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+		inv.Usernames = renamedUsers(before.User.Name, info.Name)
+
+		errTx = s.userRepository.UpdateUser(ctx, id, *info)
 		if errTx != nil {
 			return errTx
 		}
 
+		// Новый адрес не подтвержден: снимаем отметку и отправляем ссылку на него
+		if emailChanged(before.User.Email, info.Email) {
+			if errTx = s.userRepository.SetEmailVerified(ctx, id, false); errTx != nil {
+				return errTx
+			}
+
+			notice, errTx = s.issueEmailVerification(ctx, id, *info)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
 		err := s.userRepository.MakeLog(ctx, model.Log{
 			Method:    "Update",
 			CreatedAt: time.Now(),
@@ -31,5 +62,8 @@ func (s *serv) Update(ctx context.Context, id int64, info *model.UserInfo) error
 		return err
 	}
 
+	s.invalidateCache(ctx, inv)
+	s.sendEmailVerification(ctx, notice)
+
 	return nil
 }
@@ -0,0 +1,100 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Ippolid/auth/internal/logger"
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/utils"
+	"go.uber.org/zap"
+)
+
+// verificationTokenExpiration срок действия токена подтверждения email
+const verificationTokenExpiration = 24 * time.Hour
+
+// VerifyEmail подтверждает email по одноразовому токену из письма
+func (s *serv) VerifyEmail(ctx context.Context, token string) error {
+	var id int64
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		id, errTx = s.userRepository.UseEmailVerificationToken(ctx, utils.HashToken(token))
+		if errTx != nil {
+			return errTx
+		}
+
+		if errTx = s.userRepository.SetEmailVerified(ctx, id, true); errTx != nil {
+			return errTx
+		}
+
+		return s.userRepository.MakeLog(ctx, model.Log{
+			Method:    "VerifyEmail",
+			CreatedAt: time.Now(),
+			Ctx:       fmt.Sprintf("%v", ctx),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	// В закэшированном профиле verified_at еще пустой
+	s.invalidateCache(ctx, model.CacheInvalidation{UserIDs: []int64{id}})
+
+	return nil
+}
+
+// issueEmailVerification сохраняет хеш нового токена подтверждения и возвращает уведомление для отправки
+// после коммита. Без email подтверждать нечего — возвращается nil. Вызывается внутри транзакции.
+func (s *serv) issueEmailVerification(ctx context.Context, id int64, info model.UserInfo) (*model.EmailVerificationNotice, error) {
+	email := stringValue(info.Email)
+	if email == "" {
+		return nil, nil
+	}
+
+	token, err := utils.NewTokenID()
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(verificationTokenExpiration)
+
+	err = s.userRepository.CreateEmailVerificationToken(ctx, model.EmailVerificationToken{
+		TokenHash: utils.HashToken(token),
+		UserID:    id,
+		Email:     email,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.EmailVerificationNotice{
+		Email:     email,
+		Name:      stringValue(info.Name),
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// sendEmailVerification передает уведомление notifier; в приложении это очередь, которая доставляет
+// его в фоне, не задерживая ответ. Ошибка только логируется — пользователь может запросить новую ссылку,
+// сменив email или обратившись к администратору.
+func (s *serv) sendEmailVerification(ctx context.Context, notice *model.EmailVerificationNotice) {
+	if notice == nil {
+		return
+	}
+	if s.notifier == nil {
+		logger.Warn("notifier is not configured, email verification notification dropped")
+		return
+	}
+
+	if err := s.notifier.NotifyEmailVerification(ctx, *notice); err != nil {
+		logger.Error("failed to deliver email verification notification", zap.Error(err))
+	}
+}
+
+// emailChanged сравнивает адреса без учета регистра
+func emailChanged(before, after *string) bool {
+	return !strings.EqualFold(stringValue(before), stringValue(after))
+}
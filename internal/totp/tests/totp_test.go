@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/totp"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret секрет из тестовых векторов RFC 6238 (SHA1)
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	// RFC 6238, приложение B: последние 6 цифр 8-значных кодов
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, want := range vectors {
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		require.Equal(t, want, code, "time %d", unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := totp.Code(secret, totp.Step(now))
+	require.NoError(t, err)
+
+	step, ok := totp.Validate(secret, code, now)
+	require.True(t, ok)
+	require.Equal(t, totp.Step(now), step)
+
+	// Соседний шаг принимается, дальний — нет
+	_, ok = totp.Validate(secret, code, now.Add(totp.Period))
+	require.True(t, ok)
+	_, ok = totp.Validate(secret, code, now.Add(3*totp.Period))
+	require.False(t, ok)
+
+	_, ok = totp.Validate(secret, "12345", now)
+	require.False(t, ok)
+	_, ok = totp.Validate("not base32!", code, now)
+	require.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	u, err := url.Parse(totp.URI("auth", "alice", rfcSecret))
+	require.NoError(t, err)
+
+	require.Equal(t, "otpauth", u.Scheme)
+	require.Equal(t, "totp", u.Host)
+	require.Equal(t, "/auth:alice", u.Path)
+	require.Equal(t, rfcSecret, u.Query().Get("secret"))
+	require.Equal(t, "auth", u.Query().Get("issuer"))
+	require.Equal(t, "6", u.Query().Get("digits"))
+	require.Equal(t, "30", u.Query().Get("period"))
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeIdentifier приводит имя пользователя или email к форме, в которой они хранятся и сравниваются:
+// без пробелов по краям, в Unicode NFKC, чтобы визуально одинаковые строки (полноширинные символы,
+// составные буквы) совпадали. Регистр не меняется: имя и email сравниваются через LOWER в запросах.
+func NormalizeIdentifier(s string) string {
+	return norm.NFKC.String(strings.TrimSpace(s))
+}
+
+// IsEmailIdentifier сообщает, что идентификатор входа — email. Имена пользователей не содержат '@',
+// поэтому идентификатор однозначно определяется по виду, и имя одного пользователя не может
+// совпасть с email другого.
+func IsEmailIdentifier(identifier string) bool {
+	return strings.Contains(identifier, "@")
+}
+
+// NormalizeIdentifierPtr NormalizeIdentifier для необязательного значения
+func NormalizeIdentifierPtr(s *string) *string {
+	if s == nil {
+		return nil
+	}
+
+	normalized := NormalizeIdentifier(*s)
+	return &normalized
+}
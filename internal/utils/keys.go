@@ -0,0 +1,240 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+// Поддерживаемые алгоритмы подписи токенов
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgES256 = "ES256"
+	AlgEdDSA = "EdDSA"
+)
+
+const (
+	ktyRSA     = "RSA"
+	ktyEC      = "EC"
+	ktyOKP     = "OKP"
+	crvEd25519 = "Ed25519"
+)
+
+// SigningKey ключ подписи JWT-токенов.
+// Для HMAC в Private и Public лежит один и тот же секрет.
+type SigningKey struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.PrivateKey
+	Public  crypto.PublicKey
+	// NotAfter момент, после которого ключ больше не принимается при проверке (нулевое значение — бессрочно)
+	NotAfter time.Time
+}
+
+// KeySet набор ключей одного типа токенов: активный для подписи и все, что еще принимаются при проверке
+type KeySet struct {
+	Active SigningKey
+	Keys   []SigningKey
+}
+
+// Lookup ищет ключ проверки по kid из заголовка токена. Истекшие ключи не возвращаются.
+func (s KeySet) Lookup(kid string, now time.Time) (SigningKey, bool) {
+	for _, key := range s.Keys {
+		if key.ID != kid {
+			continue
+		}
+		if !key.NotAfter.IsZero() && now.After(key.NotAfter) {
+			return SigningKey{}, false
+		}
+
+		return key, true
+	}
+
+	return SigningKey{}, false
+}
+
+// Valid возвращает ключи, которые еще принимаются при проверке
+func (s KeySet) Valid(now time.Time) []SigningKey {
+	keys := make([]SigningKey, 0, len(s.Keys))
+	for _, key := range s.Keys {
+		if key.NotAfter.IsZero() || !now.After(key.NotAfter) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// NewStaticKeySet создает набор из одного ключа, который и подписывает, и проверяет
+func NewStaticKeySet(key SigningKey) KeySet {
+	return KeySet{Active: key, Keys: []SigningKey{key}}
+}
+
+// JWK открытый ключ в формате RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// NewHMACKey создает симметричный ключ HS256 из секрета
+func NewHMACKey(secret []byte) SigningKey {
+	return SigningKey{
+		Method:  jwt.SigningMethodHS256,
+		Private: secret,
+		Public:  secret,
+	}
+}
+
+// ParsePrivateKeyPEM разбирает приватный ключ в PEM и проверяет, что он подходит для алгоритма alg.
+// Идентификатор ключа вычисляется как отпечаток JWK (RFC 7638).
+func ParsePrivateKeyPEM(alg string, data []byte) (SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return SigningKey{}, errors.New("failed to decode PEM block")
+	}
+
+	var (
+		parsed interface{}
+		err    error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		parsed, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return SigningKey{}, errors.Wrap(err, "failed to parse private key")
+	}
+
+	var key SigningKey
+	switch alg {
+	case AlgRS256:
+		private, ok := parsed.(*rsa.PrivateKey)
+		if !ok {
+			return SigningKey{}, errors.Errorf("%s requires an RSA key", alg)
+		}
+		key = SigningKey{Method: jwt.SigningMethodRS256, Private: private, Public: &private.PublicKey}
+	case AlgES256:
+		private, ok := parsed.(*ecdsa.PrivateKey)
+		if !ok || private.Curve != elliptic.P256() {
+			return SigningKey{}, errors.Errorf("%s requires an ECDSA P-256 key", alg)
+		}
+		key = SigningKey{Method: jwt.SigningMethodES256, Private: private, Public: &private.PublicKey}
+	case AlgEdDSA:
+		private, ok := parsed.(ed25519.PrivateKey)
+		if !ok {
+			return SigningKey{}, errors.Errorf("%s requires an Ed25519 key", alg)
+		}
+		key = SigningKey{Method: jwt.SigningMethodEdDSA, Private: private, Public: private.Public()}
+	default:
+		return SigningKey{}, errors.Errorf("unsupported signing algorithm %q", alg)
+	}
+
+	jwk, ok := key.JWK()
+	if !ok {
+		return SigningKey{}, errors.Errorf("failed to build JWK for %s key", alg)
+	}
+	key.ID = jwk.Kid
+
+	return key, nil
+}
+
+// JWK возвращает открытую часть ключа в формате JWK.
+// Для симметричных ключей возвращает false: их нельзя публиковать.
+func (k SigningKey) JWK() (JWK, bool) {
+	var jwk JWK
+	switch public := k.Public.(type) {
+	case *rsa.PublicKey:
+		jwk = JWK{
+			Kty: ktyRSA,
+			N:   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		ecdhKey, err := public.ECDH()
+		if err != nil {
+			return JWK{}, false
+		}
+		// Несжатая точка: 0x04 || X || Y
+		point := ecdhKey.Bytes()[1:]
+		size := len(point) / 2
+		jwk = JWK{
+			Kty: ktyEC,
+			Crv: public.Curve.Params().Name,
+			X:   base64.RawURLEncoding.EncodeToString(point[:size]),
+			Y:   base64.RawURLEncoding.EncodeToString(point[size:]),
+		}
+	case ed25519.PublicKey:
+		jwk = JWK{
+			Kty: ktyOKP,
+			Crv: crvEd25519,
+			X:   base64.RawURLEncoding.EncodeToString(public),
+		}
+	default:
+		return JWK{}, false
+	}
+
+	jwk.Use = "sig"
+	jwk.Alg = k.Method.Alg()
+	jwk.Kid = k.ID
+	if jwk.Kid == "" {
+		jwk.Kid = thumbprint(jwk)
+	}
+
+	return jwk, true
+}
+
+// thumbprint вычисляет отпечаток JWK по RFC 7638: SHA-256 от обязательных полей в лексикографическом порядке
+func thumbprint(jwk JWK) string {
+	var members interface{}
+	switch jwk.Kty {
+	case ktyRSA:
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	case ktyEC:
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}{jwk.Crv, jwk.Kty, jwk.X, jwk.Y}
+	default:
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	}
+
+	// Маршалинг структуры из строк не может завершиться ошибкой
+	data, _ := json.Marshal(members)
+	sum := sha256.Sum256(data)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeIdentifier(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "trims spaces", in: "  alice@example.com\t", want: "alice@example.com"},
+		{name: "keeps case", in: "Alice@Example.com", want: "Alice@Example.com"},
+		{name: "fullwidth letters", in: "ａｌｉｃｅ", want: "alice"},
+		{name: "combining accent", in: "jose\u0301", want: "jos\u00e9"},
+		{name: "ligature", in: "ﬁona", want: "fiona"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, utils.NormalizeIdentifier(tt.in))
+		})
+	}
+
+	require.Nil(t, utils.NormalizeIdentifierPtr(nil))
+}
+
+func TestIsEmailIdentifier(t *testing.T) {
+	require.True(t, utils.IsEmailIdentifier("alice@example.com"))
+	require.False(t, utils.IsEmailIdentifier("alice"))
+	// Полноширинный '@' после нормализации тоже делает идентификатор email
+	require.True(t, utils.IsEmailIdentifier(utils.NormalizeIdentifier("alice＠example.com")))
+}
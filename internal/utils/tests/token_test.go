@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/Ippolid/auth/internal/model"
+	"github.com/Ippolid/auth/internal/utils"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func toPEM(t *testing.T, key interface{}) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestAsymmetricTokens(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		alg     string
+		pem     []byte
+		wantKty string
+	}{
+		{name: "RS256", alg: utils.AlgRS256, pem: toPEM(t, rsaKey), wantKty: "RSA"},
+		{name: "ES256", alg: utils.AlgES256, pem: toPEM(t, ecKey), wantKty: "EC"},
+		{name: "EdDSA", alg: utils.AlgEdDSA, pem: toPEM(t, edKey), wantKty: "OKP"},
+	}
+
+	info := model.UserInfoJwt{Username: gofakeit.Username(), Roles: []string{model.RoleAdmin}}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := utils.ParsePrivateKeyPEM(tt.alg, tt.pem)
+			require.NoError(t, err)
+			require.NotEmpty(t, key.ID)
+
+			token, err := utils.GenerateToken(info, key, time.Minute)
+			require.NoError(t, err)
+
+			claims, err := utils.VerifyToken(token, utils.NewStaticKeySet(key))
+			require.NoError(t, err)
+			require.Equal(t, info.Username, claims.Username)
+			require.Equal(t, []string{model.RoleAdmin}, claims.Roles)
+			require.Equal(t, model.RoleAdmin, claims.Role)
+
+			jwk, ok := key.JWK()
+			require.True(t, ok)
+			require.Equal(t, tt.wantKty, jwk.Kty)
+			require.Equal(t, tt.alg, jwk.Alg)
+			require.Equal(t, key.ID, jwk.Kid)
+
+			// Токен, подписанный HMAC-секретом, не должен приниматься асимметричным ключом
+			hmacKey := utils.NewHMACKey([]byte("secret"))
+			hmacKey.ID = key.ID
+			forged, err := utils.GenerateToken(info, hmacKey, time.Minute)
+			require.NoError(t, err)
+			_, err = utils.VerifyToken(forged, utils.NewStaticKeySet(key))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParsePrivateKeyPEMWrongAlgorithm(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	_, err = utils.ParsePrivateKeyPEM(utils.AlgRS256, toPEM(t, ecKey))
+	require.Error(t, err)
+
+	_, ok := utils.NewHMACKey([]byte("secret")).JWK()
+	require.False(t, ok)
+}
@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/Ippolid/auth/internal/model"
@@ -8,33 +11,75 @@ import (
 	"github.com/pkg/errors"
 )
 
-// GenerateToken создает JWT-токен для пользователя с заданной информацией, секретным ключом и временем действия
-func GenerateToken(info model.UserInfoJwt, secretKey []byte, duration time.Duration) (string, error) {
+const tokenIDLength = 16
+
+// GenerateToken создает JWT-токен для пользователя с заданной информацией, ключом подписи и временем действия
+func GenerateToken(info model.UserInfoJwt, key SigningKey, duration time.Duration) (string, error) {
 	claims := model.UserClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        info.TokenID,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
 		},
 		Username: info.Username,
-		Role:     map[bool]string{true: "admin", false: "user"}[info.Role],
+		Roles:    info.Roles,
+		Role:     legacyRole(info.Roles),
+		FamilyID: info.FamilyID,
+		Limited:  info.Limited,
+		UserID:   info.UserID,
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(key.Private)
+}
+
+func legacyRole(roles []string) string {
+	if model.HasRole(roles, model.RoleAdmin) {
+		return model.RoleAdmin
+	}
 
-	return token.SignedString(secretKey)
+	return model.RoleUser
 }
 
-// VerifyToken проверяет JWT-токен и возвращает информацию о пользователе, если токен действителен
-func VerifyToken(tokenStr string, secretKey []byte) (*model.UserClaims, error) {
+// NewTokenID генерирует случайный идентификатор для jti и семейства токенов
+func NewTokenID() (string, error) {
+	buf := make([]byte, tokenIDLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.Wrap(err, "failed to generate token id")
+	}
+
+	return hex.EncodeToString(buf), nil
+}
+
+// HashToken хеш одноразового токена для хранения в базе: по утечке таблицы токеном воспользоваться нельзя
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyToken проверяет JWT-токен и возвращает информацию о пользователе, если токен действителен.
+// Ключ выбирается по kid из заголовка; токены без kid проверяются ключом без идентификатора.
+func VerifyToken(tokenStr string, keys KeySet) (*model.UserClaims, error) {
 	token, err := jwt.ParseWithClaims(
 		tokenStr,
 		&model.UserClaims{},
 		func(token *jwt.Token) (interface{}, error) {
-			_, ok := token.Method.(*jwt.SigningMethodHMAC)
+			kid, _ := token.Header["kid"].(string)
+
+			key, ok := keys.Lookup(kid, time.Now())
 			if !ok {
+				return nil, errors.Errorf("unknown signing key %q", kid)
+			}
+
+			// Принимаем только алгоритм ключа, иначе открытый ключ можно подсунуть как HMAC-секрет
+			if token.Method.Alg() != key.Method.Alg() {
 				return nil, errors.Errorf("unexpected token signing method")
 			}
 
-			return secretKey, nil
+			return key.Public, nil
 		},
 	)
 	if err != nil {
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Выданные refresh-токены: каждый токен можно обменять ровно один раз
+CREATE TABLE refresh_tokens (
+    id          TEXT        PRIMARY KEY,
+    family_id   TEXT        NOT NULL,
+    username    TEXT        NOT NULL,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    rotated_at  TIMESTAMPTZ,
+    revoked_at  TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX refresh_tokens_family_id_idx ON refresh_tokens (family_id);
+CREATE INDEX refresh_tokens_username_idx ON refresh_tokens (username);
+
+-- +goose Down
+DROP TABLE refresh_tokens;
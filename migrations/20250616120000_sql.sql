@@ -0,0 +1,76 @@
+-- +goose Up
+-- Именованные роли вместо булевого флага users_table.role
+CREATE TABLE roles (
+    id          SERIAL      PRIMARY KEY,
+    name        TEXT        NOT NULL UNIQUE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO roles (name) VALUES ('user'), ('admin');
+
+CREATE TABLE user_roles (
+    user_id  INT NOT NULL REFERENCES users_table (id) ON DELETE CASCADE,
+    role_id  INT NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, role_id)
+);
+
+INSERT INTO user_roles (user_id, role_id)
+SELECT u.id, r.id
+FROM users_table u
+JOIN roles r ON r.name = CASE WHEN u.role THEN 'admin' ELSE 'user' END;
+
+ALTER TABLE users_table DROP COLUMN role;
+
+-- Права ролей на эндпоинты. Таблица access раньше создавалась вручную с булевой колонкой role,
+-- поэтому переносим ее содержимое, если она есть.
+CREATE TABLE IF NOT EXISTS access (
+    endpoint  TEXT NOT NULL,
+    role      BOOL NOT NULL
+);
+
+ALTER TABLE access RENAME TO access_legacy;
+
+CREATE TABLE access (
+    role_id     INT         NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    endpoint    TEXT        NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (role_id, endpoint)
+);
+
+INSERT INTO access (role_id, endpoint)
+SELECT DISTINCT r.id, l.endpoint
+FROM access_legacy l
+JOIN roles r ON r.name = CASE WHEN l.role THEN 'admin' ELSE 'user' END;
+
+DROP TABLE access_legacy;
+
+-- +goose Down
+CREATE TABLE access_legacy (
+    endpoint  TEXT NOT NULL,
+    role      BOOL NOT NULL
+);
+
+INSERT INTO access_legacy (endpoint, role)
+SELECT DISTINCT a.endpoint, r.name = 'admin'
+FROM access a
+JOIN roles r ON r.id = a.role_id
+WHERE r.name IN ('user', 'admin');
+
+DROP TABLE access;
+ALTER TABLE access_legacy RENAME TO access;
+
+ALTER TABLE users_table ADD COLUMN role BOOL NOT NULL DEFAULT FALSE;
+
+UPDATE users_table u
+SET role = TRUE
+WHERE EXISTS (
+    SELECT 1
+    FROM user_roles ur
+    JOIN roles r ON r.id = ur.role_id
+    WHERE ur.user_id = u.id AND r.name = 'admin'
+);
+
+ALTER TABLE users_table ALTER COLUMN role DROP DEFAULT;
+
+DROP TABLE user_roles;
+DROP TABLE roles;
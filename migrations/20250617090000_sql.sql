@@ -0,0 +1,14 @@
+-- +goose Up
+-- Журнал изменений ролей, прав и назначений ролей пользователям
+CREATE TABLE audit_log (
+    id          SERIAL      PRIMARY KEY,
+    actor       TEXT        NOT NULL,
+    action      TEXT        NOT NULL,
+    target      TEXT        NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at);
+
+-- +goose Down
+DROP TABLE audit_log;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Одноразовые токены сброса пароля. Хранится только SHA-256 токена.
+CREATE TABLE password_reset_tokens (
+    token_hash  TEXT        PRIMARY KEY,
+    user_id     INT         NOT NULL REFERENCES users_table (id) ON DELETE CASCADE,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    used_at     TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX password_reset_tokens_user_id_idx ON password_reset_tokens (user_id);
+
+-- +goose Down
+DROP TABLE password_reset_tokens;
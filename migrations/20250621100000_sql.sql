@@ -0,0 +1,21 @@
+-- +goose Up
+-- Подтверждение email. Существующие учетные записи считаются подтвержденными, чтобы не закрыть им вход.
+ALTER TABLE users_table ADD COLUMN verified_at TIMESTAMPTZ;
+
+UPDATE users_table SET verified_at = COALESCE(created_at, NOW());
+
+-- Токен подтверждает конкретный адрес: после смены email старые токены не действуют
+CREATE TABLE email_verification_tokens (
+    token_hash  TEXT        PRIMARY KEY,
+    user_id     INT         NOT NULL REFERENCES users_table (id) ON DELETE CASCADE,
+    email       TEXT        NOT NULL,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    used_at     TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX email_verification_tokens_user_id_idx ON email_verification_tokens (user_id);
+
+-- +goose Down
+DROP TABLE email_verification_tokens;
+ALTER TABLE users_table DROP COLUMN verified_at;
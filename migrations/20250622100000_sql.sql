@@ -0,0 +1,10 @@
+-- +goose Up
+-- Хеш argon2id в формате PHC длиннее bcrypt и с большими параметрами не укладывается в 100 символов
+ALTER TABLE users_table
+    DROP CONSTRAINT check_password_length,
+    ADD CONSTRAINT check_password_length CHECK (length(password) <= 255);
+
+-- +goose Down
+ALTER TABLE users_table
+    DROP CONSTRAINT check_password_length,
+    ADD CONSTRAINT check_password_length CHECK (length(password) <= 100);
@@ -0,0 +1,38 @@
+-- +goose Up
+-- Двухфакторная аутентификация TOTP. confirmed_at пуст, пока пользователь не подтвердил первый код;
+-- last_used_step — последний принятый шаг, чтобы один код нельзя было использовать дважды.
+CREATE TABLE user_totp (
+    user_id         INT         PRIMARY KEY REFERENCES users_table (id) ON DELETE CASCADE,
+    secret          TEXT        NOT NULL,
+    confirmed_at    TIMESTAMPTZ,
+    last_used_step  BIGINT      NOT NULL DEFAULT 0,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Одноразовые коды восстановления. Хранится только SHA-256 кода.
+CREATE TABLE recovery_codes (
+    code_hash   TEXT        PRIMARY KEY,
+    user_id     INT         NOT NULL REFERENCES users_table (id) ON DELETE CASCADE,
+    used_at     TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX recovery_codes_user_id_idx ON recovery_codes (user_id);
+
+-- Второй шаг входа: выдается Login после проверки пароля и обменивается на refresh-токен в VerifyMFA
+CREATE TABLE mfa_challenges (
+    token_hash  TEXT        PRIMARY KEY,
+    user_id     INT         NOT NULL REFERENCES users_table (id) ON DELETE CASCADE,
+    limited     BOOLEAN     NOT NULL DEFAULT FALSE,
+    attempts    INT         NOT NULL DEFAULT 0,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    used_at     TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX mfa_challenges_user_id_idx ON mfa_challenges (user_id);
+
+-- +goose Down
+DROP TABLE mfa_challenges;
+DROP TABLE recovery_codes;
+DROP TABLE user_totp;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- Email сравнивается без учета регистра, в форме NFKC, как его нормализует сервис перед записью
+UPDATE users_table
+SET email = normalize(email, NFKC)
+WHERE email IS NOT NULL AND email IS NOT NFKC NORMALIZED;
+
+-- Адреса, отличающиеся только регистром, не дадут создать индекс: перечисляем их и прерываем миграцию,
+-- чтобы дубликаты разобрали вручную
+-- +goose StatementBegin
+DO $$
+DECLARE
+    duplicates TEXT;
+BEGIN
+    SELECT string_agg(format('%s (ids %s)', email_key, ids), '; ' ORDER BY email_key)
+    INTO duplicates
+    FROM (
+        SELECT LOWER(email) AS email_key, string_agg(id::TEXT, ', ' ORDER BY id) AS ids
+        FROM users_table
+        WHERE email IS NOT NULL
+        GROUP BY LOWER(email)
+        HAVING COUNT(*) > 1
+    ) d;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'duplicate emails in users_table, resolve them before migrating: %', duplicates;
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+CREATE UNIQUE INDEX users_email_lower_key ON users_table (LOWER(email));
+
+-- +goose Down
+-- Нормализация адресов не откатывается
+DROP INDEX users_email_lower_key;
@@ -0,0 +1,46 @@
+-- +goose Up
+-- Имена и адреса хранятся без пробелов по краям, в форме NFKC, как их нормализует сервис перед записью,
+-- а имена, как и email, уникальны без учета регистра. Совпадения после нормализации не дадут ни обновить
+-- строки, ни создать индекс: перечисляем их и прерываем миграцию, чтобы дубликаты разобрали вручную.
+-- Имена с '@' не меняются: Login принимает такой идентификатор за email, и эти пользователи входят по адресу.
+-- +goose StatementBegin
+DO $$
+DECLARE
+    duplicates TEXT;
+BEGIN
+    SELECT string_agg(format('%s %s (ids %s)', kind, identifier_key, ids), '; ' ORDER BY kind, identifier_key)
+    INTO duplicates
+    FROM (
+        SELECT 'name' AS kind, LOWER(normalize(btrim(name), NFKC)) AS identifier_key,
+               string_agg(id::TEXT, ', ' ORDER BY id) AS ids
+        FROM users_table
+        WHERE name IS NOT NULL
+        GROUP BY LOWER(normalize(btrim(name), NFKC))
+        HAVING COUNT(*) > 1
+        UNION ALL
+        SELECT 'email', LOWER(normalize(btrim(email), NFKC)), string_agg(id::TEXT, ', ' ORDER BY id)
+        FROM users_table
+        WHERE email IS NOT NULL
+        GROUP BY LOWER(normalize(btrim(email), NFKC))
+        HAVING COUNT(*) > 1
+    ) d;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'duplicate users in users_table after normalization, resolve them before migrating: %', duplicates;
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+UPDATE users_table
+SET name = normalize(btrim(name), NFKC)
+WHERE name IS NOT NULL AND name <> normalize(btrim(name), NFKC);
+
+UPDATE users_table
+SET email = normalize(btrim(email), NFKC)
+WHERE email IS NOT NULL AND email <> normalize(btrim(email), NFKC);
+
+CREATE UNIQUE INDEX users_name_lower_key ON users_table (LOWER(name));
+
+-- +goose Down
+-- Нормализация имен и адресов не откатывается
+DROP INDEX users_name_lower_key;
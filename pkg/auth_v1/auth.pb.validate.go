@@ -180,6 +180,8 @@ func (m *LoginResponse) validate(all bool) error {
 
 	// no validation rules for RefreshToken
 
+	// no validation rules for MfaToken
+
 	if len(errors) > 0 {
 		return LoginResponseMultiError(errors)
 	}
@@ -774,3 +776,1689 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CheckRequestValidationError{}
+
+// Validate checks the field values on CheckManyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CheckManyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckManyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckManyRequestMultiError, or nil if none found.
+func (m *CheckManyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckManyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetEndpointAddresses()); l < 1 || l > 100 {
+		err := CheckManyRequestValidationError{
+			field:  "EndpointAddresses",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEndpointAddresses() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CheckManyRequestValidationError{
+				field:  fmt.Sprintf("EndpointAddresses[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckManyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckManyRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckManyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CheckManyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckManyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckManyRequestMultiError) AllErrors() []error { return m }
+
+// CheckManyRequestValidationError is the validation error returned by
+// CheckManyRequest.Validate if the designated constraints aren't met.
+type CheckManyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckManyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckManyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckManyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckManyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckManyRequestValidationError) ErrorName() string { return "CheckManyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckManyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckManyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckManyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckManyRequestValidationError{}
+
+// Validate checks the field values on AccessRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessRuleMultiError, or
+// nil if none found.
+func (m *AccessRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pattern
+
+	// no validation rules for Effect
+
+	if len(errors) > 0 {
+		return AccessRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessRuleMultiError is an error wrapping multiple validation errors
+// returned by AccessRule.ValidateAll() if the designated constraints aren't met.
+type AccessRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessRuleMultiError) AllErrors() []error { return m }
+
+// AccessRuleValidationError is the validation error returned by
+// AccessRule.Validate if the designated constraints aren't met.
+type AccessRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessRuleValidationError) ErrorName() string { return "AccessRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessRuleValidationError{}
+
+// Validate checks the field values on AccessGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessGrant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessGrantMultiError, or
+// nil if none found.
+func (m *AccessGrant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessGrant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	// no validation rules for Endpoint
+
+	if len(errors) > 0 {
+		return AccessGrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessGrantMultiError is an error wrapping multiple validation errors
+// returned by AccessGrant.ValidateAll() if the designated constraints aren't met.
+type AccessGrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessGrantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessGrantMultiError) AllErrors() []error { return m }
+
+// AccessGrantValidationError is the validation error returned by
+// AccessGrant.Validate if the designated constraints aren't met.
+type AccessGrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessGrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessGrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessGrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessGrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessGrantValidationError) ErrorName() string { return "AccessGrantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessGrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessGrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessGrantValidationError{}
+
+// Validate checks the field values on EndpointDecision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EndpointDecision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EndpointDecision with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EndpointDecisionMultiError, or nil if none found.
+func (m *EndpointDecision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EndpointDecision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EndpointAddress
+
+	// no validation rules for Allowed
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetMatchedRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EndpointDecisionValidationError{
+					field:  "MatchedRule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EndpointDecisionValidationError{
+					field:  "MatchedRule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatchedRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EndpointDecisionValidationError{
+				field:  "MatchedRule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGrant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EndpointDecisionValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EndpointDecisionValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGrant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EndpointDecisionValidationError{
+				field:  "Grant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EndpointDecisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// EndpointDecisionMultiError is an error wrapping multiple validation errors
+// returned by EndpointDecision.ValidateAll() if the designated constraints
+// aren't met.
+type EndpointDecisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EndpointDecisionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EndpointDecisionMultiError) AllErrors() []error { return m }
+
+// EndpointDecisionValidationError is the validation error returned by
+// EndpointDecision.Validate if the designated constraints aren't met.
+type EndpointDecisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndpointDecisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndpointDecisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndpointDecisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndpointDecisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndpointDecisionValidationError) ErrorName() string { return "EndpointDecisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EndpointDecisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndpointDecision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndpointDecisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndpointDecisionValidationError{}
+
+// Validate checks the field values on CheckManyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CheckManyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckManyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckManyResponseMultiError, or nil if none found.
+func (m *CheckManyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckManyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckManyResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckManyResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckManyResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckManyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckManyResponseMultiError is an error wrapping multiple validation errors
+// returned by CheckManyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CheckManyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckManyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckManyResponseMultiError) AllErrors() []error { return m }
+
+// CheckManyResponseValidationError is the validation error returned by
+// CheckManyResponse.Validate if the designated constraints aren't met.
+type CheckManyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckManyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckManyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckManyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckManyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckManyResponseValidationError) ErrorName() string {
+	return "CheckManyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckManyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckManyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckManyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckManyResponseValidationError{}
+
+// Validate checks the field values on ExplainAccessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExplainAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainAccessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExplainAccessRequestMultiError, or nil if none found.
+func (m *ExplainAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetEndpointAddress()) < 1 {
+		err := ExplainAccessRequestValidationError{
+			field:  "EndpointAddress",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Username
+
+	if len(errors) > 0 {
+		return ExplainAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplainAccessRequestMultiError is an error wrapping multiple validation
+// errors returned by ExplainAccessRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExplainAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainAccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainAccessRequestMultiError) AllErrors() []error { return m }
+
+// ExplainAccessRequestValidationError is the validation error returned by
+// ExplainAccessRequest.Validate if the designated constraints aren't met.
+type ExplainAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainAccessRequestValidationError) ErrorName() string {
+	return "ExplainAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainAccessRequestValidationError{}
+
+// Validate checks the field values on ExplainAccessResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExplainAccessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainAccessResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExplainAccessResponseMultiError, or nil if none found.
+func (m *ExplainAccessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainAccessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDecision()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplainAccessResponseValidationError{
+					field:  "Decision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplainAccessResponseValidationError{
+					field:  "Decision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainAccessResponseValidationError{
+				field:  "Decision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExplainAccessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplainAccessResponseMultiError is an error wrapping multiple validation
+// errors returned by ExplainAccessResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExplainAccessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainAccessResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainAccessResponseMultiError) AllErrors() []error { return m }
+
+// ExplainAccessResponseValidationError is the validation error returned by
+// ExplainAccessResponse.Validate if the designated constraints aren't met.
+type ExplainAccessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainAccessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainAccessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainAccessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainAccessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainAccessResponseValidationError) ErrorName() string {
+	return "ExplainAccessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainAccessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainAccessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainAccessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainAccessResponseValidationError{}
+
+// Validate checks the field values on LogoutRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogoutRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogoutRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogoutRequestMultiError, or
+// nil if none found.
+func (m *LogoutRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogoutRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRefreshToken()) < 1 {
+		err := LogoutRequestValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LogoutRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogoutRequestMultiError is an error wrapping multiple validation errors
+// returned by LogoutRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LogoutRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogoutRequestMultiError) AllErrors() []error { return m }
+
+// LogoutRequestValidationError is the validation error returned by
+// LogoutRequest.Validate if the designated constraints aren't met.
+type LogoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogoutRequestValidationError) ErrorName() string { return "LogoutRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutRequestValidationError{}
+
+// Validate checks the field values on LogoutAllRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LogoutAllRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogoutAllRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LogoutAllRequestMultiError, or nil if none found.
+func (m *LogoutAllRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogoutAllRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRefreshToken()) < 1 {
+		err := LogoutAllRequestValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LogoutAllRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogoutAllRequestMultiError is an error wrapping multiple validation errors
+// returned by LogoutAllRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LogoutAllRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutAllRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogoutAllRequestMultiError) AllErrors() []error { return m }
+
+// LogoutAllRequestValidationError is the validation error returned by
+// LogoutAllRequest.Validate if the designated constraints aren't met.
+type LogoutAllRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogoutAllRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogoutAllRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogoutAllRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogoutAllRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogoutAllRequestValidationError) ErrorName() string { return "LogoutAllRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogoutAllRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogoutAllRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogoutAllRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutAllRequestValidationError{}
+
+// Validate checks the field values on UnlockLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlockLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlockLoginRequestMultiError, or nil if none found.
+func (m *UnlockLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUsername()) > 255 {
+		err := UnlockLoginRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetIp() != "" {
+
+		if ip := net.ParseIP(m.GetIp()); ip == nil {
+			err := UnlockLoginRequestValidationError{
+				field:  "Ip",
+				reason: "value must be a valid IP address",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UnlockLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockLoginRequestMultiError is an error wrapping multiple validation errors
+// returned by UnlockLoginRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockLoginRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockLoginRequestMultiError) AllErrors() []error { return m }
+
+// UnlockLoginRequestValidationError is the validation error returned by
+// UnlockLoginRequest.Validate if the designated constraints aren't met.
+type UnlockLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockLoginRequestValidationError) ErrorName() string {
+	return "UnlockLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlockLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockLoginRequestValidationError{}
+
+// Validate checks the field values on EnrollTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTPResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTOTPResponseMultiError, or nil if none found.
+func (m *EnrollTOTPResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTOTPResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for Uri
+
+	if len(errors) > 0 {
+		return EnrollTOTPResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTOTPResponseMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTPResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollTOTPResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTOTPResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTOTPResponseMultiError) AllErrors() []error { return m }
+
+// EnrollTOTPResponseValidationError is the validation error returned by
+// EnrollTOTPResponse.Validate if the designated constraints aren't met.
+type EnrollTOTPResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTOTPResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTOTPResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTOTPResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTOTPResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTOTPResponseValidationError) ErrorName() string {
+	return "EnrollTOTPResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTOTPResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTOTPResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTOTPResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTOTPResponseValidationError{}
+
+// Validate checks the field values on ConfirmTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTOTPRequestMultiError, or nil if none found.
+func (m *ConfirmTOTPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) != 6 {
+		err := ConfirmTOTPRequestValidationError{
+			field:  "Code",
+			reason: "value length must be 6 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_ConfirmTOTPRequest_Code_Pattern.MatchString(m.GetCode()) {
+		err := ConfirmTOTPRequestValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConfirmTOTPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by ConfirmTOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ConfirmTOTPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTPRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTPRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTPRequestValidationError is the validation error returned by
+// ConfirmTOTPRequest.Validate if the designated constraints aren't met.
+type ConfirmTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTPRequestValidationError) ErrorName() string {
+	return "ConfirmTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTPRequestValidationError{}
+
+var _ConfirmTOTPRequest_Code_Pattern = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks the field values on ConfirmTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTPResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTOTPResponseMultiError, or nil if none found.
+func (m *ConfirmTOTPResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTPResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ConfirmTOTPResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTOTPResponseMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTOTPResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTOTPResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTPResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTPResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTPResponseValidationError is the validation error returned by
+// ConfirmTOTPResponse.Validate if the designated constraints aren't met.
+type ConfirmTOTPResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTPResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTPResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTPResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTPResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTPResponseValidationError) ErrorName() string {
+	return "ConfirmTOTPResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTPResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTPResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTPResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTPResponseValidationError{}
+
+// Validate checks the field values on VerifyMFARequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifyMFARequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyMFARequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyMFARequestMultiError, or nil if none found.
+func (m *VerifyMFARequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyMFARequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetMfaToken()); l < 1 || l > 255 {
+		err := VerifyMFARequestValidationError{
+			field:  "MfaToken",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 6 || l > 64 {
+		err := VerifyMFARequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 6 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyMFARequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyMFARequestMultiError is an error wrapping multiple validation errors
+// returned by VerifyMFARequest.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyMFARequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyMFARequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyMFARequestMultiError) AllErrors() []error { return m }
+
+// VerifyMFARequestValidationError is the validation error returned by
+// VerifyMFARequest.Validate if the designated constraints aren't met.
+type VerifyMFARequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyMFARequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyMFARequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyMFARequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyMFARequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyMFARequestValidationError) ErrorName() string { return "VerifyMFARequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VerifyMFARequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyMFARequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyMFARequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyMFARequestValidationError{}